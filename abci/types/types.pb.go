@@ -38,6 +38,80 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+type ResponseOfferSnapshot_Result int32
+
+const (
+	ResponseOfferSnapshot_UNKNOWN       ResponseOfferSnapshot_Result = 0
+	ResponseOfferSnapshot_ACCEPT        ResponseOfferSnapshot_Result = 1
+	ResponseOfferSnapshot_ABORT         ResponseOfferSnapshot_Result = 2
+	ResponseOfferSnapshot_REJECT        ResponseOfferSnapshot_Result = 3
+	ResponseOfferSnapshot_REJECT_FORMAT ResponseOfferSnapshot_Result = 4
+	ResponseOfferSnapshot_REJECT_SENDER ResponseOfferSnapshot_Result = 5
+)
+
+var ResponseOfferSnapshot_Result_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "ACCEPT",
+	2: "ABORT",
+	3: "REJECT",
+	4: "REJECT_FORMAT",
+	5: "REJECT_SENDER",
+}
+
+var ResponseOfferSnapshot_Result_value = map[string]int32{
+	"UNKNOWN":       0,
+	"ACCEPT":        1,
+	"ABORT":         2,
+	"REJECT":        3,
+	"REJECT_FORMAT": 4,
+	"REJECT_SENDER": 5,
+}
+
+func (x ResponseOfferSnapshot_Result) String() string {
+	return proto.EnumName(ResponseOfferSnapshot_Result_name, int32(x))
+}
+
+func (ResponseOfferSnapshot_Result) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_types_5b877df1938afe10, []int{44, 0}
+}
+
+type ResponseApplySnapshotChunk_Result int32
+
+const (
+	ResponseApplySnapshotChunk_UNKNOWN         ResponseApplySnapshotChunk_Result = 0
+	ResponseApplySnapshotChunk_ACCEPT          ResponseApplySnapshotChunk_Result = 1
+	ResponseApplySnapshotChunk_ABORT           ResponseApplySnapshotChunk_Result = 2
+	ResponseApplySnapshotChunk_RETRY           ResponseApplySnapshotChunk_Result = 3
+	ResponseApplySnapshotChunk_RETRY_SNAPSHOT  ResponseApplySnapshotChunk_Result = 4
+	ResponseApplySnapshotChunk_REJECT_SNAPSHOT ResponseApplySnapshotChunk_Result = 5
+)
+
+var ResponseApplySnapshotChunk_Result_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "ACCEPT",
+	2: "ABORT",
+	3: "RETRY",
+	4: "RETRY_SNAPSHOT",
+	5: "REJECT_SNAPSHOT",
+}
+
+var ResponseApplySnapshotChunk_Result_value = map[string]int32{
+	"UNKNOWN":         0,
+	"ACCEPT":          1,
+	"ABORT":           2,
+	"RETRY":           3,
+	"RETRY_SNAPSHOT":  4,
+	"REJECT_SNAPSHOT": 5,
+}
+
+func (x ResponseApplySnapshotChunk_Result) String() string {
+	return proto.EnumName(ResponseApplySnapshotChunk_Result_name, int32(x))
+}
+
+func (ResponseApplySnapshotChunk_Result) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_types_5b877df1938afe10, []int{46, 0}
+}
+
 type Request struct {
 	// Types that are valid to be assigned to Value:
 	//	*Request_Echo
@@ -51,6 +125,10 @@ type Request struct {
 	//	*Request_DeliverTx
 	//	*Request_EndBlock
 	//	*Request_Commit
+	//	*Request_ListSnapshots
+	//	*Request_OfferSnapshot
+	//	*Request_LoadSnapshotChunk
+	//	*Request_ApplySnapshotChunk
 	Value                isRequest_Value `protobuf_oneof:"value"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
@@ -130,18 +208,34 @@ type Request_EndBlock struct {
 type Request_Commit struct {
 	Commit *RequestCommit `protobuf:"bytes,12,opt,name=commit,oneof"`
 }
+type Request_ListSnapshots struct {
+	ListSnapshots *RequestListSnapshots `protobuf:"bytes,13,opt,name=list_snapshots,json=listSnapshots,oneof"`
+}
+type Request_OfferSnapshot struct {
+	OfferSnapshot *RequestOfferSnapshot `protobuf:"bytes,14,opt,name=offer_snapshot,json=offerSnapshot,oneof"`
+}
+type Request_LoadSnapshotChunk struct {
+	LoadSnapshotChunk *RequestLoadSnapshotChunk `protobuf:"bytes,15,opt,name=load_snapshot_chunk,json=loadSnapshotChunk,oneof"`
+}
+type Request_ApplySnapshotChunk struct {
+	ApplySnapshotChunk *RequestApplySnapshotChunk `protobuf:"bytes,16,opt,name=apply_snapshot_chunk,json=applySnapshotChunk,oneof"`
+}
 
-func (*Request_Echo) isRequest_Value()       {}
-func (*Request_Flush) isRequest_Value()      {}
-func (*Request_Info) isRequest_Value()       {}
-func (*Request_SetOption) isRequest_Value()  {}
-func (*Request_InitChain) isRequest_Value()  {}
-func (*Request_Query) isRequest_Value()      {}
-func (*Request_BeginBlock) isRequest_Value() {}
-func (*Request_CheckTx) isRequest_Value()    {}
-func (*Request_DeliverTx) isRequest_Value()  {}
-func (*Request_EndBlock) isRequest_Value()   {}
-func (*Request_Commit) isRequest_Value()     {}
+func (*Request_Echo) isRequest_Value()               {}
+func (*Request_Flush) isRequest_Value()              {}
+func (*Request_Info) isRequest_Value()               {}
+func (*Request_SetOption) isRequest_Value()          {}
+func (*Request_InitChain) isRequest_Value()          {}
+func (*Request_Query) isRequest_Value()              {}
+func (*Request_BeginBlock) isRequest_Value()         {}
+func (*Request_CheckTx) isRequest_Value()            {}
+func (*Request_DeliverTx) isRequest_Value()          {}
+func (*Request_EndBlock) isRequest_Value()           {}
+func (*Request_Commit) isRequest_Value()             {}
+func (*Request_ListSnapshots) isRequest_Value()      {}
+func (*Request_OfferSnapshot) isRequest_Value()      {}
+func (*Request_LoadSnapshotChunk) isRequest_Value()  {}
+func (*Request_ApplySnapshotChunk) isRequest_Value() {}
 
 func (m *Request) GetValue() isRequest_Value {
 	if m != nil {
@@ -227,6 +321,34 @@ func (m *Request) GetCommit() *RequestCommit {
 	return nil
 }
 
+func (m *Request) GetListSnapshots() *RequestListSnapshots {
+	if x, ok := m.GetValue().(*Request_ListSnapshots); ok {
+		return x.ListSnapshots
+	}
+	return nil
+}
+
+func (m *Request) GetOfferSnapshot() *RequestOfferSnapshot {
+	if x, ok := m.GetValue().(*Request_OfferSnapshot); ok {
+		return x.OfferSnapshot
+	}
+	return nil
+}
+
+func (m *Request) GetLoadSnapshotChunk() *RequestLoadSnapshotChunk {
+	if x, ok := m.GetValue().(*Request_LoadSnapshotChunk); ok {
+		return x.LoadSnapshotChunk
+	}
+	return nil
+}
+
+func (m *Request) GetApplySnapshotChunk() *RequestApplySnapshotChunk {
+	if x, ok := m.GetValue().(*Request_ApplySnapshotChunk); ok {
+		return x.ApplySnapshotChunk
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Request) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Request_OneofMarshaler, _Request_OneofUnmarshaler, _Request_OneofSizer, []interface{}{
@@ -241,6 +363,10 @@ func (*Request) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error
 		(*Request_DeliverTx)(nil),
 		(*Request_EndBlock)(nil),
 		(*Request_Commit)(nil),
+		(*Request_ListSnapshots)(nil),
+		(*Request_OfferSnapshot)(nil),
+		(*Request_LoadSnapshotChunk)(nil),
+		(*Request_ApplySnapshotChunk)(nil),
 	}
 }
 
@@ -303,6 +429,26 @@ func _Request_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Commit); err != nil {
 			return err
 		}
+	case *Request_ListSnapshots:
+		_ = b.EncodeVarint(13<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ListSnapshots); err != nil {
+			return err
+		}
+	case *Request_OfferSnapshot:
+		_ = b.EncodeVarint(14<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.OfferSnapshot); err != nil {
+			return err
+		}
+	case *Request_LoadSnapshotChunk:
+		_ = b.EncodeVarint(15<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.LoadSnapshotChunk); err != nil {
+			return err
+		}
+	case *Request_ApplySnapshotChunk:
+		_ = b.EncodeVarint(16<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ApplySnapshotChunk); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Request.Value has unexpected type %T", x)
@@ -401,6 +547,38 @@ func _Request_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer
 		err := b.DecodeMessage(msg)
 		m.Value = &Request_Commit{msg}
 		return true, err
+	case 13: // value.list_snapshots
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(RequestListSnapshots)
+		err := b.DecodeMessage(msg)
+		m.Value = &Request_ListSnapshots{msg}
+		return true, err
+	case 14: // value.offer_snapshot
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(RequestOfferSnapshot)
+		err := b.DecodeMessage(msg)
+		m.Value = &Request_OfferSnapshot{msg}
+		return true, err
+	case 15: // value.load_snapshot_chunk
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(RequestLoadSnapshotChunk)
+		err := b.DecodeMessage(msg)
+		m.Value = &Request_LoadSnapshotChunk{msg}
+		return true, err
+	case 16: // value.apply_snapshot_chunk
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(RequestApplySnapshotChunk)
+		err := b.DecodeMessage(msg)
+		m.Value = &Request_ApplySnapshotChunk{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -465,6 +643,26 @@ func _Request_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Request_ListSnapshots:
+		s := proto.Size(x.ListSnapshots)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Request_OfferSnapshot:
+		s := proto.Size(x.OfferSnapshot)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Request_LoadSnapshotChunk:
+		s := proto.Size(x.LoadSnapshotChunk)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Request_ApplySnapshotChunk:
+		s := proto.Size(x.ApplySnapshotChunk)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -1096,6 +1294,10 @@ type Response struct {
 	//	*Response_DeliverTx
 	//	*Response_EndBlock
 	//	*Response_Commit
+	//	*Response_ListSnapshots
+	//	*Response_OfferSnapshot
+	//	*Response_LoadSnapshotChunk
+	//	*Response_ApplySnapshotChunk
 	Value                isResponse_Value `protobuf_oneof:"value"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
@@ -1178,19 +1380,35 @@ type Response_EndBlock struct {
 type Response_Commit struct {
 	Commit *ResponseCommit `protobuf:"bytes,12,opt,name=commit,oneof"`
 }
+type Response_ListSnapshots struct {
+	ListSnapshots *ResponseListSnapshots `protobuf:"bytes,13,opt,name=list_snapshots,json=listSnapshots,oneof"`
+}
+type Response_OfferSnapshot struct {
+	OfferSnapshot *ResponseOfferSnapshot `protobuf:"bytes,14,opt,name=offer_snapshot,json=offerSnapshot,oneof"`
+}
+type Response_LoadSnapshotChunk struct {
+	LoadSnapshotChunk *ResponseLoadSnapshotChunk `protobuf:"bytes,15,opt,name=load_snapshot_chunk,json=loadSnapshotChunk,oneof"`
+}
+type Response_ApplySnapshotChunk struct {
+	ApplySnapshotChunk *ResponseApplySnapshotChunk `protobuf:"bytes,16,opt,name=apply_snapshot_chunk,json=applySnapshotChunk,oneof"`
+}
 
-func (*Response_Exception) isResponse_Value()  {}
-func (*Response_Echo) isResponse_Value()       {}
-func (*Response_Flush) isResponse_Value()      {}
-func (*Response_Info) isResponse_Value()       {}
-func (*Response_SetOption) isResponse_Value()  {}
-func (*Response_InitChain) isResponse_Value()  {}
-func (*Response_Query) isResponse_Value()      {}
-func (*Response_BeginBlock) isResponse_Value() {}
-func (*Response_CheckTx) isResponse_Value()    {}
-func (*Response_DeliverTx) isResponse_Value()  {}
-func (*Response_EndBlock) isResponse_Value()   {}
-func (*Response_Commit) isResponse_Value()     {}
+func (*Response_Exception) isResponse_Value()          {}
+func (*Response_Echo) isResponse_Value()               {}
+func (*Response_Flush) isResponse_Value()              {}
+func (*Response_Info) isResponse_Value()               {}
+func (*Response_SetOption) isResponse_Value()          {}
+func (*Response_InitChain) isResponse_Value()          {}
+func (*Response_Query) isResponse_Value()              {}
+func (*Response_BeginBlock) isResponse_Value()         {}
+func (*Response_CheckTx) isResponse_Value()            {}
+func (*Response_DeliverTx) isResponse_Value()          {}
+func (*Response_EndBlock) isResponse_Value()           {}
+func (*Response_Commit) isResponse_Value()             {}
+func (*Response_ListSnapshots) isResponse_Value()      {}
+func (*Response_OfferSnapshot) isResponse_Value()      {}
+func (*Response_LoadSnapshotChunk) isResponse_Value()  {}
+func (*Response_ApplySnapshotChunk) isResponse_Value() {}
 
 func (m *Response) GetValue() isResponse_Value {
 	if m != nil {
@@ -1283,6 +1501,34 @@ func (m *Response) GetCommit() *ResponseCommit {
 	return nil
 }
 
+func (m *Response) GetListSnapshots() *ResponseListSnapshots {
+	if x, ok := m.GetValue().(*Response_ListSnapshots); ok {
+		return x.ListSnapshots
+	}
+	return nil
+}
+
+func (m *Response) GetOfferSnapshot() *ResponseOfferSnapshot {
+	if x, ok := m.GetValue().(*Response_OfferSnapshot); ok {
+		return x.OfferSnapshot
+	}
+	return nil
+}
+
+func (m *Response) GetLoadSnapshotChunk() *ResponseLoadSnapshotChunk {
+	if x, ok := m.GetValue().(*Response_LoadSnapshotChunk); ok {
+		return x.LoadSnapshotChunk
+	}
+	return nil
+}
+
+func (m *Response) GetApplySnapshotChunk() *ResponseApplySnapshotChunk {
+	if x, ok := m.GetValue().(*Response_ApplySnapshotChunk); ok {
+		return x.ApplySnapshotChunk
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Response) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Response_OneofMarshaler, _Response_OneofUnmarshaler, _Response_OneofSizer, []interface{}{
@@ -1298,6 +1544,10 @@ func (*Response) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) erro
 		(*Response_DeliverTx)(nil),
 		(*Response_EndBlock)(nil),
 		(*Response_Commit)(nil),
+		(*Response_ListSnapshots)(nil),
+		(*Response_OfferSnapshot)(nil),
+		(*Response_LoadSnapshotChunk)(nil),
+		(*Response_ApplySnapshotChunk)(nil),
 	}
 }
 
@@ -1365,6 +1615,26 @@ func _Response_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Commit); err != nil {
 			return err
 		}
+	case *Response_ListSnapshots:
+		_ = b.EncodeVarint(13<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ListSnapshots); err != nil {
+			return err
+		}
+	case *Response_OfferSnapshot:
+		_ = b.EncodeVarint(14<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.OfferSnapshot); err != nil {
+			return err
+		}
+	case *Response_LoadSnapshotChunk:
+		_ = b.EncodeVarint(15<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.LoadSnapshotChunk); err != nil {
+			return err
+		}
+	case *Response_ApplySnapshotChunk:
+		_ = b.EncodeVarint(16<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ApplySnapshotChunk); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Response.Value has unexpected type %T", x)
@@ -1471,6 +1741,38 @@ func _Response_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffe
 		err := b.DecodeMessage(msg)
 		m.Value = &Response_Commit{msg}
 		return true, err
+	case 13: // value.list_snapshots
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ResponseListSnapshots)
+		err := b.DecodeMessage(msg)
+		m.Value = &Response_ListSnapshots{msg}
+		return true, err
+	case 14: // value.offer_snapshot
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ResponseOfferSnapshot)
+		err := b.DecodeMessage(msg)
+		m.Value = &Response_OfferSnapshot{msg}
+		return true, err
+	case 15: // value.load_snapshot_chunk
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ResponseLoadSnapshotChunk)
+		err := b.DecodeMessage(msg)
+		m.Value = &Response_LoadSnapshotChunk{msg}
+		return true, err
+	case 16: // value.apply_snapshot_chunk
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ResponseApplySnapshotChunk)
+		err := b.DecodeMessage(msg)
+		m.Value = &Response_ApplySnapshotChunk{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -1540,6 +1842,26 @@ func _Response_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Response_ListSnapshots:
+		s := proto.Size(x.ListSnapshots)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Response_OfferSnapshot:
+		s := proto.Size(x.OfferSnapshot)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Response_LoadSnapshotChunk:
+		s := proto.Size(x.LoadSnapshotChunk)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Response_ApplySnapshotChunk:
+		s := proto.Size(x.ApplySnapshotChunk)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -1682,11 +2004,15 @@ func (m *ResponseFlush) XXX_DiscardUnknown() {
 var xxx_messageInfo_ResponseFlush proto.InternalMessageInfo
 
 type ResponseInfo struct {
-	Data                 string   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Version              string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	AppVersion           uint64   `protobuf:"varint,3,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
-	LastBlockHeight      int64    `protobuf:"varint,4,opt,name=last_block_height,json=lastBlockHeight,proto3" json:"last_block_height,omitempty"`
-	LastBlockAppHash     []byte   `protobuf:"bytes,5,opt,name=last_block_app_hash,json=lastBlockAppHash,proto3" json:"last_block_app_hash,omitempty"`
+	Data             string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Version          string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	AppVersion       uint64 `protobuf:"varint,3,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	LastBlockHeight  int64  `protobuf:"varint,4,opt,name=last_block_height,json=lastBlockHeight,proto3" json:"last_block_height,omitempty"`
+	LastBlockAppHash []byte `protobuf:"bytes,5,opt,name=last_block_app_hash,json=lastBlockAppHash,proto3" json:"last_block_app_hash,omitempty"`
+	// mempool groups open at last_block_height, only read by nodes restored
+	// from a snapshot
+	OpenGroups           []int32  `protobuf:"varint,6,rep,packed,name=open_groups,json=openGroups" json:"open_groups,omitempty"`
+	NextGroup            int32    `protobuf:"varint,7,opt,name=next_group,json=nextGroup,proto3" json:"next_group,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1760,6 +2086,20 @@ func (m *ResponseInfo) GetLastBlockAppHash() []byte {
 	return nil
 }
 
+func (m *ResponseInfo) GetOpenGroups() []int32 {
+	if m != nil {
+		return m.OpenGroups
+	}
+	return nil
+}
+
+func (m *ResponseInfo) GetNextGroup() int32 {
+	if m != nil {
+		return m.NextGroup
+	}
+	return 0
+}
+
 // nondeterministic
 type ResponseSetOption struct {
 	Code uint32 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
@@ -2048,6 +2388,9 @@ type ResponseCheckTx struct {
 	GasUsed              int64           `protobuf:"varint,6,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
 	Tags                 []common.KVPair `protobuf:"bytes,7,rep,name=tags" json:"tags,omitempty"`
 	Codespace            string          `protobuf:"bytes,8,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	Sender               string          `protobuf:"bytes,9,opt,name=sender,proto3" json:"sender,omitempty"`
+	Priority             int64           `protobuf:"varint,10,opt,name=priority,proto3" json:"priority,omitempty"`
+	Nonce                uint64          `protobuf:"varint,11,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -2142,6 +2485,27 @@ func (m *ResponseCheckTx) GetCodespace() string {
 	return ""
 }
 
+func (m *ResponseCheckTx) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *ResponseCheckTx) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *ResponseCheckTx) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 type ResponseDeliverTx struct {
 	Code                 uint32          `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Data                 []byte          `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
@@ -2249,6 +2613,9 @@ type ResponseEndBlock struct {
 	ValidatorUpdates      []ValidatorUpdate `protobuf:"bytes,1,rep,name=validator_updates,json=validatorUpdates" json:"validator_updates"`
 	ConsensusParamUpdates *ConsensusParams  `protobuf:"bytes,2,opt,name=consensus_param_updates,json=consensusParamUpdates" json:"consensus_param_updates,omitempty"`
 	Tags                  []common.KVPair   `protobuf:"bytes,3,rep,name=tags" json:"tags,omitempty"`
+	OpenGroups            []int32           `protobuf:"varint,4,rep,packed,name=open_groups,json=openGroups" json:"open_groups,omitempty"`
+	CloseGroups           []int32           `protobuf:"varint,5,rep,packed,name=close_groups,json=closeGroups" json:"close_groups,omitempty"`
+	NextGroup             int32             `protobuf:"varint,6,opt,name=next_group,json=nextGroup,proto3" json:"next_group,omitempty"`
 	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
 	XXX_unrecognized      []byte            `json:"-"`
 	XXX_sizecache         int32             `json:"-"`
@@ -2308,9 +2675,31 @@ func (m *ResponseEndBlock) GetTags() []common.KVPair {
 	return nil
 }
 
+func (m *ResponseEndBlock) GetOpenGroups() []int32 {
+	if m != nil {
+		return m.OpenGroups
+	}
+	return nil
+}
+
+func (m *ResponseEndBlock) GetCloseGroups() []int32 {
+	if m != nil {
+		return m.CloseGroups
+	}
+	return nil
+}
+
+func (m *ResponseEndBlock) GetNextGroup() int32 {
+	if m != nil {
+		return m.NextGroup
+	}
+	return 0
+}
+
 type ResponseCommit struct {
 	// reserve 1
 	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	RetainHeight         int64    `protobuf:"varint,3,opt,name=retain_height,json=retainHeight,proto3" json:"retain_height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2356,6 +2745,13 @@ func (m *ResponseCommit) GetData() []byte {
 	return nil
 }
 
+func (m *ResponseCommit) GetRetainHeight() int64 {
+	if m != nil {
+		return m.RetainHeight
+	}
+	return 0
+}
+
 // ConsensusParams contains all consensus-relevant parameters
 // that can be adjusted by the abci app
 type ConsensusParams struct {
@@ -2482,10 +2878,12 @@ func (m *BlockSizeParams) GetMaxGas() int64 {
 // EvidenceParams contains limits on the evidence.
 type EvidenceParams struct {
 	// Note: must be greater than 0
-	MaxAge               int64    `protobuf:"varint,1,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	MaxAge int64 `protobuf:"varint,1,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	// Note: must be greater than 0
+	MaxAgeDuration       time.Duration `protobuf:"bytes,2,opt,name=max_age_duration,json=maxAgeDuration,stdduration" json:"max_age_duration"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *EvidenceParams) Reset()         { *m = EvidenceParams{} }
@@ -2528,6 +2926,13 @@ func (m *EvidenceParams) GetMaxAge() int64 {
 	return 0
 }
 
+func (m *EvidenceParams) GetMaxAgeDuration() time.Duration {
+	if m != nil {
+		return m.MaxAgeDuration
+	}
+	return 0
+}
+
 // ValidatorParams contains limits on validators.
 type ValidatorParams struct {
 	PubKeyTypes          []string `protobuf:"bytes,1,rep,name=pub_key_types,json=pubKeyTypes" json:"pub_key_types,omitempty"`
@@ -3273,223 +3678,622 @@ func (m *Evidence) GetTotalVotingPower() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Request)(nil), "types.Request")
-	golang_proto.RegisterType((*Request)(nil), "types.Request")
-	proto.RegisterType((*RequestEcho)(nil), "types.RequestEcho")
-	golang_proto.RegisterType((*RequestEcho)(nil), "types.RequestEcho")
-	proto.RegisterType((*RequestFlush)(nil), "types.RequestFlush")
-	golang_proto.RegisterType((*RequestFlush)(nil), "types.RequestFlush")
-	proto.RegisterType((*RequestInfo)(nil), "types.RequestInfo")
-	golang_proto.RegisterType((*RequestInfo)(nil), "types.RequestInfo")
-	proto.RegisterType((*RequestSetOption)(nil), "types.RequestSetOption")
-	golang_proto.RegisterType((*RequestSetOption)(nil), "types.RequestSetOption")
-	proto.RegisterType((*RequestInitChain)(nil), "types.RequestInitChain")
-	golang_proto.RegisterType((*RequestInitChain)(nil), "types.RequestInitChain")
-	proto.RegisterType((*RequestQuery)(nil), "types.RequestQuery")
-	golang_proto.RegisterType((*RequestQuery)(nil), "types.RequestQuery")
-	proto.RegisterType((*RequestBeginBlock)(nil), "types.RequestBeginBlock")
-	golang_proto.RegisterType((*RequestBeginBlock)(nil), "types.RequestBeginBlock")
-	proto.RegisterType((*RequestCheckTx)(nil), "types.RequestCheckTx")
-	golang_proto.RegisterType((*RequestCheckTx)(nil), "types.RequestCheckTx")
-	proto.RegisterType((*RequestDeliverTx)(nil), "types.RequestDeliverTx")
-	golang_proto.RegisterType((*RequestDeliverTx)(nil), "types.RequestDeliverTx")
-	proto.RegisterType((*RequestEndBlock)(nil), "types.RequestEndBlock")
-	golang_proto.RegisterType((*RequestEndBlock)(nil), "types.RequestEndBlock")
-	proto.RegisterType((*RequestCommit)(nil), "types.RequestCommit")
-	golang_proto.RegisterType((*RequestCommit)(nil), "types.RequestCommit")
-	proto.RegisterType((*Response)(nil), "types.Response")
-	golang_proto.RegisterType((*Response)(nil), "types.Response")
-	proto.RegisterType((*ResponseException)(nil), "types.ResponseException")
-	golang_proto.RegisterType((*ResponseException)(nil), "types.ResponseException")
-	proto.RegisterType((*ResponseEcho)(nil), "types.ResponseEcho")
-	golang_proto.RegisterType((*ResponseEcho)(nil), "types.ResponseEcho")
-	proto.RegisterType((*ResponseFlush)(nil), "types.ResponseFlush")
-	golang_proto.RegisterType((*ResponseFlush)(nil), "types.ResponseFlush")
-	proto.RegisterType((*ResponseInfo)(nil), "types.ResponseInfo")
-	golang_proto.RegisterType((*ResponseInfo)(nil), "types.ResponseInfo")
-	proto.RegisterType((*ResponseSetOption)(nil), "types.ResponseSetOption")
-	golang_proto.RegisterType((*ResponseSetOption)(nil), "types.ResponseSetOption")
-	proto.RegisterType((*ResponseInitChain)(nil), "types.ResponseInitChain")
-	golang_proto.RegisterType((*ResponseInitChain)(nil), "types.ResponseInitChain")
-	proto.RegisterType((*ResponseQuery)(nil), "types.ResponseQuery")
-	golang_proto.RegisterType((*ResponseQuery)(nil), "types.ResponseQuery")
-	proto.RegisterType((*ResponseBeginBlock)(nil), "types.ResponseBeginBlock")
-	golang_proto.RegisterType((*ResponseBeginBlock)(nil), "types.ResponseBeginBlock")
-	proto.RegisterType((*ResponseCheckTx)(nil), "types.ResponseCheckTx")
-	golang_proto.RegisterType((*ResponseCheckTx)(nil), "types.ResponseCheckTx")
-	proto.RegisterType((*ResponseDeliverTx)(nil), "types.ResponseDeliverTx")
-	golang_proto.RegisterType((*ResponseDeliverTx)(nil), "types.ResponseDeliverTx")
-	proto.RegisterType((*ResponseEndBlock)(nil), "types.ResponseEndBlock")
-	golang_proto.RegisterType((*ResponseEndBlock)(nil), "types.ResponseEndBlock")
-	proto.RegisterType((*ResponseCommit)(nil), "types.ResponseCommit")
-	golang_proto.RegisterType((*ResponseCommit)(nil), "types.ResponseCommit")
-	proto.RegisterType((*ConsensusParams)(nil), "types.ConsensusParams")
-	golang_proto.RegisterType((*ConsensusParams)(nil), "types.ConsensusParams")
-	proto.RegisterType((*BlockSizeParams)(nil), "types.BlockSizeParams")
-	golang_proto.RegisterType((*BlockSizeParams)(nil), "types.BlockSizeParams")
-	proto.RegisterType((*EvidenceParams)(nil), "types.EvidenceParams")
-	golang_proto.RegisterType((*EvidenceParams)(nil), "types.EvidenceParams")
-	proto.RegisterType((*ValidatorParams)(nil), "types.ValidatorParams")
-	golang_proto.RegisterType((*ValidatorParams)(nil), "types.ValidatorParams")
-	proto.RegisterType((*LastCommitInfo)(nil), "types.LastCommitInfo")
-	golang_proto.RegisterType((*LastCommitInfo)(nil), "types.LastCommitInfo")
-	proto.RegisterType((*Header)(nil), "types.Header")
-	golang_proto.RegisterType((*Header)(nil), "types.Header")
-	proto.RegisterType((*Version)(nil), "types.Version")
-	golang_proto.RegisterType((*Version)(nil), "types.Version")
-	proto.RegisterType((*BlockID)(nil), "types.BlockID")
-	golang_proto.RegisterType((*BlockID)(nil), "types.BlockID")
-	proto.RegisterType((*PartSetHeader)(nil), "types.PartSetHeader")
-	golang_proto.RegisterType((*PartSetHeader)(nil), "types.PartSetHeader")
-	proto.RegisterType((*Validator)(nil), "types.Validator")
-	golang_proto.RegisterType((*Validator)(nil), "types.Validator")
-	proto.RegisterType((*ValidatorUpdate)(nil), "types.ValidatorUpdate")
-	golang_proto.RegisterType((*ValidatorUpdate)(nil), "types.ValidatorUpdate")
-	proto.RegisterType((*VoteInfo)(nil), "types.VoteInfo")
-	golang_proto.RegisterType((*VoteInfo)(nil), "types.VoteInfo")
-	proto.RegisterType((*PubKey)(nil), "types.PubKey")
-	golang_proto.RegisterType((*PubKey)(nil), "types.PubKey")
-	proto.RegisterType((*Evidence)(nil), "types.Evidence")
-	golang_proto.RegisterType((*Evidence)(nil), "types.Evidence")
+type RequestListSnapshots struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (this *Request) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Request)
-	if !ok {
-		that2, ok := that.(Request)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if that1.Value == nil {
-		if this.Value != nil {
-			return false
+func (m *RequestListSnapshots) Reset()         { *m = RequestListSnapshots{} }
+func (m *RequestListSnapshots) String() string { return proto.CompactTextString(m) }
+func (*RequestListSnapshots) ProtoMessage()    {}
+func (*RequestListSnapshots) Descriptor() ([]byte, []int) {
+	return fileDescriptor_types_5b877df1938afe10, []int{39}
+}
+func (m *RequestListSnapshots) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestListSnapshots) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestListSnapshots.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.Value == nil {
-		return false
-	} else if !this.Value.Equal(that1.Value) {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+		return b[:n], nil
 	}
-	return true
 }
-func (this *Request_Echo) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (dst *RequestListSnapshots) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestListSnapshots.Merge(dst, src)
+}
+func (m *RequestListSnapshots) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestListSnapshots) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestListSnapshots.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Request_Echo)
-	if !ok {
-		that2, ok := that.(Request_Echo)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_RequestListSnapshots proto.InternalMessageInfo
+
+// offers a snapshot to the application
+type RequestOfferSnapshot struct {
+	Snapshot             *Snapshot `protobuf:"bytes,1,opt,name=snapshot" json:"snapshot,omitempty"`
+	AppHash              []byte    `protobuf:"bytes,2,opt,name=app_hash,json=appHash,proto3" json:"app_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *RequestOfferSnapshot) Reset()         { *m = RequestOfferSnapshot{} }
+func (m *RequestOfferSnapshot) String() string { return proto.CompactTextString(m) }
+func (*RequestOfferSnapshot) ProtoMessage()    {}
+func (*RequestOfferSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_types_5b877df1938afe10, []int{40}
+}
+func (m *RequestOfferSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestOfferSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestOfferSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Echo.Equal(that1.Echo) {
-		return false
+}
+func (dst *RequestOfferSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestOfferSnapshot.Merge(dst, src)
+}
+func (m *RequestOfferSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestOfferSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestOfferSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestOfferSnapshot proto.InternalMessageInfo
+
+func (m *RequestOfferSnapshot) GetSnapshot() *Snapshot {
+	if m != nil {
+		return m.Snapshot
 	}
-	return true
+	return nil
 }
-func (this *Request_Flush) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RequestOfferSnapshot) GetAppHash() []byte {
+	if m != nil {
+		return m.AppHash
 	}
+	return nil
+}
 
-	that1, ok := that.(*Request_Flush)
-	if !ok {
-		that2, ok := that.(Request_Flush)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// loads a snapshot chunk
+type RequestLoadSnapshotChunk struct {
+	Height               uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Format               uint32   `protobuf:"varint,2,opt,name=format,proto3" json:"format,omitempty"`
+	Chunk                uint32   `protobuf:"varint,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestLoadSnapshotChunk) Reset()         { *m = RequestLoadSnapshotChunk{} }
+func (m *RequestLoadSnapshotChunk) String() string { return proto.CompactTextString(m) }
+func (*RequestLoadSnapshotChunk) ProtoMessage()    {}
+func (*RequestLoadSnapshotChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_types_5b877df1938afe10, []int{41}
+}
+func (m *RequestLoadSnapshotChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestLoadSnapshotChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestLoadSnapshotChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (dst *RequestLoadSnapshotChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestLoadSnapshotChunk.Merge(dst, src)
+}
+func (m *RequestLoadSnapshotChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestLoadSnapshotChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestLoadSnapshotChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestLoadSnapshotChunk proto.InternalMessageInfo
+
+func (m *RequestLoadSnapshotChunk) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	if !this.Flush.Equal(that1.Flush) {
-		return false
+	return 0
+}
+
+func (m *RequestLoadSnapshotChunk) GetFormat() uint32 {
+	if m != nil {
+		return m.Format
 	}
-	return true
+	return 0
 }
-func (this *Request_Info) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RequestLoadSnapshotChunk) GetChunk() uint32 {
+	if m != nil {
+		return m.Chunk
 	}
+	return 0
+}
 
-	that1, ok := that.(*Request_Info)
-	if !ok {
-		that2, ok := that.(Request_Info)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// applies a snapshot chunk
+type RequestApplySnapshotChunk struct {
+	Index                uint32   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Chunk                []byte   `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Sender               string   `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestApplySnapshotChunk) Reset()         { *m = RequestApplySnapshotChunk{} }
+func (m *RequestApplySnapshotChunk) String() string { return proto.CompactTextString(m) }
+func (*RequestApplySnapshotChunk) ProtoMessage()    {}
+func (*RequestApplySnapshotChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_types_5b877df1938afe10, []int{42}
+}
+func (m *RequestApplySnapshotChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestApplySnapshotChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestApplySnapshotChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (dst *RequestApplySnapshotChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestApplySnapshotChunk.Merge(dst, src)
+}
+func (m *RequestApplySnapshotChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestApplySnapshotChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestApplySnapshotChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestApplySnapshotChunk proto.InternalMessageInfo
+
+func (m *RequestApplySnapshotChunk) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
 	}
-	if !this.Info.Equal(that1.Info) {
-		return false
+	return 0
+}
+
+func (m *RequestApplySnapshotChunk) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
 	}
-	return true
+	return nil
 }
-func (this *Request_SetOption) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RequestApplySnapshotChunk) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
+	return ""
+}
 
-	that1, ok := that.(*Request_SetOption)
-	if !ok {
-		that2, ok := that.(Request_SetOption)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ResponseListSnapshots struct {
+	Snapshots            []*Snapshot `protobuf:"bytes,1,rep,name=snapshots" json:"snapshots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ResponseListSnapshots) Reset()         { *m = ResponseListSnapshots{} }
+func (m *ResponseListSnapshots) String() string { return proto.CompactTextString(m) }
+func (*ResponseListSnapshots) ProtoMessage()    {}
+func (*ResponseListSnapshots) Descriptor() ([]byte, []int) {
+	return fileDescriptor_types_5b877df1938afe10, []int{43}
+}
+func (m *ResponseListSnapshots) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseListSnapshots) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseListSnapshots.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.SetOption.Equal(that1.SetOption) {
-		return false
-	}
-	return true
 }
-func (this *Request_InitChain) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (dst *ResponseListSnapshots) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseListSnapshots.Merge(dst, src)
+}
+func (m *ResponseListSnapshots) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseListSnapshots) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseListSnapshots.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Request_InitChain)
+var xxx_messageInfo_ResponseListSnapshots proto.InternalMessageInfo
+
+func (m *ResponseListSnapshots) GetSnapshots() []*Snapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+type ResponseOfferSnapshot struct {
+	Result               ResponseOfferSnapshot_Result `protobuf:"varint,1,opt,name=result,proto3,enum=types.ResponseOfferSnapshot_Result" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *ResponseOfferSnapshot) Reset()         { *m = ResponseOfferSnapshot{} }
+func (m *ResponseOfferSnapshot) String() string { return proto.CompactTextString(m) }
+func (*ResponseOfferSnapshot) ProtoMessage()    {}
+func (*ResponseOfferSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_types_5b877df1938afe10, []int{44}
+}
+func (m *ResponseOfferSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseOfferSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseOfferSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ResponseOfferSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseOfferSnapshot.Merge(dst, src)
+}
+func (m *ResponseOfferSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseOfferSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseOfferSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseOfferSnapshot proto.InternalMessageInfo
+
+func (m *ResponseOfferSnapshot) GetResult() ResponseOfferSnapshot_Result {
+	if m != nil {
+		return m.Result
+	}
+	return ResponseOfferSnapshot_UNKNOWN
+}
+
+type ResponseLoadSnapshotChunk struct {
+	Chunk                []byte   `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResponseLoadSnapshotChunk) Reset()         { *m = ResponseLoadSnapshotChunk{} }
+func (m *ResponseLoadSnapshotChunk) String() string { return proto.CompactTextString(m) }
+func (*ResponseLoadSnapshotChunk) ProtoMessage()    {}
+func (*ResponseLoadSnapshotChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_types_5b877df1938afe10, []int{45}
+}
+func (m *ResponseLoadSnapshotChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseLoadSnapshotChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseLoadSnapshotChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ResponseLoadSnapshotChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseLoadSnapshotChunk.Merge(dst, src)
+}
+func (m *ResponseLoadSnapshotChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseLoadSnapshotChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseLoadSnapshotChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseLoadSnapshotChunk proto.InternalMessageInfo
+
+func (m *ResponseLoadSnapshotChunk) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
+}
+
+type ResponseApplySnapshotChunk struct {
+	Result               ResponseApplySnapshotChunk_Result `protobuf:"varint,1,opt,name=result,proto3,enum=types.ResponseApplySnapshotChunk_Result" json:"result,omitempty"`
+	RefetchChunks        []uint32                          `protobuf:"varint,2,rep,packed,name=refetch_chunks,json=refetchChunks" json:"refetch_chunks,omitempty"`
+	RejectSenders        []string                          `protobuf:"bytes,3,rep,name=reject_senders,json=rejectSenders" json:"reject_senders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *ResponseApplySnapshotChunk) Reset()         { *m = ResponseApplySnapshotChunk{} }
+func (m *ResponseApplySnapshotChunk) String() string { return proto.CompactTextString(m) }
+func (*ResponseApplySnapshotChunk) ProtoMessage()    {}
+func (*ResponseApplySnapshotChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_types_5b877df1938afe10, []int{46}
+}
+func (m *ResponseApplySnapshotChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseApplySnapshotChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseApplySnapshotChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ResponseApplySnapshotChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseApplySnapshotChunk.Merge(dst, src)
+}
+func (m *ResponseApplySnapshotChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseApplySnapshotChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseApplySnapshotChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseApplySnapshotChunk proto.InternalMessageInfo
+
+func (m *ResponseApplySnapshotChunk) GetResult() ResponseApplySnapshotChunk_Result {
+	if m != nil {
+		return m.Result
+	}
+	return ResponseApplySnapshotChunk_UNKNOWN
+}
+
+func (m *ResponseApplySnapshotChunk) GetRefetchChunks() []uint32 {
+	if m != nil {
+		return m.RefetchChunks
+	}
+	return nil
+}
+
+func (m *ResponseApplySnapshotChunk) GetRejectSenders() []string {
+	if m != nil {
+		return m.RejectSenders
+	}
+	return nil
+}
+
+type Snapshot struct {
+	Height               uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Format               uint32   `protobuf:"varint,2,opt,name=format,proto3" json:"format,omitempty"`
+	Chunks               uint32   `protobuf:"varint,3,opt,name=chunks,proto3" json:"chunks,omitempty"`
+	Hash                 []byte   `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	Metadata             []byte   `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Snapshot) Reset()         { *m = Snapshot{} }
+func (m *Snapshot) String() string { return proto.CompactTextString(m) }
+func (*Snapshot) ProtoMessage()    {}
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_types_5b877df1938afe10, []int{47}
+}
+func (m *Snapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Snapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Snapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *Snapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Snapshot.Merge(dst, src)
+}
+func (m *Snapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *Snapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_Snapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Snapshot proto.InternalMessageInfo
+
+func (m *Snapshot) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Snapshot) GetFormat() uint32 {
+	if m != nil {
+		return m.Format
+	}
+	return 0
+}
+
+func (m *Snapshot) GetChunks() uint32 {
+	if m != nil {
+		return m.Chunks
+	}
+	return 0
+}
+
+func (m *Snapshot) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *Snapshot) GetMetadata() []byte {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Request)(nil), "types.Request")
+	golang_proto.RegisterType((*Request)(nil), "types.Request")
+	proto.RegisterType((*RequestEcho)(nil), "types.RequestEcho")
+	golang_proto.RegisterType((*RequestEcho)(nil), "types.RequestEcho")
+	proto.RegisterType((*RequestFlush)(nil), "types.RequestFlush")
+	golang_proto.RegisterType((*RequestFlush)(nil), "types.RequestFlush")
+	proto.RegisterType((*RequestInfo)(nil), "types.RequestInfo")
+	golang_proto.RegisterType((*RequestInfo)(nil), "types.RequestInfo")
+	proto.RegisterType((*RequestSetOption)(nil), "types.RequestSetOption")
+	golang_proto.RegisterType((*RequestSetOption)(nil), "types.RequestSetOption")
+	proto.RegisterType((*RequestInitChain)(nil), "types.RequestInitChain")
+	golang_proto.RegisterType((*RequestInitChain)(nil), "types.RequestInitChain")
+	proto.RegisterType((*RequestQuery)(nil), "types.RequestQuery")
+	golang_proto.RegisterType((*RequestQuery)(nil), "types.RequestQuery")
+	proto.RegisterType((*RequestBeginBlock)(nil), "types.RequestBeginBlock")
+	golang_proto.RegisterType((*RequestBeginBlock)(nil), "types.RequestBeginBlock")
+	proto.RegisterType((*RequestCheckTx)(nil), "types.RequestCheckTx")
+	golang_proto.RegisterType((*RequestCheckTx)(nil), "types.RequestCheckTx")
+	proto.RegisterType((*RequestDeliverTx)(nil), "types.RequestDeliverTx")
+	golang_proto.RegisterType((*RequestDeliverTx)(nil), "types.RequestDeliverTx")
+	proto.RegisterType((*RequestEndBlock)(nil), "types.RequestEndBlock")
+	golang_proto.RegisterType((*RequestEndBlock)(nil), "types.RequestEndBlock")
+	proto.RegisterType((*RequestCommit)(nil), "types.RequestCommit")
+	golang_proto.RegisterType((*RequestCommit)(nil), "types.RequestCommit")
+	proto.RegisterType((*Response)(nil), "types.Response")
+	golang_proto.RegisterType((*Response)(nil), "types.Response")
+	proto.RegisterType((*ResponseException)(nil), "types.ResponseException")
+	golang_proto.RegisterType((*ResponseException)(nil), "types.ResponseException")
+	proto.RegisterType((*ResponseEcho)(nil), "types.ResponseEcho")
+	golang_proto.RegisterType((*ResponseEcho)(nil), "types.ResponseEcho")
+	proto.RegisterType((*ResponseFlush)(nil), "types.ResponseFlush")
+	golang_proto.RegisterType((*ResponseFlush)(nil), "types.ResponseFlush")
+	proto.RegisterType((*ResponseInfo)(nil), "types.ResponseInfo")
+	golang_proto.RegisterType((*ResponseInfo)(nil), "types.ResponseInfo")
+	proto.RegisterType((*ResponseSetOption)(nil), "types.ResponseSetOption")
+	golang_proto.RegisterType((*ResponseSetOption)(nil), "types.ResponseSetOption")
+	proto.RegisterType((*ResponseInitChain)(nil), "types.ResponseInitChain")
+	golang_proto.RegisterType((*ResponseInitChain)(nil), "types.ResponseInitChain")
+	proto.RegisterType((*ResponseQuery)(nil), "types.ResponseQuery")
+	golang_proto.RegisterType((*ResponseQuery)(nil), "types.ResponseQuery")
+	proto.RegisterType((*ResponseBeginBlock)(nil), "types.ResponseBeginBlock")
+	golang_proto.RegisterType((*ResponseBeginBlock)(nil), "types.ResponseBeginBlock")
+	proto.RegisterType((*ResponseCheckTx)(nil), "types.ResponseCheckTx")
+	golang_proto.RegisterType((*ResponseCheckTx)(nil), "types.ResponseCheckTx")
+	proto.RegisterType((*ResponseDeliverTx)(nil), "types.ResponseDeliverTx")
+	golang_proto.RegisterType((*ResponseDeliverTx)(nil), "types.ResponseDeliverTx")
+	proto.RegisterType((*ResponseEndBlock)(nil), "types.ResponseEndBlock")
+	golang_proto.RegisterType((*ResponseEndBlock)(nil), "types.ResponseEndBlock")
+	proto.RegisterType((*ResponseCommit)(nil), "types.ResponseCommit")
+	golang_proto.RegisterType((*ResponseCommit)(nil), "types.ResponseCommit")
+	proto.RegisterType((*ConsensusParams)(nil), "types.ConsensusParams")
+	golang_proto.RegisterType((*ConsensusParams)(nil), "types.ConsensusParams")
+	proto.RegisterType((*BlockSizeParams)(nil), "types.BlockSizeParams")
+	golang_proto.RegisterType((*BlockSizeParams)(nil), "types.BlockSizeParams")
+	proto.RegisterType((*EvidenceParams)(nil), "types.EvidenceParams")
+	golang_proto.RegisterType((*EvidenceParams)(nil), "types.EvidenceParams")
+	proto.RegisterType((*ValidatorParams)(nil), "types.ValidatorParams")
+	golang_proto.RegisterType((*ValidatorParams)(nil), "types.ValidatorParams")
+	proto.RegisterType((*LastCommitInfo)(nil), "types.LastCommitInfo")
+	golang_proto.RegisterType((*LastCommitInfo)(nil), "types.LastCommitInfo")
+	proto.RegisterType((*Header)(nil), "types.Header")
+	golang_proto.RegisterType((*Header)(nil), "types.Header")
+	proto.RegisterType((*Version)(nil), "types.Version")
+	golang_proto.RegisterType((*Version)(nil), "types.Version")
+	proto.RegisterType((*BlockID)(nil), "types.BlockID")
+	golang_proto.RegisterType((*BlockID)(nil), "types.BlockID")
+	proto.RegisterType((*PartSetHeader)(nil), "types.PartSetHeader")
+	golang_proto.RegisterType((*PartSetHeader)(nil), "types.PartSetHeader")
+	proto.RegisterType((*Validator)(nil), "types.Validator")
+	golang_proto.RegisterType((*Validator)(nil), "types.Validator")
+	proto.RegisterType((*ValidatorUpdate)(nil), "types.ValidatorUpdate")
+	golang_proto.RegisterType((*ValidatorUpdate)(nil), "types.ValidatorUpdate")
+	proto.RegisterType((*VoteInfo)(nil), "types.VoteInfo")
+	golang_proto.RegisterType((*VoteInfo)(nil), "types.VoteInfo")
+	proto.RegisterType((*PubKey)(nil), "types.PubKey")
+	golang_proto.RegisterType((*PubKey)(nil), "types.PubKey")
+	proto.RegisterType((*Evidence)(nil), "types.Evidence")
+	golang_proto.RegisterType((*Evidence)(nil), "types.Evidence")
+	proto.RegisterType((*RequestListSnapshots)(nil), "types.RequestListSnapshots")
+	golang_proto.RegisterType((*RequestListSnapshots)(nil), "types.RequestListSnapshots")
+	proto.RegisterType((*RequestOfferSnapshot)(nil), "types.RequestOfferSnapshot")
+	golang_proto.RegisterType((*RequestOfferSnapshot)(nil), "types.RequestOfferSnapshot")
+	proto.RegisterType((*RequestLoadSnapshotChunk)(nil), "types.RequestLoadSnapshotChunk")
+	golang_proto.RegisterType((*RequestLoadSnapshotChunk)(nil), "types.RequestLoadSnapshotChunk")
+	proto.RegisterType((*RequestApplySnapshotChunk)(nil), "types.RequestApplySnapshotChunk")
+	golang_proto.RegisterType((*RequestApplySnapshotChunk)(nil), "types.RequestApplySnapshotChunk")
+	proto.RegisterType((*ResponseListSnapshots)(nil), "types.ResponseListSnapshots")
+	golang_proto.RegisterType((*ResponseListSnapshots)(nil), "types.ResponseListSnapshots")
+	proto.RegisterType((*ResponseOfferSnapshot)(nil), "types.ResponseOfferSnapshot")
+	golang_proto.RegisterType((*ResponseOfferSnapshot)(nil), "types.ResponseOfferSnapshot")
+	proto.RegisterType((*ResponseLoadSnapshotChunk)(nil), "types.ResponseLoadSnapshotChunk")
+	golang_proto.RegisterType((*ResponseLoadSnapshotChunk)(nil), "types.ResponseLoadSnapshotChunk")
+	proto.RegisterType((*ResponseApplySnapshotChunk)(nil), "types.ResponseApplySnapshotChunk")
+	golang_proto.RegisterType((*ResponseApplySnapshotChunk)(nil), "types.ResponseApplySnapshotChunk")
+	proto.RegisterType((*Snapshot)(nil), "types.Snapshot")
+	golang_proto.RegisterType((*Snapshot)(nil), "types.Snapshot")
+	proto.RegisterEnum("types.ResponseOfferSnapshot_Result", ResponseOfferSnapshot_Result_name, ResponseOfferSnapshot_Result_value)
+	golang_proto.RegisterEnum("types.ResponseOfferSnapshot_Result", ResponseOfferSnapshot_Result_name, ResponseOfferSnapshot_Result_value)
+	proto.RegisterEnum("types.ResponseApplySnapshotChunk_Result", ResponseApplySnapshotChunk_Result_name, ResponseApplySnapshotChunk_Result_value)
+	golang_proto.RegisterEnum("types.ResponseApplySnapshotChunk_Result", ResponseApplySnapshotChunk_Result_name, ResponseApplySnapshotChunk_Result_value)
+}
+func (this *Request) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Request)
 	if !ok {
-		that2, ok := that.(Request_InitChain)
+		that2, ok := that.(Request)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3501,19 +4305,28 @@ func (this *Request_InitChain) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.InitChain.Equal(that1.InitChain) {
+	if that1.Value == nil {
+		if this.Value != nil {
+			return false
+		}
+	} else if this.Value == nil {
+		return false
+	} else if !this.Value.Equal(that1.Value) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *Request_Query) Equal(that interface{}) bool {
+func (this *Request_Echo) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Request_Query)
+	that1, ok := that.(*Request_Echo)
 	if !ok {
-		that2, ok := that.(Request_Query)
+		that2, ok := that.(Request_Echo)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3525,17 +4338,137 @@ func (this *Request_Query) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Query.Equal(that1.Query) {
+	if !this.Echo.Equal(that1.Echo) {
 		return false
 	}
 	return true
 }
-func (this *Request_BeginBlock) Equal(that interface{}) bool {
+func (this *Request_Flush) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Request_BeginBlock)
+	that1, ok := that.(*Request_Flush)
+	if !ok {
+		that2, ok := that.(Request_Flush)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Flush.Equal(that1.Flush) {
+		return false
+	}
+	return true
+}
+func (this *Request_Info) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Request_Info)
+	if !ok {
+		that2, ok := that.(Request_Info)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Info.Equal(that1.Info) {
+		return false
+	}
+	return true
+}
+func (this *Request_SetOption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Request_SetOption)
+	if !ok {
+		that2, ok := that.(Request_SetOption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SetOption.Equal(that1.SetOption) {
+		return false
+	}
+	return true
+}
+func (this *Request_InitChain) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Request_InitChain)
+	if !ok {
+		that2, ok := that.(Request_InitChain)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.InitChain.Equal(that1.InitChain) {
+		return false
+	}
+	return true
+}
+func (this *Request_Query) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Request_Query)
+	if !ok {
+		that2, ok := that.(Request_Query)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Query.Equal(that1.Query) {
+		return false
+	}
+	return true
+}
+func (this *Request_BeginBlock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Request_BeginBlock)
 	if !ok {
 		that2, ok := that.(Request_BeginBlock)
 		if ok {
@@ -3650,6 +4583,102 @@ func (this *Request_Commit) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Request_ListSnapshots) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Request_ListSnapshots)
+	if !ok {
+		that2, ok := that.(Request_ListSnapshots)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ListSnapshots.Equal(that1.ListSnapshots) {
+		return false
+	}
+	return true
+}
+func (this *Request_OfferSnapshot) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Request_OfferSnapshot)
+	if !ok {
+		that2, ok := that.(Request_OfferSnapshot)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.OfferSnapshot.Equal(that1.OfferSnapshot) {
+		return false
+	}
+	return true
+}
+func (this *Request_LoadSnapshotChunk) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Request_LoadSnapshotChunk)
+	if !ok {
+		that2, ok := that.(Request_LoadSnapshotChunk)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.LoadSnapshotChunk.Equal(that1.LoadSnapshotChunk) {
+		return false
+	}
+	return true
+}
+func (this *Request_ApplySnapshotChunk) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Request_ApplySnapshotChunk)
+	if !ok {
+		that2, ok := that.(Request_ApplySnapshotChunk)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplySnapshotChunk.Equal(that1.ApplySnapshotChunk) {
+		return false
+	}
+	return true
+}
 func (this *RequestEcho) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -4311,14 +5340,14 @@ func (this *Response_Commit) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *ResponseException) Equal(that interface{}) bool {
+func (this *Response_ListSnapshots) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ResponseException)
+	that1, ok := that.(*Response_ListSnapshots)
 	if !ok {
-		that2, ok := that.(ResponseException)
+		that2, ok := that.(Response_ListSnapshots)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4330,22 +5359,19 @@ func (this *ResponseException) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Error != that1.Error {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+	if !this.ListSnapshots.Equal(that1.ListSnapshots) {
 		return false
 	}
 	return true
 }
-func (this *ResponseEcho) Equal(that interface{}) bool {
+func (this *Response_OfferSnapshot) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ResponseEcho)
+	that1, ok := that.(*Response_OfferSnapshot)
 	if !ok {
-		that2, ok := that.(ResponseEcho)
+		that2, ok := that.(Response_OfferSnapshot)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4357,22 +5383,19 @@ func (this *ResponseEcho) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Message != that1.Message {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+	if !this.OfferSnapshot.Equal(that1.OfferSnapshot) {
 		return false
 	}
 	return true
 }
-func (this *ResponseFlush) Equal(that interface{}) bool {
+func (this *Response_LoadSnapshotChunk) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ResponseFlush)
+	that1, ok := that.(*Response_LoadSnapshotChunk)
 	if !ok {
-		that2, ok := that.(ResponseFlush)
+		that2, ok := that.(Response_LoadSnapshotChunk)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4384,19 +5407,19 @@ func (this *ResponseFlush) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+	if !this.LoadSnapshotChunk.Equal(that1.LoadSnapshotChunk) {
 		return false
 	}
 	return true
 }
-func (this *ResponseInfo) Equal(that interface{}) bool {
+func (this *Response_ApplySnapshotChunk) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ResponseInfo)
+	that1, ok := that.(*Response_ApplySnapshotChunk)
 	if !ok {
-		that2, ok := that.(ResponseInfo)
+		that2, ok := that.(Response_ApplySnapshotChunk)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4408,34 +5431,19 @@ func (this *ResponseInfo) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Data != that1.Data {
-		return false
-	}
-	if this.Version != that1.Version {
-		return false
-	}
-	if this.AppVersion != that1.AppVersion {
-		return false
-	}
-	if this.LastBlockHeight != that1.LastBlockHeight {
-		return false
-	}
-	if !bytes.Equal(this.LastBlockAppHash, that1.LastBlockAppHash) {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+	if !this.ApplySnapshotChunk.Equal(that1.ApplySnapshotChunk) {
 		return false
 	}
 	return true
 }
-func (this *ResponseSetOption) Equal(that interface{}) bool {
+func (this *ResponseException) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ResponseSetOption)
+	that1, ok := that.(*ResponseException)
 	if !ok {
-		that2, ok := that.(ResponseSetOption)
+		that2, ok := that.(ResponseException)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4447,13 +5455,7 @@ func (this *ResponseSetOption) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Code != that1.Code {
-		return false
-	}
-	if this.Log != that1.Log {
-		return false
-	}
-	if this.Info != that1.Info {
+	if this.Error != that1.Error {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -4461,12 +5463,146 @@ func (this *ResponseSetOption) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *ResponseInitChain) Equal(that interface{}) bool {
+func (this *ResponseEcho) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ResponseInitChain)
+	that1, ok := that.(*ResponseEcho)
+	if !ok {
+		that2, ok := that.(ResponseEcho)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Message != that1.Message {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ResponseFlush) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResponseFlush)
+	if !ok {
+		that2, ok := that.(ResponseFlush)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ResponseInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResponseInfo)
+	if !ok {
+		that2, ok := that.(ResponseInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Data != that1.Data {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.AppVersion != that1.AppVersion {
+		return false
+	}
+	if this.LastBlockHeight != that1.LastBlockHeight {
+		return false
+	}
+	if !bytes.Equal(this.LastBlockAppHash, that1.LastBlockAppHash) {
+		return false
+	}
+	if len(this.OpenGroups) != len(that1.OpenGroups) {
+		return false
+	}
+	for i := range this.OpenGroups {
+		if this.OpenGroups[i] != that1.OpenGroups[i] {
+			return false
+		}
+	}
+	if this.NextGroup != that1.NextGroup {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ResponseSetOption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResponseSetOption)
+	if !ok {
+		that2, ok := that.(ResponseSetOption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Code != that1.Code {
+		return false
+	}
+	if this.Log != that1.Log {
+		return false
+	}
+	if this.Info != that1.Info {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ResponseInitChain) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResponseInitChain)
 	if !ok {
 		that2, ok := that.(ResponseInitChain)
 		if ok {
@@ -4627,6 +5763,15 @@ func (this *ResponseCheckTx) Equal(that interface{}) bool {
 	if this.Codespace != that1.Codespace {
 		return false
 	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.Priority != that1.Priority {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -4723,6 +5868,25 @@ func (this *ResponseEndBlock) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.OpenGroups) != len(that1.OpenGroups) {
+		return false
+	}
+	for i := range this.OpenGroups {
+		if this.OpenGroups[i] != that1.OpenGroups[i] {
+			return false
+		}
+	}
+	if len(this.CloseGroups) != len(that1.CloseGroups) {
+		return false
+	}
+	for i := range this.CloseGroups {
+		if this.CloseGroups[i] != that1.CloseGroups[i] {
+			return false
+		}
+	}
+	if this.NextGroup != that1.NextGroup {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -4750,6 +5914,9 @@ func (this *ResponseCommit) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Data, that1.Data) {
 		return false
 	}
+	if this.RetainHeight != that1.RetainHeight {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -4840,6 +6007,9 @@ func (this *EvidenceParams) Equal(that interface{}) bool {
 	if this.MaxAge != that1.MaxAge {
 		return false
 	}
+	if this.MaxAgeDuration != that1.MaxAgeDuration {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -5233,979 +6403,830 @@ func (this *Evidence) Equal(that interface{}) bool {
 	}
 	return true
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// ABCIApplicationClient is the client API for ABCIApplication service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ABCIApplicationClient interface {
-	Echo(ctx context.Context, in *RequestEcho, opts ...grpc.CallOption) (*ResponseEcho, error)
-	Flush(ctx context.Context, in *RequestFlush, opts ...grpc.CallOption) (*ResponseFlush, error)
-	Info(ctx context.Context, in *RequestInfo, opts ...grpc.CallOption) (*ResponseInfo, error)
-	SetOption(ctx context.Context, in *RequestSetOption, opts ...grpc.CallOption) (*ResponseSetOption, error)
-	DeliverTx(ctx context.Context, in *RequestDeliverTx, opts ...grpc.CallOption) (*ResponseDeliverTx, error)
-	CheckTx(ctx context.Context, in *RequestCheckTx, opts ...grpc.CallOption) (*ResponseCheckTx, error)
-	Query(ctx context.Context, in *RequestQuery, opts ...grpc.CallOption) (*ResponseQuery, error)
-	Commit(ctx context.Context, in *RequestCommit, opts ...grpc.CallOption) (*ResponseCommit, error)
-	InitChain(ctx context.Context, in *RequestInitChain, opts ...grpc.CallOption) (*ResponseInitChain, error)
-	BeginBlock(ctx context.Context, in *RequestBeginBlock, opts ...grpc.CallOption) (*ResponseBeginBlock, error)
-	EndBlock(ctx context.Context, in *RequestEndBlock, opts ...grpc.CallOption) (*ResponseEndBlock, error)
-}
-
-type aBCIApplicationClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewABCIApplicationClient(cc *grpc.ClientConn) ABCIApplicationClient {
-	return &aBCIApplicationClient{cc}
-}
-
-func (c *aBCIApplicationClient) Echo(ctx context.Context, in *RequestEcho, opts ...grpc.CallOption) (*ResponseEcho, error) {
-	out := new(ResponseEcho)
-	err := c.cc.Invoke(ctx, "/types.ABCIApplication/Echo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *RequestListSnapshots) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return out, nil
-}
 
-func (c *aBCIApplicationClient) Flush(ctx context.Context, in *RequestFlush, opts ...grpc.CallOption) (*ResponseFlush, error) {
-	out := new(ResponseFlush)
-	err := c.cc.Invoke(ctx, "/types.ABCIApplication/Flush", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*RequestListSnapshots)
+	if !ok {
+		that2, ok := that.(RequestListSnapshots)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
-}
-
-func (c *aBCIApplicationClient) Info(ctx context.Context, in *RequestInfo, opts ...grpc.CallOption) (*ResponseInfo, error) {
-	out := new(ResponseInfo)
-	err := c.cc.Invoke(ctx, "/types.ABCIApplication/Info", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return out, nil
-}
-
-func (c *aBCIApplicationClient) SetOption(ctx context.Context, in *RequestSetOption, opts ...grpc.CallOption) (*ResponseSetOption, error) {
-	out := new(ResponseSetOption)
-	err := c.cc.Invoke(ctx, "/types.ABCIApplication/SetOption", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return out, nil
+	return true
 }
-
-func (c *aBCIApplicationClient) DeliverTx(ctx context.Context, in *RequestDeliverTx, opts ...grpc.CallOption) (*ResponseDeliverTx, error) {
-	out := new(ResponseDeliverTx)
-	err := c.cc.Invoke(ctx, "/types.ABCIApplication/DeliverTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *RequestOfferSnapshot) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return out, nil
-}
 
-func (c *aBCIApplicationClient) CheckTx(ctx context.Context, in *RequestCheckTx, opts ...grpc.CallOption) (*ResponseCheckTx, error) {
-	out := new(ResponseCheckTx)
-	err := c.cc.Invoke(ctx, "/types.ABCIApplication/CheckTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*RequestOfferSnapshot)
+	if !ok {
+		that2, ok := that.(RequestOfferSnapshot)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
-}
-
-func (c *aBCIApplicationClient) Query(ctx context.Context, in *RequestQuery, opts ...grpc.CallOption) (*ResponseQuery, error) {
-	out := new(ResponseQuery)
-	err := c.cc.Invoke(ctx, "/types.ABCIApplication/Query", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return out, nil
-}
-
-func (c *aBCIApplicationClient) Commit(ctx context.Context, in *RequestCommit, opts ...grpc.CallOption) (*ResponseCommit, error) {
-	out := new(ResponseCommit)
-	err := c.cc.Invoke(ctx, "/types.ABCIApplication/Commit", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if !this.Snapshot.Equal(that1.Snapshot) {
+		return false
 	}
-	return out, nil
-}
-
-func (c *aBCIApplicationClient) InitChain(ctx context.Context, in *RequestInitChain, opts ...grpc.CallOption) (*ResponseInitChain, error) {
-	out := new(ResponseInitChain)
-	err := c.cc.Invoke(ctx, "/types.ABCIApplication/InitChain", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.AppHash, that1.AppHash) {
+		return false
 	}
-	return out, nil
-}
-
-func (c *aBCIApplicationClient) BeginBlock(ctx context.Context, in *RequestBeginBlock, opts ...grpc.CallOption) (*ResponseBeginBlock, error) {
-	out := new(ResponseBeginBlock)
-	err := c.cc.Invoke(ctx, "/types.ABCIApplication/BeginBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return out, nil
+	return true
 }
-
-func (c *aBCIApplicationClient) EndBlock(ctx context.Context, in *RequestEndBlock, opts ...grpc.CallOption) (*ResponseEndBlock, error) {
-	out := new(ResponseEndBlock)
-	err := c.cc.Invoke(ctx, "/types.ABCIApplication/EndBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *RequestLoadSnapshotChunk) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return out, nil
-}
-
-// ABCIApplicationServer is the server API for ABCIApplication service.
-type ABCIApplicationServer interface {
-	Echo(context.Context, *RequestEcho) (*ResponseEcho, error)
-	Flush(context.Context, *RequestFlush) (*ResponseFlush, error)
-	Info(context.Context, *RequestInfo) (*ResponseInfo, error)
-	SetOption(context.Context, *RequestSetOption) (*ResponseSetOption, error)
-	DeliverTx(context.Context, *RequestDeliverTx) (*ResponseDeliverTx, error)
-	CheckTx(context.Context, *RequestCheckTx) (*ResponseCheckTx, error)
-	Query(context.Context, *RequestQuery) (*ResponseQuery, error)
-	Commit(context.Context, *RequestCommit) (*ResponseCommit, error)
-	InitChain(context.Context, *RequestInitChain) (*ResponseInitChain, error)
-	BeginBlock(context.Context, *RequestBeginBlock) (*ResponseBeginBlock, error)
-	EndBlock(context.Context, *RequestEndBlock) (*ResponseEndBlock, error)
-}
-
-func RegisterABCIApplicationServer(s *grpc.Server, srv ABCIApplicationServer) {
-	s.RegisterService(&_ABCIApplication_serviceDesc, srv)
-}
 
-func _ABCIApplication_Echo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RequestEcho)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ABCIApplicationServer).Echo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.ABCIApplication/Echo",
+	that1, ok := that.(*RequestLoadSnapshotChunk)
+	if !ok {
+		that2, ok := that.(RequestLoadSnapshotChunk)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ABCIApplicationServer).Echo(ctx, req.(*RequestEcho))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _ABCIApplication_Flush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RequestFlush)
-	if err := dec(in); err != nil {
-		return nil, err
+	if this.Height != that1.Height {
+		return false
 	}
-	if interceptor == nil {
-		return srv.(ABCIApplicationServer).Flush(ctx, in)
+	if this.Format != that1.Format {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.ABCIApplication/Flush",
+	if this.Chunk != that1.Chunk {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ABCIApplicationServer).Flush(ctx, req.(*RequestFlush))
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
-
-func _ABCIApplication_Info_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RequestInfo)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ABCIApplicationServer).Info(ctx, in)
+func (this *RequestApplySnapshotChunk) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.ABCIApplication/Info",
+
+	that1, ok := that.(*RequestApplySnapshotChunk)
+	if !ok {
+		that2, ok := that.(RequestApplySnapshotChunk)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ABCIApplicationServer).Info(ctx, req.(*RequestInfo))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _ABCIApplication_SetOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RequestSetOption)
-	if err := dec(in); err != nil {
-		return nil, err
+	if this.Index != that1.Index {
+		return false
 	}
-	if interceptor == nil {
-		return srv.(ABCIApplicationServer).SetOption(ctx, in)
+	if !bytes.Equal(this.Chunk, that1.Chunk) {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.ABCIApplication/SetOption",
+	if this.Sender != that1.Sender {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ABCIApplicationServer).SetOption(ctx, req.(*RequestSetOption))
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *ResponseListSnapshots) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _ABCIApplication_DeliverTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RequestDeliverTx)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*ResponseListSnapshots)
+	if !ok {
+		that2, ok := that.(ResponseListSnapshots)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(ABCIApplicationServer).DeliverTx(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.ABCIApplication/DeliverTx",
+	if len(this.Snapshots) != len(that1.Snapshots) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ABCIApplicationServer).DeliverTx(ctx, req.(*RequestDeliverTx))
+	for i := range this.Snapshots {
+		if !this.Snapshots[i].Equal(that1.Snapshots[i]) {
+			return false
+		}
 	}
-	return interceptor(ctx, in, info, handler)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *ResponseOfferSnapshot) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _ABCIApplication_CheckTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RequestCheckTx)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*ResponseOfferSnapshot)
+	if !ok {
+		that2, ok := that.(ResponseOfferSnapshot)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(ABCIApplicationServer).CheckTx(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.ABCIApplication/CheckTx",
+	if this.Result != that1.Result {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ABCIApplicationServer).CheckTx(ctx, req.(*RequestCheckTx))
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *ResponseLoadSnapshotChunk) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _ABCIApplication_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RequestQuery)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*ResponseLoadSnapshotChunk)
+	if !ok {
+		that2, ok := that.(ResponseLoadSnapshotChunk)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(ABCIApplicationServer).Query(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.ABCIApplication/Query",
+	if !bytes.Equal(this.Chunk, that1.Chunk) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ABCIApplicationServer).Query(ctx, req.(*RequestQuery))
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *ResponseApplySnapshotChunk) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _ABCIApplication_Commit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RequestCommit)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*ResponseApplySnapshotChunk)
+	if !ok {
+		that2, ok := that.(ResponseApplySnapshotChunk)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(ABCIApplicationServer).Commit(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.ABCIApplication/Commit",
+	if this.Result != that1.Result {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ABCIApplicationServer).Commit(ctx, req.(*RequestCommit))
+	if len(this.RefetchChunks) != len(that1.RefetchChunks) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _ABCIApplication_InitChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RequestInitChain)
-	if err := dec(in); err != nil {
-		return nil, err
+	for i := range this.RefetchChunks {
+		if this.RefetchChunks[i] != that1.RefetchChunks[i] {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(ABCIApplicationServer).InitChain(ctx, in)
+	if len(this.RejectSenders) != len(that1.RejectSenders) {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.ABCIApplication/InitChain",
+	for i := range this.RejectSenders {
+		if this.RejectSenders[i] != that1.RejectSenders[i] {
+			return false
+		}
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ABCIApplicationServer).InitChain(ctx, req.(*RequestInitChain))
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *Snapshot) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _ABCIApplication_BeginBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RequestBeginBlock)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*Snapshot)
+	if !ok {
+		that2, ok := that.(Snapshot)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(ABCIApplicationServer).BeginBlock(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.ABCIApplication/BeginBlock",
+	if this.Height != that1.Height {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ABCIApplicationServer).BeginBlock(ctx, req.(*RequestBeginBlock))
+	if this.Format != that1.Format {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _ABCIApplication_EndBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RequestEndBlock)
-	if err := dec(in); err != nil {
-		return nil, err
+	if this.Chunks != that1.Chunks {
+		return false
 	}
-	if interceptor == nil {
-		return srv.(ABCIApplicationServer).EndBlock(ctx, in)
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.ABCIApplication/EndBlock",
+	if !bytes.Equal(this.Metadata, that1.Metadata) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ABCIApplicationServer).EndBlock(ctx, req.(*RequestEndBlock))
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
 
-var _ABCIApplication_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "types.ABCIApplication",
-	HandlerType: (*ABCIApplicationServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Echo",
-			Handler:    _ABCIApplication_Echo_Handler,
-		},
-		{
-			MethodName: "Flush",
-			Handler:    _ABCIApplication_Flush_Handler,
-		},
-		{
-			MethodName: "Info",
-			Handler:    _ABCIApplication_Info_Handler,
-		},
-		{
-			MethodName: "SetOption",
-			Handler:    _ABCIApplication_SetOption_Handler,
-		},
-		{
-			MethodName: "DeliverTx",
-			Handler:    _ABCIApplication_DeliverTx_Handler,
-		},
-		{
-			MethodName: "CheckTx",
-			Handler:    _ABCIApplication_CheckTx_Handler,
-		},
-		{
-			MethodName: "Query",
-			Handler:    _ABCIApplication_Query_Handler,
-		},
-		{
-			MethodName: "Commit",
-			Handler:    _ABCIApplication_Commit_Handler,
-		},
-		{
-			MethodName: "InitChain",
-			Handler:    _ABCIApplication_InitChain_Handler,
-		},
-		{
-			MethodName: "BeginBlock",
-			Handler:    _ABCIApplication_BeginBlock_Handler,
-		},
-		{
-			MethodName: "EndBlock",
-			Handler:    _ABCIApplication_EndBlock_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "abci/types/types.proto",
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ABCIApplicationClient is the client API for ABCIApplication service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ABCIApplicationClient interface {
+	Echo(ctx context.Context, in *RequestEcho, opts ...grpc.CallOption) (*ResponseEcho, error)
+	Flush(ctx context.Context, in *RequestFlush, opts ...grpc.CallOption) (*ResponseFlush, error)
+	Info(ctx context.Context, in *RequestInfo, opts ...grpc.CallOption) (*ResponseInfo, error)
+	SetOption(ctx context.Context, in *RequestSetOption, opts ...grpc.CallOption) (*ResponseSetOption, error)
+	DeliverTx(ctx context.Context, in *RequestDeliverTx, opts ...grpc.CallOption) (*ResponseDeliverTx, error)
+	CheckTx(ctx context.Context, in *RequestCheckTx, opts ...grpc.CallOption) (*ResponseCheckTx, error)
+	Query(ctx context.Context, in *RequestQuery, opts ...grpc.CallOption) (*ResponseQuery, error)
+	Commit(ctx context.Context, in *RequestCommit, opts ...grpc.CallOption) (*ResponseCommit, error)
+	InitChain(ctx context.Context, in *RequestInitChain, opts ...grpc.CallOption) (*ResponseInitChain, error)
+	BeginBlock(ctx context.Context, in *RequestBeginBlock, opts ...grpc.CallOption) (*ResponseBeginBlock, error)
+	EndBlock(ctx context.Context, in *RequestEndBlock, opts ...grpc.CallOption) (*ResponseEndBlock, error)
+	ListSnapshots(ctx context.Context, in *RequestListSnapshots, opts ...grpc.CallOption) (*ResponseListSnapshots, error)
+	OfferSnapshot(ctx context.Context, in *RequestOfferSnapshot, opts ...grpc.CallOption) (*ResponseOfferSnapshot, error)
+	LoadSnapshotChunk(ctx context.Context, in *RequestLoadSnapshotChunk, opts ...grpc.CallOption) (*ResponseLoadSnapshotChunk, error)
+	ApplySnapshotChunk(ctx context.Context, in *RequestApplySnapshotChunk, opts ...grpc.CallOption) (*ResponseApplySnapshotChunk, error)
 }
 
-func (m *Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+type aBCIApplicationClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewABCIApplicationClient(cc *grpc.ClientConn) ABCIApplicationClient {
+	return &aBCIApplicationClient{cc}
+}
+
+func (c *aBCIApplicationClient) Echo(ctx context.Context, in *RequestEcho, opts ...grpc.CallOption) (*ResponseEcho, error) {
+	out := new(ResponseEcho)
+	err := c.cc.Invoke(ctx, "/types.ABCIApplication/Echo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Request) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Value != nil {
-		nn1, err := m.Value.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += nn1
+func (c *aBCIApplicationClient) Flush(ctx context.Context, in *RequestFlush, opts ...grpc.CallOption) (*ResponseFlush, error) {
+	out := new(ResponseFlush)
+	err := c.cc.Invoke(ctx, "/types.ABCIApplication/Flush", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *aBCIApplicationClient) Info(ctx context.Context, in *RequestInfo, opts ...grpc.CallOption) (*ResponseInfo, error) {
+	out := new(ResponseInfo)
+	err := c.cc.Invoke(ctx, "/types.ABCIApplication/Info", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *Request_Echo) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Echo != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Echo.Size()))
-		n2, err := m.Echo.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
+func (c *aBCIApplicationClient) SetOption(ctx context.Context, in *RequestSetOption, opts ...grpc.CallOption) (*ResponseSetOption, error) {
+	out := new(ResponseSetOption)
+	err := c.cc.Invoke(ctx, "/types.ABCIApplication/SetOption", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
-func (m *Request_Flush) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Flush != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Flush.Size()))
-		n3, err := m.Flush.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
+
+func (c *aBCIApplicationClient) DeliverTx(ctx context.Context, in *RequestDeliverTx, opts ...grpc.CallOption) (*ResponseDeliverTx, error) {
+	out := new(ResponseDeliverTx)
+	err := c.cc.Invoke(ctx, "/types.ABCIApplication/DeliverTx", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
-func (m *Request_Info) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Info != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Info.Size()))
-		n4, err := m.Info.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
-	}
-	return i, nil
-}
-func (m *Request_SetOption) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.SetOption != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.SetOption.Size()))
-		n5, err := m.SetOption.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n5
-	}
-	return i, nil
-}
-func (m *Request_InitChain) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.InitChain != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.InitChain.Size()))
-		n6, err := m.InitChain.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
-	}
-	return i, nil
-}
-func (m *Request_Query) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Query != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Query.Size()))
-		n7, err := m.Query.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n7
-	}
-	return i, nil
-}
-func (m *Request_BeginBlock) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.BeginBlock != nil {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.BeginBlock.Size()))
-		n8, err := m.BeginBlock.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n8
-	}
-	return i, nil
-}
-func (m *Request_CheckTx) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.CheckTx != nil {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.CheckTx.Size()))
-		n9, err := m.CheckTx.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n9
-	}
-	return i, nil
-}
-func (m *Request_EndBlock) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.EndBlock != nil {
-		dAtA[i] = 0x5a
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.EndBlock.Size()))
-		n10, err := m.EndBlock.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n10
-	}
-	return i, nil
-}
-func (m *Request_Commit) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Commit != nil {
-		dAtA[i] = 0x62
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Commit.Size()))
-		n11, err := m.Commit.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n11
-	}
-	return i, nil
-}
-func (m *Request_DeliverTx) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.DeliverTx != nil {
-		dAtA[i] = 0x9a
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.DeliverTx.Size()))
-		n12, err := m.DeliverTx.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n12
+
+func (c *aBCIApplicationClient) CheckTx(ctx context.Context, in *RequestCheckTx, opts ...grpc.CallOption) (*ResponseCheckTx, error) {
+	out := new(ResponseCheckTx)
+	err := c.cc.Invoke(ctx, "/types.ABCIApplication/CheckTx", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
-func (m *RequestEcho) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+
+func (c *aBCIApplicationClient) Query(ctx context.Context, in *RequestQuery, opts ...grpc.CallOption) (*ResponseQuery, error) {
+	out := new(ResponseQuery)
+	err := c.cc.Invoke(ctx, "/types.ABCIApplication/Query", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RequestEcho) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Message) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Message)))
-		i += copy(dAtA[i:], m.Message)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *aBCIApplicationClient) Commit(ctx context.Context, in *RequestCommit, opts ...grpc.CallOption) (*ResponseCommit, error) {
+	out := new(ResponseCommit)
+	err := c.cc.Invoke(ctx, "/types.ABCIApplication/Commit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *RequestFlush) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *aBCIApplicationClient) InitChain(ctx context.Context, in *RequestInitChain, opts ...grpc.CallOption) (*ResponseInitChain, error) {
+	out := new(ResponseInitChain)
+	err := c.cc.Invoke(ctx, "/types.ABCIApplication/InitChain", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RequestFlush) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *aBCIApplicationClient) BeginBlock(ctx context.Context, in *RequestBeginBlock, opts ...grpc.CallOption) (*ResponseBeginBlock, error) {
+	out := new(ResponseBeginBlock)
+	err := c.cc.Invoke(ctx, "/types.ABCIApplication/BeginBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *RequestInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *aBCIApplicationClient) EndBlock(ctx context.Context, in *RequestEndBlock, opts ...grpc.CallOption) (*ResponseEndBlock, error) {
+	out := new(ResponseEndBlock)
+	err := c.cc.Invoke(ctx, "/types.ABCIApplication/EndBlock", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RequestInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Version) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Version)))
-		i += copy(dAtA[i:], m.Version)
-	}
-	if m.BlockVersion != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.BlockVersion))
-	}
-	if m.P2PVersion != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.P2PVersion))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *aBCIApplicationClient) ListSnapshots(ctx context.Context, in *RequestListSnapshots, opts ...grpc.CallOption) (*ResponseListSnapshots, error) {
+	out := new(ResponseListSnapshots)
+	err := c.cc.Invoke(ctx, "/types.ABCIApplication/ListSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *RequestSetOption) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *aBCIApplicationClient) OfferSnapshot(ctx context.Context, in *RequestOfferSnapshot, opts ...grpc.CallOption) (*ResponseOfferSnapshot, error) {
+	out := new(ResponseOfferSnapshot)
+	err := c.cc.Invoke(ctx, "/types.ABCIApplication/OfferSnapshot", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RequestSetOption) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
-	}
-	if len(m.Value) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *aBCIApplicationClient) LoadSnapshotChunk(ctx context.Context, in *RequestLoadSnapshotChunk, opts ...grpc.CallOption) (*ResponseLoadSnapshotChunk, error) {
+	out := new(ResponseLoadSnapshotChunk)
+	err := c.cc.Invoke(ctx, "/types.ABCIApplication/LoadSnapshotChunk", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *RequestInitChain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *aBCIApplicationClient) ApplySnapshotChunk(ctx context.Context, in *RequestApplySnapshotChunk, opts ...grpc.CallOption) (*ResponseApplySnapshotChunk, error) {
+	out := new(ResponseApplySnapshotChunk)
+	err := c.cc.Invoke(ctx, "/types.ABCIApplication/ApplySnapshotChunk", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RequestInitChain) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintTypes(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)))
-	n13, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n13
-	if len(m.ChainId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainId)))
-		i += copy(dAtA[i:], m.ChainId)
-	}
-	if m.ConsensusParams != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.ConsensusParams.Size()))
-		n14, err := m.ConsensusParams.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n14
-	}
-	if len(m.Validators) > 0 {
-		for _, msg := range m.Validators {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.AppStateBytes) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.AppStateBytes)))
-		i += copy(dAtA[i:], m.AppStateBytes)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// ABCIApplicationServer is the server API for ABCIApplication service.
+type ABCIApplicationServer interface {
+	Echo(context.Context, *RequestEcho) (*ResponseEcho, error)
+	Flush(context.Context, *RequestFlush) (*ResponseFlush, error)
+	Info(context.Context, *RequestInfo) (*ResponseInfo, error)
+	SetOption(context.Context, *RequestSetOption) (*ResponseSetOption, error)
+	DeliverTx(context.Context, *RequestDeliverTx) (*ResponseDeliverTx, error)
+	CheckTx(context.Context, *RequestCheckTx) (*ResponseCheckTx, error)
+	Query(context.Context, *RequestQuery) (*ResponseQuery, error)
+	Commit(context.Context, *RequestCommit) (*ResponseCommit, error)
+	InitChain(context.Context, *RequestInitChain) (*ResponseInitChain, error)
+	BeginBlock(context.Context, *RequestBeginBlock) (*ResponseBeginBlock, error)
+	EndBlock(context.Context, *RequestEndBlock) (*ResponseEndBlock, error)
+	ListSnapshots(context.Context, *RequestListSnapshots) (*ResponseListSnapshots, error)
+	OfferSnapshot(context.Context, *RequestOfferSnapshot) (*ResponseOfferSnapshot, error)
+	LoadSnapshotChunk(context.Context, *RequestLoadSnapshotChunk) (*ResponseLoadSnapshotChunk, error)
+	ApplySnapshotChunk(context.Context, *RequestApplySnapshotChunk) (*ResponseApplySnapshotChunk, error)
 }
 
-func (m *RequestQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func RegisterABCIApplicationServer(s *grpc.Server, srv ABCIApplicationServer) {
+	s.RegisterService(&_ABCIApplication_serviceDesc, srv)
 }
 
-func (m *RequestQuery) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
-	}
-	if len(m.Path) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Path)))
-		i += copy(dAtA[i:], m.Path)
+func _ABCIApplication_Echo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestEcho)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Height != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+	if interceptor == nil {
+		return srv.(ABCIApplicationServer).Echo(ctx, in)
 	}
-	if m.Prove {
-		dAtA[i] = 0x20
-		i++
-		if m.Prove {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.ABCIApplication/Echo",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIApplicationServer).Echo(ctx, req.(*RequestEcho))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RequestBeginBlock) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _ABCIApplication_Flush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestFlush)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ABCIApplicationServer).Flush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.ABCIApplication/Flush",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIApplicationServer).Flush(ctx, req.(*RequestFlush))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RequestBeginBlock) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
-		i += copy(dAtA[i:], m.Hash)
-	}
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintTypes(dAtA, i, uint64(m.Header.Size()))
-	n15, err := m.Header.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+func _ABCIApplication_Info_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestInfo)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i += n15
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintTypes(dAtA, i, uint64(m.LastCommitInfo.Size()))
-	n16, err := m.LastCommitInfo.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if interceptor == nil {
+		return srv.(ABCIApplicationServer).Info(ctx, in)
 	}
-	i += n16
-	if len(m.ByzantineValidators) > 0 {
-		for _, msg := range m.ByzantineValidators {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.ABCIApplication/Info",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIApplicationServer).Info(ctx, req.(*RequestInfo))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RequestCheckTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _ABCIApplication_SetOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestSetOption)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RequestCheckTx) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tx) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Tx)))
-		i += copy(dAtA[i:], m.Tx)
+	if interceptor == nil {
+		return srv.(ABCIApplicationServer).SetOption(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.ABCIApplication/SetOption",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIApplicationServer).SetOption(ctx, req.(*RequestSetOption))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RequestDeliverTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _ABCIApplication_DeliverTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDeliverTx)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RequestDeliverTx) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tx) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Tx)))
-		i += copy(dAtA[i:], m.Tx)
+	if interceptor == nil {
+		return srv.(ABCIApplicationServer).DeliverTx(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.ABCIApplication/DeliverTx",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIApplicationServer).DeliverTx(ctx, req.(*RequestDeliverTx))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RequestEndBlock) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _ABCIApplication_CheckTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestCheckTx)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ABCIApplicationServer).CheckTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.ABCIApplication/CheckTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIApplicationServer).CheckTx(ctx, req.(*RequestCheckTx))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RequestEndBlock) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+func _ABCIApplication_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(ABCIApplicationServer).Query(ctx, in)
 	}
-	return i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.ABCIApplication/Query",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIApplicationServer).Query(ctx, req.(*RequestQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RequestCommit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _ABCIApplication_Commit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestCommit)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ABCIApplicationServer).Commit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.ABCIApplication/Commit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIApplicationServer).Commit(ctx, req.(*RequestCommit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RequestCommit) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func _ABCIApplication_InitChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestInitChain)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return i, nil
+	if interceptor == nil {
+		return srv.(ABCIApplicationServer).InitChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.ABCIApplication/InitChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIApplicationServer).InitChain(ctx, req.(*RequestInitChain))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Response) Marshal() (dAtA []byte, err error) {
+func _ABCIApplication_BeginBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestBeginBlock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ABCIApplicationServer).BeginBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.ABCIApplication/BeginBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIApplicationServer).BeginBlock(ctx, req.(*RequestBeginBlock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ABCIApplication_EndBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestEndBlock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ABCIApplicationServer).EndBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.ABCIApplication/EndBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIApplicationServer).EndBlock(ctx, req.(*RequestEndBlock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ABCIApplication_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestListSnapshots)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ABCIApplicationServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.ABCIApplication/ListSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIApplicationServer).ListSnapshots(ctx, req.(*RequestListSnapshots))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ABCIApplication_OfferSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestOfferSnapshot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ABCIApplicationServer).OfferSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.ABCIApplication/OfferSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIApplicationServer).OfferSnapshot(ctx, req.(*RequestOfferSnapshot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ABCIApplication_LoadSnapshotChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestLoadSnapshotChunk)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ABCIApplicationServer).LoadSnapshotChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.ABCIApplication/LoadSnapshotChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIApplicationServer).LoadSnapshotChunk(ctx, req.(*RequestLoadSnapshotChunk))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ABCIApplication_ApplySnapshotChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestApplySnapshotChunk)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ABCIApplicationServer).ApplySnapshotChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.ABCIApplication/ApplySnapshotChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIApplicationServer).ApplySnapshotChunk(ctx, req.(*RequestApplySnapshotChunk))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ABCIApplication_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "types.ABCIApplication",
+	HandlerType: (*ABCIApplicationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Echo",
+			Handler:    _ABCIApplication_Echo_Handler,
+		},
+		{
+			MethodName: "Flush",
+			Handler:    _ABCIApplication_Flush_Handler,
+		},
+		{
+			MethodName: "Info",
+			Handler:    _ABCIApplication_Info_Handler,
+		},
+		{
+			MethodName: "SetOption",
+			Handler:    _ABCIApplication_SetOption_Handler,
+		},
+		{
+			MethodName: "DeliverTx",
+			Handler:    _ABCIApplication_DeliverTx_Handler,
+		},
+		{
+			MethodName: "CheckTx",
+			Handler:    _ABCIApplication_CheckTx_Handler,
+		},
+		{
+			MethodName: "Query",
+			Handler:    _ABCIApplication_Query_Handler,
+		},
+		{
+			MethodName: "Commit",
+			Handler:    _ABCIApplication_Commit_Handler,
+		},
+		{
+			MethodName: "InitChain",
+			Handler:    _ABCIApplication_InitChain_Handler,
+		},
+		{
+			MethodName: "BeginBlock",
+			Handler:    _ABCIApplication_BeginBlock_Handler,
+		},
+		{
+			MethodName: "EndBlock",
+			Handler:    _ABCIApplication_EndBlock_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _ABCIApplication_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "OfferSnapshot",
+			Handler:    _ABCIApplication_OfferSnapshot_Handler,
+		},
+		{
+			MethodName: "LoadSnapshotChunk",
+			Handler:    _ABCIApplication_LoadSnapshotChunk_Handler,
+		},
+		{
+			MethodName: "ApplySnapshotChunk",
+			Handler:    _ABCIApplication_ApplySnapshotChunk_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "abci/types/types.proto",
+}
+
+func (m *Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6215,17 +7236,17 @@ func (m *Response) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Response) MarshalTo(dAtA []byte) (int, error) {
+func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	if m.Value != nil {
-		nn17, err := m.Value.MarshalTo(dAtA[i:])
+		nn1, err := m.Value.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn17
+		i += nn1
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6233,202 +7254,221 @@ func (m *Response) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *Response_Exception) MarshalTo(dAtA []byte) (int, error) {
+func (m *Request_Echo) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.Exception != nil {
-		dAtA[i] = 0xa
+	if m.Echo != nil {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Exception.Size()))
-		n18, err := m.Exception.MarshalTo(dAtA[i:])
+		i = encodeVarintTypes(dAtA, i, uint64(m.Echo.Size()))
+		n2, err := m.Echo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n2
 	}
 	return i, nil
 }
-func (m *Response_Echo) MarshalTo(dAtA []byte) (int, error) {
+func (m *Request_Flush) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.Echo != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Echo.Size()))
-		n19, err := m.Echo.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n19
-	}
-	return i, nil
-}
-func (m *Response_Flush) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Flush != nil {
-		dAtA[i] = 0x1a
+	if m.Flush != nil {
+		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.Flush.Size()))
-		n20, err := m.Flush.MarshalTo(dAtA[i:])
+		n3, err := m.Flush.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n3
 	}
 	return i, nil
 }
-func (m *Response_Info) MarshalTo(dAtA []byte) (int, error) {
+func (m *Request_Info) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.Info != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.Info.Size()))
-		n21, err := m.Info.MarshalTo(dAtA[i:])
+		n4, err := m.Info.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n4
 	}
 	return i, nil
 }
-func (m *Response_SetOption) MarshalTo(dAtA []byte) (int, error) {
+func (m *Request_SetOption) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.SetOption != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.SetOption.Size()))
-		n22, err := m.SetOption.MarshalTo(dAtA[i:])
+		n5, err := m.SetOption.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n5
 	}
 	return i, nil
 }
-func (m *Response_InitChain) MarshalTo(dAtA []byte) (int, error) {
+func (m *Request_InitChain) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.InitChain != nil {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.InitChain.Size()))
-		n23, err := m.InitChain.MarshalTo(dAtA[i:])
+		n6, err := m.InitChain.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n6
 	}
 	return i, nil
 }
-func (m *Response_Query) MarshalTo(dAtA []byte) (int, error) {
+func (m *Request_Query) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.Query != nil {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.Query.Size()))
-		n24, err := m.Query.MarshalTo(dAtA[i:])
+		n7, err := m.Query.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n7
 	}
 	return i, nil
 }
-func (m *Response_BeginBlock) MarshalTo(dAtA []byte) (int, error) {
+func (m *Request_BeginBlock) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.BeginBlock != nil {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.BeginBlock.Size()))
-		n25, err := m.BeginBlock.MarshalTo(dAtA[i:])
+		n8, err := m.BeginBlock.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n8
 	}
 	return i, nil
 }
-func (m *Response_CheckTx) MarshalTo(dAtA []byte) (int, error) {
+func (m *Request_CheckTx) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.CheckTx != nil {
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.CheckTx.Size()))
-		n26, err := m.CheckTx.MarshalTo(dAtA[i:])
+		n9, err := m.CheckTx.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n9
 	}
 	return i, nil
 }
-func (m *Response_DeliverTx) MarshalTo(dAtA []byte) (int, error) {
+func (m *Request_EndBlock) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.DeliverTx != nil {
-		dAtA[i] = 0x52
+	if m.EndBlock != nil {
+		dAtA[i] = 0x5a
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.DeliverTx.Size()))
-		n27, err := m.DeliverTx.MarshalTo(dAtA[i:])
+		i = encodeVarintTypes(dAtA, i, uint64(m.EndBlock.Size()))
+		n10, err := m.EndBlock.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n10
 	}
 	return i, nil
 }
-func (m *Response_EndBlock) MarshalTo(dAtA []byte) (int, error) {
+func (m *Request_Commit) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.EndBlock != nil {
-		dAtA[i] = 0x5a
+	if m.Commit != nil {
+		dAtA[i] = 0x62
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.EndBlock.Size()))
-		n28, err := m.EndBlock.MarshalTo(dAtA[i:])
+		i = encodeVarintTypes(dAtA, i, uint64(m.Commit.Size()))
+		n11, err := m.Commit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n11
 	}
 	return i, nil
 }
-func (m *Response_Commit) MarshalTo(dAtA []byte) (int, error) {
+func (m *Request_ListSnapshots) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.Commit != nil {
-		dAtA[i] = 0x62
+	if m.ListSnapshots != nil {
+		dAtA[i] = 0x6a
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Commit.Size()))
-		n29, err := m.Commit.MarshalTo(dAtA[i:])
+		i = encodeVarintTypes(dAtA, i, uint64(m.ListSnapshots.Size()))
+		n44, err := m.ListSnapshots.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n44
 	}
 	return i, nil
 }
-func (m *ResponseException) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Request_OfferSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.OfferSnapshot != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.OfferSnapshot.Size()))
+		n45, err := m.OfferSnapshot.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n45
 	}
-	return dAtA[:n], nil
+	return i, nil
 }
-
-func (m *ResponseException) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Error) > 0 {
-		dAtA[i] = 0xa
+func (m *Request_LoadSnapshotChunk) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.LoadSnapshotChunk != nil {
+		dAtA[i] = 0x7a
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Error)))
-		i += copy(dAtA[i:], m.Error)
+		i = encodeVarintTypes(dAtA, i, uint64(m.LoadSnapshotChunk.Size()))
+		n46, err := m.LoadSnapshotChunk.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n46
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return i, nil
+}
+func (m *Request_ApplySnapshotChunk) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ApplySnapshotChunk != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.ApplySnapshotChunk.Size()))
+		n47, err := m.ApplySnapshotChunk.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n47
 	}
 	return i, nil
 }
-
-func (m *ResponseEcho) Marshal() (dAtA []byte, err error) {
+func (m *Request_DeliverTx) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.DeliverTx != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.DeliverTx.Size()))
+		n12, err := m.DeliverTx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	return i, nil
+}
+func (m *RequestEcho) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6438,7 +7478,7 @@ func (m *ResponseEcho) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResponseEcho) MarshalTo(dAtA []byte) (int, error) {
+func (m *RequestEcho) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6455,7 +7495,7 @@ func (m *ResponseEcho) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ResponseFlush) Marshal() (dAtA []byte, err error) {
+func (m *RequestFlush) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6465,7 +7505,7 @@ func (m *ResponseFlush) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResponseFlush) MarshalTo(dAtA []byte) (int, error) {
+func (m *RequestFlush) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6476,7 +7516,7 @@ func (m *ResponseFlush) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ResponseInfo) Marshal() (dAtA []byte, err error) {
+func (m *RequestInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6486,38 +7526,26 @@ func (m *ResponseInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResponseInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *RequestInfo) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
-	}
 	if len(m.Version) > 0 {
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(len(m.Version)))
 		i += copy(dAtA[i:], m.Version)
 	}
-	if m.AppVersion != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.AppVersion))
-	}
-	if m.LastBlockHeight != 0 {
-		dAtA[i] = 0x20
+	if m.BlockVersion != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.LastBlockHeight))
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockVersion))
 	}
-	if len(m.LastBlockAppHash) > 0 {
-		dAtA[i] = 0x2a
+	if m.P2PVersion != 0 {
+		dAtA[i] = 0x18
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.LastBlockAppHash)))
-		i += copy(dAtA[i:], m.LastBlockAppHash)
+		i = encodeVarintTypes(dAtA, i, uint64(m.P2PVersion))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6525,7 +7553,7 @@ func (m *ResponseInfo) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ResponseSetOption) Marshal() (dAtA []byte, err error) {
+func (m *RequestSetOption) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6535,27 +7563,22 @@ func (m *ResponseSetOption) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResponseSetOption) MarshalTo(dAtA []byte) (int, error) {
+func (m *RequestSetOption) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Code != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Code))
-	}
-	if len(m.Log) > 0 {
-		dAtA[i] = 0x1a
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Log)))
-		i += copy(dAtA[i:], m.Log)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
 	}
-	if len(m.Info) > 0 {
-		dAtA[i] = 0x22
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Info)))
-		i += copy(dAtA[i:], m.Info)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6563,7 +7586,7 @@ func (m *ResponseSetOption) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ResponseInitChain) Marshal() (dAtA []byte, err error) {
+func (m *RequestInitChain) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6573,24 +7596,38 @@ func (m *ResponseInitChain) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResponseInitChain) MarshalTo(dAtA []byte) (int, error) {
+func (m *RequestInitChain) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintTypes(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)))
+	n13, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n13
+	if len(m.ChainId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainId)))
+		i += copy(dAtA[i:], m.ChainId)
+	}
 	if m.ConsensusParams != nil {
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.ConsensusParams.Size()))
-		n30, err := m.ConsensusParams.MarshalTo(dAtA[i:])
+		n14, err := m.ConsensusParams.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n14
 	}
 	if len(m.Validators) > 0 {
 		for _, msg := range m.Validators {
-			dAtA[i] = 0x12
+			dAtA[i] = 0x22
 			i++
 			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -6600,13 +7637,19 @@ func (m *ResponseInitChain) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.AppStateBytes) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AppStateBytes)))
+		i += copy(dAtA[i:], m.AppStateBytes)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *ResponseQuery) Marshal() (dAtA []byte, err error) {
+func (m *RequestQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6616,65 +7659,37 @@ func (m *ResponseQuery) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResponseQuery) MarshalTo(dAtA []byte) (int, error) {
+func (m *RequestQuery) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Code != 0 {
-		dAtA[i] = 0x8
+	if len(m.Data) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Code))
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
 	}
-	if len(m.Log) > 0 {
-		dAtA[i] = 0x1a
+	if len(m.Path) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Log)))
-		i += copy(dAtA[i:], m.Log)
-	}
-	if len(m.Info) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Info)))
-		i += copy(dAtA[i:], m.Info)
-	}
-	if m.Index != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Index))
-	}
-	if len(m.Key) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
-	}
-	if len(m.Value) > 0 {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
-	}
-	if m.Proof != nil {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Proof.Size()))
-		n31, err := m.Proof.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n31
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
 	}
 	if m.Height != 0 {
-		dAtA[i] = 0x48
+		dAtA[i] = 0x18
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
 	}
-	if len(m.Codespace) > 0 {
-		dAtA[i] = 0x52
+	if m.Prove {
+		dAtA[i] = 0x20
+		i++
+		if m.Prove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Codespace)))
-		i += copy(dAtA[i:], m.Codespace)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6682,7 +7697,7 @@ func (m *ResponseQuery) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ResponseBeginBlock) Marshal() (dAtA []byte, err error) {
+func (m *RequestBeginBlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6692,14 +7707,36 @@ func (m *ResponseBeginBlock) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResponseBeginBlock) MarshalTo(dAtA []byte) (int, error) {
+func (m *RequestBeginBlock) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Tags) > 0 {
-		for _, msg := range m.Tags {
-			dAtA[i] = 0xa
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintTypes(dAtA, i, uint64(m.Header.Size()))
+	n15, err := m.Header.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n15
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintTypes(dAtA, i, uint64(m.LastCommitInfo.Size()))
+	n16, err := m.LastCommitInfo.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n16
+	if len(m.ByzantineValidators) > 0 {
+		for _, msg := range m.ByzantineValidators {
+			dAtA[i] = 0x22
 			i++
 			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -6715,7 +7752,7 @@ func (m *ResponseBeginBlock) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ResponseCheckTx) Marshal() (dAtA []byte, err error) {
+func (m *RequestCheckTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6725,61 +7762,16 @@ func (m *ResponseCheckTx) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResponseCheckTx) MarshalTo(dAtA []byte) (int, error) {
+func (m *RequestCheckTx) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Code != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Code))
-	}
-	if len(m.Data) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
-	}
-	if len(m.Log) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Log)))
-		i += copy(dAtA[i:], m.Log)
-	}
-	if len(m.Info) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Info)))
-		i += copy(dAtA[i:], m.Info)
-	}
-	if m.GasWanted != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.GasWanted))
-	}
-	if m.GasUsed != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.GasUsed))
-	}
-	if len(m.Tags) > 0 {
-		for _, msg := range m.Tags {
-			dAtA[i] = 0x3a
-			i++
-			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.Codespace) > 0 {
-		dAtA[i] = 0x42
+	if len(m.Tx) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Codespace)))
-		i += copy(dAtA[i:], m.Codespace)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Tx)))
+		i += copy(dAtA[i:], m.Tx)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6787,7 +7779,7 @@ func (m *ResponseCheckTx) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ResponseDeliverTx) Marshal() (dAtA []byte, err error) {
+func (m *RequestDeliverTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6797,61 +7789,16 @@ func (m *ResponseDeliverTx) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResponseDeliverTx) MarshalTo(dAtA []byte) (int, error) {
+func (m *RequestDeliverTx) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Code != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Code))
-	}
-	if len(m.Data) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
-	}
-	if len(m.Log) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Log)))
-		i += copy(dAtA[i:], m.Log)
-	}
-	if len(m.Info) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Info)))
-		i += copy(dAtA[i:], m.Info)
-	}
-	if m.GasWanted != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.GasWanted))
-	}
-	if m.GasUsed != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.GasUsed))
-	}
-	if len(m.Tags) > 0 {
-		for _, msg := range m.Tags {
-			dAtA[i] = 0x3a
-			i++
-			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.Codespace) > 0 {
-		dAtA[i] = 0x42
+	if len(m.Tx) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Codespace)))
-		i += copy(dAtA[i:], m.Codespace)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Tx)))
+		i += copy(dAtA[i:], m.Tx)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6859,7 +7806,7 @@ func (m *ResponseDeliverTx) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ResponseEndBlock) Marshal() (dAtA []byte, err error) {
+func (m *RequestEndBlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6869,44 +7816,15 @@ func (m *ResponseEndBlock) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResponseEndBlock) MarshalTo(dAtA []byte) (int, error) {
+func (m *RequestEndBlock) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValidatorUpdates) > 0 {
-		for _, msg := range m.ValidatorUpdates {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.ConsensusParamUpdates != nil {
-		dAtA[i] = 0x12
+	if m.Height != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.ConsensusParamUpdates.Size()))
-		n32, err := m.ConsensusParamUpdates.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n32
-	}
-	if len(m.Tags) > 0 {
-		for _, msg := range m.Tags {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6914,7 +7832,7 @@ func (m *ResponseEndBlock) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ResponseCommit) Marshal() (dAtA []byte, err error) {
+func (m *RequestCommit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6924,24 +7842,18 @@ func (m *ResponseCommit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResponseCommit) MarshalTo(dAtA []byte) (int, error) {
+func (m *RequestCommit) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
-	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *ConsensusParams) Marshal() (dAtA []byte, err error) {
+func (m *Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6951,48 +7863,251 @@ func (m *ConsensusParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ConsensusParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *Response) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.BlockSize != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.BlockSize.Size()))
-		n33, err := m.BlockSize.MarshalTo(dAtA[i:])
+	if m.Value != nil {
+		nn17, err := m.Value.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += nn17
 	}
-	if m.Evidence != nil {
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Response_Exception) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Exception != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Exception.Size()))
+		n18, err := m.Exception.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	return i, nil
+}
+func (m *Response_Echo) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Echo != nil {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Evidence.Size()))
-		n34, err := m.Evidence.MarshalTo(dAtA[i:])
+		i = encodeVarintTypes(dAtA, i, uint64(m.Echo.Size()))
+		n19, err := m.Echo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n19
 	}
-	if m.Validator != nil {
+	return i, nil
+}
+func (m *Response_Flush) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Flush != nil {
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Validator.Size()))
-		n35, err := m.Validator.MarshalTo(dAtA[i:])
+		i = encodeVarintTypes(dAtA, i, uint64(m.Flush.Size()))
+		n20, err := m.Flush.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n20
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return i, nil
+}
+func (m *Response_Info) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Info != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Info.Size()))
+		n21, err := m.Info.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
 	}
 	return i, nil
 }
-
-func (m *BlockSizeParams) Marshal() (dAtA []byte, err error) {
+func (m *Response_SetOption) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.SetOption != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.SetOption.Size()))
+		n22, err := m.SetOption.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	return i, nil
+}
+func (m *Response_InitChain) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.InitChain != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.InitChain.Size()))
+		n23, err := m.InitChain.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	return i, nil
+}
+func (m *Response_Query) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Query != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Query.Size()))
+		n24, err := m.Query.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	return i, nil
+}
+func (m *Response_BeginBlock) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BeginBlock != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.BeginBlock.Size()))
+		n25, err := m.BeginBlock.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	return i, nil
+}
+func (m *Response_CheckTx) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CheckTx != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.CheckTx.Size()))
+		n26, err := m.CheckTx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
+	}
+	return i, nil
+}
+func (m *Response_DeliverTx) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.DeliverTx != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.DeliverTx.Size()))
+		n27, err := m.DeliverTx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	return i, nil
+}
+func (m *Response_EndBlock) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EndBlock != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.EndBlock.Size()))
+		n28, err := m.EndBlock.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	return i, nil
+}
+func (m *Response_Commit) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Commit != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Commit.Size()))
+		n29, err := m.Commit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
+	return i, nil
+}
+func (m *Response_ListSnapshots) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ListSnapshots != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.ListSnapshots.Size()))
+		n48, err := m.ListSnapshots.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n48
+	}
+	return i, nil
+}
+func (m *Response_OfferSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.OfferSnapshot != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.OfferSnapshot.Size()))
+		n49, err := m.OfferSnapshot.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n49
+	}
+	return i, nil
+}
+func (m *Response_LoadSnapshotChunk) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.LoadSnapshotChunk != nil {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.LoadSnapshotChunk.Size()))
+		n50, err := m.LoadSnapshotChunk.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n50
+	}
+	return i, nil
+}
+func (m *Response_ApplySnapshotChunk) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ApplySnapshotChunk != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.ApplySnapshotChunk.Size()))
+		n51, err := m.ApplySnapshotChunk.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n51
+	}
+	return i, nil
+}
+func (m *ResponseException) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7002,20 +8117,16 @@ func (m *BlockSizeParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockSizeParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResponseException) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.MaxBytes != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.MaxBytes))
-	}
-	if m.MaxGas != 0 {
-		dAtA[i] = 0x10
+	if len(m.Error) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.MaxGas))
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7023,7 +8134,7 @@ func (m *BlockSizeParams) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *EvidenceParams) Marshal() (dAtA []byte, err error) {
+func (m *ResponseEcho) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7033,15 +8144,16 @@ func (m *EvidenceParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EvidenceParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResponseEcho) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.MaxAge != 0 {
-		dAtA[i] = 0x8
+	if len(m.Message) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.MaxAge))
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7049,7 +8161,7 @@ func (m *EvidenceParams) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ValidatorParams) Marshal() (dAtA []byte, err error) {
+func (m *ResponseFlush) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7059,33 +8171,18 @@ func (m *ValidatorParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResponseFlush) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.PubKeyTypes) > 0 {
-		for _, s := range m.PubKeyTypes {
-			dAtA[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *LastCommitInfo) Marshal() (dAtA []byte, err error) {
+func (m *ResponseInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7095,149 +8192,61 @@ func (m *LastCommitInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LastCommitInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResponseInfo) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Round != 0 {
-		dAtA[i] = 0x8
+	if len(m.Data) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
-	}
-	if len(m.Votes) > 0 {
-		for _, msg := range m.Votes {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
-}
-
-func (m *Header) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Header) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintTypes(dAtA, i, uint64(m.Version.Size()))
-	n36, err := m.Version.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
 	}
-	i += n36
-	if len(m.ChainID) > 0 {
+	if len(m.Version) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainID)))
-		i += copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
 	}
-	if m.Height != 0 {
+	if m.AppVersion != 0 {
 		dAtA[i] = 0x18
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
-	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintTypes(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)))
-	n37, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n37
-	if m.NumTxs != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.NumTxs))
-	}
-	if m.TotalTxs != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.TotalTxs))
-	}
-	dAtA[i] = 0x3a
-	i++
-	i = encodeVarintTypes(dAtA, i, uint64(m.LastBlockId.Size()))
-	n38, err := m.LastBlockId.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n38
-	if len(m.LastCommitHash) > 0 {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.LastCommitHash)))
-		i += copy(dAtA[i:], m.LastCommitHash)
-	}
-	if len(m.DataHash) > 0 {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.DataHash)))
-		i += copy(dAtA[i:], m.DataHash)
-	}
-	if len(m.ValidatorsHash) > 0 {
-		dAtA[i] = 0x52
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorsHash)))
-		i += copy(dAtA[i:], m.ValidatorsHash)
-	}
-	if len(m.NextValidatorsHash) > 0 {
-		dAtA[i] = 0x5a
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.NextValidatorsHash)))
-		i += copy(dAtA[i:], m.NextValidatorsHash)
-	}
-	if len(m.ConsensusHash) > 0 {
-		dAtA[i] = 0x62
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ConsensusHash)))
-		i += copy(dAtA[i:], m.ConsensusHash)
+		i = encodeVarintTypes(dAtA, i, uint64(m.AppVersion))
 	}
-	if len(m.AppHash) > 0 {
-		dAtA[i] = 0x6a
+	if m.LastBlockHeight != 0 {
+		dAtA[i] = 0x20
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.AppHash)))
-		i += copy(dAtA[i:], m.AppHash)
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastBlockHeight))
 	}
-	if len(m.LastResultsHash) > 0 {
-		dAtA[i] = 0x72
+	if len(m.LastBlockAppHash) > 0 {
+		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.LastResultsHash)))
-		i += copy(dAtA[i:], m.LastResultsHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LastBlockAppHash)))
+		i += copy(dAtA[i:], m.LastBlockAppHash)
 	}
-	if len(m.EvidenceHash) > 0 {
-		dAtA[i] = 0x7a
+	if len(m.OpenGroups) > 0 {
+		dAtA105 := make([]byte, len(m.OpenGroups)*10)
+		var j104 int
+		for _, num1 := range m.OpenGroups {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA105[j104] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j104++
+			}
+			dAtA105[j104] = uint8(num)
+			j104++
+		}
+		dAtA[i] = 0x32
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvidenceHash)))
-		i += copy(dAtA[i:], m.EvidenceHash)
+		i = encodeVarintTypes(dAtA, i, uint64(j104))
+		i += copy(dAtA[i:], dAtA105[:j104])
 	}
-	if len(m.ProposerAddress) > 0 {
-		dAtA[i] = 0x82
+	if m.NextGroup != 0 {
+		dAtA[i] = 0x38
 		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProposerAddress)))
-		i += copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextGroup))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7245,7 +8254,7 @@ func (m *Header) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *Version) Marshal() (dAtA []byte, err error) {
+func (m *ResponseSetOption) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7255,20 +8264,27 @@ func (m *Version) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Version) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResponseSetOption) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Block != 0 {
+	if m.Code != 0 {
 		dAtA[i] = 0x8
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Block))
+		i = encodeVarintTypes(dAtA, i, uint64(m.Code))
 	}
-	if m.App != 0 {
-		dAtA[i] = 0x10
+	if len(m.Log) > 0 {
+		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.App))
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Log)))
+		i += copy(dAtA[i:], m.Log)
+	}
+	if len(m.Info) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Info)))
+		i += copy(dAtA[i:], m.Info)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7276,7 +8292,7 @@ func (m *Version) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *BlockID) Marshal() (dAtA []byte, err error) {
+func (m *ResponseInitChain) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7286,32 +8302,40 @@ func (m *BlockID) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockID) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResponseInitChain) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Hash) > 0 {
+	if m.ConsensusParams != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
-		i += copy(dAtA[i:], m.Hash)
+		i = encodeVarintTypes(dAtA, i, uint64(m.ConsensusParams.Size()))
+		n30, err := m.ConsensusParams.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
 	}
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintTypes(dAtA, i, uint64(m.PartsHeader.Size()))
-	n39, err := m.PartsHeader.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if len(m.Validators) > 0 {
+		for _, msg := range m.Validators {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	i += n39
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *PartSetHeader) Marshal() (dAtA []byte, err error) {
+func (m *ResponseQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7321,53 +8345,65 @@ func (m *PartSetHeader) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PartSetHeader) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResponseQuery) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Total != 0 {
+	if m.Code != 0 {
 		dAtA[i] = 0x8
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Total))
+		i = encodeVarintTypes(dAtA, i, uint64(m.Code))
 	}
-	if len(m.Hash) > 0 {
-		dAtA[i] = 0x12
+	if len(m.Log) > 0 {
+		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
-		i += copy(dAtA[i:], m.Hash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Log)))
+		i += copy(dAtA[i:], m.Log)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Info) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Info)))
+		i += copy(dAtA[i:], m.Info)
 	}
-	return i, nil
-}
-
-func (m *Validator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if m.Index != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Index))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		dAtA[i] = 0xa
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x32
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
-		i += copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
 	}
-	if m.Power != 0 {
-		dAtA[i] = 0x18
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x3a
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Power))
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	if m.Proof != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Proof.Size()))
+		n31, err := m.Proof.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
+	}
+	if m.Height != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+	}
+	if len(m.Codespace) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Codespace)))
+		i += copy(dAtA[i:], m.Codespace)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7375,7 +8411,7 @@ func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ValidatorUpdate) Marshal() (dAtA []byte, err error) {
+func (m *ResponseBeginBlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7385,23 +8421,22 @@ func (m *ValidatorUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResponseBeginBlock) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintTypes(dAtA, i, uint64(m.PubKey.Size()))
-	n40, err := m.PubKey.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n40
-	if m.Power != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Power))
+	if len(m.Tags) > 0 {
+		for _, msg := range m.Tags {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7409,7 +8444,7 @@ func (m *ValidatorUpdate) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *VoteInfo) Marshal() (dAtA []byte, err error) {
+func (m *ResponseCheckTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7419,28 +8454,77 @@ func (m *VoteInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VoteInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResponseCheckTx) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintTypes(dAtA, i, uint64(m.Validator.Size()))
-	n41, err := m.Validator.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Code))
 	}
-	i += n41
-	if m.SignedLastBlock {
-		dAtA[i] = 0x10
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x12
 		i++
-		if m.SignedLastBlock {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if len(m.Log) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Log)))
+		i += copy(dAtA[i:], m.Log)
+	}
+	if len(m.Info) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Info)))
+		i += copy(dAtA[i:], m.Info)
+	}
+	if m.GasWanted != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasWanted))
+	}
+	if m.GasUsed != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasUsed))
+	}
+	if len(m.Tags) > 0 {
+		for _, msg := range m.Tags {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
+	}
+	if len(m.Codespace) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Codespace)))
+		i += copy(dAtA[i:], m.Codespace)
+	}
+	if len(m.Sender) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i += copy(dAtA[i:], m.Sender)
+	}
+	if m.Priority != 0 {
+		dAtA[i] = 0x50
 		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Priority))
+	}
+	if m.Nonce != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7448,7 +8532,7 @@ func (m *VoteInfo) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *PubKey) Marshal() (dAtA []byte, err error) {
+func (m *ResponseDeliverTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7458,16 +8542,15 @@ func (m *PubKey) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PubKey) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResponseDeliverTx) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Type) > 0 {
-		dAtA[i] = 0xa
+	if m.Code != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Type)))
-		i += copy(dAtA[i:], m.Type)
+		i = encodeVarintTypes(dAtA, i, uint64(m.Code))
 	}
 	if len(m.Data) > 0 {
 		dAtA[i] = 0x12
@@ -7475,13 +8558,53 @@ func (m *PubKey) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
 		i += copy(dAtA[i:], m.Data)
 	}
+	if len(m.Log) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Log)))
+		i += copy(dAtA[i:], m.Log)
+	}
+	if len(m.Info) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Info)))
+		i += copy(dAtA[i:], m.Info)
+	}
+	if m.GasWanted != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasWanted))
+	}
+	if m.GasUsed != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasUsed))
+	}
+	if len(m.Tags) > 0 {
+		for _, msg := range m.Tags {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Codespace) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Codespace)))
+		i += copy(dAtA[i:], m.Codespace)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *Evidence) Marshal() (dAtA []byte, err error) {
+func (m *ResponseEndBlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7491,42 +8614,85 @@ func (m *Evidence) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Evidence) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResponseEndBlock) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Type) > 0 {
-		dAtA[i] = 0xa
+	if len(m.ValidatorUpdates) > 0 {
+		for _, msg := range m.ValidatorUpdates {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.ConsensusParamUpdates != nil {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Type)))
-		i += copy(dAtA[i:], m.Type)
+		i = encodeVarintTypes(dAtA, i, uint64(m.ConsensusParamUpdates.Size()))
+		n32, err := m.ConsensusParamUpdates.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
 	}
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintTypes(dAtA, i, uint64(m.Validator.Size()))
-	n42, err := m.Validator.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if len(m.Tags) > 0 {
+		for _, msg := range m.Tags {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	i += n42
-	if m.Height != 0 {
-		dAtA[i] = 0x18
+	if len(m.OpenGroups) > 0 {
+		dAtA101 := make([]byte, len(m.OpenGroups)*10)
+		var j100 int
+		for _, num1 := range m.OpenGroups {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA101[j100] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j100++
+			}
+			dAtA101[j100] = uint8(num)
+			j100++
+		}
+		dAtA[i] = 0x22
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
-	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintTypes(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)))
-	n43, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i:])
-	if err != nil {
-		return 0, err
+		i = encodeVarintTypes(dAtA, i, uint64(j100))
+		i += copy(dAtA[i:], dAtA101[:j100])
+	}
+	if len(m.CloseGroups) > 0 {
+		dAtA103 := make([]byte, len(m.CloseGroups)*10)
+		var j102 int
+		for _, num1 := range m.CloseGroups {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA103[j102] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j102++
+			}
+			dAtA103[j102] = uint8(num)
+			j102++
+		}
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(j102))
+		i += copy(dAtA[i:], dAtA103[:j102])
 	}
-	i += n43
-	if m.TotalVotingPower != 0 {
-		dAtA[i] = 0x28
+	if m.NextGroup != 0 {
+		dAtA[i] = 0x30
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.TotalVotingPower))
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextGroup))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7534,1901 +8700,4606 @@ func (m *Evidence) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ResponseCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func NewPopulatedRequest(r randyTypes, easy bool) *Request {
-	this := &Request{}
-	oneofNumber_Value := []int32{2, 3, 4, 5, 6, 7, 8, 9, 11, 12, 19}[r.Intn(11)]
-	switch oneofNumber_Value {
-	case 2:
-		this.Value = NewPopulatedRequest_Echo(r, easy)
-	case 3:
-		this.Value = NewPopulatedRequest_Flush(r, easy)
-	case 4:
-		this.Value = NewPopulatedRequest_Info(r, easy)
-	case 5:
-		this.Value = NewPopulatedRequest_SetOption(r, easy)
-	case 6:
-		this.Value = NewPopulatedRequest_InitChain(r, easy)
-	case 7:
-		this.Value = NewPopulatedRequest_Query(r, easy)
-	case 8:
-		this.Value = NewPopulatedRequest_BeginBlock(r, easy)
-	case 9:
-		this.Value = NewPopulatedRequest_CheckTx(r, easy)
-	case 11:
-		this.Value = NewPopulatedRequest_EndBlock(r, easy)
-	case 12:
-		this.Value = NewPopulatedRequest_Commit(r, easy)
-	case 19:
-		this.Value = NewPopulatedRequest_DeliverTx(r, easy)
+
+func (m *ResponseCommit) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 20)
+	if m.RetainHeight != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.RetainHeight))
 	}
-	return this
-}
-
-func NewPopulatedRequest_Echo(r randyTypes, easy bool) *Request_Echo {
-	this := &Request_Echo{}
-	this.Echo = NewPopulatedRequestEcho(r, easy)
-	return this
-}
-func NewPopulatedRequest_Flush(r randyTypes, easy bool) *Request_Flush {
-	this := &Request_Flush{}
-	this.Flush = NewPopulatedRequestFlush(r, easy)
-	return this
-}
-func NewPopulatedRequest_Info(r randyTypes, easy bool) *Request_Info {
-	this := &Request_Info{}
-	this.Info = NewPopulatedRequestInfo(r, easy)
-	return this
-}
-func NewPopulatedRequest_SetOption(r randyTypes, easy bool) *Request_SetOption {
-	this := &Request_SetOption{}
-	this.SetOption = NewPopulatedRequestSetOption(r, easy)
-	return this
-}
-func NewPopulatedRequest_InitChain(r randyTypes, easy bool) *Request_InitChain {
-	this := &Request_InitChain{}
-	this.InitChain = NewPopulatedRequestInitChain(r, easy)
-	return this
-}
-func NewPopulatedRequest_Query(r randyTypes, easy bool) *Request_Query {
-	this := &Request_Query{}
-	this.Query = NewPopulatedRequestQuery(r, easy)
-	return this
-}
-func NewPopulatedRequest_BeginBlock(r randyTypes, easy bool) *Request_BeginBlock {
-	this := &Request_BeginBlock{}
-	this.BeginBlock = NewPopulatedRequestBeginBlock(r, easy)
-	return this
-}
-func NewPopulatedRequest_CheckTx(r randyTypes, easy bool) *Request_CheckTx {
-	this := &Request_CheckTx{}
-	this.CheckTx = NewPopulatedRequestCheckTx(r, easy)
-	return this
-}
-func NewPopulatedRequest_EndBlock(r randyTypes, easy bool) *Request_EndBlock {
-	this := &Request_EndBlock{}
-	this.EndBlock = NewPopulatedRequestEndBlock(r, easy)
-	return this
-}
-func NewPopulatedRequest_Commit(r randyTypes, easy bool) *Request_Commit {
-	this := &Request_Commit{}
-	this.Commit = NewPopulatedRequestCommit(r, easy)
-	return this
-}
-func NewPopulatedRequest_DeliverTx(r randyTypes, easy bool) *Request_DeliverTx {
-	this := &Request_DeliverTx{}
-	this.DeliverTx = NewPopulatedRequestDeliverTx(r, easy)
-	return this
-}
-func NewPopulatedRequestEcho(r randyTypes, easy bool) *RequestEcho {
-	this := &RequestEcho{}
-	this.Message = string(randStringTypes(r))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 2)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return this
+	return i, nil
 }
 
-func NewPopulatedRequestFlush(r randyTypes, easy bool) *RequestFlush {
-	this := &RequestFlush{}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 1)
+func (m *ConsensusParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedRequestInfo(r randyTypes, easy bool) *RequestInfo {
-	this := &RequestInfo{}
-	this.Version = string(randStringTypes(r))
-	this.BlockVersion = uint64(uint64(r.Uint32()))
-	this.P2PVersion = uint64(uint64(r.Uint32()))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 4)
+func (m *ConsensusParams) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.BlockSize != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockSize.Size()))
+		n33, err := m.BlockSize.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
 	}
-	return this
+	if m.Evidence != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Evidence.Size()))
+		n34, err := m.Evidence.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
+	}
+	if m.Validator != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Validator.Size()))
+		n35, err := m.Validator.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
 }
 
-func NewPopulatedRequestSetOption(r randyTypes, easy bool) *RequestSetOption {
-	this := &RequestSetOption{}
-	this.Key = string(randStringTypes(r))
-	this.Value = string(randStringTypes(r))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
+func (m *BlockSizeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedRequestInitChain(r randyTypes, easy bool) *RequestInitChain {
-	this := &RequestInitChain{}
-	v1 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.Time = *v1
-	this.ChainId = string(randStringTypes(r))
-	if r.Intn(10) != 0 {
-		this.ConsensusParams = NewPopulatedConsensusParams(r, easy)
+func (m *BlockSizeParams) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBytes != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxBytes))
 	}
-	if r.Intn(10) != 0 {
-		v2 := r.Intn(5)
-		this.Validators = make([]ValidatorUpdate, v2)
-		for i := 0; i < v2; i++ {
-			v3 := NewPopulatedValidatorUpdate(r, easy)
-			this.Validators[i] = *v3
-		}
+	if m.MaxGas != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxGas))
 	}
-	v4 := r.Intn(100)
-	this.AppStateBytes = make([]byte, v4)
-	for i := 0; i < v4; i++ {
-		this.AppStateBytes[i] = byte(r.Intn(256))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 6)
+	return i, nil
+}
+
+func (m *EvidenceParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedRequestQuery(r randyTypes, easy bool) *RequestQuery {
-	this := &RequestQuery{}
-	v5 := r.Intn(100)
-	this.Data = make([]byte, v5)
-	for i := 0; i < v5; i++ {
-		this.Data[i] = byte(r.Intn(256))
+func (m *EvidenceParams) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MaxAge != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxAge))
 	}
-	this.Path = string(randStringTypes(r))
-	this.Height = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.Height *= -1
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintTypes(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAgeDuration)))
+	n38, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	this.Prove = bool(bool(r.Intn(2) == 0))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 5)
+	i += n38
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return this
+	return i, nil
 }
 
-func NewPopulatedRequestBeginBlock(r randyTypes, easy bool) *RequestBeginBlock {
-	this := &RequestBeginBlock{}
-	v6 := r.Intn(100)
-	this.Hash = make([]byte, v6)
-	for i := 0; i < v6; i++ {
-		this.Hash[i] = byte(r.Intn(256))
-	}
-	v7 := NewPopulatedHeader(r, easy)
-	this.Header = *v7
-	v8 := NewPopulatedLastCommitInfo(r, easy)
-	this.LastCommitInfo = *v8
-	if r.Intn(10) != 0 {
-		v9 := r.Intn(5)
-		this.ByzantineValidators = make([]Evidence, v9)
-		for i := 0; i < v9; i++ {
-			v10 := NewPopulatedEvidence(r, easy)
-			this.ByzantineValidators[i] = *v10
-		}
-	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 5)
+func (m *ValidatorParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedRequestCheckTx(r randyTypes, easy bool) *RequestCheckTx {
-	this := &RequestCheckTx{}
-	v11 := r.Intn(100)
-	this.Tx = make([]byte, v11)
-	for i := 0; i < v11; i++ {
-		this.Tx[i] = byte(r.Intn(256))
+func (m *ValidatorParams) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKeyTypes) > 0 {
+		for _, s := range m.PubKeyTypes {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 2)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return this
+	return i, nil
 }
 
-func NewPopulatedRequestDeliverTx(r randyTypes, easy bool) *RequestDeliverTx {
-	this := &RequestDeliverTx{}
-	v12 := r.Intn(100)
-	this.Tx = make([]byte, v12)
-	for i := 0; i < v12; i++ {
-		this.Tx[i] = byte(r.Intn(256))
-	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 2)
+func (m *LastCommitInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedRequestEndBlock(r randyTypes, easy bool) *RequestEndBlock {
-	this := &RequestEndBlock{}
-	this.Height = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.Height *= -1
+func (m *LastCommitInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Round != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 2)
+	if len(m.Votes) > 0 {
+		for _, msg := range m.Votes {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	return this
-}
-
-func NewPopulatedRequestCommit(r randyTypes, easy bool) *RequestCommit {
-	this := &RequestCommit{}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 1)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return this
+	return i, nil
 }
 
-func NewPopulatedResponse(r randyTypes, easy bool) *Response {
-	this := &Response{}
-	oneofNumber_Value := []int32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}[r.Intn(12)]
-	switch oneofNumber_Value {
-	case 1:
-		this.Value = NewPopulatedResponse_Exception(r, easy)
-	case 2:
-		this.Value = NewPopulatedResponse_Echo(r, easy)
-	case 3:
-		this.Value = NewPopulatedResponse_Flush(r, easy)
-	case 4:
-		this.Value = NewPopulatedResponse_Info(r, easy)
-	case 5:
-		this.Value = NewPopulatedResponse_SetOption(r, easy)
-	case 6:
-		this.Value = NewPopulatedResponse_InitChain(r, easy)
-	case 7:
-		this.Value = NewPopulatedResponse_Query(r, easy)
-	case 8:
-		this.Value = NewPopulatedResponse_BeginBlock(r, easy)
-	case 9:
-		this.Value = NewPopulatedResponse_CheckTx(r, easy)
-	case 10:
-		this.Value = NewPopulatedResponse_DeliverTx(r, easy)
-	case 11:
-		this.Value = NewPopulatedResponse_EndBlock(r, easy)
-	case 12:
-		this.Value = NewPopulatedResponse_Commit(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 13)
+func (m *Header) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedResponse_Exception(r randyTypes, easy bool) *Response_Exception {
-	this := &Response_Exception{}
-	this.Exception = NewPopulatedResponseException(r, easy)
-	return this
-}
-func NewPopulatedResponse_Echo(r randyTypes, easy bool) *Response_Echo {
-	this := &Response_Echo{}
-	this.Echo = NewPopulatedResponseEcho(r, easy)
-	return this
-}
-func NewPopulatedResponse_Flush(r randyTypes, easy bool) *Response_Flush {
-	this := &Response_Flush{}
-	this.Flush = NewPopulatedResponseFlush(r, easy)
-	return this
-}
-func NewPopulatedResponse_Info(r randyTypes, easy bool) *Response_Info {
-	this := &Response_Info{}
-	this.Info = NewPopulatedResponseInfo(r, easy)
-	return this
-}
-func NewPopulatedResponse_SetOption(r randyTypes, easy bool) *Response_SetOption {
-	this := &Response_SetOption{}
-	this.SetOption = NewPopulatedResponseSetOption(r, easy)
-	return this
-}
-func NewPopulatedResponse_InitChain(r randyTypes, easy bool) *Response_InitChain {
-	this := &Response_InitChain{}
-	this.InitChain = NewPopulatedResponseInitChain(r, easy)
-	return this
-}
-func NewPopulatedResponse_Query(r randyTypes, easy bool) *Response_Query {
-	this := &Response_Query{}
-	this.Query = NewPopulatedResponseQuery(r, easy)
-	return this
-}
-func NewPopulatedResponse_BeginBlock(r randyTypes, easy bool) *Response_BeginBlock {
-	this := &Response_BeginBlock{}
-	this.BeginBlock = NewPopulatedResponseBeginBlock(r, easy)
-	return this
-}
-func NewPopulatedResponse_CheckTx(r randyTypes, easy bool) *Response_CheckTx {
-	this := &Response_CheckTx{}
-	this.CheckTx = NewPopulatedResponseCheckTx(r, easy)
-	return this
-}
-func NewPopulatedResponse_DeliverTx(r randyTypes, easy bool) *Response_DeliverTx {
-	this := &Response_DeliverTx{}
-	this.DeliverTx = NewPopulatedResponseDeliverTx(r, easy)
-	return this
-}
-func NewPopulatedResponse_EndBlock(r randyTypes, easy bool) *Response_EndBlock {
-	this := &Response_EndBlock{}
-	this.EndBlock = NewPopulatedResponseEndBlock(r, easy)
-	return this
-}
-func NewPopulatedResponse_Commit(r randyTypes, easy bool) *Response_Commit {
-	this := &Response_Commit{}
-	this.Commit = NewPopulatedResponseCommit(r, easy)
-	return this
-}
-func NewPopulatedResponseException(r randyTypes, easy bool) *ResponseException {
-	this := &ResponseException{}
-	this.Error = string(randStringTypes(r))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 2)
+func (m *Header) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintTypes(dAtA, i, uint64(m.Version.Size()))
+	n36, err := m.Version.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return this
-}
-
-func NewPopulatedResponseEcho(r randyTypes, easy bool) *ResponseEcho {
-	this := &ResponseEcho{}
-	this.Message = string(randStringTypes(r))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 2)
+	i += n36
+	if len(m.ChainID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainID)))
+		i += copy(dAtA[i:], m.ChainID)
 	}
-	return this
-}
-
-func NewPopulatedResponseFlush(r randyTypes, easy bool) *ResponseFlush {
-	this := &ResponseFlush{}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 1)
+	if m.Height != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
 	}
-	return this
-}
-
-func NewPopulatedResponseInfo(r randyTypes, easy bool) *ResponseInfo {
-	this := &ResponseInfo{}
-	this.Data = string(randStringTypes(r))
-	this.Version = string(randStringTypes(r))
-	this.AppVersion = uint64(uint64(r.Uint32()))
-	this.LastBlockHeight = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.LastBlockHeight *= -1
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintTypes(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)))
+	n37, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	v13 := r.Intn(100)
-	this.LastBlockAppHash = make([]byte, v13)
-	for i := 0; i < v13; i++ {
-		this.LastBlockAppHash[i] = byte(r.Intn(256))
+	i += n37
+	if m.NumTxs != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.NumTxs))
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 6)
+	if m.TotalTxs != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalTxs))
 	}
-	return this
-}
-
-func NewPopulatedResponseSetOption(r randyTypes, easy bool) *ResponseSetOption {
-	this := &ResponseSetOption{}
-	this.Code = uint32(r.Uint32())
-	this.Log = string(randStringTypes(r))
-	this.Info = string(randStringTypes(r))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 5)
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintTypes(dAtA, i, uint64(m.LastBlockId.Size()))
+	n38, err := m.LastBlockId.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return this
-}
-
-func NewPopulatedResponseInitChain(r randyTypes, easy bool) *ResponseInitChain {
-	this := &ResponseInitChain{}
-	if r.Intn(10) != 0 {
-		this.ConsensusParams = NewPopulatedConsensusParams(r, easy)
+	i += n38
+	if len(m.LastCommitHash) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LastCommitHash)))
+		i += copy(dAtA[i:], m.LastCommitHash)
 	}
-	if r.Intn(10) != 0 {
-		v14 := r.Intn(5)
-		this.Validators = make([]ValidatorUpdate, v14)
-		for i := 0; i < v14; i++ {
-			v15 := NewPopulatedValidatorUpdate(r, easy)
-			this.Validators[i] = *v15
-		}
+	if len(m.DataHash) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DataHash)))
+		i += copy(dAtA[i:], m.DataHash)
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
+	if len(m.ValidatorsHash) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorsHash)))
+		i += copy(dAtA[i:], m.ValidatorsHash)
 	}
-	return this
-}
-
-func NewPopulatedResponseQuery(r randyTypes, easy bool) *ResponseQuery {
-	this := &ResponseQuery{}
-	this.Code = uint32(r.Uint32())
-	this.Log = string(randStringTypes(r))
-	this.Info = string(randStringTypes(r))
-	this.Index = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.Index *= -1
+	if len(m.NextValidatorsHash) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NextValidatorsHash)))
+		i += copy(dAtA[i:], m.NextValidatorsHash)
 	}
-	v16 := r.Intn(100)
-	this.Key = make([]byte, v16)
-	for i := 0; i < v16; i++ {
-		this.Key[i] = byte(r.Intn(256))
+	if len(m.ConsensusHash) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ConsensusHash)))
+		i += copy(dAtA[i:], m.ConsensusHash)
 	}
-	v17 := r.Intn(100)
-	this.Value = make([]byte, v17)
-	for i := 0; i < v17; i++ {
-		this.Value[i] = byte(r.Intn(256))
+	if len(m.AppHash) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AppHash)))
+		i += copy(dAtA[i:], m.AppHash)
 	}
-	if r.Intn(10) != 0 {
-		this.Proof = merkle.NewPopulatedProof(r, easy)
+	if len(m.LastResultsHash) > 0 {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LastResultsHash)))
+		i += copy(dAtA[i:], m.LastResultsHash)
 	}
-	this.Height = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.Height *= -1
+	if len(m.EvidenceHash) > 0 {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvidenceHash)))
+		i += copy(dAtA[i:], m.EvidenceHash)
 	}
-	this.Codespace = string(randStringTypes(r))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 11)
+	if len(m.ProposerAddress) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProposerAddress)))
+		i += copy(dAtA[i:], m.ProposerAddress)
 	}
-	return this
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
 }
 
-func NewPopulatedResponseBeginBlock(r randyTypes, easy bool) *ResponseBeginBlock {
-	this := &ResponseBeginBlock{}
-	if r.Intn(10) != 0 {
-		v18 := r.Intn(5)
-		this.Tags = make([]common.KVPair, v18)
-		for i := 0; i < v18; i++ {
-			v19 := common.NewPopulatedKVPair(r, easy)
-			this.Tags[i] = *v19
-		}
-	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 2)
+func (m *Version) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedResponseCheckTx(r randyTypes, easy bool) *ResponseCheckTx {
-	this := &ResponseCheckTx{}
-	this.Code = uint32(r.Uint32())
-	v20 := r.Intn(100)
-	this.Data = make([]byte, v20)
-	for i := 0; i < v20; i++ {
-		this.Data[i] = byte(r.Intn(256))
-	}
-	this.Log = string(randStringTypes(r))
-	this.Info = string(randStringTypes(r))
-	this.GasWanted = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.GasWanted *= -1
-	}
-	this.GasUsed = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.GasUsed *= -1
+func (m *Version) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Block != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Block))
 	}
-	if r.Intn(10) != 0 {
-		v21 := r.Intn(5)
-		this.Tags = make([]common.KVPair, v21)
-		for i := 0; i < v21; i++ {
-			v22 := common.NewPopulatedKVPair(r, easy)
-			this.Tags[i] = *v22
-		}
+	if m.App != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.App))
 	}
-	this.Codespace = string(randStringTypes(r))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 9)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return this
+	return i, nil
 }
 
-func NewPopulatedResponseDeliverTx(r randyTypes, easy bool) *ResponseDeliverTx {
-	this := &ResponseDeliverTx{}
-	this.Code = uint32(r.Uint32())
-	v23 := r.Intn(100)
-	this.Data = make([]byte, v23)
-	for i := 0; i < v23; i++ {
-		this.Data[i] = byte(r.Intn(256))
-	}
-	this.Log = string(randStringTypes(r))
-	this.Info = string(randStringTypes(r))
-	this.GasWanted = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.GasWanted *= -1
+func (m *BlockID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	this.GasUsed = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.GasUsed *= -1
+	return dAtA[:n], nil
+}
+
+func (m *BlockID) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
 	}
-	if r.Intn(10) != 0 {
-		v24 := r.Intn(5)
-		this.Tags = make([]common.KVPair, v24)
-		for i := 0; i < v24; i++ {
-			v25 := common.NewPopulatedKVPair(r, easy)
-			this.Tags[i] = *v25
-		}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintTypes(dAtA, i, uint64(m.PartsHeader.Size()))
+	n39, err := m.PartsHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	this.Codespace = string(randStringTypes(r))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 9)
+	i += n39
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return this
+	return i, nil
 }
 
-func NewPopulatedResponseEndBlock(r randyTypes, easy bool) *ResponseEndBlock {
-	this := &ResponseEndBlock{}
-	if r.Intn(10) != 0 {
-		v26 := r.Intn(5)
-		this.ValidatorUpdates = make([]ValidatorUpdate, v26)
-		for i := 0; i < v26; i++ {
-			v27 := NewPopulatedValidatorUpdate(r, easy)
-			this.ValidatorUpdates[i] = *v27
-		}
+func (m *PartSetHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if r.Intn(10) != 0 {
-		this.ConsensusParamUpdates = NewPopulatedConsensusParams(r, easy)
+	return dAtA[:n], nil
+}
+
+func (m *PartSetHeader) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Total != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Total))
 	}
-	if r.Intn(10) != 0 {
-		v28 := r.Intn(5)
-		this.Tags = make([]common.KVPair, v28)
-		for i := 0; i < v28; i++ {
-			v29 := common.NewPopulatedKVPair(r, easy)
-			this.Tags[i] = *v29
-		}
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 4)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return this
+	return i, nil
 }
 
-func NewPopulatedResponseCommit(r randyTypes, easy bool) *ResponseCommit {
-	this := &ResponseCommit{}
-	v30 := r.Intn(100)
-	this.Data = make([]byte, v30)
-	for i := 0; i < v30; i++ {
-		this.Data[i] = byte(r.Intn(256))
-	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
+func (m *Validator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedConsensusParams(r randyTypes, easy bool) *ConsensusParams {
-	this := &ConsensusParams{}
-	if r.Intn(10) != 0 {
-		this.BlockSize = NewPopulatedBlockSizeParams(r, easy)
-	}
-	if r.Intn(10) != 0 {
-		this.Evidence = NewPopulatedEvidenceParams(r, easy)
+func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
 	}
-	if r.Intn(10) != 0 {
-		this.Validator = NewPopulatedValidatorParams(r, easy)
+	if m.Power != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Power))
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 4)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return this
+	return i, nil
 }
 
-func NewPopulatedBlockSizeParams(r randyTypes, easy bool) *BlockSizeParams {
-	this := &BlockSizeParams{}
-	this.MaxBytes = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.MaxBytes *= -1
-	}
-	this.MaxGas = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.MaxGas *= -1
-	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
+func (m *ValidatorUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedEvidenceParams(r randyTypes, easy bool) *EvidenceParams {
-	this := &EvidenceParams{}
-	this.MaxAge = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.MaxAge *= -1
+func (m *ValidatorUpdate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintTypes(dAtA, i, uint64(m.PubKey.Size()))
+	n40, err := m.PubKey.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 2)
+	i += n40
+	if m.Power != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Power))
 	}
-	return this
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
 }
 
-func NewPopulatedValidatorParams(r randyTypes, easy bool) *ValidatorParams {
-	this := &ValidatorParams{}
-	v31 := r.Intn(10)
-	this.PubKeyTypes = make([]string, v31)
-	for i := 0; i < v31; i++ {
-		this.PubKeyTypes[i] = string(randStringTypes(r))
-	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 2)
+func (m *VoteInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedLastCommitInfo(r randyTypes, easy bool) *LastCommitInfo {
-	this := &LastCommitInfo{}
-	this.Round = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.Round *= -1
+func (m *VoteInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintTypes(dAtA, i, uint64(m.Validator.Size()))
+	n41, err := m.Validator.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if r.Intn(10) != 0 {
-		v32 := r.Intn(5)
-		this.Votes = make([]VoteInfo, v32)
-		for i := 0; i < v32; i++ {
-			v33 := NewPopulatedVoteInfo(r, easy)
-			this.Votes[i] = *v33
+	i += n41
+	if m.SignedLastBlock {
+		dAtA[i] = 0x10
+		i++
+		if m.SignedLastBlock {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return this
+	return i, nil
 }
 
-func NewPopulatedHeader(r randyTypes, easy bool) *Header {
-	this := &Header{}
-	v34 := NewPopulatedVersion(r, easy)
-	this.Version = *v34
-	this.ChainID = string(randStringTypes(r))
-	this.Height = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.Height *= -1
+func (m *PubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	v35 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.Time = *v35
-	this.NumTxs = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.NumTxs *= -1
+	return dAtA[:n], nil
+}
+
+func (m *PubKey) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
 	}
-	this.TotalTxs = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.TotalTxs *= -1
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
 	}
-	v36 := NewPopulatedBlockID(r, easy)
-	this.LastBlockId = *v36
-	v37 := r.Intn(100)
-	this.LastCommitHash = make([]byte, v37)
-	for i := 0; i < v37; i++ {
-		this.LastCommitHash[i] = byte(r.Intn(256))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	v38 := r.Intn(100)
-	this.DataHash = make([]byte, v38)
-	for i := 0; i < v38; i++ {
-		this.DataHash[i] = byte(r.Intn(256))
+	return i, nil
+}
+
+func (m *Evidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	v39 := r.Intn(100)
-	this.ValidatorsHash = make([]byte, v39)
-	for i := 0; i < v39; i++ {
-		this.ValidatorsHash[i] = byte(r.Intn(256))
-	}
-	v40 := r.Intn(100)
-	this.NextValidatorsHash = make([]byte, v40)
-	for i := 0; i < v40; i++ {
-		this.NextValidatorsHash[i] = byte(r.Intn(256))
-	}
-	v41 := r.Intn(100)
-	this.ConsensusHash = make([]byte, v41)
-	for i := 0; i < v41; i++ {
-		this.ConsensusHash[i] = byte(r.Intn(256))
+	return dAtA[:n], nil
+}
+
+func (m *Evidence) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
 	}
-	v42 := r.Intn(100)
-	this.AppHash = make([]byte, v42)
-	for i := 0; i < v42; i++ {
-		this.AppHash[i] = byte(r.Intn(256))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintTypes(dAtA, i, uint64(m.Validator.Size()))
+	n42, err := m.Validator.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	v43 := r.Intn(100)
-	this.LastResultsHash = make([]byte, v43)
-	for i := 0; i < v43; i++ {
-		this.LastResultsHash[i] = byte(r.Intn(256))
+	i += n42
+	if m.Height != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
 	}
-	v44 := r.Intn(100)
-	this.EvidenceHash = make([]byte, v44)
-	for i := 0; i < v44; i++ {
-		this.EvidenceHash[i] = byte(r.Intn(256))
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintTypes(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)))
+	n43, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	v45 := r.Intn(100)
-	this.ProposerAddress = make([]byte, v45)
-	for i := 0; i < v45; i++ {
-		this.ProposerAddress[i] = byte(r.Intn(256))
+	i += n43
+	if m.TotalVotingPower != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalVotingPower))
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 17)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return this
+	return i, nil
 }
 
-func NewPopulatedVersion(r randyTypes, easy bool) *Version {
-	this := &Version{}
-	this.Block = uint64(uint64(r.Uint32()))
-	this.App = uint64(uint64(r.Uint32()))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
+func (m *RequestListSnapshots) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedBlockID(r randyTypes, easy bool) *BlockID {
-	this := &BlockID{}
-	v46 := r.Intn(100)
-	this.Hash = make([]byte, v46)
-	for i := 0; i < v46; i++ {
-		this.Hash[i] = byte(r.Intn(256))
+func (m *RequestListSnapshots) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	v47 := NewPopulatedPartSetHeader(r, easy)
-	this.PartsHeader = *v47
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
+	return i, nil
+}
+
+func (m *RequestOfferSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedPartSetHeader(r randyTypes, easy bool) *PartSetHeader {
-	this := &PartSetHeader{}
-	this.Total = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.Total *= -1
+func (m *RequestOfferSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Snapshot != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Snapshot.Size()))
+		n52, err := m.Snapshot.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n52
 	}
-	v48 := r.Intn(100)
-	this.Hash = make([]byte, v48)
-	for i := 0; i < v48; i++ {
-		this.Hash[i] = byte(r.Intn(256))
+	if len(m.AppHash) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AppHash)))
+		i += copy(dAtA[i:], m.AppHash)
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return this
+	return i, nil
 }
 
-func NewPopulatedValidator(r randyTypes, easy bool) *Validator {
-	this := &Validator{}
-	v49 := r.Intn(100)
-	this.Address = make([]byte, v49)
-	for i := 0; i < v49; i++ {
-		this.Address[i] = byte(r.Intn(256))
-	}
-	this.Power = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.Power *= -1
-	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 4)
+func (m *RequestLoadSnapshotChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedValidatorUpdate(r randyTypes, easy bool) *ValidatorUpdate {
-	this := &ValidatorUpdate{}
-	v50 := NewPopulatedPubKey(r, easy)
-	this.PubKey = *v50
-	this.Power = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.Power *= -1
+func (m *RequestLoadSnapshotChunk) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
+	if m.Format != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Format))
 	}
-	return this
+	if m.Chunk != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Chunk))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
 }
 
-func NewPopulatedVoteInfo(r randyTypes, easy bool) *VoteInfo {
-	this := &VoteInfo{}
-	v51 := NewPopulatedValidator(r, easy)
-	this.Validator = *v51
-	this.SignedLastBlock = bool(bool(r.Intn(2) == 0))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
+func (m *RequestApplySnapshotChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedPubKey(r randyTypes, easy bool) *PubKey {
-	this := &PubKey{}
-	this.Type = string(randStringTypes(r))
-	v52 := r.Intn(100)
-	this.Data = make([]byte, v52)
-	for i := 0; i < v52; i++ {
-		this.Data[i] = byte(r.Intn(256))
+func (m *RequestApplySnapshotChunk) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Index))
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
+	if len(m.Chunk) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Chunk)))
+		i += copy(dAtA[i:], m.Chunk)
 	}
-	return this
-}
-
-func NewPopulatedEvidence(r randyTypes, easy bool) *Evidence {
-	this := &Evidence{}
-	this.Type = string(randStringTypes(r))
-	v53 := NewPopulatedValidator(r, easy)
-	this.Validator = *v53
-	this.Height = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.Height *= -1
+	if len(m.Sender) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i += copy(dAtA[i:], m.Sender)
 	}
-	v54 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.Time = *v54
-	this.TotalVotingPower = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.TotalVotingPower *= -1
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 6)
+	return i, nil
+}
+
+func (m *ResponseListSnapshots) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-type randyTypes interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
-}
-
-func randUTF8RuneTypes(r randyTypes) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
-	}
-	return rune(ru + 61)
-}
-func randStringTypes(r randyTypes) string {
-	v55 := r.Intn(100)
-	tmps := make([]rune, v55)
-	for i := 0; i < v55; i++ {
-		tmps[i] = randUTF8RuneTypes(r)
-	}
-	return string(tmps)
-}
-func randUnrecognizedTypes(r randyTypes, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
+func (m *ResponseListSnapshots) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for _, msg := range m.Snapshots {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldTypes(dAtA, r, fieldNumber, wire)
 	}
-	return dAtA
-}
-func randFieldTypes(dAtA []byte, r randyTypes, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateTypes(dAtA, uint64(key))
-		v56 := r.Int63()
-		if r.Intn(2) == 0 {
-			v56 *= -1
-		}
-		dAtA = encodeVarintPopulateTypes(dAtA, uint64(v56))
-	case 1:
-		dAtA = encodeVarintPopulateTypes(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateTypes(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateTypes(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
-		}
-	default:
-		dAtA = encodeVarintPopulateTypes(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return dAtA
+	return i, nil
 }
-func encodeVarintPopulateTypes(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
-		v >>= 7
+
+func (m *ResponseOfferSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
+	return dAtA[:n], nil
 }
-func (m *Request) Size() (n int) {
+
+func (m *ResponseOfferSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Value != nil {
-		n += m.Value.Size()
+	if m.Result != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Result))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Request_Echo) Size() (n int) {
-	var l int
-	_ = l
-	if m.Echo != nil {
-		l = m.Echo.Size()
-		n += 1 + l + sovTypes(uint64(l))
+func (m *ResponseLoadSnapshotChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *Request_Flush) Size() (n int) {
+
+func (m *ResponseLoadSnapshotChunk) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Flush != nil {
-		l = m.Flush.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Chunk) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Chunk)))
+		i += copy(dAtA[i:], m.Chunk)
 	}
-	return n
-}
-func (m *Request_Info) Size() (n int) {
-	var l int
-	_ = l
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
-func (m *Request_SetOption) Size() (n int) {
-	var l int
-	_ = l
-	if m.SetOption != nil {
-		l = m.SetOption.Size()
-		n += 1 + l + sovTypes(uint64(l))
+
+func (m *ResponseApplySnapshotChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *Request_InitChain) Size() (n int) {
+
+func (m *ResponseApplySnapshotChunk) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.InitChain != nil {
-		l = m.InitChain.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Result != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Result))
 	}
-	return n
-}
-func (m *Request_Query) Size() (n int) {
-	var l int
-	_ = l
-	if m.Query != nil {
-		l = m.Query.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.RefetchChunks) > 0 {
+		dAtA107 := make([]byte, len(m.RefetchChunks)*10)
+		var j106 int
+		for _, num := range m.RefetchChunks {
+			for num >= 1<<7 {
+				dAtA107[j106] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j106++
+			}
+			dAtA107[j106] = uint8(num)
+			j106++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(j106))
+		i += copy(dAtA[i:], dAtA107[:j106])
 	}
-	return n
-}
-func (m *Request_BeginBlock) Size() (n int) {
-	var l int
-	_ = l
-	if m.BeginBlock != nil {
-		l = m.BeginBlock.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.RejectSenders) > 0 {
+		for _, s := range m.RejectSenders {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	return n
-}
-func (m *Request_CheckTx) Size() (n int) {
-	var l int
-	_ = l
-	if m.CheckTx != nil {
-		l = m.CheckTx.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
-func (m *Request_EndBlock) Size() (n int) {
-	var l int
-	_ = l
-	if m.EndBlock != nil {
-		l = m.EndBlock.Size()
-		n += 1 + l + sovTypes(uint64(l))
+
+func (m *Snapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *Request_Commit) Size() (n int) {
+
+func (m *Snapshot) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Height != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
 	}
-	return n
-}
-func (m *Request_DeliverTx) Size() (n int) {
-	var l int
-	_ = l
-	if m.DeliverTx != nil {
-		l = m.DeliverTx.Size()
-		n += 2 + l + sovTypes(uint64(l))
+	if m.Format != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Format))
 	}
-	return n
-}
-func (m *RequestEcho) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Chunks != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Chunks))
+	}
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	if len(m.Metadata) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Metadata)))
+		i += copy(dAtA[i:], m.Metadata)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RequestFlush) Size() (n int) {
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
-	return n
+	dAtA[offset] = uint8(v)
+	return offset + 1
 }
-
-func (m *RequestInfo) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.BlockVersion != 0 {
-		n += 1 + sovTypes(uint64(m.BlockVersion))
-	}
-	if m.P2PVersion != 0 {
-		n += 1 + sovTypes(uint64(m.P2PVersion))
+func NewPopulatedRequest(r randyTypes, easy bool) *Request {
+	this := &Request{}
+	oneofNumber_Value := []int32{2, 3, 4, 5, 6, 7, 8, 9, 11, 12, 13, 14, 15, 16, 19}[r.Intn(15)]
+	switch oneofNumber_Value {
+	case 2:
+		this.Value = NewPopulatedRequest_Echo(r, easy)
+	case 3:
+		this.Value = NewPopulatedRequest_Flush(r, easy)
+	case 4:
+		this.Value = NewPopulatedRequest_Info(r, easy)
+	case 5:
+		this.Value = NewPopulatedRequest_SetOption(r, easy)
+	case 6:
+		this.Value = NewPopulatedRequest_InitChain(r, easy)
+	case 7:
+		this.Value = NewPopulatedRequest_Query(r, easy)
+	case 8:
+		this.Value = NewPopulatedRequest_BeginBlock(r, easy)
+	case 9:
+		this.Value = NewPopulatedRequest_CheckTx(r, easy)
+	case 11:
+		this.Value = NewPopulatedRequest_EndBlock(r, easy)
+	case 12:
+		this.Value = NewPopulatedRequest_Commit(r, easy)
+	case 13:
+		this.Value = NewPopulatedRequest_ListSnapshots(r, easy)
+	case 14:
+		this.Value = NewPopulatedRequest_OfferSnapshot(r, easy)
+	case 15:
+		this.Value = NewPopulatedRequest_LoadSnapshotChunk(r, easy)
+	case 16:
+		this.Value = NewPopulatedRequest_ApplySnapshotChunk(r, easy)
+	case 19:
+		this.Value = NewPopulatedRequest_DeliverTx(r, easy)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 20)
 	}
-	return n
+	return this
 }
 
-func (m *RequestSetOption) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+func NewPopulatedRequest_Echo(r randyTypes, easy bool) *Request_Echo {
+	this := &Request_Echo{}
+	this.Echo = NewPopulatedRequestEcho(r, easy)
+	return this
+}
+func NewPopulatedRequest_Flush(r randyTypes, easy bool) *Request_Flush {
+	this := &Request_Flush{}
+	this.Flush = NewPopulatedRequestFlush(r, easy)
+	return this
+}
+func NewPopulatedRequest_Info(r randyTypes, easy bool) *Request_Info {
+	this := &Request_Info{}
+	this.Info = NewPopulatedRequestInfo(r, easy)
+	return this
+}
+func NewPopulatedRequest_SetOption(r randyTypes, easy bool) *Request_SetOption {
+	this := &Request_SetOption{}
+	this.SetOption = NewPopulatedRequestSetOption(r, easy)
+	return this
+}
+func NewPopulatedRequest_InitChain(r randyTypes, easy bool) *Request_InitChain {
+	this := &Request_InitChain{}
+	this.InitChain = NewPopulatedRequestInitChain(r, easy)
+	return this
+}
+func NewPopulatedRequest_Query(r randyTypes, easy bool) *Request_Query {
+	this := &Request_Query{}
+	this.Query = NewPopulatedRequestQuery(r, easy)
+	return this
+}
+func NewPopulatedRequest_BeginBlock(r randyTypes, easy bool) *Request_BeginBlock {
+	this := &Request_BeginBlock{}
+	this.BeginBlock = NewPopulatedRequestBeginBlock(r, easy)
+	return this
+}
+func NewPopulatedRequest_CheckTx(r randyTypes, easy bool) *Request_CheckTx {
+	this := &Request_CheckTx{}
+	this.CheckTx = NewPopulatedRequestCheckTx(r, easy)
+	return this
+}
+func NewPopulatedRequest_EndBlock(r randyTypes, easy bool) *Request_EndBlock {
+	this := &Request_EndBlock{}
+	this.EndBlock = NewPopulatedRequestEndBlock(r, easy)
+	return this
+}
+func NewPopulatedRequest_Commit(r randyTypes, easy bool) *Request_Commit {
+	this := &Request_Commit{}
+	this.Commit = NewPopulatedRequestCommit(r, easy)
+	return this
+}
+
+func NewPopulatedRequest_ListSnapshots(r randyTypes, easy bool) *Request_ListSnapshots {
+	this := &Request_ListSnapshots{}
+	this.ListSnapshots = NewPopulatedRequestListSnapshots(r, easy)
+	return this
+}
+
+func NewPopulatedRequest_OfferSnapshot(r randyTypes, easy bool) *Request_OfferSnapshot {
+	this := &Request_OfferSnapshot{}
+	this.OfferSnapshot = NewPopulatedRequestOfferSnapshot(r, easy)
+	return this
+}
+
+func NewPopulatedRequest_LoadSnapshotChunk(r randyTypes, easy bool) *Request_LoadSnapshotChunk {
+	this := &Request_LoadSnapshotChunk{}
+	this.LoadSnapshotChunk = NewPopulatedRequestLoadSnapshotChunk(r, easy)
+	return this
+}
+
+func NewPopulatedRequest_ApplySnapshotChunk(r randyTypes, easy bool) *Request_ApplySnapshotChunk {
+	this := &Request_ApplySnapshotChunk{}
+	this.ApplySnapshotChunk = NewPopulatedRequestApplySnapshotChunk(r, easy)
+	return this
+}
+func NewPopulatedRequest_DeliverTx(r randyTypes, easy bool) *Request_DeliverTx {
+	this := &Request_DeliverTx{}
+	this.DeliverTx = NewPopulatedRequestDeliverTx(r, easy)
+	return this
+}
+func NewPopulatedRequestEcho(r randyTypes, easy bool) *RequestEcho {
+	this := &RequestEcho{}
+	this.Message = string(randStringTypes(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 2)
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return this
+}
+
+func NewPopulatedRequestFlush(r randyTypes, easy bool) *RequestFlush {
+	this := &RequestFlush{}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 1)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return this
+}
+
+func NewPopulatedRequestInfo(r randyTypes, easy bool) *RequestInfo {
+	this := &RequestInfo{}
+	this.Version = string(randStringTypes(r))
+	this.BlockVersion = uint64(uint64(r.Uint32()))
+	this.P2PVersion = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 4)
 	}
-	return n
+	return this
 }
 
-func (m *RequestInitChain) Size() (n int) {
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+func NewPopulatedRequestSetOption(r randyTypes, easy bool) *RequestSetOption {
+	this := &RequestSetOption{}
+	this.Key = string(randStringTypes(r))
+	this.Value = string(randStringTypes(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
 	}
-	if m.ConsensusParams != nil {
-		l = m.ConsensusParams.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	return this
+}
+
+func NewPopulatedRequestInitChain(r randyTypes, easy bool) *RequestInitChain {
+	this := &RequestInitChain{}
+	v1 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.Time = *v1
+	this.ChainId = string(randStringTypes(r))
+	if r.Intn(10) != 0 {
+		this.ConsensusParams = NewPopulatedConsensusParams(r, easy)
 	}
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if r.Intn(10) != 0 {
+		v2 := r.Intn(5)
+		this.Validators = make([]ValidatorUpdate, v2)
+		for i := 0; i < v2; i++ {
+			v3 := NewPopulatedValidatorUpdate(r, easy)
+			this.Validators[i] = *v3
 		}
 	}
-	l = len(m.AppStateBytes)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	v4 := r.Intn(100)
+	this.AppStateBytes = make([]byte, v4)
+	for i := 0; i < v4; i++ {
+		this.AppStateBytes[i] = byte(r.Intn(256))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 6)
 	}
-	return n
+	return this
 }
 
-func (m *RequestQuery) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovTypes(uint64(m.Height))
+func NewPopulatedRequestQuery(r randyTypes, easy bool) *RequestQuery {
+	this := &RequestQuery{}
+	v5 := r.Intn(100)
+	this.Data = make([]byte, v5)
+	for i := 0; i < v5; i++ {
+		this.Data[i] = byte(r.Intn(256))
 	}
-	if m.Prove {
-		n += 2
+	this.Path = string(randStringTypes(r))
+	this.Height = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Height *= -1
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	this.Prove = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 5)
 	}
-	return n
+	return this
 }
 
-func (m *RequestBeginBlock) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+func NewPopulatedRequestBeginBlock(r randyTypes, easy bool) *RequestBeginBlock {
+	this := &RequestBeginBlock{}
+	v6 := r.Intn(100)
+	this.Hash = make([]byte, v6)
+	for i := 0; i < v6; i++ {
+		this.Hash[i] = byte(r.Intn(256))
 	}
-	l = m.Header.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.LastCommitInfo.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if len(m.ByzantineValidators) > 0 {
-		for _, e := range m.ByzantineValidators {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	v7 := NewPopulatedHeader(r, easy)
+	this.Header = *v7
+	v8 := NewPopulatedLastCommitInfo(r, easy)
+	this.LastCommitInfo = *v8
+	if r.Intn(10) != 0 {
+		v9 := r.Intn(5)
+		this.ByzantineValidators = make([]Evidence, v9)
+		for i := 0; i < v9; i++ {
+			v10 := NewPopulatedEvidence(r, easy)
+			this.ByzantineValidators[i] = *v10
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 5)
 	}
-	return n
+	return this
 }
 
-func (m *RequestCheckTx) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Tx)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+func NewPopulatedRequestCheckTx(r randyTypes, easy bool) *RequestCheckTx {
+	this := &RequestCheckTx{}
+	v11 := r.Intn(100)
+	this.Tx = make([]byte, v11)
+	for i := 0; i < v11; i++ {
+		this.Tx[i] = byte(r.Intn(256))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 2)
 	}
-	return n
+	return this
 }
 
-func (m *RequestDeliverTx) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Tx)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+func NewPopulatedRequestDeliverTx(r randyTypes, easy bool) *RequestDeliverTx {
+	this := &RequestDeliverTx{}
+	v12 := r.Intn(100)
+	this.Tx = make([]byte, v12)
+	for i := 0; i < v12; i++ {
+		this.Tx[i] = byte(r.Intn(256))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 2)
 	}
-	return n
+	return this
 }
 
-func (m *RequestEndBlock) Size() (n int) {
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovTypes(uint64(m.Height))
+func NewPopulatedRequestEndBlock(r randyTypes, easy bool) *RequestEndBlock {
+	this := &RequestEndBlock{}
+	this.Height = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Height *= -1
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 2)
 	}
-	return n
+	return this
 }
 
-func (m *RequestCommit) Size() (n int) {
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func NewPopulatedRequestCommit(r randyTypes, easy bool) *RequestCommit {
+	this := &RequestCommit{}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 1)
 	}
-	return n
+	return this
 }
 
-func (m *Response) Size() (n int) {
-	var l int
-	_ = l
-	if m.Value != nil {
-		n += m.Value.Size()
+func NewPopulatedResponse(r randyTypes, easy bool) *Response {
+	this := &Response{}
+	oneofNumber_Value := []int32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}[r.Intn(16)]
+	switch oneofNumber_Value {
+	case 1:
+		this.Value = NewPopulatedResponse_Exception(r, easy)
+	case 2:
+		this.Value = NewPopulatedResponse_Echo(r, easy)
+	case 3:
+		this.Value = NewPopulatedResponse_Flush(r, easy)
+	case 4:
+		this.Value = NewPopulatedResponse_Info(r, easy)
+	case 5:
+		this.Value = NewPopulatedResponse_SetOption(r, easy)
+	case 6:
+		this.Value = NewPopulatedResponse_InitChain(r, easy)
+	case 7:
+		this.Value = NewPopulatedResponse_Query(r, easy)
+	case 8:
+		this.Value = NewPopulatedResponse_BeginBlock(r, easy)
+	case 9:
+		this.Value = NewPopulatedResponse_CheckTx(r, easy)
+	case 10:
+		this.Value = NewPopulatedResponse_DeliverTx(r, easy)
+	case 11:
+		this.Value = NewPopulatedResponse_EndBlock(r, easy)
+	case 12:
+		this.Value = NewPopulatedResponse_Commit(r, easy)
+	case 13:
+		this.Value = NewPopulatedResponse_ListSnapshots(r, easy)
+	case 14:
+		this.Value = NewPopulatedResponse_OfferSnapshot(r, easy)
+	case 15:
+		this.Value = NewPopulatedResponse_LoadSnapshotChunk(r, easy)
+	case 16:
+		this.Value = NewPopulatedResponse_ApplySnapshotChunk(r, easy)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 17)
 	}
-	return n
+	return this
 }
 
-func (m *Response_Exception) Size() (n int) {
-	var l int
-	_ = l
-	if m.Exception != nil {
-		l = m.Exception.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+func NewPopulatedResponse_Exception(r randyTypes, easy bool) *Response_Exception {
+	this := &Response_Exception{}
+	this.Exception = NewPopulatedResponseException(r, easy)
+	return this
 }
-func (m *Response_Echo) Size() (n int) {
-	var l int
-	_ = l
-	if m.Echo != nil {
-		l = m.Echo.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+func NewPopulatedResponse_Echo(r randyTypes, easy bool) *Response_Echo {
+	this := &Response_Echo{}
+	this.Echo = NewPopulatedResponseEcho(r, easy)
+	return this
 }
-func (m *Response_Flush) Size() (n int) {
-	var l int
-	_ = l
-	if m.Flush != nil {
-		l = m.Flush.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+func NewPopulatedResponse_Flush(r randyTypes, easy bool) *Response_Flush {
+	this := &Response_Flush{}
+	this.Flush = NewPopulatedResponseFlush(r, easy)
+	return this
 }
-func (m *Response_Info) Size() (n int) {
-	var l int
-	_ = l
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+func NewPopulatedResponse_Info(r randyTypes, easy bool) *Response_Info {
+	this := &Response_Info{}
+	this.Info = NewPopulatedResponseInfo(r, easy)
+	return this
 }
-func (m *Response_SetOption) Size() (n int) {
-	var l int
-	_ = l
-	if m.SetOption != nil {
-		l = m.SetOption.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+func NewPopulatedResponse_SetOption(r randyTypes, easy bool) *Response_SetOption {
+	this := &Response_SetOption{}
+	this.SetOption = NewPopulatedResponseSetOption(r, easy)
+	return this
 }
-func (m *Response_InitChain) Size() (n int) {
-	var l int
-	_ = l
-	if m.InitChain != nil {
-		l = m.InitChain.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+func NewPopulatedResponse_InitChain(r randyTypes, easy bool) *Response_InitChain {
+	this := &Response_InitChain{}
+	this.InitChain = NewPopulatedResponseInitChain(r, easy)
+	return this
 }
-func (m *Response_Query) Size() (n int) {
-	var l int
-	_ = l
-	if m.Query != nil {
-		l = m.Query.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+func NewPopulatedResponse_Query(r randyTypes, easy bool) *Response_Query {
+	this := &Response_Query{}
+	this.Query = NewPopulatedResponseQuery(r, easy)
+	return this
 }
-func (m *Response_BeginBlock) Size() (n int) {
-	var l int
-	_ = l
-	if m.BeginBlock != nil {
-		l = m.BeginBlock.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+func NewPopulatedResponse_BeginBlock(r randyTypes, easy bool) *Response_BeginBlock {
+	this := &Response_BeginBlock{}
+	this.BeginBlock = NewPopulatedResponseBeginBlock(r, easy)
+	return this
 }
-func (m *Response_CheckTx) Size() (n int) {
-	var l int
-	_ = l
-	if m.CheckTx != nil {
-		l = m.CheckTx.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+func NewPopulatedResponse_CheckTx(r randyTypes, easy bool) *Response_CheckTx {
+	this := &Response_CheckTx{}
+	this.CheckTx = NewPopulatedResponseCheckTx(r, easy)
+	return this
 }
-func (m *Response_DeliverTx) Size() (n int) {
-	var l int
-	_ = l
-	if m.DeliverTx != nil {
-		l = m.DeliverTx.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+func NewPopulatedResponse_DeliverTx(r randyTypes, easy bool) *Response_DeliverTx {
+	this := &Response_DeliverTx{}
+	this.DeliverTx = NewPopulatedResponseDeliverTx(r, easy)
+	return this
 }
-func (m *Response_EndBlock) Size() (n int) {
-	var l int
-	_ = l
-	if m.EndBlock != nil {
-		l = m.EndBlock.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+func NewPopulatedResponse_EndBlock(r randyTypes, easy bool) *Response_EndBlock {
+	this := &Response_EndBlock{}
+	this.EndBlock = NewPopulatedResponseEndBlock(r, easy)
+	return this
 }
-func (m *Response_Commit) Size() (n int) {
-	var l int
-	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+func NewPopulatedResponse_Commit(r randyTypes, easy bool) *Response_Commit {
+	this := &Response_Commit{}
+	this.Commit = NewPopulatedResponseCommit(r, easy)
+	return this
 }
-func (m *ResponseException) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func NewPopulatedResponse_ListSnapshots(r randyTypes, easy bool) *Response_ListSnapshots {
+	this := &Response_ListSnapshots{}
+	this.ListSnapshots = NewPopulatedResponseListSnapshots(r, easy)
+	return this
 }
 
-func (m *ResponseEcho) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func NewPopulatedResponse_OfferSnapshot(r randyTypes, easy bool) *Response_OfferSnapshot {
+	this := &Response_OfferSnapshot{}
+	this.OfferSnapshot = NewPopulatedResponseOfferSnapshot(r, easy)
+	return this
+}
+
+func NewPopulatedResponse_LoadSnapshotChunk(r randyTypes, easy bool) *Response_LoadSnapshotChunk {
+	this := &Response_LoadSnapshotChunk{}
+	this.LoadSnapshotChunk = NewPopulatedResponseLoadSnapshotChunk(r, easy)
+	return this
+}
+
+func NewPopulatedResponse_ApplySnapshotChunk(r randyTypes, easy bool) *Response_ApplySnapshotChunk {
+	this := &Response_ApplySnapshotChunk{}
+	this.ApplySnapshotChunk = NewPopulatedResponseApplySnapshotChunk(r, easy)
+	return this
+}
+func NewPopulatedResponseException(r randyTypes, easy bool) *ResponseException {
+	this := &ResponseException{}
+	this.Error = string(randStringTypes(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 2)
 	}
-	return n
+	return this
 }
 
-func (m *ResponseFlush) Size() (n int) {
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func NewPopulatedResponseEcho(r randyTypes, easy bool) *ResponseEcho {
+	this := &ResponseEcho{}
+	this.Message = string(randStringTypes(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 2)
 	}
-	return n
+	return this
 }
 
-func (m *ResponseInfo) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+func NewPopulatedResponseFlush(r randyTypes, easy bool) *ResponseFlush {
+	this := &ResponseFlush{}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 1)
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return this
+}
+
+func NewPopulatedResponseInfo(r randyTypes, easy bool) *ResponseInfo {
+	this := &ResponseInfo{}
+	this.Data = string(randStringTypes(r))
+	this.Version = string(randStringTypes(r))
+	this.AppVersion = uint64(uint64(r.Uint32()))
+	this.LastBlockHeight = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.LastBlockHeight *= -1
 	}
-	if m.AppVersion != 0 {
-		n += 1 + sovTypes(uint64(m.AppVersion))
+	v13 := r.Intn(100)
+	this.LastBlockAppHash = make([]byte, v13)
+	for i := 0; i < v13; i++ {
+		this.LastBlockAppHash[i] = byte(r.Intn(256))
 	}
-	if m.LastBlockHeight != 0 {
-		n += 1 + sovTypes(uint64(m.LastBlockHeight))
+	v102 := r.Intn(10)
+	this.OpenGroups = make([]int32, v102)
+	for i := 0; i < v102; i++ {
+		this.OpenGroups[i] = int32(r.Int31())
+		if r.Intn(2) == 0 {
+			this.OpenGroups[i] *= -1
+		}
 	}
-	l = len(m.LastBlockAppHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	this.NextGroup = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.NextGroup *= -1
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 8)
 	}
-	return n
+	return this
 }
 
-func (m *ResponseSetOption) Size() (n int) {
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovTypes(uint64(m.Code))
-	}
-	l = len(m.Log)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Info)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func NewPopulatedResponseSetOption(r randyTypes, easy bool) *ResponseSetOption {
+	this := &ResponseSetOption{}
+	this.Code = uint32(r.Uint32())
+	this.Log = string(randStringTypes(r))
+	this.Info = string(randStringTypes(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 5)
 	}
-	return n
+	return this
 }
 
-func (m *ResponseInitChain) Size() (n int) {
-	var l int
-	_ = l
-	if m.ConsensusParams != nil {
-		l = m.ConsensusParams.Size()
-		n += 1 + l + sovTypes(uint64(l))
+func NewPopulatedResponseInitChain(r randyTypes, easy bool) *ResponseInitChain {
+	this := &ResponseInitChain{}
+	if r.Intn(10) != 0 {
+		this.ConsensusParams = NewPopulatedConsensusParams(r, easy)
 	}
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if r.Intn(10) != 0 {
+		v14 := r.Intn(5)
+		this.Validators = make([]ValidatorUpdate, v14)
+		for i := 0; i < v14; i++ {
+			v15 := NewPopulatedValidatorUpdate(r, easy)
+			this.Validators[i] = *v15
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
 	}
-	return n
+	return this
 }
 
-func (m *ResponseQuery) Size() (n int) {
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovTypes(uint64(m.Code))
-	}
-	l = len(m.Log)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Info)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Index != 0 {
-		n += 1 + sovTypes(uint64(m.Index))
-	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+func NewPopulatedResponseQuery(r randyTypes, easy bool) *ResponseQuery {
+	this := &ResponseQuery{}
+	this.Code = uint32(r.Uint32())
+	this.Log = string(randStringTypes(r))
+	this.Info = string(randStringTypes(r))
+	this.Index = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Index *= -1
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	v16 := r.Intn(100)
+	this.Key = make([]byte, v16)
+	for i := 0; i < v16; i++ {
+		this.Key[i] = byte(r.Intn(256))
 	}
-	if m.Proof != nil {
-		l = m.Proof.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	v17 := r.Intn(100)
+	this.Value = make([]byte, v17)
+	for i := 0; i < v17; i++ {
+		this.Value[i] = byte(r.Intn(256))
 	}
-	if m.Height != 0 {
-		n += 1 + sovTypes(uint64(m.Height))
+	if r.Intn(10) != 0 {
+		this.Proof = merkle.NewPopulatedProof(r, easy)
 	}
-	l = len(m.Codespace)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	this.Height = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Height *= -1
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	this.Codespace = string(randStringTypes(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 11)
 	}
-	return n
+	return this
 }
 
-func (m *ResponseBeginBlock) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Tags) > 0 {
-		for _, e := range m.Tags {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+func NewPopulatedResponseBeginBlock(r randyTypes, easy bool) *ResponseBeginBlock {
+	this := &ResponseBeginBlock{}
+	if r.Intn(10) != 0 {
+		v18 := r.Intn(5)
+		this.Tags = make([]common.KVPair, v18)
+		for i := 0; i < v18; i++ {
+			v19 := common.NewPopulatedKVPair(r, easy)
+			this.Tags[i] = *v19
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 2)
 	}
-	return n
+	return this
 }
 
-func (m *ResponseCheckTx) Size() (n int) {
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovTypes(uint64(m.Code))
+func NewPopulatedResponseCheckTx(r randyTypes, easy bool) *ResponseCheckTx {
+	this := &ResponseCheckTx{}
+	this.Code = uint32(r.Uint32())
+	v20 := r.Intn(100)
+	this.Data = make([]byte, v20)
+	for i := 0; i < v20; i++ {
+		this.Data[i] = byte(r.Intn(256))
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	this.Log = string(randStringTypes(r))
+	this.Info = string(randStringTypes(r))
+	this.GasWanted = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.GasWanted *= -1
 	}
-	l = len(m.Log)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	this.GasUsed = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.GasUsed *= -1
 	}
-	l = len(m.Info)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.GasWanted != 0 {
-		n += 1 + sovTypes(uint64(m.GasWanted))
-	}
-	if m.GasUsed != 0 {
-		n += 1 + sovTypes(uint64(m.GasUsed))
-	}
-	if len(m.Tags) > 0 {
-		for _, e := range m.Tags {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if r.Intn(10) != 0 {
+		v21 := r.Intn(5)
+		this.Tags = make([]common.KVPair, v21)
+		for i := 0; i < v21; i++ {
+			v22 := common.NewPopulatedKVPair(r, easy)
+			this.Tags[i] = *v22
 		}
 	}
-	l = len(m.Codespace)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	this.Codespace = string(randStringTypes(r))
+	this.Sender = string(randStringTypes(r))
+	this.Priority = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Priority *= -1
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	this.Nonce = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 12)
 	}
-	return n
+	return this
 }
 
-func (m *ResponseDeliverTx) Size() (n int) {
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovTypes(uint64(m.Code))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Log)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Info)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+func NewPopulatedResponseDeliverTx(r randyTypes, easy bool) *ResponseDeliverTx {
+	this := &ResponseDeliverTx{}
+	this.Code = uint32(r.Uint32())
+	v23 := r.Intn(100)
+	this.Data = make([]byte, v23)
+	for i := 0; i < v23; i++ {
+		this.Data[i] = byte(r.Intn(256))
 	}
-	if m.GasWanted != 0 {
-		n += 1 + sovTypes(uint64(m.GasWanted))
+	this.Log = string(randStringTypes(r))
+	this.Info = string(randStringTypes(r))
+	this.GasWanted = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.GasWanted *= -1
 	}
-	if m.GasUsed != 0 {
-		n += 1 + sovTypes(uint64(m.GasUsed))
+	this.GasUsed = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.GasUsed *= -1
 	}
-	if len(m.Tags) > 0 {
-		for _, e := range m.Tags {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if r.Intn(10) != 0 {
+		v24 := r.Intn(5)
+		this.Tags = make([]common.KVPair, v24)
+		for i := 0; i < v24; i++ {
+			v25 := common.NewPopulatedKVPair(r, easy)
+			this.Tags[i] = *v25
 		}
 	}
-	l = len(m.Codespace)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	this.Codespace = string(randStringTypes(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 9)
 	}
-	return n
+	return this
 }
 
-func (m *ResponseEndBlock) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.ValidatorUpdates) > 0 {
-		for _, e := range m.ValidatorUpdates {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+func NewPopulatedResponseEndBlock(r randyTypes, easy bool) *ResponseEndBlock {
+	this := &ResponseEndBlock{}
+	if r.Intn(10) != 0 {
+		v26 := r.Intn(5)
+		this.ValidatorUpdates = make([]ValidatorUpdate, v26)
+		for i := 0; i < v26; i++ {
+			v27 := NewPopulatedValidatorUpdate(r, easy)
+			this.ValidatorUpdates[i] = *v27
 		}
 	}
-	if m.ConsensusParamUpdates != nil {
-		l = m.ConsensusParamUpdates.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if r.Intn(10) != 0 {
+		this.ConsensusParamUpdates = NewPopulatedConsensusParams(r, easy)
 	}
-	if len(m.Tags) > 0 {
-		for _, e := range m.Tags {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if r.Intn(10) != 0 {
+		v28 := r.Intn(5)
+		this.Tags = make([]common.KVPair, v28)
+		for i := 0; i < v28; i++ {
+			v29 := common.NewPopulatedKVPair(r, easy)
+			this.Tags[i] = *v29
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	v100 := r.Intn(10)
+	this.OpenGroups = make([]int32, v100)
+	for i := 0; i < v100; i++ {
+		this.OpenGroups[i] = int32(r.Int31())
+		if r.Intn(2) == 0 {
+			this.OpenGroups[i] *= -1
+		}
 	}
-	return n
+	v101 := r.Intn(10)
+	this.CloseGroups = make([]int32, v101)
+	for i := 0; i < v101; i++ {
+		this.CloseGroups[i] = int32(r.Int31())
+		if r.Intn(2) == 0 {
+			this.CloseGroups[i] *= -1
+		}
+	}
+	this.NextGroup = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.NextGroup *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 7)
+	}
+	return this
 }
 
-func (m *ResponseCommit) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+func NewPopulatedResponseCommit(r randyTypes, easy bool) *ResponseCommit {
+	this := &ResponseCommit{}
+	v30 := r.Intn(100)
+	this.Data = make([]byte, v30)
+	for i := 0; i < v30; i++ {
+		this.Data[i] = byte(r.Intn(256))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	this.RetainHeight = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.RetainHeight *= -1
 	}
-	return n
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 4)
+	}
+	return this
 }
 
-func (m *ConsensusParams) Size() (n int) {
-	var l int
-	_ = l
-	if m.BlockSize != nil {
-		l = m.BlockSize.Size()
-		n += 1 + l + sovTypes(uint64(l))
+func NewPopulatedConsensusParams(r randyTypes, easy bool) *ConsensusParams {
+	this := &ConsensusParams{}
+	if r.Intn(10) != 0 {
+		this.BlockSize = NewPopulatedBlockSizeParams(r, easy)
 	}
-	if m.Evidence != nil {
-		l = m.Evidence.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if r.Intn(10) != 0 {
+		this.Evidence = NewPopulatedEvidenceParams(r, easy)
 	}
-	if m.Validator != nil {
-		l = m.Validator.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if r.Intn(10) != 0 {
+		this.Validator = NewPopulatedValidatorParams(r, easy)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 4)
 	}
-	return n
+	return this
 }
 
-func (m *BlockSizeParams) Size() (n int) {
-	var l int
-	_ = l
-	if m.MaxBytes != 0 {
-		n += 1 + sovTypes(uint64(m.MaxBytes))
+func NewPopulatedBlockSizeParams(r randyTypes, easy bool) *BlockSizeParams {
+	this := &BlockSizeParams{}
+	this.MaxBytes = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.MaxBytes *= -1
 	}
-	if m.MaxGas != 0 {
-		n += 1 + sovTypes(uint64(m.MaxGas))
+	this.MaxGas = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.MaxGas *= -1
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
 	}
-	return n
+	return this
 }
 
-func (m *EvidenceParams) Size() (n int) {
-	var l int
-	_ = l
-	if m.MaxAge != 0 {
-		n += 1 + sovTypes(uint64(m.MaxAge))
+func NewPopulatedEvidenceParams(r randyTypes, easy bool) *EvidenceParams {
+	this := &EvidenceParams{}
+	this.MaxAge = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.MaxAge *= -1
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	v36 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.MaxAgeDuration = *v36
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
 	}
-	return n
+	return this
 }
 
-func (m *ValidatorParams) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.PubKeyTypes) > 0 {
-		for _, s := range m.PubKeyTypes {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
-		}
+func NewPopulatedValidatorParams(r randyTypes, easy bool) *ValidatorParams {
+	this := &ValidatorParams{}
+	v31 := r.Intn(10)
+	this.PubKeyTypes = make([]string, v31)
+	for i := 0; i < v31; i++ {
+		this.PubKeyTypes[i] = string(randStringTypes(r))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 2)
 	}
-	return n
+	return this
 }
 
-func (m *LastCommitInfo) Size() (n int) {
-	var l int
-	_ = l
-	if m.Round != 0 {
-		n += 1 + sovTypes(uint64(m.Round))
+func NewPopulatedLastCommitInfo(r randyTypes, easy bool) *LastCommitInfo {
+	this := &LastCommitInfo{}
+	this.Round = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Round *= -1
 	}
-	if len(m.Votes) > 0 {
-		for _, e := range m.Votes {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if r.Intn(10) != 0 {
+		v32 := r.Intn(5)
+		this.Votes = make([]VoteInfo, v32)
+		for i := 0; i < v32; i++ {
+			v33 := NewPopulatedVoteInfo(r, easy)
+			this.Votes[i] = *v33
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
+	}
+	return this
 }
 
-func (m *Header) Size() (n int) {
-	var l int
-	_ = l
-	l = m.Version.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovTypes(uint64(m.Height))
+func NewPopulatedHeader(r randyTypes, easy bool) *Header {
+	this := &Header{}
+	v34 := NewPopulatedVersion(r, easy)
+	this.Version = *v34
+	this.ChainID = string(randStringTypes(r))
+	this.Height = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Height *= -1
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
-	n += 1 + l + sovTypes(uint64(l))
-	if m.NumTxs != 0 {
-		n += 1 + sovTypes(uint64(m.NumTxs))
+	v35 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.Time = *v35
+	this.NumTxs = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.NumTxs *= -1
 	}
-	if m.TotalTxs != 0 {
-		n += 1 + sovTypes(uint64(m.TotalTxs))
+	this.TotalTxs = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.TotalTxs *= -1
 	}
-	l = m.LastBlockId.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.LastCommitHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	v36 := NewPopulatedBlockID(r, easy)
+	this.LastBlockId = *v36
+	v37 := r.Intn(100)
+	this.LastCommitHash = make([]byte, v37)
+	for i := 0; i < v37; i++ {
+		this.LastCommitHash[i] = byte(r.Intn(256))
 	}
-	l = len(m.DataHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	v38 := r.Intn(100)
+	this.DataHash = make([]byte, v38)
+	for i := 0; i < v38; i++ {
+		this.DataHash[i] = byte(r.Intn(256))
 	}
-	l = len(m.ValidatorsHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	v39 := r.Intn(100)
+	this.ValidatorsHash = make([]byte, v39)
+	for i := 0; i < v39; i++ {
+		this.ValidatorsHash[i] = byte(r.Intn(256))
 	}
-	l = len(m.NextValidatorsHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	v40 := r.Intn(100)
+	this.NextValidatorsHash = make([]byte, v40)
+	for i := 0; i < v40; i++ {
+		this.NextValidatorsHash[i] = byte(r.Intn(256))
 	}
-	l = len(m.ConsensusHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	v41 := r.Intn(100)
+	this.ConsensusHash = make([]byte, v41)
+	for i := 0; i < v41; i++ {
+		this.ConsensusHash[i] = byte(r.Intn(256))
 	}
-	l = len(m.AppHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	v42 := r.Intn(100)
+	this.AppHash = make([]byte, v42)
+	for i := 0; i < v42; i++ {
+		this.AppHash[i] = byte(r.Intn(256))
 	}
-	l = len(m.LastResultsHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	v43 := r.Intn(100)
+	this.LastResultsHash = make([]byte, v43)
+	for i := 0; i < v43; i++ {
+		this.LastResultsHash[i] = byte(r.Intn(256))
 	}
-	l = len(m.EvidenceHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	v44 := r.Intn(100)
+	this.EvidenceHash = make([]byte, v44)
+	for i := 0; i < v44; i++ {
+		this.EvidenceHash[i] = byte(r.Intn(256))
 	}
-	l = len(m.ProposerAddress)
-	if l > 0 {
-		n += 2 + l + sovTypes(uint64(l))
+	v45 := r.Intn(100)
+	this.ProposerAddress = make([]byte, v45)
+	for i := 0; i < v45; i++ {
+		this.ProposerAddress[i] = byte(r.Intn(256))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 17)
 	}
-	return n
+	return this
 }
 
-func (m *Version) Size() (n int) {
-	var l int
-	_ = l
-	if m.Block != 0 {
-		n += 1 + sovTypes(uint64(m.Block))
-	}
-	if m.App != 0 {
-		n += 1 + sovTypes(uint64(m.App))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func NewPopulatedVersion(r randyTypes, easy bool) *Version {
+	this := &Version{}
+	this.Block = uint64(uint64(r.Uint32()))
+	this.App = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
 	}
-	return n
+	return this
 }
 
-func (m *BlockID) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+func NewPopulatedBlockID(r randyTypes, easy bool) *BlockID {
+	this := &BlockID{}
+	v46 := r.Intn(100)
+	this.Hash = make([]byte, v46)
+	for i := 0; i < v46; i++ {
+		this.Hash[i] = byte(r.Intn(256))
 	}
-	l = m.PartsHeader.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	v47 := NewPopulatedPartSetHeader(r, easy)
+	this.PartsHeader = *v47
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
 	}
-	return n
+	return this
 }
 
-func (m *PartSetHeader) Size() (n int) {
-	var l int
-	_ = l
-	if m.Total != 0 {
-		n += 1 + sovTypes(uint64(m.Total))
+func NewPopulatedPartSetHeader(r randyTypes, easy bool) *PartSetHeader {
+	this := &PartSetHeader{}
+	this.Total = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Total *= -1
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	v48 := r.Intn(100)
+	this.Hash = make([]byte, v48)
+	for i := 0; i < v48; i++ {
+		this.Hash[i] = byte(r.Intn(256))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
 	}
-	return n
+	return this
 }
 
-func (m *Validator) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+func NewPopulatedValidator(r randyTypes, easy bool) *Validator {
+	this := &Validator{}
+	v49 := r.Intn(100)
+	this.Address = make([]byte, v49)
+	for i := 0; i < v49; i++ {
+		this.Address[i] = byte(r.Intn(256))
 	}
-	if m.Power != 0 {
-		n += 1 + sovTypes(uint64(m.Power))
+	this.Power = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Power *= -1
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 4)
 	}
-	return n
+	return this
 }
 
-func (m *ValidatorUpdate) Size() (n int) {
-	var l int
-	_ = l
-	l = m.PubKey.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.Power != 0 {
-		n += 1 + sovTypes(uint64(m.Power))
+func NewPopulatedValidatorUpdate(r randyTypes, easy bool) *ValidatorUpdate {
+	this := &ValidatorUpdate{}
+	v50 := NewPopulatedPubKey(r, easy)
+	this.PubKey = *v50
+	this.Power = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Power *= -1
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
 	}
-	return n
+	return this
 }
 
-func (m *VoteInfo) Size() (n int) {
-	var l int
-	_ = l
-	l = m.Validator.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.SignedLastBlock {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func NewPopulatedVoteInfo(r randyTypes, easy bool) *VoteInfo {
+	this := &VoteInfo{}
+	v51 := NewPopulatedValidator(r, easy)
+	this.Validator = *v51
+	this.SignedLastBlock = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
 	}
-	return n
+	return this
 }
 
-func (m *PubKey) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+func NewPopulatedPubKey(r randyTypes, easy bool) *PubKey {
+	this := &PubKey{}
+	this.Type = string(randStringTypes(r))
+	v52 := r.Intn(100)
+	this.Data = make([]byte, v52)
+	for i := 0; i < v52; i++ {
+		this.Data[i] = byte(r.Intn(256))
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
 	}
-	if m.XXX_unrecognized != nil {
+	return this
+}
+
+func NewPopulatedEvidence(r randyTypes, easy bool) *Evidence {
+	this := &Evidence{}
+	this.Type = string(randStringTypes(r))
+	v53 := NewPopulatedValidator(r, easy)
+	this.Validator = *v53
+	this.Height = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Height *= -1
+	}
+	v54 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.Time = *v54
+	this.TotalVotingPower = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.TotalVotingPower *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 6)
+	}
+	return this
+}
+
+func NewPopulatedRequestListSnapshots(r randyTypes, easy bool) *RequestListSnapshots {
+	this := &RequestListSnapshots{}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 1)
+	}
+	return this
+}
+
+func NewPopulatedRequestOfferSnapshot(r randyTypes, easy bool) *RequestOfferSnapshot {
+	this := &RequestOfferSnapshot{}
+	if r.Intn(5) != 0 {
+		this.Snapshot = NewPopulatedSnapshot(r, easy)
+	}
+	v103 := r.Intn(100)
+	this.AppHash = make([]byte, v103)
+	for i := 0; i < v103; i++ {
+		this.AppHash[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedRequestLoadSnapshotChunk(r randyTypes, easy bool) *RequestLoadSnapshotChunk {
+	this := &RequestLoadSnapshotChunk{}
+	this.Height = uint64(uint64(r.Uint32()))
+	this.Format = uint32(r.Uint32())
+	this.Chunk = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedRequestApplySnapshotChunk(r randyTypes, easy bool) *RequestApplySnapshotChunk {
+	this := &RequestApplySnapshotChunk{}
+	this.Index = uint32(r.Uint32())
+	v104 := r.Intn(100)
+	this.Chunk = make([]byte, v104)
+	for i := 0; i < v104; i++ {
+		this.Chunk[i] = byte(r.Intn(256))
+	}
+	this.Sender = string(randStringTypes(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedResponseListSnapshots(r randyTypes, easy bool) *ResponseListSnapshots {
+	this := &ResponseListSnapshots{}
+	if r.Intn(5) != 0 {
+		v105 := r.Intn(5)
+		this.Snapshots = make([]*Snapshot, v105)
+		for i := 0; i < v105; i++ {
+			this.Snapshots[i] = NewPopulatedSnapshot(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedResponseOfferSnapshot(r randyTypes, easy bool) *ResponseOfferSnapshot {
+	this := &ResponseOfferSnapshot{}
+	this.Result = ResponseOfferSnapshot_Result([]int32{0, 1, 2, 3, 4, 5}[r.Intn(6)])
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedResponseLoadSnapshotChunk(r randyTypes, easy bool) *ResponseLoadSnapshotChunk {
+	this := &ResponseLoadSnapshotChunk{}
+	v106 := r.Intn(100)
+	this.Chunk = make([]byte, v106)
+	for i := 0; i < v106; i++ {
+		this.Chunk[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedResponseApplySnapshotChunk(r randyTypes, easy bool) *ResponseApplySnapshotChunk {
+	this := &ResponseApplySnapshotChunk{}
+	this.Result = ResponseApplySnapshotChunk_Result([]int32{0, 1, 2, 3, 4, 5}[r.Intn(6)])
+	v107 := r.Intn(10)
+	this.RefetchChunks = make([]uint32, v107)
+	for i := 0; i < v107; i++ {
+		this.RefetchChunks[i] = uint32(r.Uint32())
+	}
+	v108 := r.Intn(10)
+	this.RejectSenders = make([]string, v108)
+	for i := 0; i < v108; i++ {
+		this.RejectSenders[i] = string(randStringTypes(r))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedSnapshot(r randyTypes, easy bool) *Snapshot {
+	this := &Snapshot{}
+	this.Height = uint64(uint64(r.Uint32()))
+	this.Format = uint32(r.Uint32())
+	this.Chunks = uint32(r.Uint32())
+	v109 := r.Intn(100)
+	this.Hash = make([]byte, v109)
+	for i := 0; i < v109; i++ {
+		this.Hash[i] = byte(r.Intn(256))
+	}
+	v110 := r.Intn(100)
+	this.Metadata = make([]byte, v110)
+	for i := 0; i < v110; i++ {
+		this.Metadata[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 6)
+	}
+	return this
+}
+
+type randyTypes interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneTypes(r randyTypes) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringTypes(r randyTypes) string {
+	v55 := r.Intn(100)
+	tmps := make([]rune, v55)
+	for i := 0; i < v55; i++ {
+		tmps[i] = randUTF8RuneTypes(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedTypes(r randyTypes, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldTypes(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldTypes(dAtA []byte, r randyTypes, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateTypes(dAtA, uint64(key))
+		v56 := r.Int63()
+		if r.Intn(2) == 0 {
+			v56 *= -1
+		}
+		dAtA = encodeVarintPopulateTypes(dAtA, uint64(v56))
+	case 1:
+		dAtA = encodeVarintPopulateTypes(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateTypes(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateTypes(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateTypes(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateTypes(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *Request) Size() (n int) {
+	var l int
+	_ = l
+	if m.Value != nil {
+		n += m.Value.Size()
+	}
+	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *Evidence) Size() (n int) {
+func (m *Request_Echo) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Type)
-	if l > 0 {
+	if m.Echo != nil {
+		l = m.Echo.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	l = m.Validator.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.Height != 0 {
-		n += 1 + sovTypes(uint64(m.Height))
+	return n
+}
+func (m *Request_Flush) Size() (n int) {
+	var l int
+	_ = l
+	if m.Flush != nil {
+		l = m.Flush.Size()
+		n += 1 + l + sovTypes(uint64(l))
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
-	n += 1 + l + sovTypes(uint64(l))
-	if m.TotalVotingPower != 0 {
-		n += 1 + sovTypes(uint64(m.TotalVotingPower))
+	return n
+}
+func (m *Request_Info) Size() (n int) {
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovTypes(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return n
+}
+func (m *Request_SetOption) Size() (n int) {
+	var l int
+	_ = l
+	if m.SetOption != nil {
+		l = m.SetOption.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Request_InitChain) Size() (n int) {
+	var l int
+	_ = l
+	if m.InitChain != nil {
+		l = m.InitChain.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Request_Query) Size() (n int) {
+	var l int
+	_ = l
+	if m.Query != nil {
+		l = m.Query.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Request_BeginBlock) Size() (n int) {
+	var l int
+	_ = l
+	if m.BeginBlock != nil {
+		l = m.BeginBlock.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Request_CheckTx) Size() (n int) {
+	var l int
+	_ = l
+	if m.CheckTx != nil {
+		l = m.CheckTx.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Request_EndBlock) Size() (n int) {
+	var l int
+	_ = l
+	if m.EndBlock != nil {
+		l = m.EndBlock.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Request_Commit) Size() (n int) {
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Request_ListSnapshots) Size() (n int) {
+	var l int
+	_ = l
+	if m.ListSnapshots != nil {
+		l = m.ListSnapshots.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Request_OfferSnapshot) Size() (n int) {
+	var l int
+	_ = l
+	if m.OfferSnapshot != nil {
+		l = m.OfferSnapshot.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Request_LoadSnapshotChunk) Size() (n int) {
+	var l int
+	_ = l
+	if m.LoadSnapshotChunk != nil {
+		l = m.LoadSnapshotChunk.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Request_ApplySnapshotChunk) Size() (n int) {
+	var l int
+	_ = l
+	if m.ApplySnapshotChunk != nil {
+		l = m.ApplySnapshotChunk.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Request_DeliverTx) Size() (n int) {
+	var l int
+	_ = l
+	if m.DeliverTx != nil {
+		l = m.DeliverTx.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *RequestEcho) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestFlush) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestInfo) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.BlockVersion != 0 {
+		n += 1 + sovTypes(uint64(m.BlockVersion))
+	}
+	if m.P2PVersion != 0 {
+		n += 1 + sovTypes(uint64(m.P2PVersion))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestSetOption) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestInitChain) Size() (n int) {
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ConsensusParams != nil {
+		l = m.ConsensusParams.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.AppStateBytes)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestQuery) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Prove {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestBeginBlock) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Header.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.LastCommitInfo.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.ByzantineValidators) > 0 {
+		for _, e := range m.ByzantineValidators {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestCheckTx) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Tx)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestDeliverTx) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Tx)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestEndBlock) Size() (n int) {
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestCommit) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Value != nil {
+		n += m.Value.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Response_Exception) Size() (n int) {
+	var l int
+	_ = l
+	if m.Exception != nil {
+		l = m.Exception.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Response_Echo) Size() (n int) {
+	var l int
+	_ = l
+	if m.Echo != nil {
+		l = m.Echo.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Response_Flush) Size() (n int) {
+	var l int
+	_ = l
+	if m.Flush != nil {
+		l = m.Flush.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Response_Info) Size() (n int) {
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Response_SetOption) Size() (n int) {
+	var l int
+	_ = l
+	if m.SetOption != nil {
+		l = m.SetOption.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Response_InitChain) Size() (n int) {
+	var l int
+	_ = l
+	if m.InitChain != nil {
+		l = m.InitChain.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Response_Query) Size() (n int) {
+	var l int
+	_ = l
+	if m.Query != nil {
+		l = m.Query.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Response_BeginBlock) Size() (n int) {
+	var l int
+	_ = l
+	if m.BeginBlock != nil {
+		l = m.BeginBlock.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Response_CheckTx) Size() (n int) {
+	var l int
+	_ = l
+	if m.CheckTx != nil {
+		l = m.CheckTx.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Response_DeliverTx) Size() (n int) {
+	var l int
+	_ = l
+	if m.DeliverTx != nil {
+		l = m.DeliverTx.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Response_EndBlock) Size() (n int) {
+	var l int
+	_ = l
+	if m.EndBlock != nil {
+		l = m.EndBlock.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Response_Commit) Size() (n int) {
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Response_ListSnapshots) Size() (n int) {
+	var l int
+	_ = l
+	if m.ListSnapshots != nil {
+		l = m.ListSnapshots.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Response_OfferSnapshot) Size() (n int) {
+	var l int
+	_ = l
+	if m.OfferSnapshot != nil {
+		l = m.OfferSnapshot.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Response_LoadSnapshotChunk) Size() (n int) {
+	var l int
+	_ = l
+	if m.LoadSnapshotChunk != nil {
+		l = m.LoadSnapshotChunk.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Response_ApplySnapshotChunk) Size() (n int) {
+	var l int
+	_ = l
+	if m.ApplySnapshotChunk != nil {
+		l = m.ApplySnapshotChunk.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *ResponseException) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResponseEcho) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResponseFlush) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResponseInfo) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.AppVersion != 0 {
+		n += 1 + sovTypes(uint64(m.AppVersion))
+	}
+	if m.LastBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.LastBlockHeight))
+	}
+	l = len(m.LastBlockAppHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.OpenGroups) > 0 {
+		l = 0
+		for _, e := range m.OpenGroups {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if m.NextGroup != 0 {
+		n += 1 + sovTypes(uint64(m.NextGroup))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResponseSetOption) Size() (n int) {
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovTypes(uint64(m.Code))
+	}
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Info)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResponseInitChain) Size() (n int) {
+	var l int
+	_ = l
+	if m.ConsensusParams != nil {
+		l = m.ConsensusParams.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResponseQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovTypes(uint64(m.Code))
+	}
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Info)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTypes(uint64(m.Index))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResponseBeginBlock) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResponseCheckTx) Size() (n int) {
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovTypes(uint64(m.Code))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Info)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GasWanted != 0 {
+		n += 1 + sovTypes(uint64(m.GasWanted))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovTypes(uint64(m.GasUsed))
+	}
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovTypes(uint64(m.Priority))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResponseDeliverTx) Size() (n int) {
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovTypes(uint64(m.Code))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Info)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GasWanted != 0 {
+		n += 1 + sovTypes(uint64(m.GasWanted))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovTypes(uint64(m.GasUsed))
+	}
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResponseEndBlock) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.ValidatorUpdates) > 0 {
+		for _, e := range m.ValidatorUpdates {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ConsensusParamUpdates != nil {
+		l = m.ConsensusParamUpdates.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.OpenGroups) > 0 {
+		l = 0
+		for _, e := range m.OpenGroups {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.CloseGroups) > 0 {
+		l = 0
+		for _, e := range m.CloseGroups {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if m.NextGroup != 0 {
+		n += 1 + sovTypes(uint64(m.NextGroup))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResponseCommit) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.RetainHeight != 0 {
+		n += 1 + sovTypes(uint64(m.RetainHeight))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ConsensusParams) Size() (n int) {
+	var l int
+	_ = l
+	if m.BlockSize != nil {
+		l = m.BlockSize.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Evidence != nil {
+		l = m.Evidence.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Validator != nil {
+		l = m.Validator.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockSizeParams) Size() (n int) {
+	var l int
+	_ = l
+	if m.MaxBytes != 0 {
+		n += 1 + sovTypes(uint64(m.MaxBytes))
+	}
+	if m.MaxGas != 0 {
+		n += 1 + sovTypes(uint64(m.MaxGas))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EvidenceParams) Size() (n int) {
+	var l int
+	_ = l
+	if m.MaxAge != 0 {
+		n += 1 + sovTypes(uint64(m.MaxAge))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAgeDuration)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorParams) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.PubKeyTypes) > 0 {
+		for _, s := range m.PubKeyTypes {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LastCommitInfo) Size() (n int) {
+	var l int
+	_ = l
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Header) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Version.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.NumTxs != 0 {
+		n += 1 + sovTypes(uint64(m.NumTxs))
+	}
+	if m.TotalTxs != 0 {
+		n += 1 + sovTypes(uint64(m.TotalTxs))
+	}
+	l = m.LastBlockId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.LastCommitHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.DataHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ValidatorsHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.NextValidatorsHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ConsensusHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.AppHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.LastResultsHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EvidenceHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Version) Size() (n int) {
+	var l int
+	_ = l
+	if m.Block != 0 {
+		n += 1 + sovTypes(uint64(m.Block))
+	}
+	if m.App != 0 {
+		n += 1 + sovTypes(uint64(m.App))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockID) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.PartsHeader.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PartSetHeader) Size() (n int) {
+	var l int
+	_ = l
+	if m.Total != 0 {
+		n += 1 + sovTypes(uint64(m.Total))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Validator) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovTypes(uint64(m.Power))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorUpdate) Size() (n int) {
+	var l int
+	_ = l
+	l = m.PubKey.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Power != 0 {
+		n += 1 + sovTypes(uint64(m.Power))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VoteInfo) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Validator.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.SignedLastBlock {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PubKey) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Evidence) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Validator.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovTypes(uint64(m.TotalVotingPower))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestListSnapshots) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestOfferSnapshot) Size() (n int) {
+	var l int
+	_ = l
+	if m.Snapshot != nil {
+		l = m.Snapshot.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.AppHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestLoadSnapshotChunk) Size() (n int) {
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Format != 0 {
+		n += 1 + sovTypes(uint64(m.Format))
+	}
+	if m.Chunk != 0 {
+		n += 1 + sovTypes(uint64(m.Chunk))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestApplySnapshotChunk) Size() (n int) {
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovTypes(uint64(m.Index))
+	}
+	l = len(m.Chunk)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResponseListSnapshots) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResponseOfferSnapshot) Size() (n int) {
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovTypes(uint64(m.Result))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResponseLoadSnapshotChunk) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Chunk)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResponseApplySnapshotChunk) Size() (n int) {
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovTypes(uint64(m.Result))
+	}
+	if len(m.RefetchChunks) > 0 {
+		l = 0
+		for _, e := range m.RefetchChunks {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.RejectSenders) > 0 {
+		for _, s := range m.RejectSenders {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Snapshot) Size() (n int) {
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Format != 0 {
+		n += 1 + sovTypes(uint64(m.Format))
+	}
+	if m.Chunks != 0 {
+		n += 1 + sovTypes(uint64(m.Chunks))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Echo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestEcho{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Request_Echo{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flush", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestFlush{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Request_Flush{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestInfo{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Request_Info{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetOption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestSetOption{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Request_SetOption{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitChain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestInitChain{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Request_InitChain{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestQuery{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Request_Query{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestBeginBlock{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Request_BeginBlock{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestCheckTx{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Request_CheckTx{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestEndBlock{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Request_EndBlock{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestCommit{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Request_Commit{v}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestListSnapshots{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Request_ListSnapshots{v}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferSnapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestOfferSnapshot{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Request_OfferSnapshot{v}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoadSnapshotChunk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestLoadSnapshotChunk{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Request_LoadSnapshotChunk{v}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplySnapshotChunk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestApplySnapshotChunk{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Request_ApplySnapshotChunk{v}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliverTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestDeliverTx{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Request_DeliverTx{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestEcho) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestEcho: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestEcho: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestFlush) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestFlush: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestFlush: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockVersion", wireType)
+			}
+			m.BlockVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P2PVersion", wireType)
+			}
+			m.P2PVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.P2PVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestSetOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestSetOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestSetOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestInitChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestInitChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestInitChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusParams == nil {
+				m.ConsensusParams = &ConsensusParams{}
+			}
+			if err := m.ConsensusParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, ValidatorUpdate{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppStateBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppStateBytes = append(m.AppStateBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppStateBytes == nil {
+				m.AppStateBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Prove = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovTypes(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Request) Unmarshal(dAtA []byte) error {
+func (m *RequestBeginBlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9451,17 +13322,17 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestBeginBlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestBeginBlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Echo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -9471,27 +13342,26 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RequestEcho{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
 			}
-			m.Value = &Request_Echo{v}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flush", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9515,15 +13385,13 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RequestFlush{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &Request_Flush{v}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCommitInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9547,15 +13415,13 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RequestInfo{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastCommitInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &Request_Info{v}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetOption", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ByzantineValidators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9579,49 +13445,67 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RequestSetOption{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ByzantineValidators = append(m.ByzantineValidators, Evidence{})
+			if err := m.ByzantineValidators[len(m.ByzantineValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &Request_SetOption{v}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitChain", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RequestInitChain{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestCheckTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-			m.Value = &Request_InitChain{v}
-			iNdEx = postIndex
-		case 7:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestCheckTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestCheckTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -9631,29 +13515,79 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RequestQuery{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tx == nil {
+				m.Tx = []byte{}
 			}
-			m.Value = &Request_Query{v}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestDeliverTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestDeliverTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestDeliverTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -9663,29 +13597,79 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RequestBeginBlock{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tx == nil {
+				m.Tx = []byte{}
 			}
-			m.Value = &Request_BeginBlock{v}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckTx", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestEndBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestEndBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestEndBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -9695,27 +13679,116 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Height |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RequestCheckTx{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.Value = &Request_CheckTx{v}
-			iNdEx = postIndex
-		case 11:
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exception", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9739,15 +13812,15 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RequestEndBlock{}
+			v := &ResponseException{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &Request_EndBlock{v}
+			m.Value = &Response_Exception{v}
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Echo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9771,15 +13844,15 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RequestCommit{}
+			v := &ResponseEcho{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &Request_Commit{v}
+			m.Value = &Response_Echo{v}
 			iNdEx = postIndex
-		case 19:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeliverTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Flush", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9803,68 +13876,17 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RequestDeliverTx{}
+			v := &ResponseFlush{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &Request_DeliverTx{v}
+			m.Value = &Response_Flush{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RequestEcho) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RequestEcho: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestEcho: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -9874,128 +13896,61 @@ func (m *RequestEcho) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
+			v := &ResponseInfo{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RequestFlush) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Value = &Response_Info{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetOption", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RequestFlush: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestFlush: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RequestInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &ResponseSetOption{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RequestInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Value = &Response_SetOption{v}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitChain", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10005,26 +13960,29 @@ func (m *RequestInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			v := &ResponseInitChain{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Response_InitChain{v}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockVersion", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
-			m.BlockVersion = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10034,16 +13992,29 @@ func (m *RequestInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockVersion |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field P2PVersion", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.P2PVersion = 0
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ResponseQuery{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Response_Query{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlock", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10053,67 +14024,61 @@ func (m *RequestInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.P2PVersion |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RequestSetOption) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			v := &ResponseBeginBlock{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Value = &Response_BeginBlock{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckTx", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RequestSetOption: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestSetOption: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ResponseCheckTx{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Response_CheckTx{v}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliverTx", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10123,26 +14088,29 @@ func (m *RequestSetOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			v := &ResponseDeliverTx{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Response_DeliverTx{v}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10152,75 +14120,27 @@ func (m *RequestSetOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
+			v := &ResponseEndBlock{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RequestInitChain) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RequestInitChain: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestInitChain: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Value = &Response_EndBlock{v}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10244,15 +14164,17 @@ func (m *RequestInitChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+			v := &ResponseCommit{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Value = &Response_Commit{v}
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListSnapshots", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10262,24 +14184,27 @@ func (m *RequestInitChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			v := &ResponseListSnapshots{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Response_ListSnapshots{v}
 			iNdEx = postIndex
-		case 3:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferSnapshot", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10303,16 +14228,15 @@ func (m *RequestInitChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsensusParams == nil {
-				m.ConsensusParams = &ConsensusParams{}
-			}
-			if err := m.ConsensusParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &ResponseOfferSnapshot{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Value = &Response_OfferSnapshot{v}
 			iNdEx = postIndex
-		case 4:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LoadSnapshotChunk", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10336,16 +14260,17 @@ func (m *RequestInitChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, ValidatorUpdate{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &ResponseLoadSnapshotChunk{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Value = &Response_LoadSnapshotChunk{v}
 			iNdEx = postIndex
-		case 5:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppStateBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplySnapshotChunk", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10355,22 +14280,23 @@ func (m *RequestInitChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppStateBytes = append(m.AppStateBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.AppStateBytes == nil {
-				m.AppStateBytes = []byte{}
+			v := &ResponseApplySnapshotChunk{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			m.Value = &Response_ApplySnapshotChunk{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10394,7 +14320,7 @@ func (m *RequestInitChain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequestQuery) Unmarshal(dAtA []byte) error {
+func (m *ResponseException) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10417,46 +14343,15 @@ func (m *RequestQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResponseException: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResponseException: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10481,32 +14376,64 @@ func (m *RequestQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prove", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseEcho) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseEcho: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseEcho: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10516,12 +14443,21 @@ func (m *RequestQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Prove = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -10544,7 +14480,7 @@ func (m *RequestQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequestBeginBlock) Unmarshal(dAtA []byte) error {
+func (m *ResponseFlush) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10567,17 +14503,68 @@ func (m *RequestBeginBlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestBeginBlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResponseFlush: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestBeginBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResponseFlush: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10587,28 +14574,26 @@ func (m *RequestBeginBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
-			}
+			m.Data = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10618,27 +14603,26 @@ func (m *RequestBeginBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCommitInfo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
 			}
-			var msglen int
+			m.AppVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10648,27 +14632,16 @@ func (m *RequestBeginBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.AppVersion |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LastCommitInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ByzantineValidators", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockHeight", wireType)
 			}
-			var msglen int
+			m.LastBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10678,77 +14651,14 @@ func (m *RequestBeginBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.LastBlockHeight |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ByzantineValidators = append(m.ByzantineValidators, Evidence{})
-			if err := m.ByzantineValidators[len(m.ByzantineValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RequestCheckTx) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RequestCheckTx: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestCheckTx: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockAppHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -10772,11 +14682,92 @@ func (m *RequestCheckTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
-			if m.Tx == nil {
-				m.Tx = []byte{}
+			m.LastBlockAppHash = append(m.LastBlockAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LastBlockAppHash == nil {
+				m.LastBlockAppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OpenGroups = append(m.OpenGroups, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OpenGroups = append(m.OpenGroups, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenGroups", wireType)
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextGroup", wireType)
+			}
+			m.NextGroup = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextGroup |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -10799,7 +14790,7 @@ func (m *RequestCheckTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequestDeliverTx) Unmarshal(dAtA []byte) error {
+func (m *ResponseSetOption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10822,17 +14813,36 @@ func (m *RequestDeliverTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestDeliverTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResponseSetOption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestDeliverTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResponseSetOption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10842,22 +14852,49 @@ func (m *RequestDeliverTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
-			if m.Tx == nil {
-				m.Tx = []byte{}
+			m.Log = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Info = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10881,7 +14918,7 @@ func (m *RequestDeliverTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequestEndBlock) Unmarshal(dAtA []byte) error {
+func (m *ResponseInitChain) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10904,17 +14941,17 @@ func (m *RequestEndBlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestEndBlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResponseInitChain: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestEndBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResponseInitChain: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusParams", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10924,62 +14961,56 @@ func (m *RequestEndBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RequestCommit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			if m.ConsensusParams == nil {
+				m.ConsensusParams = &ConsensusParams{}
 			}
-			if iNdEx >= l {
+			if err := m.ConsensusParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Validators = append(m.Validators, ValidatorUpdate{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RequestCommit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestCommit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -11002,7 +15033,7 @@ func (m *RequestCommit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Response) Unmarshal(dAtA []byte) error {
+func (m *ResponseQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11025,17 +15056,113 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResponseQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResponseQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Log = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exception", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -11045,29 +15172,28 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ResponseException{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
 			}
-			m.Value = &Response_Exception{v}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Echo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -11077,27 +15203,26 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ResponseEcho{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
-			m.Value = &Response_Echo{v}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flush", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11121,17 +15246,18 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ResponseFlush{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Proof == nil {
+				m.Proof = &merkle.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &Response_Flush{v}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -11141,29 +15267,16 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Height |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &ResponseInfo{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Value = &Response_Info{v}
-			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetOption", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -11173,27 +15286,75 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ResponseSetOption{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.Value = &Response_SetOption{v}
-			iNdEx = postIndex
-		case 6:
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseBeginBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseBeginBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseBeginBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11217,17 +15378,67 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ResponseInitChain{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tags = append(m.Tags, common.KVPair{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &Response_InitChain{v}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseCheckTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseCheckTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseCheckTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -11237,29 +15448,16 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Code |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &ResponseQuery{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Value = &Response_Query{v}
-			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -11269,29 +15467,28 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ResponseBeginBlock{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
-			m.Value = &Response_BeginBlock{v}
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -11301,29 +15498,26 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ResponseCheckTx{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Value = &Response_CheckTx{v}
+			m.Log = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeliverTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -11333,29 +15527,26 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ResponseDeliverTx{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Value = &Response_DeliverTx{v}
+			m.Info = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
 			}
-			var msglen int
+			m.GasWanted = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -11365,27 +15556,33 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.GasWanted |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			v := &ResponseEndBlock{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Value = &Response_EndBlock{v}
-			iNdEx = postIndex
-		case 12:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11409,66 +15606,14 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ResponseCommit{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tags = append(m.Tags, common.KVPair{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &Response_Commit{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResponseException) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseException: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseException: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11493,62 +15638,11 @@ func (m *ResponseException) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.Codespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResponseEcho) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseEcho: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseEcho: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11573,59 +15667,46 @@ func (m *ResponseEcho) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResponseFlush) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseFlush: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseFlush: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -11648,7 +15729,7 @@ func (m *ResponseFlush) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResponseInfo) Unmarshal(dAtA []byte) error {
+func (m *ResponseDeliverTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11671,16 +15752,66 @@ func (m *ResponseInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResponseDeliverTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResponseDeliverTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -11704,11 +15835,11 @@ func (m *ResponseInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			m.Log = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11733,13 +15864,13 @@ func (m *ResponseInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Info = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
 			}
-			m.AppVersion = 0
+			m.GasWanted = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -11749,16 +15880,16 @@ func (m *ResponseInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AppVersion |= (uint64(b) & 0x7F) << shift
+				m.GasWanted |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			m.LastBlockHeight = 0
+			m.GasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -11768,16 +15899,16 @@ func (m *ResponseInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastBlockHeight |= (int64(b) & 0x7F) << shift
+				m.GasUsed |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockAppHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -11787,22 +15918,51 @@ func (m *ResponseInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastBlockAppHash = append(m.LastBlockAppHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.LastBlockAppHash == nil {
-				m.LastBlockAppHash = []byte{}
+			m.Tags = append(m.Tags, common.KVPair{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11826,7 +15986,7 @@ func (m *ResponseInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResponseSetOption) Unmarshal(dAtA []byte) error {
+func (m *ResponseEndBlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11849,65 +16009,236 @@ func (m *ResponseSetOption) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseSetOption: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResponseEndBlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseSetOption: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResponseEndBlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUpdates", wireType)
 			}
-			m.Code = 0
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorUpdates = append(m.ValidatorUpdates, ValidatorUpdate{})
+			if err := m.ValidatorUpdates[len(m.ValidatorUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusParamUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusParamUpdates == nil {
+				m.ConsensusParamUpdates = &ConsensusParams{}
+			}
+			if err := m.ConsensusParamUpdates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, common.KVPair{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OpenGroups = append(m.OpenGroups, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Code |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OpenGroups = append(m.OpenGroups, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenGroups", wireType)
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
+		case 5:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.CloseGroups = append(m.CloseGroups, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CloseGroups = append(m.CloseGroups, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseGroups", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Log = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextGroup", wireType)
 			}
-			var stringLen uint64
+			m.NextGroup = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -11917,21 +16248,11 @@ func (m *ResponseSetOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.NextGroup |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Info = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -11954,7 +16275,7 @@ func (m *ResponseSetOption) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResponseInitChain) Unmarshal(dAtA []byte) error {
+func (m *ResponseCommit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11977,17 +16298,17 @@ func (m *ResponseInitChain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseInitChain: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResponseCommit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseInitChain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResponseCommit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -11997,30 +16318,28 @@ func (m *ResponseInitChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsensusParams == nil {
-				m.ConsensusParams = &ConsensusParams{}
-			}
-			if err := m.ConsensusParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetainHeight", wireType)
 			}
-			var msglen int
+			m.RetainHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12030,23 +16349,11 @@ func (m *ResponseInitChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.RetainHeight |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Validators = append(m.Validators, ValidatorUpdate{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -12069,7 +16376,7 @@ func (m *ResponseInitChain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResponseQuery) Unmarshal(dAtA []byte) error {
+func (m *ConsensusParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12092,144 +16399,17 @@ func (m *ResponseQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsensusParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsensusParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
-			}
-			m.Code = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Code |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Log = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Info = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
-			iNdEx = postIndex
-		case 7:
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSize", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12239,26 +16419,28 @@ func (m *ResponseQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			if m.BlockSize == nil {
+				m.BlockSize = &BlockSizeParams{}
+			}
+			if err := m.BlockSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12282,37 +16464,18 @@ func (m *ResponseQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Proof == nil {
-				m.Proof = &merkle.Proof{}
+			if m.Evidence == nil {
+				m.Evidence = &EvidenceParams{}
 			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12322,20 +16485,24 @@ func (m *ResponseQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Codespace = string(dAtA[iNdEx:postIndex])
+			if m.Validator == nil {
+				m.Validator = &ValidatorParams{}
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12359,7 +16526,7 @@ func (m *ResponseQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResponseBeginBlock) Unmarshal(dAtA []byte) error {
+func (m *BlockSizeParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12382,17 +16549,17 @@ func (m *ResponseBeginBlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseBeginBlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockSizeParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseBeginBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockSizeParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
 			}
-			var msglen int
+			m.MaxBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12402,23 +16569,30 @@ func (m *ResponseBeginBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.MaxBytes |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
 			}
-			m.Tags = append(m.Tags, common.KVPair{})
-			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -12441,7 +16615,7 @@ func (m *ResponseBeginBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResponseCheckTx) Unmarshal(dAtA []byte) error {
+func (m *EvidenceParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12464,17 +16638,17 @@ func (m *ResponseCheckTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseCheckTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: EvidenceParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseCheckTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EvidenceParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
 			}
-			m.Code = 0
+			m.MaxAge = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12484,16 +16658,16 @@ func (m *ResponseCheckTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Code |= (uint32(b) & 0x7F) << shift
+				m.MaxAge |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeDuration", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12503,153 +16677,76 @@ func (m *ResponseCheckTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxAgeDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Log = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
-			}
-			m.GasWanted = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasWanted |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
-			}
-			m.GasUsed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasUsed |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tags = append(m.Tags, common.KVPair{})
-			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyTypes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12674,7 +16771,7 @@ func (m *ResponseCheckTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Codespace = string(dAtA[iNdEx:postIndex])
+			m.PubKeyTypes = append(m.PubKeyTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12698,7 +16795,7 @@ func (m *ResponseCheckTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResponseDeliverTx) Unmarshal(dAtA []byte) error {
+func (m *LastCommitInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12721,17 +16818,17 @@ func (m *ResponseDeliverTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseDeliverTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: LastCommitInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseDeliverTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LastCommitInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
 			}
-			m.Code = 0
+			m.Round = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12741,16 +16838,16 @@ func (m *ResponseDeliverTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Code |= (uint32(b) & 0x7F) << shift
+				m.Round |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12760,28 +16857,79 @@ func (m *ResponseDeliverTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Votes = append(m.Votes, VoteInfo{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Header) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12791,24 +16939,25 @@ func (m *ResponseDeliverTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Log = string(dAtA[iNdEx:postIndex])
+			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12833,32 +16982,13 @@ func (m *ResponseDeliverTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = string(dAtA[iNdEx:postIndex])
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
-			}
-			m.GasWanted = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasWanted |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.GasUsed = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12868,14 +16998,14 @@ func (m *ResponseDeliverTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasUsed |= (int64(b) & 0x7F) << shift
+				m.Height |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12899,16 +17029,15 @@ func (m *ResponseDeliverTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tags = append(m.Tags, common.KVPair{})
-			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTxs", wireType)
 			}
-			var stringLen uint64
+			m.NumTxs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12918,75 +17047,33 @@ func (m *ResponseDeliverTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.NumTxs |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Codespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResponseEndBlock) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTxs", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TotalTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalTxs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseEndBlock: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseEndBlock: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13010,16 +17097,15 @@ func (m *ResponseEndBlock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorUpdates = append(m.ValidatorUpdates, ValidatorUpdate{})
-			if err := m.ValidatorUpdates[len(m.ValidatorUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastBlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusParamUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCommitHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13029,30 +17115,28 @@ func (m *ResponseEndBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsensusParamUpdates == nil {
-				m.ConsensusParamUpdates = &ConsensusParams{}
-			}
-			if err := m.ConsensusParamUpdates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.LastCommitHash = append(m.LastCommitHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LastCommitHash == nil {
+				m.LastCommitHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13062,77 +17146,57 @@ func (m *ResponseEndBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tags = append(m.Tags, common.KVPair{})
-			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DataHash = append(m.DataHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataHash == nil {
+				m.DataHash = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypes
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsHash", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResponseCommit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ValidatorsHash = append(m.ValidatorsHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorsHash == nil {
+				m.ValidatorsHash = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseCommit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseCommit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextValidatorsHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -13156,67 +17220,78 @@ func (m *ResponseCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.NextValidatorsHash = append(m.NextValidatorsHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextValidatorsHash == nil {
+				m.NextValidatorsHash = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusHash", wireType)
 			}
-			if skippy < 0 {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConsensusParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			m.ConsensusHash = append(m.ConsensusHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsensusHash == nil {
+				m.ConsensusHash = []byte{}
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppHash == nil {
+				m.AppHash = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConsensusParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsensusParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastResultsHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13226,30 +17301,28 @@ func (m *ConsensusParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockSize == nil {
-				m.BlockSize = &BlockSizeParams{}
-			}
-			if err := m.BlockSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.LastResultsHash = append(m.LastResultsHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LastResultsHash == nil {
+				m.LastResultsHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13259,30 +17332,28 @@ func (m *ConsensusParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Evidence == nil {
-				m.Evidence = &EvidenceParams{}
-			}
-			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.EvidenceHash = append(m.EvidenceHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvidenceHash == nil {
+				m.EvidenceHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13292,23 +17363,21 @@ func (m *ConsensusParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Validator == nil {
-				m.Validator = &ValidatorParams{}
-			}
-			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -13333,7 +17402,7 @@ func (m *ConsensusParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockSizeParams) Unmarshal(dAtA []byte) error {
+func (m *Version) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13356,17 +17425,17 @@ func (m *BlockSizeParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockSizeParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: Version: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockSizeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Version: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
-			m.MaxBytes = 0
+			m.Block = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13376,16 +17445,16 @@ func (m *BlockSizeParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxBytes |= (int64(b) & 0x7F) << shift
+				m.Block |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
 			}
-			m.MaxGas = 0
+			m.App = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13395,7 +17464,7 @@ func (m *BlockSizeParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxGas |= (int64(b) & 0x7F) << shift
+				m.App |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13422,7 +17491,7 @@ func (m *BlockSizeParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EvidenceParams) Unmarshal(dAtA []byte) error {
+func (m *BlockID) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13445,17 +17514,17 @@ func (m *EvidenceParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EvidenceParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockID: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EvidenceParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockID: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			m.MaxAge = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13465,67 +17534,28 @@ func (m *EvidenceParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxAge |= (int64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartsHeader", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13535,20 +17565,21 @@ func (m *ValidatorParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKeyTypes = append(m.PubKeyTypes, string(dAtA[iNdEx:postIndex]))
+			if err := m.PartsHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13572,7 +17603,7 @@ func (m *ValidatorParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastCommitInfo) Unmarshal(dAtA []byte) error {
+func (m *PartSetHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13595,17 +17626,17 @@ func (m *LastCommitInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastCommitInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: PartSetHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastCommitInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PartSetHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			m.Round = 0
+			m.Total = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13615,16 +17646,16 @@ func (m *LastCommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Round |= (int32(b) & 0x7F) << shift
+				m.Total |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13634,21 +17665,21 @@ func (m *LastCommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Votes = append(m.Votes, VoteInfo{})
-			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -13673,7 +17704,7 @@ func (m *LastCommitInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Header) Unmarshal(dAtA []byte) error {
+func (m *Validator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13696,17 +17727,17 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13716,27 +17747,28 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			var stringLen uint64
+			m.Power = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13746,43 +17778,65 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Power |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13806,15 +17860,15 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumTxs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			m.NumTxs = 0
+			m.Power = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13824,33 +17878,65 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumTxs |= (int64(b) & 0x7F) << shift
+				m.Power |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalTxs", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.TotalTxs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalTxs |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 7:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13874,15 +17960,15 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastBlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCommitHash", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedLastBlock", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13892,28 +17978,68 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			m.SignedLastBlock = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastCommitHash = append(m.LastCommitHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.LastCommitHash == nil {
-				m.LastCommitHash = []byte{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-			iNdEx = postIndex
-		case 9:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13923,26 +18049,24 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataHash = append(m.DataHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.DataHash == nil {
-				m.DataHash = []byte{}
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -13966,47 +18090,67 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorsHash = append(m.ValidatorsHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatorsHash == nil {
-				m.ValidatorsHash = []byte{}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextValidatorsHash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextValidatorsHash = append(m.NextValidatorsHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextValidatorsHash == nil {
-				m.NextValidatorsHash = []byte{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Evidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-			iNdEx = postIndex
-		case 12:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Evidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Evidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -14016,28 +18160,26 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsensusHash = append(m.ConsensusHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.ConsensusHash == nil {
-				m.ConsensusHash = []byte{}
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -14047,28 +18189,27 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.AppHash == nil {
-				m.AppHash = []byte{}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastResultsHash", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var byteLen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -14078,28 +18219,16 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.Height |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LastResultsHash = append(m.LastResultsHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.LastResultsHash == nil {
-				m.LastResultsHash = []byte{}
-			}
-			iNdEx = postIndex
-		case 15:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -14109,28 +18238,27 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvidenceHash = append(m.EvidenceHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.EvidenceHash == nil {
-				m.EvidenceHash = []byte{}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
 			}
-			var byteLen int
+			m.TotalVotingPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -14140,23 +18268,62 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.TotalVotingPower |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposerAddress == nil {
-				m.ProposerAddress = []byte{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestListSnapshots) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestListSnapshots: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestListSnapshots: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -14179,7 +18346,7 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Version) Unmarshal(dAtA []byte) error {
+func (m *RequestOfferSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14202,17 +18369,17 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Version: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestOfferSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Version: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestOfferSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
 			}
-			m.Block = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -14222,16 +18389,30 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Block |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Snapshot == nil {
+				m.Snapshot = &Snapshot{}
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
 			}
-			m.App = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -14241,11 +18422,23 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.App |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppHash == nil {
+				m.AppHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -14268,7 +18461,7 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockID) Unmarshal(dAtA []byte) error {
+func (m *RequestLoadSnapshotChunk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14291,17 +18484,17 @@ func (m *BlockID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockID: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestLoadSnapshotChunk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestLoadSnapshotChunk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var byteLen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -14311,28 +18504,16 @@ func (m *BlockID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.Height |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartsHeader", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
-			var msglen int
+			m.Format = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -14342,22 +18523,30 @@ func (m *BlockID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Format |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
 			}
-			if err := m.PartsHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Chunk = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chunk |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -14380,7 +18569,7 @@ func (m *BlockID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PartSetHeader) Unmarshal(dAtA []byte) error {
+func (m *RequestApplySnapshotChunk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14403,17 +18592,17 @@ func (m *PartSetHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PartSetHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestApplySnapshotChunk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PartSetHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestApplySnapshotChunk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.Total = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -14423,16 +18612,47 @@ func (m *PartSetHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Total |= (int32(b) & 0x7F) << shift
+				m.Index |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chunk == nil {
+				m.Chunk = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -14442,22 +18662,20 @@ func (m *PartSetHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14481,7 +18699,7 @@ func (m *PartSetHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validator) Unmarshal(dAtA []byte) error {
+func (m *ResponseListSnapshots) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14504,17 +18722,17 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResponseListSnapshots: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResponseListSnapshots: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -14524,42 +18742,23 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			m.Snapshots = append(m.Snapshots, &Snapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
-			}
-			m.Power = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Power |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -14582,7 +18781,7 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorUpdate) Unmarshal(dAtA []byte) error {
+func (m *ResponseOfferSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14605,47 +18804,17 @@ func (m *ValidatorUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResponseOfferSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResponseOfferSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			m.Power = 0
+			m.Result = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -14655,7 +18824,7 @@ func (m *ValidatorUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= (int64(b) & 0x7F) << shift
+				m.Result |= (ResponseOfferSnapshot_Result(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -14682,7 +18851,7 @@ func (m *ValidatorUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VoteInfo) Unmarshal(dAtA []byte) error {
+func (m *ResponseLoadSnapshotChunk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14705,17 +18874,17 @@ func (m *VoteInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VoteInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResponseLoadSnapshotChunk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VoteInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResponseLoadSnapshotChunk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -14725,42 +18894,23 @@ func (m *VoteInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chunk == nil {
+				m.Chunk = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedLastBlock", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SignedLastBlock = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -14783,7 +18933,7 @@ func (m *VoteInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PubKey) Unmarshal(dAtA []byte) error {
+func (m *ResponseApplySnapshotChunk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14806,17 +18956,17 @@ func (m *PubKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PubKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResponseApplySnapshotChunk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResponseApplySnapshotChunk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var stringLen uint64
+			m.Result = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -14826,26 +18976,78 @@ func (m *PubKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Result |= (ResponseApplySnapshotChunk_Result(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RefetchChunks = append(m.RefetchChunks, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RefetchChunks = append(m.RefetchChunks, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefetchChunks", wireType)
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectSenders", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -14855,22 +19057,20 @@ func (m *PubKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.RejectSenders = append(m.RejectSenders, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14894,7 +19094,7 @@ func (m *PubKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Evidence) Unmarshal(dAtA []byte) error {
+func (m *Snapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14917,17 +19117,17 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Evidence: wiretype end group for non-group")
+			return fmt.Errorf("proto: Snapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Evidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Snapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -14937,26 +19137,16 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Height |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
-			var msglen int
+			m.Format = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -14966,27 +19156,16 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Format |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
 			}
-			m.Height = 0
+			m.Chunks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -14996,16 +19175,16 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= (int64(b) & 0x7F) << shift
+				m.Chunks |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -15015,27 +19194,28 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			m.TotalVotingPower = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -15045,11 +19225,23 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalVotingPower |= (int64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Metadata == nil {
+				m.Metadata = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -15183,144 +19375,183 @@ func init() {
 }
 
 var fileDescriptor_types_5b877df1938afe10 = []byte{
-	// 2214 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcb, 0x73, 0x1b, 0xc7,
-	0xd1, 0xe7, 0x82, 0x20, 0x81, 0x6d, 0x10, 0x0f, 0x8d, 0x28, 0x09, 0xc2, 0xe7, 0x8f, 0x54, 0xad,
-	0x12, 0x5b, 0x8c, 0x65, 0xd0, 0xa6, 0xa3, 0x14, 0x65, 0x39, 0xa9, 0x22, 0x24, 0xc5, 0x64, 0xd9,
-	0x49, 0x98, 0x95, 0xc4, 0x5c, 0x52, 0xb5, 0x35, 0xc0, 0x8e, 0x80, 0x2d, 0x02, 0xbb, 0xeb, 0xdd,
-	0x01, 0x0d, 0xea, 0x98, 0xb3, 0x0f, 0x3e, 0xe4, 0x8f, 0xc8, 0x35, 0x37, 0x1f, 0x73, 0x4a, 0xf9,
-	0x98, 0x43, 0xce, 0x4a, 0xc2, 0x54, 0x0e, 0xc9, 0x35, 0x95, 0xaa, 0x1c, 0x53, 0xd3, 0x33, 0xb3,
-	0x2f, 0x2e, 0x14, 0xcb, 0xc9, 0x29, 0x17, 0x60, 0xa6, 0x1f, 0xf3, 0xe8, 0xed, 0xee, 0x5f, 0xf7,
-	0xc0, 0x75, 0x3a, 0x1c, 0x79, 0xbb, 0xfc, 0x3c, 0x64, 0xb1, 0xfc, 0xed, 0x87, 0x51, 0xc0, 0x03,
-	0xb2, 0x86, 0x93, 0xde, 0x3b, 0x63, 0x8f, 0x4f, 0xe6, 0xc3, 0xfe, 0x28, 0x98, 0xed, 0x8e, 0x83,
-	0x71, 0xb0, 0x8b, 0xdc, 0xe1, 0xfc, 0x39, 0xce, 0x70, 0x82, 0x23, 0xa9, 0xd5, 0xdb, 0x1e, 0x07,
-	0xc1, 0x78, 0xca, 0x52, 0x29, 0xee, 0xcd, 0x58, 0xcc, 0xe9, 0x2c, 0x54, 0x02, 0xfb, 0x99, 0xf5,
-	0x38, 0xf3, 0x5d, 0x16, 0xcd, 0x3c, 0x9f, 0x67, 0x87, 0x53, 0x6f, 0x18, 0xef, 0x8e, 0x82, 0xd9,
-	0x2c, 0xf0, 0xb3, 0x07, 0xea, 0x3d, 0xf8, 0xb7, 0x9a, 0xa3, 0xe8, 0x3c, 0xe4, 0xc1, 0xee, 0x8c,
-	0x45, 0xa7, 0x53, 0xa6, 0xfe, 0xa4, 0xb2, 0xf5, 0xdb, 0x2a, 0xd4, 0x6c, 0xf6, 0xe9, 0x9c, 0xc5,
-	0x9c, 0xdc, 0x81, 0x2a, 0x1b, 0x4d, 0x82, 0x6e, 0xe5, 0x96, 0x71, 0xa7, 0xb1, 0x47, 0xfa, 0x72,
-	0x13, 0xc5, 0x7d, 0x3c, 0x9a, 0x04, 0x87, 0x2b, 0x36, 0x4a, 0x90, 0xb7, 0x61, 0xed, 0xf9, 0x74,
-	0x1e, 0x4f, 0xba, 0xab, 0x28, 0x7a, 0x35, 0x2f, 0xfa, 0x43, 0xc1, 0x3a, 0x5c, 0xb1, 0xa5, 0x8c,
-	0x58, 0xd6, 0xf3, 0x9f, 0x07, 0xdd, 0x6a, 0xd9, 0xb2, 0x47, 0xfe, 0x73, 0x5c, 0x56, 0x48, 0x90,
-	0x7d, 0x80, 0x98, 0x71, 0x27, 0x08, 0xb9, 0x17, 0xf8, 0xdd, 0x35, 0x94, 0xbf, 0x91, 0x97, 0x7f,
-	0xc2, 0xf8, 0x4f, 0x90, 0x7d, 0xb8, 0x62, 0x9b, 0xb1, 0x9e, 0x08, 0x4d, 0xcf, 0xf7, 0xb8, 0x33,
-	0x9a, 0x50, 0xcf, 0xef, 0xae, 0x97, 0x69, 0x1e, 0xf9, 0x1e, 0x7f, 0x28, 0xd8, 0x42, 0xd3, 0xd3,
-	0x13, 0x71, 0x95, 0x4f, 0xe7, 0x2c, 0x3a, 0xef, 0xd6, 0xca, 0xae, 0xf2, 0x53, 0xc1, 0x12, 0x57,
-	0x41, 0x19, 0xf2, 0x00, 0x1a, 0x43, 0x36, 0xf6, 0x7c, 0x67, 0x38, 0x0d, 0x46, 0xa7, 0xdd, 0x3a,
-	0xaa, 0x74, 0xf3, 0x2a, 0x03, 0x21, 0x30, 0x10, 0xfc, 0xc3, 0x15, 0x1b, 0x86, 0xc9, 0x8c, 0xec,
-	0x41, 0x7d, 0x34, 0x61, 0xa3, 0x53, 0x87, 0x2f, 0xba, 0x26, 0x6a, 0x5e, 0xcb, 0x6b, 0x3e, 0x14,
-	0xdc, 0xa7, 0x8b, 0xc3, 0x15, 0xbb, 0x36, 0x92, 0x43, 0x72, 0x0f, 0x4c, 0xe6, 0xbb, 0x6a, 0xbb,
-	0x06, 0x2a, 0x5d, 0x2f, 0x7c, 0x17, 0xdf, 0xd5, 0x9b, 0xd5, 0x99, 0x1a, 0x93, 0x3e, 0xac, 0x0b,
-	0x47, 0xf1, 0x78, 0x77, 0x03, 0x75, 0x36, 0x0b, 0x1b, 0x21, 0xef, 0x70, 0xc5, 0x56, 0x52, 0xc2,
-	0x7c, 0x2e, 0x9b, 0x7a, 0x67, 0x2c, 0x12, 0x87, 0xbb, 0x5a, 0x66, 0xbe, 0x47, 0x92, 0x8f, 0xc7,
-	0x33, 0x5d, 0x3d, 0x19, 0xd4, 0x60, 0xed, 0x8c, 0x4e, 0xe7, 0xcc, 0x7a, 0x0b, 0x1a, 0x19, 0x4f,
-	0x21, 0x5d, 0xa8, 0xcd, 0x58, 0x1c, 0xd3, 0x31, 0xeb, 0x1a, 0xb7, 0x8c, 0x3b, 0xa6, 0xad, 0xa7,
-	0x56, 0x0b, 0x36, 0xb2, 0x7e, 0x62, 0xcd, 0x12, 0x45, 0xe1, 0x0b, 0x42, 0xf1, 0x8c, 0x45, 0xb1,
-	0x70, 0x00, 0xa5, 0xa8, 0xa6, 0xe4, 0x36, 0x34, 0xd1, 0x0e, 0x8e, 0xe6, 0x0b, 0x3f, 0xad, 0xda,
-	0x1b, 0x48, 0x3c, 0x51, 0x42, 0xdb, 0xd0, 0x08, 0xf7, 0xc2, 0x44, 0x64, 0x15, 0x45, 0x20, 0xdc,
-	0x0b, 0x95, 0x80, 0xf5, 0x01, 0x74, 0x8a, 0xae, 0x44, 0x3a, 0xb0, 0x7a, 0xca, 0xce, 0xd5, 0x7e,
-	0x62, 0x48, 0x36, 0xd5, 0xb5, 0x70, 0x0f, 0xd3, 0x56, 0x77, 0xfc, 0xa2, 0x92, 0x28, 0x27, 0xde,
-	0x44, 0xf6, 0xa1, 0x2a, 0x62, 0x19, 0xb5, 0x1b, 0x7b, 0xbd, 0xbe, 0x0c, 0xf4, 0xbe, 0x0e, 0xf4,
-	0xfe, 0x53, 0x1d, 0xe8, 0x83, 0xfa, 0x57, 0x2f, 0xb7, 0x57, 0xbe, 0xf8, 0xc3, 0xb6, 0x61, 0xa3,
-	0x06, 0xb9, 0x29, 0x1c, 0x82, 0x7a, 0xbe, 0xe3, 0xb9, 0x6a, 0x9f, 0x1a, 0xce, 0x8f, 0x5c, 0x72,
-	0x00, 0x9d, 0x51, 0xe0, 0xc7, 0xcc, 0x8f, 0xe7, 0xb1, 0x13, 0xd2, 0x88, 0xce, 0x62, 0x15, 0x6b,
-	0xfa, 0xf3, 0x3f, 0xd4, 0xec, 0x63, 0xe4, 0xda, 0xed, 0x51, 0x9e, 0x40, 0x3e, 0x04, 0x38, 0xa3,
-	0x53, 0xcf, 0xa5, 0x3c, 0x88, 0xe2, 0x6e, 0xf5, 0xd6, 0x6a, 0x46, 0xf9, 0x44, 0x33, 0x9e, 0x85,
-	0x2e, 0xe5, 0x6c, 0x50, 0x15, 0x27, 0xb3, 0x33, 0xf2, 0xe4, 0x4d, 0x68, 0xd3, 0x30, 0x74, 0x62,
-	0x4e, 0x39, 0x73, 0x86, 0xe7, 0x9c, 0xc5, 0x18, 0x8f, 0x1b, 0x76, 0x93, 0x86, 0xe1, 0x13, 0x41,
-	0x1d, 0x08, 0xa2, 0xe5, 0x26, 0x5f, 0x13, 0x43, 0x85, 0x10, 0xa8, 0xba, 0x94, 0x53, 0xb4, 0xc6,
-	0x86, 0x8d, 0x63, 0x41, 0x0b, 0x29, 0x9f, 0xa8, 0x3b, 0xe2, 0x98, 0x5c, 0x87, 0xf5, 0x09, 0xf3,
-	0xc6, 0x13, 0x8e, 0xd7, 0x5a, 0xb5, 0xd5, 0x4c, 0x18, 0x3e, 0x8c, 0x82, 0x33, 0x86, 0xd9, 0xa2,
-	0x6e, 0xcb, 0x89, 0xf5, 0x17, 0x03, 0xae, 0x5c, 0x0a, 0x2f, 0xb1, 0xee, 0x84, 0xc6, 0x13, 0xbd,
-	0x97, 0x18, 0x93, 0xb7, 0xc5, 0xba, 0xd4, 0x65, 0x91, 0xca, 0x62, 0x4d, 0x75, 0xe3, 0x43, 0x24,
-	0xaa, 0x8b, 0x2a, 0x11, 0xf2, 0x18, 0x3a, 0x53, 0x1a, 0x73, 0x47, 0x46, 0x81, 0x83, 0x59, 0x6a,
-	0x35, 0x17, 0x99, 0x9f, 0x50, 0x1d, 0x2d, 0xc2, 0x39, 0x95, 0x7a, 0x6b, 0x9a, 0xa3, 0x92, 0x43,
-	0xd8, 0x1c, 0x9e, 0xbf, 0xa0, 0x3e, 0xf7, 0x7c, 0xe6, 0x5c, 0xb2, 0x79, 0x5b, 0x2d, 0xf5, 0xf8,
-	0xcc, 0x73, 0x99, 0x3f, 0xd2, 0xc6, 0xbe, 0x9a, 0xa8, 0x24, 0x1f, 0x23, 0xb6, 0x6e, 0x41, 0x2b,
-	0x9f, 0x0b, 0x48, 0x0b, 0x2a, 0x7c, 0xa1, 0x6e, 0x58, 0xe1, 0x0b, 0xcb, 0x4a, 0x3c, 0x30, 0x09,
-	0xc8, 0x4b, 0x32, 0x3b, 0xd0, 0x2e, 0x24, 0x87, 0x8c, 0xb9, 0x8d, 0xac, 0xb9, 0xad, 0x36, 0x34,
-	0x73, 0x39, 0xc1, 0xfa, 0x7c, 0x0d, 0xea, 0x36, 0x8b, 0x43, 0xe1, 0x4c, 0x64, 0x1f, 0x4c, 0xb6,
-	0x18, 0x31, 0x99, 0x8e, 0x8d, 0x42, 0xb2, 0x93, 0x32, 0x8f, 0x35, 0x5f, 0xa4, 0x85, 0x44, 0x98,
-	0xec, 0xe4, 0xa0, 0xe4, 0x6a, 0x51, 0x29, 0x8b, 0x25, 0x77, 0xf3, 0x58, 0xb2, 0x59, 0x90, 0x2d,
-	0x80, 0xc9, 0x4e, 0x0e, 0x4c, 0x8a, 0x0b, 0xe7, 0xd0, 0xe4, 0x7e, 0x09, 0x9a, 0x14, 0x8f, 0xbf,
-	0x04, 0x4e, 0xee, 0x97, 0xc0, 0x49, 0xf7, 0xd2, 0x5e, 0xa5, 0x78, 0x72, 0x37, 0x8f, 0x27, 0xc5,
-	0xeb, 0x14, 0x00, 0xe5, 0xc3, 0x32, 0x40, 0xb9, 0x59, 0xd0, 0x59, 0x8a, 0x28, 0xef, 0x5f, 0x42,
-	0x94, 0xeb, 0x05, 0xd5, 0x12, 0x48, 0xb9, 0x9f, 0xcb, 0xf5, 0x50, 0x7a, 0xb7, 0xf2, 0x64, 0x4f,
-	0xbe, 0x77, 0x19, 0x8d, 0x6e, 0x14, 0x3f, 0x6d, 0x19, 0x1c, 0xed, 0x16, 0xe0, 0xe8, 0x5a, 0xf1,
-	0x94, 0x05, 0x3c, 0x4a, 0x51, 0x65, 0x47, 0xc4, 0x7d, 0xc1, 0xd3, 0x44, 0x8e, 0x60, 0x51, 0x14,
-	0x44, 0x2a, 0x61, 0xcb, 0x89, 0x75, 0x47, 0x64, 0xa2, 0xd4, 0xbf, 0x5e, 0x81, 0x40, 0xe8, 0xf4,
-	0x19, 0xef, 0xb2, 0xbe, 0x34, 0x52, 0x5d, 0x8c, 0xe8, 0x6c, 0x16, 0x33, 0x55, 0x16, 0xcb, 0x00,
-	0x53, 0x25, 0x0f, 0x4c, 0xdb, 0xd0, 0x10, 0xb9, 0xb2, 0x80, 0x39, 0x34, 0xd4, 0x98, 0x43, 0xbe,
-	0x03, 0x57, 0x30, 0xcf, 0x48, 0xf8, 0x52, 0x81, 0x58, 0xc5, 0x40, 0x6c, 0x0b, 0x86, 0xb4, 0x98,
-	0x4c, 0x80, 0xef, 0xc0, 0xd5, 0x8c, 0xac, 0x58, 0x17, 0x73, 0x9c, 0x4c, 0xbe, 0x9d, 0x44, 0xfa,
-	0x20, 0x0c, 0x0f, 0x69, 0x3c, 0xb1, 0x7e, 0x94, 0x1a, 0x28, 0xc5, 0x33, 0x02, 0xd5, 0x51, 0xe0,
-	0xca, 0x7b, 0x37, 0x6d, 0x1c, 0x0b, 0x8c, 0x9b, 0x06, 0x63, 0x3c, 0x9c, 0x69, 0x8b, 0xa1, 0x90,
-	0x4a, 0x42, 0xc9, 0x94, 0x31, 0x63, 0xfd, 0xd2, 0x48, 0xd7, 0x4b, 0x21, 0xae, 0x0c, 0x8d, 0x8c,
-	0xff, 0x04, 0x8d, 0x2a, 0xaf, 0x87, 0x46, 0xd6, 0x85, 0x91, 0x7e, 0xb2, 0x04, 0x67, 0xbe, 0xd9,
-	0x15, 0x85, 0xf7, 0x78, 0xbe, 0xcb, 0x16, 0x68, 0xd2, 0x55, 0x5b, 0x4e, 0x74, 0x09, 0xb0, 0x8e,
-	0x66, 0xce, 0x97, 0x00, 0x35, 0xa4, 0xc9, 0x09, 0xb9, 0x8d, 0xf8, 0x14, 0x3c, 0x57, 0xa1, 0xda,
-	0xec, 0xab, 0x6a, 0xfa, 0x58, 0x10, 0x6d, 0xc9, 0xcb, 0x64, 0x5b, 0x33, 0x07, 0x6e, 0x6f, 0x80,
-	0x29, 0x0e, 0x1a, 0x87, 0x74, 0xc4, 0x30, 0xf2, 0x4c, 0x3b, 0x25, 0x58, 0xc7, 0x40, 0x2e, 0x47,
-	0x3c, 0xf9, 0x00, 0xaa, 0x9c, 0x8e, 0x85, 0xbd, 0x85, 0xc9, 0x5a, 0x7d, 0xd9, 0x00, 0xf4, 0x3f,
-	0x3e, 0x39, 0xa6, 0x5e, 0x34, 0xb8, 0x2e, 0x4c, 0xf5, 0xb7, 0x97, 0xdb, 0x2d, 0x21, 0x73, 0x37,
-	0x98, 0x79, 0x9c, 0xcd, 0x42, 0x7e, 0x6e, 0xa3, 0x8e, 0xf5, 0x77, 0x43, 0x20, 0x41, 0x2e, 0x13,
-	0x94, 0x1a, 0x4e, 0xbb, 0x7b, 0x25, 0x03, 0xda, 0x5f, 0xcf, 0x98, 0xff, 0x0f, 0x30, 0xa6, 0xb1,
-	0xf3, 0x19, 0xf5, 0x39, 0x73, 0x95, 0x45, 0xcd, 0x31, 0x8d, 0x7f, 0x86, 0x04, 0x51, 0xe1, 0x08,
-	0xf6, 0x3c, 0x66, 0x2e, 0x9a, 0x76, 0xd5, 0xae, 0x8d, 0x69, 0xfc, 0x2c, 0x66, 0x6e, 0x72, 0xaf,
-	0xda, 0xeb, 0xdf, 0x2b, 0x6f, 0xc7, 0x7a, 0xd1, 0x8e, 0xff, 0xc8, 0xf8, 0x70, 0x0a, 0x92, 0xff,
-	0xfb, 0xf7, 0xfe, 0xab, 0x21, 0x6a, 0x83, 0x7c, 0x1a, 0x26, 0x47, 0x70, 0x25, 0x89, 0x23, 0x67,
-	0x8e, 0xf1, 0xa5, 0x7d, 0xe9, 0xd5, 0xe1, 0xd7, 0x39, 0xcb, 0x93, 0x63, 0xf2, 0x63, 0xb8, 0x51,
-	0xc8, 0x02, 0xc9, 0x82, 0x95, 0x57, 0x26, 0x83, 0x6b, 0xf9, 0x64, 0xa0, 0xd7, 0xd3, 0x96, 0x58,
-	0xfd, 0x06, 0x9e, 0xfd, 0x2d, 0x51, 0x28, 0x65, 0xc1, 0xa3, 0xec, 0x5b, 0x5a, 0xbf, 0x36, 0xa0,
-	0x5d, 0x38, 0x0c, 0xb9, 0x07, 0x20, 0x53, 0x6b, 0xec, 0xbd, 0x60, 0x85, 0x2c, 0x86, 0x26, 0x7b,
-	0xe2, 0xbd, 0x60, 0xea, 0xe0, 0xe6, 0x50, 0x13, 0xc8, 0x7b, 0x50, 0x67, 0xaa, 0x80, 0x53, 0xb7,
-	0xbd, 0x56, 0xa8, 0xeb, 0x94, 0x4e, 0x22, 0x46, 0xbe, 0x0b, 0x66, 0x62, 0xc3, 0x42, 0xf1, 0x9e,
-	0x98, 0x5c, 0x6f, 0x94, 0x08, 0x5a, 0x1f, 0x41, 0xbb, 0x70, 0x0c, 0xf2, 0x7f, 0x60, 0xce, 0xe8,
-	0x42, 0x55, 0xe1, 0xb2, 0x7e, 0xab, 0xcf, 0xe8, 0x02, 0x0b, 0x70, 0x72, 0x03, 0x6a, 0x82, 0x39,
-	0xa6, 0xf2, 0x2b, 0xac, 0xda, 0xeb, 0x33, 0xba, 0xf8, 0x88, 0xc6, 0xd6, 0x0e, 0xb4, 0xf2, 0x47,
-	0xd3, 0xa2, 0x1a, 0x11, 0xa5, 0xe8, 0xc1, 0x98, 0x59, 0xf7, 0xa0, 0x5d, 0x38, 0x11, 0xb1, 0xa0,
-	0x19, 0xce, 0x87, 0xce, 0x29, 0x3b, 0x77, 0xf0, 0xc8, 0xe8, 0x33, 0xa6, 0xdd, 0x08, 0xe7, 0xc3,
-	0x8f, 0xd9, 0xf9, 0x53, 0x41, 0xb2, 0x9e, 0x40, 0x2b, 0x5f, 0x1f, 0x8b, 0x9c, 0x19, 0x05, 0x73,
-	0xdf, 0xc5, 0xf5, 0xd7, 0x6c, 0x39, 0x11, 0x2d, 0xf6, 0x59, 0x20, 0xdd, 0x24, 0x5b, 0x10, 0x9f,
-	0x04, 0x9c, 0x65, 0xaa, 0x6a, 0x29, 0x63, 0xfd, 0x62, 0x0d, 0xd6, 0x65, 0xb1, 0x4e, 0xfa, 0xf9,
-	0x56, 0x50, 0xf8, 0x88, 0xd2, 0x94, 0x54, 0xa5, 0x98, 0xe0, 0xf0, 0x9b, 0xc5, 0x7e, 0x6a, 0xd0,
-	0xb8, 0x78, 0xb9, 0x5d, 0x43, 0x0c, 0x3b, 0x7a, 0x94, 0x36, 0x57, 0xcb, 0x7a, 0x0f, 0xdd, 0xc9,
-	0x55, 0x5f, 0xbb, 0x93, 0xbb, 0x01, 0x35, 0x7f, 0x3e, 0x73, 0xf8, 0x22, 0x56, 0xb9, 0x60, 0xdd,
-	0x9f, 0xcf, 0x9e, 0x2e, 0xf0, 0xd3, 0xf1, 0x80, 0xd3, 0x29, 0xb2, 0x64, 0x26, 0xa8, 0x23, 0x41,
-	0x30, 0xf7, 0xa1, 0x99, 0x81, 0x7a, 0xcf, 0x55, 0x25, 0x63, 0x2b, 0xeb, 0x8d, 0x47, 0x8f, 0xd4,
-	0x2d, 0x1b, 0x09, 0xf4, 0x1f, 0xb9, 0xe4, 0x4e, 0xbe, 0x71, 0xc1, 0x0a, 0xa1, 0x8e, 0x8e, 0x9f,
-	0xe9, 0x4d, 0x44, 0x7d, 0x20, 0x0e, 0x20, 0x42, 0x41, 0x8a, 0x98, 0x28, 0x52, 0x17, 0x04, 0x64,
-	0xbe, 0x05, 0xed, 0x14, 0x64, 0xa5, 0x08, 0xc8, 0x55, 0x52, 0x32, 0x0a, 0xbe, 0x0b, 0x9b, 0x3e,
-	0x5b, 0x70, 0xa7, 0x28, 0xdd, 0x40, 0x69, 0x22, 0x78, 0x27, 0x79, 0x8d, 0x6f, 0x43, 0x2b, 0x4d,
-	0x16, 0x28, 0xbb, 0x21, 0xdb, 0xc7, 0x84, 0x8a, 0x62, 0x37, 0xa1, 0x9e, 0x94, 0x38, 0x4d, 0x14,
-	0xa8, 0x51, 0x59, 0xd9, 0x24, 0x45, 0x53, 0xc4, 0xe2, 0xf9, 0x94, 0xab, 0x45, 0x5a, 0x28, 0x83,
-	0x45, 0x93, 0x2d, 0xe9, 0x28, 0x7b, 0x1b, 0x9a, 0x3a, 0xec, 0xa4, 0x5c, 0x1b, 0xe5, 0x36, 0x34,
-	0x11, 0x85, 0x76, 0xa0, 0x13, 0x46, 0x41, 0x18, 0xc4, 0x2c, 0x72, 0xa8, 0xeb, 0x46, 0x2c, 0x8e,
-	0xbb, 0x1d, 0xb9, 0x9e, 0xa6, 0x1f, 0x48, 0xb2, 0xf5, 0x1e, 0xd4, 0x74, 0xed, 0xb6, 0x09, 0x6b,
-	0x68, 0x75, 0x74, 0xc1, 0xaa, 0x2d, 0x27, 0x02, 0x25, 0x0e, 0xc2, 0x50, 0xbd, 0x40, 0x88, 0xa1,
-	0xf5, 0x73, 0xa8, 0xa9, 0x0f, 0x56, 0xda, 0x97, 0x7e, 0x1f, 0x36, 0x42, 0x1a, 0x89, 0x6b, 0x64,
-	0xbb, 0x53, 0xdd, 0x1d, 0x1c, 0xd3, 0x88, 0x3f, 0x61, 0x3c, 0xd7, 0xa4, 0x36, 0x50, 0x5e, 0x92,
-	0xac, 0xfb, 0xd0, 0xcc, 0xc9, 0x88, 0x63, 0xa1, 0x1f, 0xe9, 0x48, 0xc3, 0x49, 0xb2, 0x73, 0x25,
-	0xdd, 0xd9, 0x7a, 0x00, 0x66, 0xf2, 0x6d, 0x44, 0x11, 0xab, 0xaf, 0x6e, 0x28, 0x73, 0xcb, 0x29,
-	0x36, 0xde, 0xc1, 0x67, 0x2c, 0x52, 0x31, 0x21, 0x27, 0xd6, 0xb3, 0x4c, 0x66, 0x90, 0x79, 0x9b,
-	0xdc, 0x85, 0x9a, 0xca, 0x0c, 0x2a, 0x2a, 0x75, 0x8b, 0x7d, 0x8c, 0xa9, 0x41, 0xb7, 0xd8, 0x32,
-	0x51, 0xa4, 0xcb, 0x56, 0xb2, 0xcb, 0x4e, 0xa1, 0xae, 0xa3, 0x3f, 0x9f, 0x26, 0xe5, 0x8a, 0x9d,
-	0x62, 0x9a, 0x54, 0x8b, 0xa6, 0x82, 0xc2, 0x3b, 0x62, 0x6f, 0xec, 0x33, 0xd7, 0x49, 0x43, 0x08,
-	0xf7, 0xa8, 0xdb, 0x6d, 0xc9, 0xf8, 0x44, 0xc7, 0x8b, 0xf5, 0x2e, 0xac, 0xcb, 0xb3, 0x09, 0xfb,
-	0x88, 0x95, 0x75, 0x5d, 0x2f, 0xc6, 0xa5, 0xc0, 0xf1, 0x7b, 0x03, 0xea, 0x3a, 0x79, 0x96, 0x2a,
-	0xe5, 0x0e, 0x5d, 0xf9, 0xba, 0x87, 0xfe, 0xef, 0x27, 0x9e, 0xbb, 0x40, 0x64, 0x7e, 0x39, 0x0b,
-	0xb8, 0xe7, 0x8f, 0x1d, 0x69, 0x6b, 0x99, 0x83, 0x3a, 0xc8, 0x39, 0x41, 0xc6, 0xb1, 0xa0, 0xef,
-	0x7d, 0xbe, 0x06, 0xed, 0x83, 0xc1, 0xc3, 0xa3, 0x83, 0x30, 0x9c, 0x7a, 0x23, 0x8a, 0xbd, 0xc2,
-	0x2e, 0x54, 0xb1, 0x5d, 0x2a, 0x79, 0xee, 0xed, 0x95, 0xf5, 0xed, 0x64, 0x0f, 0xd6, 0xb0, 0x6b,
-	0x22, 0x65, 0xaf, 0xbe, 0xbd, 0xd2, 0xf6, 0x5d, 0x6c, 0x22, 0xfb, 0xaa, 0xcb, 0x8f, 0xbf, 0xbd,
-	0xb2, 0x1e, 0x9e, 0xfc, 0x00, 0xcc, 0xb4, 0x9d, 0x59, 0xf6, 0x04, 0xdc, 0x5b, 0xda, 0xcd, 0x0b,
-	0xfd, 0xb4, 0xf4, 0x5b, 0xf6, 0x92, 0xd9, 0x5b, 0xda, 0xf6, 0x92, 0x7d, 0xa8, 0xe9, 0x82, 0xb9,
-	0xfc, 0x91, 0xb6, 0xb7, 0xa4, 0xd3, 0x16, 0xe6, 0x91, 0x1d, 0x4a, 0xd9, 0x4b, 0x72, 0xaf, 0xf4,
-	0x39, 0x80, 0xdc, 0x83, 0x75, 0x55, 0xc5, 0x94, 0x3e, 0xd4, 0xf6, 0xca, 0xfb, 0x65, 0x71, 0xc9,
-	0xb4, 0x47, 0x5b, 0xf6, 0xda, 0xdd, 0x5b, 0xfa, 0x6e, 0x41, 0x0e, 0x00, 0x32, 0x8d, 0xc6, 0xd2,
-	0x67, 0xec, 0xde, 0xf2, 0xf7, 0x08, 0xf2, 0x00, 0xea, 0xe9, 0x1b, 0x53, 0xf9, 0xc3, 0x74, 0x6f,
-	0xd9, 0x13, 0xc1, 0xe0, 0x8d, 0x7f, 0xfe, 0x69, 0xcb, 0xf8, 0xd5, 0xc5, 0x96, 0xf1, 0xe5, 0xc5,
-	0x96, 0xf1, 0xd5, 0xc5, 0x96, 0xf1, 0xbb, 0x8b, 0x2d, 0xe3, 0x8f, 0x17, 0x5b, 0xc6, 0x6f, 0xfe,
-	0xbc, 0x65, 0x0c, 0xd7, 0xd1, 0xfd, 0xdf, 0xff, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfc, 0x8d,
-	0xcb, 0x04, 0x88, 0x19, 0x00, 0x00,
+	// 2834 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcf, 0x6f, 0xe4, 0xb6,
+	0xf5, 0xb7, 0xe6, 0xb7, 0xde, 0xfc, 0x34, 0xed, 0xf5, 0xce, 0x4e, 0xf2, 0xb5, 0x1d, 0x2d, 0xbe,
+	0x89, 0xb7, 0xd9, 0xd8, 0x89, 0xd3, 0x2d, 0x76, 0xb3, 0x69, 0x51, 0xdb, 0xeb, 0xc4, 0xce, 0x8f,
+	0x5d, 0x47, 0xf6, 0x3a, 0x08, 0x50, 0x44, 0xd1, 0x8c, 0xe8, 0x19, 0xd5, 0x33, 0x92, 0x22, 0x69,
+	0x1c, 0x3b, 0xc7, 0xfc, 0x01, 0x45, 0x0e, 0xfd, 0x23, 0x7a, 0xe9, 0xa1, 0x40, 0x0f, 0x3d, 0xf6,
+	0x52, 0x20, 0xc7, 0x1e, 0x0a, 0x14, 0xbd, 0xa4, 0xad, 0x8b, 0x5e, 0x7a, 0x2d, 0x0a, 0x14, 0xe8,
+	0xa5, 0xe0, 0x23, 0xa9, 0x91, 0x34, 0x1a, 0x3b, 0x9b, 0xf6, 0xd4, 0xcb, 0x8c, 0xf8, 0xf8, 0xde,
+	0x23, 0xf9, 0x48, 0x7e, 0x3e, 0xe4, 0x23, 0x2c, 0x99, 0xdd, 0x9e, 0xbd, 0x11, 0x5e, 0x78, 0x34,
+	0xe0, 0xbf, 0xeb, 0x9e, 0xef, 0x86, 0x2e, 0x29, 0x62, 0xa1, 0xf3, 0x4a, 0xdf, 0x0e, 0x07, 0xe3,
+	0xee, 0x7a, 0xcf, 0x1d, 0x6d, 0xf4, 0xdd, 0xbe, 0xbb, 0x81, 0xb5, 0xdd, 0xf1, 0x09, 0x96, 0xb0,
+	0x80, 0x5f, 0xdc, 0xaa, 0xb3, 0xd2, 0x77, 0xdd, 0xfe, 0x90, 0x4e, 0xb4, 0x42, 0x7b, 0x44, 0x83,
+	0xd0, 0x1c, 0x79, 0x42, 0xe1, 0x7e, 0xcc, 0x5f, 0x48, 0x1d, 0x8b, 0xfa, 0x23, 0xdb, 0x09, 0xe3,
+	0x9f, 0x43, 0xbb, 0x1b, 0x6c, 0xf4, 0xdc, 0xd1, 0xc8, 0x75, 0xe2, 0x1d, 0xea, 0x3c, 0xbc, 0xd6,
+	0xb2, 0xe7, 0x5f, 0x78, 0xa1, 0xbb, 0x31, 0xa2, 0xfe, 0xe9, 0x90, 0x8a, 0x3f, 0x6e, 0xac, 0xfd,
+	0xab, 0x04, 0x65, 0x9d, 0x7e, 0x3a, 0xa6, 0x41, 0x48, 0xd6, 0xa0, 0x40, 0x7b, 0x03, 0xb7, 0x9d,
+	0x5b, 0x55, 0xd6, 0xaa, 0x9b, 0x64, 0x9d, 0x37, 0x22, 0x6a, 0x77, 0x7b, 0x03, 0x77, 0x6f, 0x4e,
+	0x47, 0x0d, 0xf2, 0x32, 0x14, 0x4f, 0x86, 0xe3, 0x60, 0xd0, 0xce, 0xa3, 0xea, 0x42, 0x52, 0xf5,
+	0x2d, 0x56, 0xb5, 0x37, 0xa7, 0x73, 0x1d, 0xe6, 0xd6, 0x76, 0x4e, 0xdc, 0x76, 0x21, 0xcb, 0xed,
+	0xbe, 0x73, 0x82, 0x6e, 0x99, 0x06, 0xb9, 0x0f, 0x10, 0xd0, 0xd0, 0x70, 0xbd, 0xd0, 0x76, 0x9d,
+	0x76, 0x11, 0xf5, 0x6f, 0x26, 0xf5, 0x0f, 0x69, 0xf8, 0x04, 0xab, 0xf7, 0xe6, 0x74, 0x35, 0x90,
+	0x05, 0x66, 0x69, 0x3b, 0x76, 0x68, 0xf4, 0x06, 0xa6, 0xed, 0xb4, 0x4b, 0x59, 0x96, 0xfb, 0x8e,
+	0x1d, 0xee, 0xb0, 0x6a, 0x66, 0x69, 0xcb, 0x02, 0x1b, 0xca, 0xa7, 0x63, 0xea, 0x5f, 0xb4, 0xcb,
+	0x59, 0x43, 0xf9, 0x80, 0x55, 0xb1, 0xa1, 0xa0, 0x0e, 0x79, 0x08, 0xd5, 0x2e, 0xed, 0xdb, 0x8e,
+	0xd1, 0x1d, 0xba, 0xbd, 0xd3, 0x76, 0x05, 0x4d, 0xda, 0x49, 0x93, 0x6d, 0xa6, 0xb0, 0xcd, 0xea,
+	0xf7, 0xe6, 0x74, 0xe8, 0x46, 0x25, 0xb2, 0x09, 0x95, 0xde, 0x80, 0xf6, 0x4e, 0x8d, 0xf0, 0xbc,
+	0xad, 0xa2, 0xe5, 0x8d, 0xa4, 0xe5, 0x0e, 0xab, 0x3d, 0x3a, 0xdf, 0x9b, 0xd3, 0xcb, 0x3d, 0xfe,
+	0xc9, 0xc6, 0x65, 0xd1, 0xa1, 0x7d, 0x46, 0x7d, 0x66, 0xb5, 0x90, 0x35, 0xae, 0x47, 0xbc, 0x1e,
+	0xed, 0x54, 0x4b, 0x16, 0xc8, 0x3d, 0x50, 0xa9, 0x63, 0x89, 0x8e, 0x56, 0xd1, 0x70, 0x29, 0x35,
+	0xa3, 0x8e, 0x25, 0xbb, 0x59, 0xa1, 0xe2, 0x9b, 0xac, 0x43, 0x89, 0x2d, 0x31, 0x3b, 0x6c, 0xd7,
+	0xd0, 0x66, 0x31, 0xd5, 0x45, 0xac, 0xdb, 0x9b, 0xd3, 0x85, 0x16, 0x79, 0x04, 0x8d, 0xa1, 0x1d,
+	0x84, 0x46, 0xe0, 0x98, 0x5e, 0x30, 0x70, 0xc3, 0xa0, 0x5d, 0x47, 0xbb, 0xe7, 0x92, 0x76, 0xef,
+	0xd9, 0x41, 0x78, 0x28, 0x55, 0xf6, 0xe6, 0xf4, 0xfa, 0x30, 0x2e, 0x60, 0x5e, 0xdc, 0x93, 0x13,
+	0xea, 0x47, 0x6e, 0xda, 0x8d, 0x2c, 0x2f, 0x4f, 0x98, 0x8e, 0xb4, 0x62, 0x5e, 0xdc, 0xb8, 0x80,
+	0x7c, 0x00, 0x0b, 0x43, 0xd7, 0xb4, 0x22, 0x27, 0x46, 0x6f, 0x30, 0x76, 0x4e, 0xdb, 0x4d, 0x74,
+	0xb5, 0x92, 0xea, 0x90, 0x6b, 0x5a, 0xd2, 0x70, 0x87, 0xa9, 0xed, 0xcd, 0xe9, 0xf3, 0xc3, 0xb4,
+	0x90, 0x1c, 0xc1, 0xa2, 0xe9, 0x79, 0xc3, 0x8b, 0xb4, 0xcf, 0x16, 0xfa, 0x5c, 0x4d, 0xfa, 0xdc,
+	0x62, 0x9a, 0x69, 0xa7, 0xc4, 0x9c, 0x92, 0x6e, 0x97, 0xa1, 0x78, 0x66, 0x0e, 0xc7, 0x54, 0x7b,
+	0x09, 0xaa, 0xb1, 0xed, 0x45, 0xda, 0x50, 0x1e, 0xd1, 0x20, 0x30, 0xfb, 0xb4, 0xad, 0xac, 0x2a,
+	0x6b, 0xaa, 0x2e, 0x8b, 0x5a, 0x03, 0x6a, 0xf1, 0xcd, 0xa5, 0x8d, 0xa0, 0x1a, 0xdb, 0x40, 0xcc,
+	0xf0, 0x8c, 0xfa, 0x01, 0xdb, 0x35, 0xc2, 0x50, 0x14, 0xc9, 0x6d, 0xa8, 0xe3, 0x12, 0x30, 0x64,
+	0x3d, 0xdb, 0xdc, 0x05, 0xbd, 0x86, 0xc2, 0x63, 0xa1, 0xb4, 0x02, 0x55, 0x6f, 0xd3, 0x8b, 0x54,
+	0xf2, 0xa8, 0x02, 0xde, 0xa6, 0x27, 0x14, 0xb4, 0x37, 0xa0, 0x95, 0xde, 0x7f, 0xa4, 0x05, 0xf9,
+	0x53, 0x7a, 0x21, 0xda, 0x63, 0x9f, 0x64, 0x51, 0x0c, 0x0b, 0xdb, 0x50, 0x75, 0x31, 0xc6, 0x2f,
+	0x73, 0xd0, 0x4a, 0x6f, 0x41, 0x72, 0x1f, 0x0a, 0x0c, 0x00, 0xd1, 0xba, 0xba, 0xd9, 0x59, 0xe7,
+	0xe8, 0xb8, 0x2e, 0xd1, 0x71, 0xfd, 0x48, 0xa2, 0xe3, 0x76, 0xe5, 0xab, 0xaf, 0x57, 0xe6, 0xbe,
+	0xfc, 0xe3, 0x8a, 0xa2, 0xa3, 0x05, 0xb9, 0xc5, 0x76, 0x91, 0x69, 0x3b, 0x86, 0x6d, 0x89, 0x76,
+	0xca, 0x58, 0xde, 0xb7, 0xc8, 0x16, 0xb4, 0x7a, 0xae, 0x13, 0x50, 0x27, 0x18, 0x07, 0x86, 0x67,
+	0xfa, 0xe6, 0x28, 0x68, 0xe7, 0x13, 0x2b, 0x7f, 0x47, 0x56, 0x1f, 0x60, 0xad, 0xde, 0xec, 0x25,
+	0x05, 0xe4, 0x4d, 0x80, 0x33, 0x73, 0x68, 0x5b, 0x66, 0xe8, 0xfa, 0x41, 0xbb, 0xb0, 0x9a, 0x8f,
+	0x19, 0x1f, 0xcb, 0x8a, 0xa7, 0x9e, 0x65, 0x86, 0x74, 0xbb, 0xc0, 0x7a, 0xa6, 0xc7, 0xf4, 0xc9,
+	0x8b, 0xd0, 0x34, 0x3d, 0xcf, 0x08, 0x42, 0x33, 0xa4, 0x46, 0xf7, 0x22, 0xa4, 0x01, 0x82, 0x58,
+	0x4d, 0xaf, 0x9b, 0x9e, 0x77, 0xc8, 0xa4, 0xdb, 0x4c, 0xa8, 0x59, 0x50, 0x8b, 0xe3, 0x0b, 0x21,
+	0x50, 0xb0, 0xcc, 0xd0, 0xc4, 0x68, 0xd4, 0x74, 0xfc, 0x66, 0x32, 0xcf, 0x0c, 0x07, 0x62, 0x8c,
+	0xf8, 0x4d, 0x96, 0xa0, 0x34, 0xa0, 0x76, 0x7f, 0x10, 0xe2, 0xb0, 0xf2, 0xba, 0x28, 0xb1, 0xc0,
+	0x7b, 0xbe, 0x7b, 0x46, 0x11, 0x62, 0x2b, 0x3a, 0x2f, 0x68, 0x7f, 0x55, 0x60, 0x7e, 0x0a, 0x93,
+	0x98, 0xdf, 0x81, 0x19, 0x0c, 0x64, 0x5b, 0xec, 0x9b, 0xbc, 0xcc, 0xfc, 0x9a, 0x16, 0xf5, 0x05,
+	0xf4, 0xd7, 0xc5, 0x88, 0xf7, 0x50, 0x28, 0x06, 0x2a, 0x54, 0xc8, 0x2e, 0xb4, 0x86, 0x66, 0x10,
+	0x1a, 0x1c, 0x00, 0x0c, 0x84, 0xf6, 0x7c, 0x02, 0xce, 0xde, 0x33, 0x25, 0x50, 0xb0, 0xc5, 0x29,
+	0xcc, 0x1b, 0xc3, 0x84, 0x94, 0xec, 0xc1, 0x62, 0xf7, 0xe2, 0x73, 0xd3, 0x09, 0x6d, 0x87, 0x1a,
+	0x53, 0x31, 0x6f, 0x0a, 0x57, 0xbb, 0x67, 0xb6, 0x45, 0x9d, 0x9e, 0x0c, 0xf6, 0x42, 0x64, 0x12,
+	0x4d, 0x46, 0xa0, 0xad, 0x42, 0x23, 0x09, 0xa0, 0xa4, 0x01, 0xb9, 0xf0, 0x5c, 0x8c, 0x30, 0x17,
+	0x9e, 0x6b, 0x1a, 0xb4, 0xd2, 0x60, 0x39, 0xa5, 0x73, 0x07, 0x9a, 0x29, 0x5c, 0x8c, 0x85, 0x5b,
+	0x89, 0x87, 0x5b, 0x6b, 0x42, 0x3d, 0x01, 0x87, 0xda, 0x6f, 0xca, 0x50, 0xd1, 0x69, 0xe0, 0xb1,
+	0xc5, 0x44, 0xee, 0x83, 0x4a, 0xcf, 0x7b, 0x94, 0x73, 0x98, 0x92, 0x62, 0x08, 0xae, 0xb3, 0x2b,
+	0xeb, 0x19, 0x64, 0x47, 0xca, 0xe4, 0x4e, 0x82, 0x7f, 0x17, 0xd2, 0x46, 0x71, 0x02, 0xbe, 0x9b,
+	0x24, 0xe0, 0xc5, 0x94, 0x6e, 0x8a, 0x81, 0xef, 0x24, 0x18, 0x38, 0xed, 0x38, 0x41, 0xc1, 0x0f,
+	0x32, 0x28, 0x38, 0xdd, 0xfd, 0x19, 0x1c, 0xfc, 0x20, 0x83, 0x83, 0xdb, 0x53, 0x6d, 0x65, 0x92,
+	0xf0, 0xdd, 0x24, 0x09, 0xa7, 0x87, 0x93, 0x62, 0xe1, 0x37, 0xb3, 0x58, 0xf8, 0x56, 0xca, 0x66,
+	0x26, 0x0d, 0xbf, 0x3e, 0x45, 0xc3, 0x4b, 0x29, 0xd3, 0x0c, 0x1e, 0x7e, 0x90, 0xe0, 0x61, 0xc8,
+	0x1c, 0xdb, 0x0c, 0x22, 0xfe, 0xde, 0x34, 0x11, 0xdf, 0x4c, 0x4f, 0x6d, 0x16, 0x13, 0x6f, 0xa4,
+	0x98, 0xf8, 0x46, 0xba, 0x97, 0x69, 0x2a, 0xde, 0x9d, 0x41, 0xc5, 0xcf, 0xa7, 0x0c, 0xaf, 0xe1,
+	0xe2, 0xdd, 0x19, 0x5c, 0x9c, 0x76, 0x73, 0x0d, 0x19, 0xeb, 0x57, 0x91, 0xf1, 0x6a, 0xba, 0x4b,
+	0xdf, 0x8c, 0x8d, 0x9f, 0x5e, 0xc9, 0xc6, 0x2f, 0xa4, 0x9c, 0x3e, 0x3b, 0x1d, 0xdf, 0x81, 0x79,
+	0x69, 0x1c, 0x6d, 0x51, 0x06, 0xae, 0xd4, 0xf7, 0x5d, 0x5f, 0x30, 0x1d, 0x2f, 0x68, 0x6b, 0x50,
+	0x8b, 0x54, 0xaf, 0xa6, 0x6e, 0x44, 0x8b, 0xd8, 0xb6, 0xd4, 0xfe, 0xa0, 0x40, 0x2d, 0xbe, 0xf7,
+	0x12, 0xf0, 0xaf, 0x0a, 0xf8, 0x8f, 0x31, 0x7a, 0x2e, 0xc9, 0xe8, 0x2b, 0x50, 0x65, 0x24, 0x93,
+	0x22, 0x6b, 0xd3, 0x93, 0x64, 0x4d, 0xbe, 0x03, 0xf3, 0x08, 0xd0, 0x9c, 0xf7, 0x05, 0x82, 0x15,
+	0x10, 0xc1, 0x9a, 0xac, 0x82, 0x2f, 0x35, 0x14, 0x93, 0x57, 0x60, 0x21, 0xa6, 0xcb, 0xfc, 0x22,
+	0x39, 0x70, 0xd6, 0x6a, 0x45, 0xda, 0x5b, 0x9e, 0xb7, 0xc7, 0x88, 0x62, 0x05, 0xaa, 0xae, 0x47,
+	0x1d, 0xa3, 0xef, 0xbb, 0x63, 0x2f, 0x68, 0x97, 0x56, 0xf3, 0x6b, 0x45, 0x1d, 0x98, 0xe8, 0x6d,
+	0x94, 0x68, 0xef, 0xc3, 0xfc, 0x14, 0x4a, 0xb0, 0xf1, 0xf5, 0x5c, 0x8b, 0x07, 0xa6, 0xae, 0xe3,
+	0x37, 0x3b, 0x3d, 0x0c, 0xdd, 0x3e, 0xf6, 0x5e, 0xd5, 0xd9, 0x27, 0xd3, 0x8a, 0x40, 0x4a, 0xe5,
+	0x68, 0xa4, 0xfd, 0x54, 0x81, 0xf9, 0x29, 0xe8, 0xc8, 0xe4, 0x79, 0xe5, 0x3f, 0xe1, 0xf9, 0xdc,
+	0xb3, 0xf1, 0xbc, 0x76, 0xa9, 0x40, 0x3d, 0x81, 0x4d, 0xdf, 0x7e, 0x88, 0x6c, 0x79, 0xd9, 0x8e,
+	0x45, 0xcf, 0x31, 0xe6, 0x79, 0x9d, 0x17, 0xe4, 0xe1, 0xaa, 0x84, 0xf3, 0x90, 0x3c, 0x5c, 0x95,
+	0x51, 0xc6, 0x0b, 0xe4, 0x36, 0x32, 0xbf, 0x7b, 0x22, 0x40, 0xb0, 0xbe, 0x2e, 0x2e, 0x77, 0x07,
+	0x4c, 0xa8, 0xf3, 0xba, 0x18, 0x8f, 0xa9, 0x89, 0x63, 0xc3, 0xf3, 0xa0, 0xb2, 0x8e, 0x06, 0x9e,
+	0xd9, 0xa3, 0x88, 0x69, 0xaa, 0x3e, 0x11, 0x68, 0x07, 0x40, 0xa6, 0xb1, 0x94, 0xbc, 0x01, 0x85,
+	0xd0, 0xec, 0xb3, 0x78, 0xb3, 0x90, 0x35, 0xd6, 0xf9, 0x7d, 0x74, 0xfd, 0xdd, 0xe3, 0x03, 0xd3,
+	0xf6, 0xb7, 0x97, 0x58, 0xa8, 0xfe, 0xf6, 0xf5, 0x4a, 0x83, 0xe9, 0xdc, 0x75, 0x47, 0x76, 0x48,
+	0x47, 0x5e, 0x78, 0xa1, 0xa3, 0x8d, 0xf6, 0x77, 0x05, 0x9a, 0xd2, 0xa5, 0xa4, 0xea, 0xac, 0xc0,
+	0xc9, 0xfd, 0x90, 0x8b, 0x1d, 0x87, 0xbe, 0x59, 0x30, 0xff, 0x0f, 0xa0, 0x6f, 0x06, 0xc6, 0x67,
+	0xa6, 0x13, 0x52, 0x4b, 0x44, 0x54, 0xed, 0x9b, 0xc1, 0x87, 0x28, 0x60, 0x67, 0x47, 0x56, 0x3d,
+	0x0e, 0xa8, 0x85, 0xa1, 0xcd, 0xeb, 0xe5, 0xbe, 0x19, 0x3c, 0x0d, 0xa8, 0x15, 0x8d, 0xab, 0xfc,
+	0xec, 0xe3, 0x4a, 0xc6, 0xb1, 0x92, 0x8e, 0xe3, 0x3f, 0x62, 0x6b, 0x78, 0x72, 0xfc, 0xf8, 0xdf,
+	0x1f, 0xf7, 0xcf, 0xf1, 0xdc, 0x9f, 0x24, 0x38, 0xb2, 0x0f, 0xf3, 0xd1, 0x3e, 0x32, 0xc6, 0xb8,
+	0xbf, 0xe4, 0x5a, 0xba, 0x7a, 0xfb, 0xb5, 0xce, 0x92, 0xe2, 0x80, 0x3c, 0x86, 0x9b, 0x29, 0x14,
+	0x88, 0x1c, 0xe6, 0xae, 0x04, 0x83, 0x1b, 0x49, 0x30, 0x90, 0xfe, 0x64, 0x24, 0xf2, 0xdf, 0x22,
+	0x12, 0x29, 0x5c, 0x2c, 0xa4, 0x71, 0x91, 0xbc, 0x00, 0xb5, 0xde, 0xd0, 0x0d, 0xa8, 0xd4, 0x28,
+	0xa2, 0x46, 0x15, 0x65, 0x02, 0x3a, 0xf7, 0xa1, 0x21, 0xc3, 0xc5, 0xa9, 0x3d, 0x73, 0x3d, 0xdc,
+	0x86, 0xba, 0x4f, 0x43, 0x76, 0xff, 0x49, 0xdc, 0x04, 0x6a, 0x5c, 0xc8, 0x51, 0x5d, 0xfb, 0x85,
+	0x02, 0xcd, 0xd4, 0xa8, 0xc9, 0x3d, 0x00, 0x0e, 0xf2, 0x81, 0xfd, 0x39, 0x4d, 0xc1, 0x25, 0xce,
+	0xcd, 0xa1, 0xfd, 0x39, 0x15, 0x11, 0x52, 0xbb, 0x52, 0x40, 0x5e, 0x83, 0x0a, 0x15, 0x67, 0xf0,
+	0x76, 0x2e, 0x71, 0x0e, 0x91, 0x47, 0x73, 0x61, 0x13, 0xa9, 0x91, 0xef, 0x82, 0x1a, 0x4d, 0x56,
+	0xea, 0xfe, 0x15, 0xcd, 0xad, 0x6c, 0x28, 0x52, 0xd4, 0xde, 0x86, 0x66, 0xaa, 0x1b, 0xe4, 0x39,
+	0x50, 0x47, 0xe6, 0xb9, 0xb8, 0x48, 0xf1, 0x23, 0x78, 0x65, 0x64, 0x9e, 0xe3, 0x1d, 0x8a, 0xdc,
+	0x84, 0x32, 0xab, 0xec, 0x9b, 0x7c, 0xba, 0xf3, 0x7a, 0x69, 0x64, 0x9e, 0xbf, 0x6d, 0x06, 0xda,
+	0x1d, 0x68, 0x24, 0xbb, 0x26, 0x55, 0x25, 0x37, 0x73, 0xd5, 0xad, 0x3e, 0xd5, 0xee, 0x41, 0x33,
+	0xd5, 0x23, 0xa2, 0x41, 0xdd, 0x1b, 0x77, 0x8d, 0x53, 0x7a, 0x61, 0x60, 0x97, 0x71, 0x71, 0xaa,
+	0x7a, 0xd5, 0x1b, 0x77, 0xdf, 0xa5, 0x17, 0x47, 0x4c, 0xa4, 0x1d, 0x42, 0x23, 0x79, 0xc5, 0x61,
+	0xe0, 0xec, 0xbb, 0x63, 0xc7, 0x42, 0xff, 0x45, 0x9d, 0x17, 0x58, 0x6a, 0xe9, 0xcc, 0xe5, 0xeb,
+	0x31, 0x7e, 0xa7, 0x39, 0x76, 0x43, 0x1a, 0xbb, 0x18, 0x71, 0x1d, 0xed, 0x8b, 0x22, 0x94, 0xf8,
+	0x7d, 0x8b, 0xac, 0x27, 0x6f, 0xf3, 0x6c, 0x31, 0x0a, 0x4b, 0x2e, 0x15, 0x86, 0x52, 0x89, 0xbc,
+	0x98, 0xbe, 0x12, 0x6f, 0x57, 0x2f, 0xbf, 0x5e, 0x29, 0x23, 0x59, 0xee, 0x3f, 0x9a, 0xdc, 0x8f,
+	0x67, 0x5d, 0x1f, 0xe5, 0x65, 0xbc, 0xf0, 0xcc, 0x97, 0xf1, 0x9b, 0x50, 0x76, 0xc6, 0x23, 0x23,
+	0x3c, 0x0f, 0x04, 0xe8, 0x94, 0x9c, 0xf1, 0xe8, 0xe8, 0x1c, 0xa7, 0x2e, 0x74, 0x43, 0x73, 0x88,
+	0x55, 0x1c, 0x72, 0x2a, 0x28, 0x60, 0x95, 0xf7, 0xa1, 0x1e, 0x3b, 0x74, 0xd8, 0x56, 0xbb, 0x9c,
+	0x18, 0x25, 0x2e, 0x83, 0xfd, 0x47, 0x62, 0x94, 0xd5, 0xe8, 0x10, 0xb2, 0x6f, 0x91, 0xb5, 0xe4,
+	0xdd, 0x13, 0xcf, 0x2a, 0x15, 0xdc, 0x1d, 0xb1, 0xeb, 0x25, 0x9e, 0x54, 0x9e, 0x03, 0x95, 0xed,
+	0x17, 0xae, 0xa2, 0xa2, 0x4a, 0x85, 0x09, 0xb0, 0xf2, 0x25, 0x68, 0x4e, 0xd8, 0x9c, 0xab, 0x00,
+	0xf7, 0x32, 0x11, 0xa3, 0xe2, 0xab, 0xb0, 0xe8, 0xd0, 0xf3, 0xd0, 0x48, 0x6b, 0x57, 0x51, 0x9b,
+	0xb0, 0xba, 0xe3, 0xa4, 0xc5, 0xff, 0x43, 0x63, 0x82, 0x4a, 0xa8, 0x5b, 0xe3, 0x19, 0x80, 0x48,
+	0x8a, 0x6a, 0xb7, 0xa0, 0x12, 0x1d, 0xb6, 0xea, 0xa8, 0x50, 0x36, 0xc5, 0x19, 0x4b, 0x1e, 0xdf,
+	0x7c, 0x1a, 0x8c, 0x87, 0xa1, 0x70, 0xd2, 0x40, 0x1d, 0x3c, 0xbe, 0xe9, 0x5c, 0x8e, 0xba, 0xb7,
+	0xa1, 0x2e, 0xb7, 0x1d, 0xd7, 0x6b, 0xa2, 0x5e, 0x4d, 0x0a, 0x51, 0xe9, 0x0e, 0xb4, 0x3c, 0xdf,
+	0xf5, 0xdc, 0x80, 0xfa, 0x86, 0x69, 0x59, 0x3e, 0x0d, 0x02, 0x3c, 0x31, 0xd7, 0xf4, 0xa6, 0x94,
+	0x6f, 0x71, 0xb1, 0xf6, 0x1a, 0x94, 0xe5, 0x29, 0x72, 0x11, 0x8a, 0x18, 0x75, 0x5c, 0x82, 0x05,
+	0x9d, 0x17, 0x18, 0x1d, 0x6d, 0x79, 0x9e, 0x48, 0x22, 0xb1, 0x4f, 0xed, 0x47, 0x50, 0x16, 0x13,
+	0x96, 0x99, 0x5a, 0xf8, 0x3e, 0xd4, 0x3c, 0xd3, 0x67, 0xc3, 0x88, 0x27, 0x18, 0xe4, 0x05, 0xef,
+	0xc0, 0xf4, 0x59, 0x46, 0x29, 0x91, 0x67, 0xa8, 0xa2, 0x3e, 0x17, 0x69, 0x0f, 0xa0, 0x9e, 0xd0,
+	0x61, 0xdd, 0xc2, 0x75, 0x24, 0x77, 0x1a, 0x16, 0xa2, 0x96, 0x73, 0x93, 0x96, 0xb5, 0x87, 0xa0,
+	0x46, 0x73, 0xc3, 0x8e, 0xd3, 0x72, 0xe8, 0x8a, 0x08, 0x37, 0x2f, 0x32, 0x87, 0x9e, 0xfb, 0x19,
+	0xf5, 0xc5, 0x9e, 0xe0, 0x05, 0xed, 0x34, 0x86, 0x0c, 0x9c, 0x20, 0xc8, 0x5d, 0x28, 0x0b, 0x64,
+	0x68, 0x2b, 0x89, 0x2c, 0xc9, 0x01, 0x42, 0x83, 0xcc, 0x92, 0x70, 0xa0, 0x98, 0xb8, 0xcd, 0xc5,
+	0xdc, 0x32, 0x29, 0x12, 0x00, 0x36, 0x56, 0xd4, 0x79, 0x41, 0x1b, 0x42, 0x45, 0x62, 0x42, 0x12,
+	0x3c, 0x79, 0x3b, 0xad, 0x34, 0x78, 0x8a, 0xa6, 0x26, 0x8a, 0x6c, 0xcd, 0x04, 0x76, 0xdf, 0xa1,
+	0x96, 0x31, 0xd9, 0x58, 0xd8, 0x72, 0x45, 0x6f, 0xf2, 0x8a, 0xf7, 0xe4, 0x2e, 0xd2, 0x5e, 0x85,
+	0x12, 0xef, 0x31, 0x8b, 0x1a, 0xf3, 0x2c, 0xef, 0x1d, 0xec, 0x3b, 0x8b, 0x73, 0xb4, 0xdf, 0x29,
+	0x50, 0x91, 0x90, 0x9a, 0x69, 0x94, 0xe8, 0x74, 0xee, 0x9b, 0x76, 0xfa, 0xbf, 0x0f, 0x47, 0x77,
+	0x81, 0x70, 0xd4, 0x39, 0x73, 0x43, 0xdb, 0xe9, 0x1b, 0x7c, 0x06, 0x38, 0x32, 0xb5, 0xb0, 0xe6,
+	0x18, 0x2b, 0x0e, 0x70, 0x8e, 0x97, 0x60, 0x31, 0x2b, 0x3b, 0xad, 0x7d, 0x1c, 0xc9, 0x13, 0x57,
+	0x5c, 0xf2, 0x32, 0x54, 0xa2, 0x2b, 0x31, 0x9f, 0x19, 0x89, 0xe8, 0x52, 0x45, 0x8f, 0x14, 0x12,
+	0x1b, 0x3c, 0x97, 0xd8, 0xe0, 0xda, 0x27, 0xd0, 0x9e, 0x95, 0x84, 0x4e, 0xa5, 0x9c, 0x0a, 0x51,
+	0x4c, 0x96, 0xa0, 0x74, 0xe2, 0xfa, 0x23, 0x33, 0x44, 0x67, 0x75, 0x5d, 0x94, 0xd8, 0x82, 0xe2,
+	0x57, 0xe0, 0x3c, 0x8a, 0x79, 0x41, 0x33, 0xe0, 0xd6, 0xcc, 0x94, 0xf4, 0xe4, 0xc2, 0xc1, 0x8f,
+	0x9e, 0xbc, 0x30, 0x71, 0xc4, 0x3b, 0xcb, 0x0b, 0xac, 0xd9, 0x00, 0x9f, 0x91, 0xc4, 0x01, 0x54,
+	0x94, 0xb4, 0xb7, 0xe0, 0x46, 0x66, 0x36, 0x81, 0xbc, 0x02, 0xea, 0x24, 0xfd, 0xa0, 0x24, 0x68,
+	0x2f, 0x0a, 0xd2, 0x44, 0x43, 0xfb, 0xa5, 0x32, 0x71, 0x94, 0x0c, 0xf6, 0x43, 0x28, 0x71, 0x00,
+	0xc4, 0x6e, 0x36, 0x36, 0x6f, 0x5f, 0x95, 0x7d, 0x58, 0xe7, 0x98, 0xa8, 0x0b, 0x13, 0xed, 0x63,
+	0x28, 0x71, 0x09, 0xa9, 0x42, 0xf9, 0xe9, 0xe3, 0x77, 0x1f, 0x3f, 0xf9, 0xf0, 0x71, 0x6b, 0x8e,
+	0x00, 0x94, 0xb6, 0x76, 0x76, 0x76, 0x0f, 0x8e, 0x5a, 0x0a, 0x51, 0xa1, 0xb8, 0xb5, 0xfd, 0x44,
+	0x3f, 0x6a, 0xe5, 0x98, 0x58, 0xdf, 0x7d, 0x67, 0x77, 0xe7, 0xa8, 0x95, 0x27, 0xf3, 0x50, 0xe7,
+	0xdf, 0xc6, 0x5b, 0x4f, 0xf4, 0xf7, 0xb7, 0x8e, 0x5a, 0x85, 0x98, 0xe8, 0x70, 0xf7, 0xf1, 0xa3,
+	0x5d, 0xbd, 0x55, 0xd4, 0x5e, 0x83, 0x5b, 0xb2, 0x1f, 0xd3, 0x53, 0x18, 0x45, 0x52, 0x89, 0x45,
+	0x52, 0xfb, 0x49, 0x0e, 0x3a, 0xb3, 0x13, 0x13, 0xe4, 0x87, 0xa9, 0xe1, 0xae, 0x5d, 0x9b, 0xcb,
+	0x48, 0x8d, 0x99, 0x11, 0x8f, 0x4f, 0x4f, 0x68, 0xd8, 0x1b, 0xf0, 0xa4, 0x08, 0x3f, 0x75, 0xd4,
+	0xf5, 0xba, 0x90, 0xa2, 0x51, 0xc0, 0xd5, 0x7e, 0x4c, 0x7b, 0xa1, 0xc1, 0xa7, 0x92, 0x9f, 0x77,
+	0x55, 0xbd, 0xce, 0xa5, 0x87, 0x5c, 0xa8, 0x7d, 0xf2, 0x4c, 0x11, 0x54, 0xa1, 0xa8, 0xef, 0x1e,
+	0xe9, 0x1f, 0xb5, 0xf2, 0x84, 0x40, 0x03, 0x3f, 0x8d, 0xc3, 0xc7, 0x5b, 0x07, 0x87, 0x7b, 0x4f,
+	0x58, 0x04, 0x17, 0xa0, 0x29, 0x23, 0x28, 0x85, 0x45, 0xed, 0x0b, 0x05, 0x2a, 0xd1, 0x6c, 0x3f,
+	0xeb, 0xb2, 0x5f, 0x82, 0x92, 0x18, 0x24, 0x5f, 0xf7, 0xa2, 0x14, 0xf1, 0x40, 0x21, 0xc6, 0x40,
+	0x1d, 0xa8, 0x8c, 0x68, 0x68, 0x22, 0xaa, 0xf1, 0xbc, 0x46, 0x54, 0xde, 0xfc, 0x7d, 0x19, 0x9a,
+	0x5b, 0xdb, 0x3b, 0xfb, 0x2c, 0xbc, 0x76, 0xcf, 0xc4, 0x6c, 0xc5, 0x06, 0x14, 0x30, 0xa3, 0x93,
+	0xf1, 0xfe, 0xd9, 0xc9, 0xca, 0xc9, 0x92, 0x4d, 0x28, 0x62, 0x62, 0x87, 0x64, 0x3d, 0x83, 0x76,
+	0x32, 0x53, 0xb3, 0xac, 0x11, 0x9e, 0xfa, 0x99, 0x7e, 0x0d, 0xed, 0x64, 0xe5, 0x67, 0xc9, 0x0f,
+	0x40, 0x9d, 0x24, 0x54, 0x66, 0xbd, 0x89, 0x76, 0x66, 0x66, 0x6a, 0x99, 0xfd, 0xe4, 0xf2, 0x39,
+	0xeb, 0x05, 0xb1, 0x33, 0x33, 0xa5, 0x49, 0xee, 0x43, 0x59, 0x5e, 0xd9, 0xb3, 0x5f, 0x2d, 0x3b,
+	0x33, 0xb2, 0xa8, 0x2c, 0x3c, 0x3c, 0x47, 0x92, 0xf5, 0xb4, 0xda, 0xc9, 0x4c, 0xf5, 0x92, 0x7b,
+	0x50, 0x12, 0x77, 0xa0, 0xcc, 0xf7, 0xc7, 0x4e, 0x76, 0x2e, 0x94, 0x0d, 0x72, 0x92, 0x25, 0x9a,
+	0xf5, 0xfc, 0xdb, 0x99, 0x99, 0x93, 0x26, 0x5b, 0x00, 0xb1, 0x54, 0xc7, 0xcc, 0x77, 0xdd, 0xce,
+	0xec, 0x5c, 0x33, 0x79, 0x08, 0x95, 0xc9, 0xfb, 0x41, 0xf6, 0x7b, 0x6b, 0x67, 0x56, 0xfa, 0x97,
+	0xbc, 0x03, 0xf5, 0x24, 0x9c, 0x5e, 0xf5, 0x8a, 0xda, 0xb9, 0x32, 0xaf, 0xcb, 0x7c, 0x25, 0x11,
+	0xf5, 0xaa, 0xb7, 0xd4, 0xce, 0x95, 0xc9, 0x5d, 0x72, 0x0c, 0xf3, 0xd3, 0x38, 0x77, 0xdd, 0x83,
+	0x6a, 0xe7, 0xda, 0x24, 0x2f, 0xf9, 0x08, 0x48, 0x06, 0x16, 0x5e, 0xfb, 0xaa, 0xda, 0xb9, 0x3e,
+	0xd3, 0xbb, 0xfd, 0xfc, 0x3f, 0xff, 0xbc, 0xac, 0xfc, 0xec, 0x72, 0x59, 0xf9, 0xd5, 0xe5, 0xb2,
+	0xf2, 0xd5, 0xe5, 0xb2, 0xf2, 0xdb, 0xcb, 0x65, 0xe5, 0x4f, 0x97, 0xcb, 0xca, 0xaf, 0xff, 0xb2,
+	0xac, 0x74, 0x4b, 0x78, 0x98, 0x78, 0xfd, 0xdf, 0x03, 0x00, 0x23, 0xa7, 0x38, 0xfe, 0xe4, 0x21,
+	0x00, 0x00,
 }
@@ -46,6 +46,10 @@ const (
 	maxDiffBetweenCurrentAndReceivedBlockHeight = 100
 )
 
+// initialThroughput is the throughput assumed for peers until it's measured.
+// It's high enough for new peers to be tried before the slow ones.
+var initialThroughput = float64(minRecvRate) * math.E
+
 var peerTimeout = 15 * time.Second // not const so we can override with tests
 
 /*
@@ -69,6 +73,7 @@ type BlockPool struct {
 	height     int64 // the lowest key in requesters.
 	// peers
 	peers         map[p2p.ID]*bpPeer
+	bannedPeers   map[p2p.ID]bool // peers which sent us bad blocks
 	maxPeerHeight int64
 
 	// atomic
@@ -80,7 +85,8 @@ type BlockPool struct {
 
 func NewBlockPool(start int64, requestsCh chan<- BlockRequest, errorsCh chan<- peerError) *BlockPool {
 	bp := &BlockPool{
-		peers: make(map[p2p.ID]*bpPeer),
+		peers:       make(map[p2p.ID]*bpPeer),
+		bannedPeers: make(map[p2p.ID]bool),
 
 		requesters: make(map[int64]*bpRequester),
 		height:     start,
@@ -194,6 +200,29 @@ func (pool *BlockPool) PeekTwoBlocks() (first *types.Block, second *types.Block)
 	return
 }
 
+// PeekBlocks returns up to max consecutive blocks starting at pool.height,
+// stopping at the first one not received yet. Each block is verified by the
+// LastCommit of the next one, so the last block returned can't be verified
+// until the block after it arrives.
+func (pool *BlockPool) PeekBlocks(max int) []*types.Block {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+
+	blocks := make([]*types.Block, 0, max)
+	for h := pool.height; len(blocks) < max; h++ {
+		r := pool.requesters[h]
+		if r == nil {
+			break
+		}
+		block := r.getBlock()
+		if block == nil {
+			break
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
+
 // Pop the first block at pool.height
 // It must have been validated by 'second'.Commit from PeekTwoBlocks().
 func (pool *BlockPool) PopRequest() {
@@ -214,22 +243,34 @@ func (pool *BlockPool) PopRequest() {
 	}
 }
 
-// Invalidates the block at pool.height,
-// Remove the peer and redo request from others.
-// Returns the ID of the removed peer.
+// Invalidates the block at the given height, which is bad.
+// Bans the peer which sent it and redoes its requests from others.
+// Returns the ID of the banned peer.
 func (pool *BlockPool) RedoRequest(height int64) p2p.ID {
 	pool.mtx.Lock()
 	defer pool.mtx.Unlock()
 
 	request := pool.requesters[height]
+	if request == nil {
+		return p2p.ID("")
+	}
 	peerID := request.getPeerID()
 	if peerID != p2p.ID("") {
 		// RemovePeer will redo all requesters associated with this peer.
 		pool.removePeer(peerID)
+		pool.bannedPeers[peerID] = true
 	}
 	return peerID
 }
 
+// IsBanned returns true if the peer sent us a bad block. Banned peers aren't
+// added to the pool again.
+func (pool *BlockPool) IsBanned(peerID p2p.ID) bool {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+	return pool.bannedPeers[peerID]
+}
+
 // TODO: ensure that blocks come in order for each peer.
 func (pool *BlockPool) AddBlock(peerID p2p.ID, block *types.Block, blockSize int) {
 	pool.mtx.Lock()
@@ -267,16 +308,22 @@ func (pool *BlockPool) MaxPeerHeight() int64 {
 	return pool.maxPeerHeight
 }
 
-// Sets the peer's alleged blockchain height.
-func (pool *BlockPool) SetPeerHeight(peerID p2p.ID, height int64) {
+// SetPeerRange sets the peer's alleged blockchain base and height: the
+// lowest and highest blocks it can send us.
+func (pool *BlockPool) SetPeerRange(peerID p2p.ID, base int64, height int64) {
 	pool.mtx.Lock()
 	defer pool.mtx.Unlock()
 
+	if pool.bannedPeers[peerID] {
+		return
+	}
+
 	peer := pool.peers[peerID]
 	if peer != nil {
+		peer.base = base
 		peer.height = height
 	} else {
-		peer = newBPPeer(pool, peerID, height)
+		peer = newBPPeer(pool, peerID, base, height)
 		peer.setLogger(pool.Logger.With("peer", peerID))
 		pool.peers[peerID] = peer
 	}
@@ -302,12 +349,19 @@ func (pool *BlockPool) removePeer(peerID p2p.ID) {
 	delete(pool.peers, peerID)
 }
 
-// Pick an available peer with at least the given minHeight.
+// Pick an available peer with the given minHeight, i.e. a peer which has not
+// pruned it and has reached it. The peer expected to send the block the
+// soonest is picked: the one with the highest measured throughput per
+// pending request.
 // If no peers are available, returns nil.
 func (pool *BlockPool) pickIncrAvailablePeer(minHeight int64) *bpPeer {
 	pool.mtx.Lock()
 	defer pool.mtx.Unlock()
 
+	var (
+		best      *bpPeer
+		bestScore float64
+	)
 	for _, peer := range pool.peers {
 		if peer.didTimeout {
 			pool.removePeer(peer.id)
@@ -316,13 +370,18 @@ func (pool *BlockPool) pickIncrAvailablePeer(minHeight int64) *bpPeer {
 		if peer.numPending >= maxPendingRequestsPerPeer {
 			continue
 		}
-		if peer.height < minHeight {
+		if minHeight < peer.base || minHeight > peer.height {
 			continue
 		}
-		peer.incrPending()
-		return peer
+		score := peer.throughput / float64(peer.numPending+1)
+		if best == nil || score > bestScore {
+			best, bestScore = peer, score
+		}
 	}
-	return nil
+	if best != nil {
+		best.incrPending()
+	}
+	return best
 }
 
 func (pool *BlockPool) makeNextRequester() {
@@ -389,20 +448,27 @@ type bpPeer struct {
 	id          p2p.ID
 	recvMonitor *flow.Monitor
 
+	base       int64
 	height     int64
 	numPending int32
 	timeout    *time.Timer
 	didTimeout bool
 
+	// recv rate measured over the peer's past requests, in bytes/s, kept
+	// across the recvMonitor resets
+	throughput float64
+
 	logger log.Logger
 }
 
-func newBPPeer(pool *BlockPool, peerID p2p.ID, height int64) *bpPeer {
+func newBPPeer(pool *BlockPool, peerID p2p.ID, base int64, height int64) *bpPeer {
 	peer := &bpPeer{
 		pool:       pool,
 		id:         peerID,
+		base:       base,
 		height:     height,
 		numPending: 0,
+		throughput: initialThroughput,
 		logger:     log.NewNopLogger(),
 	}
 	return peer
@@ -414,8 +480,7 @@ func (peer *bpPeer) setLogger(l log.Logger) {
 
 func (peer *bpPeer) resetMonitor() {
 	peer.recvMonitor = flow.New(time.Second, time.Second*40)
-	initialValue := float64(minRecvRate) * math.E
-	peer.recvMonitor.SetREMA(initialValue)
+	peer.recvMonitor.SetREMA(initialThroughput)
 }
 
 func (peer *bpPeer) resetTimeout() {
@@ -442,6 +507,9 @@ func (peer *bpPeer) decrPending(recvSize int) {
 		peer.recvMonitor.Update(recvSize)
 		peer.resetTimeout()
 	}
+	if rate := peer.recvMonitor.Status().CurRate; rate > 0 {
+		peer.throughput = float64(rate)
+	}
 }
 
 func (peer *bpPeer) onTimeout() {
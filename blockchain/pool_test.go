@@ -4,6 +4,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+
 	cmn "github.com/tendermint/tendermint/libs/common"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -17,6 +19,7 @@ func init() {
 
 type testPeer struct {
 	id        p2p.ID
+	base      int64
 	height    int64
 	inputChan chan inputData //make sure each peer's data is sequential
 }
@@ -61,7 +64,7 @@ func makePeers(numPeers int, minHeight, maxHeight int64) testPeers {
 	for i := 0; i < numPeers; i++ {
 		peerID := p2p.ID(cmn.RandStr(12))
 		height := minHeight + cmn.RandInt63n(maxHeight-minHeight)
-		peers[peerID] = testPeer{peerID, height, make(chan inputData, 10)}
+		peers[peerID] = testPeer{peerID, 0, height, make(chan inputData, 10)}
 	}
 	return peers
 }
@@ -87,7 +90,7 @@ func TestBasic(t *testing.T) {
 	// Introduce each peer.
 	go func() {
 		for _, peer := range peers {
-			pool.SetPeerHeight(peer.id, peer.height)
+			pool.SetPeerRange(peer.id, peer.base, peer.height)
 		}
 	}()
 
@@ -142,7 +145,7 @@ func TestTimeout(t *testing.T) {
 	// Introduce each peer.
 	go func() {
 		for _, peer := range peers {
-			pool.SetPeerHeight(peer.id, peer.height)
+			pool.SetPeerRange(peer.id, peer.base, peer.height)
 		}
 	}()
 
@@ -180,3 +183,103 @@ func TestTimeout(t *testing.T) {
 		}
 	}
 }
+
+func TestPoolSkipsPrunedPeers(t *testing.T) {
+	requestsCh := make(chan BlockRequest, 10)
+	pool := NewBlockPool(1, requestsCh, make(chan peerError, 10))
+	pool.SetLogger(log.TestingLogger())
+
+	// the first peer pruned the blocks below 50
+	pool.SetPeerRange("pruned", 50, 100)
+	pool.SetPeerRange("full", 1, 20)
+
+	peer := pool.pickIncrAvailablePeer(10)
+	if assert.NotNil(t, peer) {
+		assert.EqualValues(t, "full", peer.id)
+	}
+	assert.Nil(t, pool.pickIncrAvailablePeer(30), "no peer has height 30")
+	peer = pool.pickIncrAvailablePeer(60)
+	if assert.NotNil(t, peer) {
+		assert.EqualValues(t, "pruned", peer.id)
+	}
+}
+
+// addRequester adds a requester for the given height, assigned to the peer
+// picked by the pool, without starting its request routine.
+func addRequester(pool *BlockPool, height int64) {
+	requester := newBPRequester(pool, height)
+	requester.peerID = pool.pickIncrAvailablePeer(height).id
+	pool.requesters[height] = requester
+}
+
+func stopPeerTimeouts(pool *BlockPool) {
+	for _, peer := range pool.peers {
+		if peer.timeout != nil {
+			peer.timeout.Stop()
+		}
+	}
+}
+
+func TestPoolPeekBlocks(t *testing.T) {
+	pool := NewBlockPool(1, make(chan BlockRequest, 10), make(chan peerError, 10))
+	pool.SetLogger(log.TestingLogger())
+	pool.SetPeerRange("peer", 1, 5)
+	defer stopPeerTimeouts(pool)
+	for height := int64(1); height <= 5; height++ {
+		addRequester(pool, height)
+	}
+	for _, height := range []int64{1, 2, 4} {
+		pool.AddBlock("peer", &types.Block{Header: types.Header{Height: height}}, 100)
+	}
+
+	// blocks are returned in order up to the first missing one
+	blocks := pool.PeekBlocks(10)
+	if assert.Len(t, blocks, 2) {
+		assert.EqualValues(t, 1, blocks[0].Height)
+		assert.EqualValues(t, 2, blocks[1].Height)
+	}
+	assert.Len(t, pool.PeekBlocks(1), 1)
+}
+
+func TestPoolBansPeerOfBadBlock(t *testing.T) {
+	pool := NewBlockPool(1, make(chan BlockRequest, 10), make(chan peerError, 10))
+	pool.SetLogger(log.TestingLogger())
+	pool.SetPeerRange("bad", 1, 5)
+	defer stopPeerTimeouts(pool)
+	addRequester(pool, 1)
+	pool.AddBlock("bad", &types.Block{Header: types.Header{Height: 1}}, 100)
+
+	assert.EqualValues(t, "bad", pool.RedoRequest(1))
+	assert.True(t, pool.IsBanned("bad"))
+	assert.Nil(t, pool.pickIncrAvailablePeer(1))
+
+	// banned peers aren't added again
+	pool.SetPeerRange("bad", 1, 5)
+	assert.Nil(t, pool.pickIncrAvailablePeer(1))
+	pool.SetPeerRange("good", 1, 5)
+	peer := pool.pickIncrAvailablePeer(1)
+	if assert.NotNil(t, peer) {
+		assert.EqualValues(t, "good", peer.id)
+	}
+}
+
+func TestPoolPicksFastestPeer(t *testing.T) {
+	pool := NewBlockPool(1, make(chan BlockRequest, 10), make(chan peerError, 10))
+	pool.SetLogger(log.TestingLogger())
+	pool.SetPeerRange("slow", 1, 100)
+	pool.SetPeerRange("fast", 1, 100)
+	pool.peers["slow"].throughput = 10000
+	pool.peers["fast"].throughput = 45000
+
+	// the fast peer gets requests until its throughput per pending request
+	// drops below the slow peer's
+	var picked []p2p.ID
+	for i := 0; i < 5; i++ {
+		peer := pool.pickIncrAvailablePeer(1)
+		if assert.NotNil(t, peer) {
+			picked = append(picked, peer.id)
+		}
+	}
+	assert.Equal(t, []p2p.ID{"fast", "fast", "fast", "fast", "slow"}, picked)
+	stopPeerTimeouts(pool)
+}
@@ -1,6 +1,7 @@
 package blockchain
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"reflect"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/p2p"
+	bh "github.com/tendermint/tendermint/p2p/behaviour"
 	sm "github.com/tendermint/tendermint/state"
 	"github.com/tendermint/tendermint/types"
 )
@@ -29,6 +31,9 @@ const (
 	// check if we should switch to consensus reactor
 	switchToConsensusIntervalSeconds = 1
 
+	// number of blocks verified concurrently, ahead of the block being applied
+	verifyWindow = 16
+
 	// NOTE: keep up to date with bcBlockResponseMessage
 	bcBlockResponseMessagePrefixSize   = 4
 	bcBlockResponseMessageFieldKeySize = 1
@@ -66,13 +71,21 @@ type BlockchainReactor struct {
 
 	requestsCh <-chan BlockRequest
 	errorsCh   <-chan peerError
+
+	reporter bh.Reporter
 }
 
 // NewBlockchainReactor returns new reactor instance.
 func NewBlockchainReactor(state sm.State, blockExec *sm.BlockExecutor, store *BlockStore,
 	fastSync bool) *BlockchainReactor {
 
-	if state.LastBlockHeight != store.Height() {
+	// the store of a node bootstrapped by state sync is empty until the block
+	// after the snapshot height is saved
+	startHeight := store.Height() + 1
+	if store.Base() == 0 {
+		startHeight = state.LastBlockHeight + 1
+	}
+	if state.LastBlockHeight != startHeight-1 {
 		panic(fmt.Sprintf("state (%v) and store (%v) height mismatch", state.LastBlockHeight,
 			store.Height()))
 	}
@@ -83,7 +96,7 @@ func NewBlockchainReactor(state sm.State, blockExec *sm.BlockExecutor, store *Bl
 	errorsCh := make(chan peerError, capacity) // so we don't block in #Receive#pool.AddBlock
 
 	pool := NewBlockPool(
-		store.Height()+1,
+		startHeight,
 		requestsCh,
 		errorsCh,
 	)
@@ -107,6 +120,20 @@ func (bcR *BlockchainReactor) SetLogger(l log.Logger) {
 	bcR.pool.Logger = l
 }
 
+// SetSwitch implements Reactor. The behaviour of the peers is reported to the
+// switch.
+func (bcR *BlockchainReactor) SetSwitch(sw *p2p.Switch) {
+	bcR.BaseReactor.SetSwitch(sw)
+	bcR.reporter = bh.NewSwitchReporter(sw)
+}
+
+// report reports the behaviour of a peer, which may have disconnected since.
+func (bcR *BlockchainReactor) report(behaviour bh.PeerBehaviour) {
+	if err := bcR.reporter.Report(behaviour); err != nil {
+		bcR.Logger.Debug("Could not report peer behaviour", "peer", behaviour.PeerID(), "err", err)
+	}
+}
+
 // OnStart implements cmn.Service.
 func (bcR *BlockchainReactor) OnStart() error {
 	if bcR.fastSync {
@@ -119,6 +146,21 @@ func (bcR *BlockchainReactor) OnStart() error {
 	return nil
 }
 
+// SwitchToFastSync is called by the state sync reactor once the application
+// is restored from a snapshot, to fast sync from the height of its state.
+func (bcR *BlockchainReactor) SwitchToFastSync(state sm.State) error {
+	bcR.fastSync = true
+	bcR.initialState = state
+
+	bcR.pool.height = state.LastBlockHeight + 1
+	err := bcR.pool.Start()
+	if err != nil {
+		return err
+	}
+	go bcR.poolRoutine()
+	return nil
+}
+
 // OnStop implements cmn.Service.
 func (bcR *BlockchainReactor) OnStop() {
 	bcR.pool.Stop()
@@ -139,12 +181,12 @@ func (bcR *BlockchainReactor) GetChannels() []*p2p.ChannelDescriptor {
 
 // AddPeer implements Reactor by sending our state to peer.
 func (bcR *BlockchainReactor) AddPeer(peer p2p.Peer) {
-	msgBytes := cdc.MustMarshalBinaryBare(&bcStatusResponseMessage{bcR.store.Height()})
+	msgBytes := cdc.MustMarshalBinaryBare(&bcStatusResponseMessage{Height: bcR.store.Height(), Base: bcR.store.Base()})
 	if !peer.Send(BlockchainChannel, msgBytes) {
 		// doing nothing, will try later in `poolRoutine`
 	}
 	// peer is added to the pool once we receive the first
-	// bcStatusResponseMessage from the peer and call pool.SetPeerHeight
+	// bcStatusResponseMessage from the peer and call pool.SetPeerRange
 }
 
 // RemovePeer implements Reactor by removing peer from the pool.
@@ -176,13 +218,13 @@ func (bcR *BlockchainReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte)
 	msg, err := decodeMsg(msgBytes)
 	if err != nil {
 		bcR.Logger.Error("Error decoding message", "src", src, "chId", chID, "msg", msg, "err", err, "bytes", msgBytes)
-		bcR.Switch.StopPeerForError(src, err)
+		bcR.report(bh.BadMessage(src.ID(), err.Error()))
 		return
 	}
 
 	if err = msg.ValidateBasic(); err != nil {
 		bcR.Logger.Error("Peer sent us invalid msg", "peer", src, "msg", msg, "err", err)
-		bcR.Switch.StopPeerForError(src, err)
+		bcR.report(bh.BadMessage(src.ID(), err.Error()))
 		return
 	}
 
@@ -197,14 +239,14 @@ func (bcR *BlockchainReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte)
 		bcR.pool.AddBlock(src.ID(), msg.Block, len(msgBytes))
 	case *bcStatusRequestMessage:
 		// Send peer our state.
-		msgBytes := cdc.MustMarshalBinaryBare(&bcStatusResponseMessage{bcR.store.Height()})
+		msgBytes := cdc.MustMarshalBinaryBare(&bcStatusResponseMessage{Height: bcR.store.Height(), Base: bcR.store.Base()})
 		queued := src.TrySend(BlockchainChannel, msgBytes)
 		if !queued {
 			// sorry
 		}
 	case *bcStatusResponseMessage:
 		// Got a peer status. Unverified.
-		bcR.pool.SetPeerHeight(src.ID(), msg.Height)
+		bcR.pool.SetPeerRange(src.ID(), msg.Base, msg.Height)
 	default:
 		bcR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
 	}
@@ -228,6 +270,12 @@ func (bcR *BlockchainReactor) poolRoutine() {
 
 	didProcessCh := make(chan struct{}, 1)
 
+	// The blocks ahead of the next one to apply are verified concurrently,
+	// while the blocks before them are applied.
+	verifiedCh := make(chan *verifiedBlock, verifyWindow)
+	verifying := make(map[int64]bool)
+	verified := make(map[int64]*verifiedBlock)
+
 FOR_LOOP:
 	for {
 		select {
@@ -279,74 +327,93 @@ FOR_LOOP:
 			default:
 			}
 
+		case vb := <-verifiedCh:
+			delete(verifying, vb.block.Height)
+			verified[vb.block.Height] = vb
+			select {
+			case didProcessCh <- struct{}{}:
+			default:
+			}
+
 		case <-didProcessCh:
-			// NOTE: It is a subtle mistake to process more than a single block
+			// NOTE: It is a subtle mistake to apply more than a single block
 			// at a time (e.g. 10) here, because we only TrySend 1 request per
 			// loop.  The ratio mismatch can result in starving of blocks, a
 			// sudden burst of requests and responses, and repeat.
-			// Consequently, it is better to split these routines rather than
-			// coupling them as it's written here.  TODO uncouple from request
-			// routine.
+			// Consequently, the blocks are only verified ahead of time, in
+			// their own goroutines, and applied one at a time here.
 
 			// See if there are any blocks to sync.
-			first, second := bcR.pool.PeekTwoBlocks()
-			//bcR.Logger.Info("TrySync peeked", "first", first, "second", second)
-			if first == nil || second == nil {
+			blocks := bcR.pool.PeekBlocks(verifyWindow + 1)
+			if len(blocks) < 2 {
 				// We need both to sync the first block.
 				continue FOR_LOOP
-			} else {
-				// Try again quickly next loop.
-				didProcessCh <- struct{}{}
 			}
 
-			firstParts := first.MakePartSet(types.BlockPartSizeBytes)
-			firstPartsHeader := firstParts.Header()
-			firstID := types.BlockID{Hash: first.Hash(), PartsHeader: firstPartsHeader}
-			// Finally, verify the first block using the second's commit
-			// NOTE: we can probably make this more efficient, but note that calling
-			// first.Hash() doesn't verify the tx contents, so MakePartSet() is
-			// currently necessary.
-			err := state.Validators.VerifyCommit(
-				chainID, firstID, first.Height, second.LastCommit)
-			if err != nil {
-				bcR.Logger.Error("Error in validation", "err", err)
-				peerID := bcR.pool.RedoRequest(first.Height)
-				peer := bcR.Switch.Peers().Get(peerID)
-				if peer != nil {
-					// NOTE: we've already removed the peer's request, but we
-					// still need to clean up the rest.
-					bcR.Switch.StopPeerForError(peer, fmt.Errorf("BlockchainReactor validation error: %v", err))
+			// Verify the blocks of the window we know the validators of, if
+			// not already done.
+			for i := 0; i < len(blocks)-1; i++ {
+				block, next := blocks[i], blocks[i+1]
+				if verifying[block.Height] || verified[block.Height].matches(block, next) {
+					continue
 				}
-				peerID2 := bcR.pool.RedoRequest(second.Height)
-				peer2 := bcR.Switch.Peers().Get(peerID2)
-				if peer2 != nil && peer2 != peer {
-					// NOTE: we've already removed the peer's request, but we
-					// still need to clean up the rest.
-					bcR.Switch.StopPeerForError(peer2, fmt.Errorf("BlockchainReactor validation error: %v", err))
+				vals := expectedValidators(state, blocks, i)
+				if vals == nil {
+					break
 				}
+				verifying[block.Height] = true
+				go func() {
+					verifiedCh <- verifyBlock(chainID, vals, block, next)
+				}()
+			}
+
+			first, second := blocks[0], blocks[1]
+			vb := verified[first.Height]
+			if !vb.matches(first, second) {
+				// wait for the verification in progress
 				continue FOR_LOOP
-			} else {
-				bcR.pool.PopRequest()
-
-				// TODO: batch saves so we dont persist to disk every block
-				bcR.store.SaveBlock(first, firstParts, second.LastCommit)
-
-				// TODO: same thing for app - but we would need a way to
-				// get the hash without persisting the state
-				var err error
-				state, err = bcR.blockExec.ApplyBlock(state, firstID, first)
-				if err != nil {
-					// TODO This is bad, are we zombie?
-					panic(fmt.Sprintf("Failed to process committed block (%d:%X): %v", first.Height, first.Hash(), err))
-				}
-				blocksSynced++
+			}
+			delete(verified, first.Height)
+			if !bytes.Equal(vb.valsHash, state.BlockValidators().Hash()) {
+				// the validators of the block changed since it was verified
+				vb = verifyBlock(chainID, state.BlockValidators(), first, second)
+			}
 
-				if blocksSynced%100 == 0 {
-					lastRate = 0.9*lastRate + 0.1*(100/time.Since(lastHundred).Seconds())
-					bcR.Logger.Info("Fast Sync Rate", "height", bcR.pool.height,
-						"max_peer_height", bcR.pool.MaxPeerHeight(), "blocks/s", lastRate)
-					lastHundred = time.Now()
-				}
+			if vb.err != nil {
+				// Blame the peer which sent the bad block. It's banned and
+				// its requests are redone with other peers.
+				badHeight := badBlockHeight(chainID, state.BlockValidators(), first, second)
+				bcR.Logger.Error("Error in validation", "height", first.Height, "badHeight", badHeight, "err", vb.err)
+				peerID := bcR.pool.RedoRequest(badHeight)
+				// NOTE: we've already removed the peer's request, but we
+				// still need to clean up the rest.
+				bcR.report(bh.BadMessage(peerID, fmt.Sprintf("BlockchainReactor validation error: %v", vb.err)))
+				continue FOR_LOOP
+			}
+
+			// Try again quickly next loop.
+			didProcessCh <- struct{}{}
+
+			bcR.pool.PopRequest()
+
+			// TODO: batch saves so we dont persist to disk every block
+			bcR.store.SaveBlock(first, vb.parts, second.LastCommit)
+
+			// TODO: same thing for app - but we would need a way to
+			// get the hash without persisting the state
+			var err error
+			state, err = bcR.blockExec.ApplyBlock(state, vb.blockID, first)
+			if err != nil {
+				// TODO This is bad, are we zombie?
+				panic(fmt.Sprintf("Failed to process committed block (%d:%X): %v", first.Height, first.Hash(), err))
+			}
+			blocksSynced++
+
+			if blocksSynced%100 == 0 {
+				lastRate = 0.9*lastRate + 0.1*(100/time.Since(lastHundred).Seconds())
+				bcR.Logger.Info("Fast Sync Rate", "height", bcR.pool.height,
+					"max_peer_height", bcR.pool.MaxPeerHeight(), "blocks/s", lastRate)
+				lastHundred = time.Now()
 			}
 			continue FOR_LOOP
 
@@ -356,6 +423,85 @@ FOR_LOOP:
 	}
 }
 
+// verifiedBlock is the result of verifying a block with the LastCommit of the
+// next block.
+type verifiedBlock struct {
+	block    *types.Block
+	next     *types.Block
+	parts    *types.PartSet
+	blockID  types.BlockID
+	valsHash []byte // hash of the validators the commit was verified with
+	err      error
+}
+
+// matches returns true if the verification is of the given blocks, which
+// are replaced when their requests are redone.
+func (vb *verifiedBlock) matches(block, next *types.Block) bool {
+	return vb != nil && vb.block == block && vb.next == next
+}
+
+// verifyBlock verifies a block with the LastCommit of the next block, which
+// must be signed by the given validators.
+// NOTE: we can probably make this more efficient, but note that calling
+// block.Hash() doesn't verify the tx contents, so MakePartSet() is
+// currently necessary.
+func verifyBlock(chainID string, vals *types.ValidatorSet, block, next *types.Block) *verifiedBlock {
+	parts := block.MakePartSet(types.BlockPartSizeBytes)
+	blockID := types.BlockID{Hash: block.Hash(), PartsHeader: parts.Header()}
+	return &verifiedBlock{
+		block:    block,
+		next:     next,
+		parts:    parts,
+		blockID:  blockID,
+		valsHash: vals.Hash(),
+		err:      vals.VerifyCommit(chainID, blockID, block.Height, next.LastCommit),
+	}
+}
+
+// badBlockHeight returns the height of the bad block when a block can't be
+// verified with the LastCommit of the next block. It's the next block if its
+// LastCommit isn't a valid commit for any block, and the block itself if it
+// doesn't match a valid commit.
+func badBlockHeight(chainID string, vals *types.ValidatorSet, block, next *types.Block) int64 {
+	if next.LastCommit == nil {
+		return next.Height
+	}
+	err := vals.VerifyCommit(chainID, next.LastCommit.BlockID, block.Height, next.LastCommit)
+	if err != nil {
+		return next.Height
+	}
+	return block.Height
+}
+
+// expectedValidators returns the validators expected to sign the block at
+// index i of a window of blocks starting at the next height of the state, or
+// nil if they aren't known yet. Further blocks are expected to be signed by
+// the validators of the state when their ValidatorsHash matches, which must
+// be checked again once the blocks before them are applied.
+func expectedValidators(state sm.State, blocks []*types.Block, i int) *types.ValidatorSet {
+	if i == 0 {
+		return state.BlockValidators()
+	}
+	var vals *types.ValidatorSet
+	switch block := blocks[i]; {
+	case bytes.Equal(block.ValidatorsHash, state.Validators.Hash()):
+		vals = state.Validators
+	case bytes.Equal(block.ValidatorsHash, state.NextValidators.Hash()):
+		vals = state.NextValidators
+	default:
+		return nil
+	}
+	if !state.GroupValidators() {
+		return vals
+	}
+	// the signing group follows the group of the previous block
+	return sm.State{
+		Validators:      vals,
+		LastBlockGroup:  blocks[i-1].Group,
+		ConsensusParams: state.ConsensusParams,
+	}.BlockValidators()
+}
+
 // BroadcastStatusRequest broadcasts `BlockStore` height.
 func (bcR *BlockchainReactor) BroadcastStatusRequest() error {
 	msgBytes := cdc.MustMarshalBinaryBare(&bcStatusRequestMessage{bcR.store.Height()})
@@ -459,6 +605,9 @@ func (m *bcStatusRequestMessage) String() string {
 
 type bcStatusResponseMessage struct {
 	Height int64
+	// lowest block the peer has, older ones were pruned. Peers which don't
+	// prune may send 0.
+	Base int64
 }
 
 // ValidateBasic performs basic validation.
@@ -466,9 +615,15 @@ func (m *bcStatusResponseMessage) ValidateBasic() error {
 	if m.Height < 0 {
 		return errors.New("Negative Height")
 	}
+	if m.Base < 0 {
+		return errors.New("Negative Base")
+	}
+	if m.Base > m.Height {
+		return fmt.Errorf("Base %v above Height %v", m.Base, m.Height)
+	}
 	return nil
 }
 
 func (m *bcStatusResponseMessage) String() string {
-	return fmt.Sprintf("[bcStatusResponseMessage %v]", m.Height)
+	return fmt.Sprintf("[bcStatusResponseMessage %v:%v]", m.Base, m.Height)
 }
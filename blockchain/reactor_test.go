@@ -303,3 +303,62 @@ func (app *testApp) Commit() abci.ResponseCommit {
 func (app *testApp) Query(reqQuery abci.RequestQuery) (resQuery abci.ResponseQuery) {
 	return
 }
+
+// makeCommittedBlocks returns a block at height 1, and a block at height 2
+// committing it, signed by the given validators.
+func makeCommittedBlocks(t *testing.T, chainID string, vals *types.ValidatorSet,
+	privVals []types.PrivValidator) (*types.Block, *types.Block) {
+	block := &types.Block{Header: types.Header{ChainID: chainID, Height: 1}}
+	parts := block.MakePartSet(types.BlockPartSizeBytes)
+	blockID := types.BlockID{Hash: block.Hash(), PartsHeader: parts.Header()}
+	voteSet := types.NewVoteSet(chainID, 1, 0, types.PrecommitType, vals)
+	commit, err := types.MakeCommit(blockID, 1, 0, voteSet, privVals)
+	if err != nil {
+		t.Fatal(err)
+	}
+	next := &types.Block{Header: types.Header{ChainID: chainID, Height: 2}, LastCommit: commit}
+	return block, next
+}
+
+func TestVerifyBlock(t *testing.T) {
+	chainID := "test-chain"
+	vals, privVals := types.RandValidatorSet(4, 10)
+	block, next := makeCommittedBlocks(t, chainID, vals, privVals)
+
+	vb := verifyBlock(chainID, vals, block, next)
+	assert.NoError(t, vb.err)
+	assert.Equal(t, vals.Hash(), vb.valsHash)
+	assert.True(t, vb.matches(block, next))
+	assert.False(t, vb.matches(next, block))
+
+	// the first block doesn't match the commit: it's the bad one
+	badBlock := &types.Block{Header: types.Header{ChainID: chainID, Height: 1, NumTxs: 1}}
+	vb = verifyBlock(chainID, vals, badBlock, next)
+	assert.Error(t, vb.err)
+	assert.EqualValues(t, 1, badBlockHeight(chainID, vals, badBlock, next))
+
+	// the commit isn't signed by the validators: the second block is the bad one
+	otherVals, otherPrivVals := types.RandValidatorSet(4, 10)
+	_, badNext := makeCommittedBlocks(t, chainID, otherVals, otherPrivVals)
+	vb = verifyBlock(chainID, vals, block, badNext)
+	assert.Error(t, vb.err)
+	assert.EqualValues(t, 2, badBlockHeight(chainID, vals, block, badNext))
+}
+
+func TestExpectedValidators(t *testing.T) {
+	vals, _ := types.RandValidatorSet(4, 10)
+	nextVals, _ := types.RandValidatorSet(4, 10)
+	otherVals, _ := types.RandValidatorSet(4, 10)
+	state := sm.State{Validators: vals, NextValidators: nextVals}
+
+	blocks := []*types.Block{
+		{Header: types.Header{Height: 1, ValidatorsHash: vals.Hash()}},
+		{Header: types.Header{Height: 2, ValidatorsHash: nextVals.Hash()}},
+		{Header: types.Header{Height: 3, ValidatorsHash: nextVals.Hash()}},
+		{Header: types.Header{Height: 4, ValidatorsHash: otherVals.Hash()}},
+	}
+	assert.Equal(t, vals, expectedValidators(state, blocks, 0))
+	assert.Equal(t, nextVals, expectedValidators(state, blocks, 1))
+	assert.Equal(t, nextVals, expectedValidators(state, blocks, 2))
+	assert.Nil(t, expectedValidators(state, blocks, 3))
+}
@@ -18,6 +18,8 @@ There are three types of information stored:
  - Block part:  Parts of each block, aggregated w/ PartSet
  - Commit:      The commit part of each block, for gossiping precommit votes
 
+The blocks below the base height may have been pruned (see PruneBlocks).
+
 Currently the precommit signatures are duplicated in the Block parts as
 well as the Commit.  In the future this may change, perhaps by moving
 the Commit data outside the Block. (TODO)
@@ -29,6 +31,7 @@ type BlockStore struct {
 	db dbm.DB
 
 	mtx    sync.RWMutex
+	base   int64
 	height int64
 }
 
@@ -36,12 +39,25 @@ type BlockStore struct {
 // initialized to the last height that was committed to the DB.
 func NewBlockStore(db dbm.DB) *BlockStore {
 	bsjson := LoadBlockStoreStateJSON(db)
+	// stores saved before pruning was added start at the first block
+	if bsjson.Base == 0 && bsjson.Height > 0 {
+		bsjson.Base = 1
+	}
 	return &BlockStore{
+		base:   bsjson.Base,
 		height: bsjson.Height,
 		db:     db,
 	}
 }
 
+// Base returns the first known contiguous block height, or 0 for empty block
+// stores.
+func (bs *BlockStore) Base() int64 {
+	bs.mtx.RLock()
+	defer bs.mtx.RUnlock()
+	return bs.base
+}
+
 // Height returns the last known contiguous block height.
 func (bs *BlockStore) Height() int64 {
 	bs.mtx.RLock()
@@ -147,7 +163,9 @@ func (bs *BlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, s
 		cmn.PanicSanity("BlockStore can only save a non-nil block")
 	}
 	height := block.Height
-	if g, w := height, bs.Height()+1; g != w {
+	// an empty store takes any first block, e.g. the one after the snapshot
+	// height of a node bootstrapped by state sync
+	if g, w := height, bs.Height()+1; bs.Base() > 0 && g != w {
 		cmn.PanicSanity(fmt.Sprintf("BlockStore can only save contiguous blocks. Wanted %v, got %v", w, g))
 	}
 	if !blockParts.IsComplete() {
@@ -175,19 +193,84 @@ func (bs *BlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, s
 	bs.db.Set(calcSeenCommitKey(height), seenCommitBytes)
 
 	// Save new BlockStoreStateJSON descriptor
-	BlockStoreStateJSON{Height: height}.Save(bs.db)
-
-	// Done!
 	bs.mtx.Lock()
+	if bs.base == 0 {
+		bs.base = height
+	}
 	bs.height = height
 	bs.mtx.Unlock()
+	bs.saveState()
 
 	// Flush
 	bs.db.SetSync(nil, nil)
 }
 
+// SaveSeenCommit saves a seen commit for a height without its block, like
+// the commit of the snapshot height of a node bootstrapped by state sync.
+func (bs *BlockStore) SaveSeenCommit(height int64, seenCommit *types.Commit) {
+	seenCommitBytes := cdc.MustMarshalBinaryBare(seenCommit)
+	bs.db.SetSync(calcSeenCommitKey(height), seenCommitBytes)
+}
+
+// PruneBlocks removes the blocks below the given height (their metas, parts
+// and commits), and returns the number of blocks pruned. The base height is
+// moved before the blocks are deleted, so they're never loaded half removed.
+func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
+	if height <= 0 {
+		return 0, fmt.Errorf("height must be greater than 0")
+	}
+	bs.mtx.RLock()
+	base, storeHeight := bs.base, bs.height
+	bs.mtx.RUnlock()
+	if height > storeHeight {
+		return 0, fmt.Errorf("cannot prune beyond the latest height %v", storeHeight)
+	}
+	if height < base {
+		return 0, fmt.Errorf("cannot prune to height %v, it is lower than the base height %v", height, base)
+	}
+
+	flush := func(batch dbm.Batch, base int64) {
+		bs.mtx.Lock()
+		bs.base = base
+		bs.mtx.Unlock()
+		bs.saveState()
+		batch.WriteSync()
+	}
+
+	pruned := uint64(0)
+	batch := bs.db.NewBatch()
+	for h := base; h < height; h++ {
+		meta := bs.LoadBlockMeta(h)
+		if meta == nil { // already pruned, e.g. before a crash
+			continue
+		}
+		batch.Delete(calcBlockMetaKey(h))
+		batch.Delete(calcBlockCommitKey(h))
+		batch.Delete(calcSeenCommitKey(h))
+		for i := 0; i < meta.BlockID.PartsHeader.Total; i++ {
+			batch.Delete(calcBlockPartKey(h, i))
+		}
+		pruned++
+
+		// flush every 1000 blocks, to keep batches small
+		if pruned%1000 == 0 {
+			flush(batch, h+1)
+			batch = bs.db.NewBatch()
+		}
+	}
+	flush(batch, height)
+	return pruned, nil
+}
+
+func (bs *BlockStore) saveState() {
+	bs.mtx.RLock()
+	bsjson := BlockStoreStateJSON{Base: bs.base, Height: bs.height}
+	bs.mtx.RUnlock()
+	bsjson.Save(bs.db)
+}
+
 func (bs *BlockStore) saveBlockPart(height int64, index int, part *types.Part) {
-	if height != bs.Height()+1 {
+	if bs.Base() > 0 && height != bs.Height()+1 {
 		cmn.PanicSanity(fmt.Sprintf("BlockStore can only save contiguous blocks. Wanted %v, got %v", bs.Height()+1, height))
 	}
 	partBytes := cdc.MustMarshalBinaryBare(part)
@@ -217,6 +300,7 @@ func calcSeenCommitKey(height int64) []byte {
 var blockStoreKey = []byte("blockStore")
 
 type BlockStoreStateJSON struct {
+	Base   int64 `json:"base"`
 	Height int64 `json:"height"`
 }
 
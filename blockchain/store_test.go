@@ -61,6 +61,7 @@ func TestNewBlockStore(t *testing.T) {
 	db.Set(blockStoreKey, []byte(`{"height": "10000"}`))
 	bs := NewBlockStore(db)
 	require.Equal(t, int64(10000), bs.Height(), "failed to properly parse blockstore")
+	require.Equal(t, int64(1), bs.Base(), "stores without a base start at the first block")
 
 	panicCausers := []struct {
 		data    []byte
@@ -241,6 +242,10 @@ func TestBlockStoreSaveLoadBlock(t *testing.T) {
 
 	for i, tuple := range tuples {
 		bs, db := freshBlockStore()
+		if tuple.block != nil && tuple.block.Height > 1 {
+			// an empty store takes any first block
+			bs.SaveBlock(newBlock(header1, commitAtH10), validPartSet, seenCommit1)
+		}
 		// SaveBlock
 		res, err, panicErr := doFn(func() (interface{}, error) {
 			bs.SaveBlock(tuple.block, tuple.parts, tuple.seenCommit)
@@ -391,6 +396,87 @@ func TestBlockFetchAtHeight(t *testing.T) {
 	require.Nil(t, blockAtHeightPlus2, "expecting an unsuccessful load of Height()+2")
 }
 
+func TestPruneBlocks(t *testing.T) {
+	state, bs, cleanup := makeStateAndBlockStore(log.NewTMLogger(new(bytes.Buffer)))
+	defer cleanup()
+	assert.EqualValues(t, 0, bs.Base())
+	assert.EqualValues(t, 0, bs.Height())
+
+	_, err := bs.PruneBlocks(1)
+	require.Error(t, err, "nothing to prune in an empty store")
+
+	// make more than 1000 blocks, to test batch flushing
+	for h := int64(1); h <= 1500; h++ {
+		block := makeBlock(h, state, new(types.Commit))
+		partSet := block.MakePartSet(2)
+		seenCommit := makeTestCommit(h, tmtime.Now())
+		bs.SaveBlock(block, partSet, seenCommit)
+	}
+	assert.EqualValues(t, 1, bs.Base())
+	assert.EqualValues(t, 1500, bs.Height())
+
+	pruned, err := bs.PruneBlocks(1200)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1199, pruned)
+	assert.EqualValues(t, 1200, bs.Base())
+	assert.EqualValues(t, 1500, bs.Height())
+
+	// the base is persisted
+	assert.EqualValues(t, BlockStoreStateJSON{Base: 1200, Height: 1500}, LoadBlockStoreStateJSON(bs.db))
+	assert.EqualValues(t, 1200, NewBlockStore(bs.db).Base())
+
+	require.NotNil(t, bs.LoadBlock(1200))
+	require.Nil(t, bs.LoadBlock(1199))
+	require.Nil(t, bs.LoadBlockMeta(1199))
+	require.Nil(t, bs.LoadBlockCommit(1199))
+	require.Nil(t, bs.LoadSeenCommit(1199))
+	require.Nil(t, bs.LoadBlockPart(1199, 0))
+	require.Nil(t, bs.LoadBlock(1))
+
+	// pruning to the base is a noop
+	pruned, err = bs.PruneBlocks(1200)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, pruned)
+
+	// heights below the base or above the latest height are rejected
+	_, err = bs.PruneBlocks(1100)
+	require.Error(t, err)
+	_, err = bs.PruneBlocks(1501)
+	require.Error(t, err)
+
+	// the latest block can't be pruned
+	pruned, err = bs.PruneBlocks(1500)
+	require.NoError(t, err)
+	assert.EqualValues(t, 300, pruned)
+	assert.EqualValues(t, 1500, bs.Base())
+	require.NotNil(t, bs.LoadBlock(1500))
+}
+
+func TestBootstrappedBlockStore(t *testing.T) {
+	state, bs, cleanup := makeStateAndBlockStore(log.NewTMLogger(new(bytes.Buffer)))
+	defer cleanup()
+
+	// a node restored from a snapshot at height 100 has only its seen commit
+	seenCommit := makeTestCommit(100, tmtime.Now())
+	bs.SaveSeenCommit(100, seenCommit)
+	assert.EqualValues(t, 0, bs.Height())
+	require.NotNil(t, bs.LoadSeenCommit(100))
+	require.Nil(t, bs.LoadBlock(100))
+
+	// and continues from the next block
+	block := makeBlock(101, state, new(types.Commit))
+	bs.SaveBlock(block, block.MakePartSet(2), makeTestCommit(101, tmtime.Now()))
+	assert.EqualValues(t, 101, bs.Base())
+	assert.EqualValues(t, 101, bs.Height())
+	require.NotNil(t, bs.LoadBlock(101))
+
+	// but only with contiguous blocks
+	block = makeBlock(103, state, new(types.Commit))
+	require.Panics(t, func() {
+		bs.SaveBlock(block, block.MakePartSet(2), makeTestCommit(103, tmtime.Now()))
+	})
+}
+
 func doFn(fn func() (interface{}, error)) (res interface{}, err error, panicErr error) {
 	defer func() {
 		if r := recover(); r != nil {
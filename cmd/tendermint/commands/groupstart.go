@@ -18,25 +18,30 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
-	"github.com/tendermint/tendermint/tomlconfig"
+	"github.com/spf13/viper"
+
+	cfg "github.com/tendermint/tendermint/config"
 )
+
 var confpath string
+
 // groupstartCmd represents the groupstart command
 var groupstartCmd = &cobra.Command{
 	Use:   "groupstart",
-	Short: "A brief description of your command",
-	Long: `A longer description that spans multiple lines and likely contains examples
-and usage of using your command. For example:
-
-Cobra is a CLI library for Go that empowers applications.
-This application is a tool to generate the needed files
-to quickly create a Cobra application.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if confpath == "" {
-			cmd.HelpFunc()(cmd, args)
-		} else {
-			getGroupId(confpath)
+	Short: "Show the mempool groups this node runs",
+	Long: `Show the mempool groups configured by the [[mempool.groups]] entries of
+the node's config file, or of the file given with --confpath, along with
+their settings.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		conf := config
+		if confpath != "" {
+			var err error
+			if conf, err = loadConfigFile(confpath); err != nil {
+				return err
+			}
 		}
+		printGroups(conf.Mempool)
+		return nil
 	},
 }
 
@@ -55,8 +60,26 @@ func init() {
 	// groupstartCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
 }
 
-func getGroupId(confpath string)  {
-	// set up some defaults
-	cfg := tomlconfig.TomlDefaultConfig(confpath)
-	fmt.Println("Group id:",cfg.BaseConfig.Group)
+// loadConfigFile reads and validates the config file at path.
+func loadConfigFile(path string) (*cfg.Config, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+	conf := cfg.DefaultConfig(0)
+	if err := v.Unmarshal(conf); err != nil {
+		return nil, err
+	}
+	if err := conf.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("Error in config file: %v", err)
+	}
+	return conf, nil
+}
+
+func printGroups(conf *cfg.MempoolConfig) {
+	for _, group := range conf.GroupConfigs() {
+		fmt.Printf("Group %d (%s): size=%d cache_size=%d recheck=%v broadcast=%v wal_dir=%q\n",
+			group.ID, group.Name, group.Size, group.CacheSize, group.Recheck, group.Broadcast, group.WalPath)
+	}
 }
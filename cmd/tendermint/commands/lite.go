@@ -3,10 +3,14 @@ package commands
 import (
 	"fmt"
 	"net/url"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/lite"
+	lclient "github.com/tendermint/tendermint/lite/client"
 	"github.com/tendermint/tendermint/lite/proxy"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 )
@@ -32,6 +36,9 @@ var (
 	home               string
 	maxOpenConnections int
 	cacheSize          int
+	groupValidators    bool
+	trustingPeriod     time.Duration
+	witnessAddrs       string
 )
 
 func init() {
@@ -41,6 +48,9 @@ func init() {
 	LiteCmd.Flags().StringVar(&home, "home-dir", ".tendermint-lite", "Specify the home directory")
 	LiteCmd.Flags().IntVar(&maxOpenConnections, "max-open-connections", 900, "Maximum number of simultaneous connections (including WebSocket).")
 	LiteCmd.Flags().IntVar(&cacheSize, "cache-size", 10, "Specify the memory trust store cache size")
+	LiteCmd.Flags().BoolVar(&groupValidators, "group-validators", false, "Verify commits against the validators of the block's group (chains with group_validators set)")
+	LiteCmd.Flags().DurationVar(&trustingPeriod, "trusting-period", 168*time.Hour, "How long a verified header is trusted for; should be less than the unbonding period (0 to trust forever)")
+	LiteCmd.Flags().StringVar(&witnessAddrs, "witnesses", "", "Comma-separated addresses of Tendermint nodes to cross-check verified headers with")
 }
 
 func ensureAddrHasSchemeOrDefaultToTCP(addr string) (string, error) {
@@ -78,6 +88,30 @@ func runProxy(cmd *cobra.Command, args []string) error {
 		return cmn.ErrorWrap(err, "constructing Verifier")
 	}
 	cert.SetLogger(logger)
+	cert.SetGroupValidators(groupValidators)
+	cert.SetTrustingPeriod(trustingPeriod)
+	if witnessAddrs != "" {
+		addrs := strings.Split(witnessAddrs, ",")
+		witnesses := make([]lite.Provider, len(addrs))
+		clients := make([]*rpcclient.HTTP, len(addrs))
+		for i, addr := range addrs {
+			addr, err := ensureAddrHasSchemeOrDefaultToTCP(strings.TrimSpace(addr))
+			if err != nil {
+				return err
+			}
+			clients[i] = rpcclient.NewHTTP(addr, "/websocket")
+			witnesses[i] = lclient.NewProvider(chainID, clients[i])
+		}
+		cert.SetWitnesses(func(r lite.ConflictReport) {
+			logger.Error("Conflicting headers, the chain forked or the node is attacking us",
+				"height", r.SignedHeader.Height, "witness", addrs[r.Witness], "duplicateVotes", r.DuplicateVotes)
+			// The witness can split the evidence against the validators who
+			// signed the header which isn't committed.
+			if _, err := clients[r.Witness].BroadcastEvidence(r.Evidence()); err != nil {
+				logger.Error("Failed to submit the conflicting headers", "witness", addrs[r.Witness], "err", err)
+			}
+		}, witnesses...)
+	}
 	sc := proxy.SecureClient(node, cert)
 
 	logger.Info("Starting proxy...")
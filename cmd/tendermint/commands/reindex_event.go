@@ -0,0 +1,225 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	bc "github.com/tendermint/tendermint/blockchain"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	nm "github.com/tendermint/tendermint/node"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/state/txindex/null"
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	// progress is saved, and reported, every reindexProgressInterval blocks
+	reindexProgressInterval = 100
+
+	reindexProgressFile = "reindex_event.json"
+)
+
+// ReindexEventCmd rebuilds the tx and block indexes from the blocks and ABCI
+// responses stored by the node.
+var ReindexEventCmd = &cobra.Command{
+	Use:   "reindex-event",
+	Short: "Rebuild the tx and block indexes from the stored blocks",
+	Long: `Reindex the txs and blocks between --start-height and --end-height
+(by default, all the stored blocks) with the indexer and the tags of the
+[tx_index] config, e.g. after changing index_tags or the indexer.
+
+The node must be stopped. Progress is saved in data/reindex_event.json: if
+the command is interrupted, running it again with the same heights continues
+where it stopped.
+
+Entries of tags no longer indexed are not removed: delete the index
+(data/tx_index.db and data/block_index.db, or data/tx_index.sqlite) first to
+drop them.`,
+	RunE:         reindexEvent,
+	SilenceUsage: true,
+}
+
+var (
+	reindexStartHeight int64
+	reindexEndHeight   int64
+)
+
+func init() {
+	ReindexEventCmd.Flags().Int64Var(&reindexStartHeight, "start-height", 0, "First height to reindex (default: the lowest stored height)")
+	ReindexEventCmd.Flags().Int64Var(&reindexEndHeight, "end-height", 0, "Last height to reindex (default: the latest height)")
+}
+
+// reindexProgress is what has been reindexed of the requested heights.
+type reindexProgress struct {
+	StartHeight int64 `json:"start_height"`
+	EndHeight   int64 `json:"end_height"`
+	LastHeight  int64 `json:"last_height"`
+}
+
+func reindexEvent(cmd *cobra.Command, args []string) error {
+	txIndexer, blockIndexer, err := nm.CreateIndexers(config, nm.DefaultDBProvider)
+	if err != nil {
+		return err
+	}
+	if _, ok := txIndexer.(*null.TxIndex); ok {
+		return fmt.Errorf("indexing is disabled (tx_index.indexer = %q)", config.TxIndex.Indexer)
+	}
+
+	blockStoreDB, err := nm.DefaultDBProvider(&nm.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		return err
+	}
+	defer blockStoreDB.Close()
+	stateDB, err := nm.DefaultDBProvider(&nm.DBContext{ID: "state", Config: config})
+	if err != nil {
+		return err
+	}
+	defer stateDB.Close()
+
+	return reindexEvents(bc.NewBlockStore(blockStoreDB), stateDB, txIndexer, blockIndexer,
+		reindexStartHeight, reindexEndHeight, filepath.Join(config.DBDir(), reindexProgressFile))
+}
+
+// reindexEvents indexes the txs and blocks from startHeight to endHeight,
+// 0 meaning the lowest stored height (the base of blockStore, which is above
+// 1 once blocks are pruned) and the latest height respectively. Progress is saved in
+// progressFile, and the reindexing resumes from it when it holds the same
+// heights. The file is removed once done.
+func reindexEvents(blockStore sm.BlockStore, stateDB dbm.DB,
+	txIndexer txindex.TxIndexer, blockIndexer txindex.BlockIndexer,
+	startHeight, endHeight int64, progressFile string) error {
+
+	// blocks are stored before being executed, only the executed ones have
+	// ABCI responses
+	lastHeight := sm.LoadState(stateDB).LastBlockHeight
+	base := cmn.MaxInt64(blockStore.Base(), 1)
+	if startHeight == 0 {
+		startHeight = base
+	}
+	if endHeight == 0 {
+		endHeight = lastHeight
+	}
+	if startHeight < 1 || startHeight > endHeight {
+		return fmt.Errorf("invalid heights: start height %d, end height %d", startHeight, endHeight)
+	}
+	if startHeight < base {
+		return fmt.Errorf("start height %d is below the lowest stored height %d", startHeight, base)
+	}
+	if endHeight > lastHeight {
+		return fmt.Errorf("end height %d is above the latest height %d", endHeight, lastHeight)
+	}
+
+	progress := reindexProgress{StartHeight: startHeight, EndHeight: endHeight, LastHeight: startHeight - 1}
+	if saved, err := loadReindexProgress(progressFile); err != nil {
+		return err
+	} else if saved != nil && saved.StartHeight == startHeight && saved.EndHeight == endHeight {
+		progress = *saved
+		logger.Info("Resuming reindexing", "height", progress.LastHeight+1, "endHeight", endHeight)
+	}
+
+	started := time.Now()
+	for height := progress.LastHeight + 1; height <= endHeight; height++ {
+		if err := reindexHeight(blockStore, stateDB, txIndexer, blockIndexer, height); err != nil {
+			return err
+		}
+		progress.LastHeight = height
+
+		if height%reindexProgressInterval == 0 || height == endHeight {
+			if err := saveReindexProgress(progressFile, progress); err != nil {
+				return err
+			}
+			done := height - startHeight + 1
+			logger.Info("Reindexed blocks", "height", height, "endHeight", endHeight,
+				"progress", fmt.Sprintf("%.1f%%", 100*float64(done)/float64(endHeight-startHeight+1)),
+				"elapsed", time.Since(started).Round(time.Second))
+		}
+	}
+
+	logger.Info("Reindexing done", "startHeight", startHeight, "endHeight", endHeight)
+	if err := os.Remove(progressFile); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// reindexHeight indexes the block at height and its txs, with the tags of
+// their ABCI responses, like the IndexerService does for new blocks.
+func reindexHeight(blockStore sm.BlockStore, stateDB dbm.DB,
+	txIndexer txindex.TxIndexer, blockIndexer txindex.BlockIndexer, height int64) error {
+
+	block := blockStore.LoadBlock(height)
+	if block == nil {
+		return fmt.Errorf("block %d not found in the block store", height)
+	}
+	abciResponses, err := sm.LoadABCIResponses(stateDB, height)
+	if err != nil {
+		return err
+	}
+	if len(abciResponses.DeliverTx) != len(block.Data.Txs) {
+		return fmt.Errorf("block %d has %d txs but %d DeliverTx responses",
+			height, len(block.Data.Txs), len(abciResponses.DeliverTx))
+	}
+
+	blockHeader := types.EventDataNewBlockHeader{Header: block.Header}
+	if abciResponses.BeginBlock != nil {
+		blockHeader.ResultBeginBlock = *abciResponses.BeginBlock
+	}
+	if abciResponses.EndBlock != nil {
+		blockHeader.ResultEndBlock = *abciResponses.EndBlock
+	}
+	if err := blockIndexer.Index(blockHeader); err != nil {
+		return fmt.Errorf("failed to index block %d: %v", height, err)
+	}
+
+	batch := txindex.NewBatch(block.NumTxs)
+	for i, tx := range block.Data.Txs {
+		txResult := &types.TxResult{
+			Height: block.Height,
+			Index:  uint32(i),
+			Tx:     tx,
+			Group:  block.TxGroup(i),
+		}
+		// amino decodes empty responses as nil
+		if res := abciResponses.DeliverTx[i]; res != nil {
+			txResult.Result = *res
+		}
+		if err := batch.Add(txResult); err != nil {
+			return err
+		}
+	}
+	if err := txIndexer.AddBatch(batch); err != nil {
+		return fmt.Errorf("failed to index the txs of block %d: %v", height, err)
+	}
+	return nil
+}
+
+// loadReindexProgress returns the saved progress, or nil if there is none.
+func loadReindexProgress(file string) (*reindexProgress, error) {
+	bz, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	progress := new(reindexProgress)
+	if err := json.Unmarshal(bz, progress); err != nil {
+		return nil, fmt.Errorf("failed to read the reindexing progress from %s: %v", file, err)
+	}
+	return progress, nil
+}
+
+func saveReindexProgress(file string, progress reindexProgress) error {
+	bz, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+	return cmn.WriteFileAtomic(file, bz, 0600)
+}
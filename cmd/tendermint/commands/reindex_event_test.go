@@ -0,0 +1,102 @@
+package commands
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/state/txindex/kv"
+	"github.com/tendermint/tendermint/types"
+)
+
+type reindexBlockStore struct {
+	sm.BlockStore
+	base   int64
+	blocks map[int64]*types.Block
+}
+
+func (bs reindexBlockStore) Base() int64                         { return bs.base }
+func (bs reindexBlockStore) LoadBlock(height int64) *types.Block { return bs.blocks[height] }
+
+func TestReindexEvents(t *testing.T) {
+	dir, err := ioutil.TempDir("", "reindex_event")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	progressFile := filepath.Join(dir, reindexProgressFile)
+
+	const lastHeight = 250
+	blockStore := reindexBlockStore{base: 1, blocks: make(map[int64]*types.Block)}
+	stateDB := dbm.NewMemDB()
+	for height := int64(1); height <= lastHeight; height++ {
+		txs := []types.Tx{types.Tx(fmt.Sprintf("tx%d-0", height)), types.Tx(fmt.Sprintf("tx%d-1", height))}
+		blockStore.blocks[height] = types.MakeBlock(height, txs, nil, nil)
+		sm.SaveABCIResponses(stateDB, height, &sm.ABCIResponses{
+			DeliverTx: []*abci.ResponseDeliverTx{
+				{Tags: []cmn.KVPair{{Key: []byte("app.creator"), Value: []byte("Cosmoshi")}}},
+				{},
+			},
+			BeginBlock: &abci.ResponseBeginBlock{},
+			EndBlock: &abci.ResponseEndBlock{Tags: []cmn.KVPair{
+				{Key: []byte("app.parity"), Value: []byte(fmt.Sprintf("%d", height%2))},
+			}},
+		})
+	}
+	sm.SaveState(stateDB, sm.State{LastBlockHeight: lastHeight})
+
+	txIndexer := kv.NewTxIndex(dbm.NewMemDB(), kv.IndexAllTags())
+	blockIndexer := kv.NewBlockIndex(dbm.NewMemDB(), kv.BlockIndexAllTags())
+
+	// invalid heights
+	for _, heights := range [][2]int64{{-1, 0}, {5, 4}, {1, lastHeight + 1}} {
+		err := reindexEvents(blockStore, stateDB, txIndexer, blockIndexer, heights[0], heights[1], progressFile)
+		assert.Error(t, err, "heights %v", heights)
+	}
+
+	// an interrupted reindexing resumes from the saved progress
+	require.NoError(t, saveReindexProgress(progressFile, reindexProgress{StartHeight: 1, EndHeight: lastHeight, LastHeight: 100}))
+	require.NoError(t, reindexEvents(blockStore, stateDB, txIndexer, blockIndexer, 0, 0, progressFile))
+
+	res, err := txIndexer.Search(query.MustParse("app.creator = 'Cosmoshi'"), txindex.SearchOptions{Limit: 100})
+	require.NoError(t, err)
+	assert.Equal(t, lastHeight-100, res.Total)
+	assert.EqualValues(t, 101, res.Txs[0].Height)
+
+	blockRes, err := blockIndexer.Search(query.MustParse("app.parity = 1"), txindex.SearchOptions{Limit: 100})
+	require.NoError(t, err)
+	assert.Equal(t, (lastHeight-100)/2, blockRes.Total)
+
+	_, err = os.Stat(progressFile)
+	assert.True(t, os.IsNotExist(err), "progress file should be removed once done")
+
+	// without saved progress, all the heights are reindexed
+	require.NoError(t, reindexEvents(blockStore, stateDB, txIndexer, blockIndexer, 0, 0, progressFile))
+	res, err = txIndexer.Search(query.MustParse("app.creator = 'Cosmoshi'"), txindex.SearchOptions{Limit: 100})
+	require.NoError(t, err)
+	assert.Equal(t, lastHeight, res.Total)
+
+	txResult, err := txIndexer.Get(types.Tx("tx7-1").Hash())
+	require.NoError(t, err)
+	require.NotNil(t, txResult)
+	assert.EqualValues(t, 7, txResult.Height)
+	assert.EqualValues(t, 1, txResult.Index)
+
+	// once blocks are pruned, the reindexing starts from the lowest stored height
+	blockStore.base = 201
+	for height := int64(1); height < blockStore.base; height++ {
+		delete(blockStore.blocks, height)
+	}
+	err = reindexEvents(blockStore, stateDB, txIndexer, blockIndexer, 100, 0, progressFile)
+	assert.Error(t, err, "pruned heights can't be reindexed")
+	require.NoError(t, reindexEvents(blockStore, stateDB, txIndexer, blockIndexer, 0, 0, progressFile))
+}
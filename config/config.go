@@ -1,9 +1,12 @@
 package config
 
 import (
+	"encoding/hex"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -19,6 +22,15 @@ const (
 	LogFormatPlain = "plain"
 	// LogFormatJSON is a format for json output
 	LogFormatJSON = "json"
+
+	// GroupSelectorRoundRobin gives blocks to mempool groups in strict rotation
+	GroupSelectorRoundRobin = "round_robin"
+	// GroupSelectorOldestTx gives blocks to the groups with txs in the last block in rotation
+	GroupSelectorOldestTx = "oldest_tx"
+	// GroupSelectorBacklog picks a group at random, weighted by its txs in the last block
+	GroupSelectorBacklog = "backlog"
+	// GroupSelectorABCI lets the application choose the group in EndBlock
+	GroupSelectorABCI = "abci"
 )
 
 // NOTE: Most of the structs & relevant comments + the
@@ -68,6 +80,7 @@ type Config struct {
 	P2P             *P2PConfig             `mapstructure:"p2p"`
 	Mempool         *MempoolConfig         `mapstructure:"mempool"`
 	Consensus       *ConsensusConfig       `mapstructure:"consensus"`
+	StateSync       *StateSyncConfig       `mapstructure:"statesync"`
 	TxIndex         *TxIndexConfig         `mapstructure:"tx_index"`
 	Instrumentation *InstrumentationConfig `mapstructure:"instrumentation"`
 }
@@ -80,6 +93,7 @@ func DefaultConfig(group int32) *Config {
 		P2P:             DefaultP2PConfig(),
 		Mempool:         DefaultMempoolConfig(group),
 		Consensus:       DefaultConsensusConfig(),
+		StateSync:       DefaultStateSyncConfig(),
 		TxIndex:         DefaultTxIndexConfig(),
 		Instrumentation: DefaultInstrumentationConfig(),
 	}
@@ -118,6 +132,7 @@ func TestConfig() *Config {
 		P2P:             TestP2PConfig(),
 		Mempool:         mempools[0],
 		Consensus:       TestConsensusConfig(),
+		StateSync:       TestStateSyncConfig(),
 		TxIndex:         TestTxIndexConfig(),
 		Instrumentation: TestInstrumentationConfig(),
 	}
@@ -146,10 +161,15 @@ func (cfg *Config) ValidateBasic() error {
 	if err := cfg.P2P.ValidateBasic(); err != nil {
 		return errors.Wrap(err, "Error in [p2p] section")
 	}
-
+	if err := cfg.Mempool.ValidateBasic(); err != nil {
+		return errors.Wrap(err, "Error in [mempool] section")
+	}
 	if err := cfg.Consensus.ValidateBasic(); err != nil {
 		return errors.Wrap(err, "Error in [consensus] section")
 	}
+	if err := cfg.StateSync.ValidateBasic(); err != nil {
+		return errors.Wrap(err, "Error in [statesync] section")
+	}
 	return errors.Wrap(
 		cfg.Instrumentation.ValidateBasic(),
 		"Error in [instrumentation] section",
@@ -218,6 +238,11 @@ type BaseConfig struct {
 	// so the app can decide if we should keep the connection or not
 	FilterPeers bool `toml:"filter_peers" mapstructure:"filter_peers"` // false
 
+	// Number of recent blocks to keep, older blocks and states are pruned.
+	// 0 keeps all the blocks, unless the app prunes them with the
+	// RetainHeight of its Commit responses. The blocks within the evidence
+	// max age are always kept.
+	RetainBlocks int64 `toml:"retain_blocks" mapstructure:"retain_blocks"`
 }
 
 // DefaultBaseConfig returns a default base configuration for a Tendermint node
@@ -293,6 +318,9 @@ func (cfg BaseConfig) ValidateBasic() error {
 	default:
 		return errors.New("unknown log_format (must be 'plain' or 'json')")
 	}
+	if cfg.RetainBlocks < 0 {
+		return errors.New("retain_blocks can't be negative")
+	}
 	return nil
 }
 
@@ -351,6 +379,10 @@ type RPCConfig struct {
 	// Should be < {ulimit -Sn} - {MaxNumInboundPeers} - {MaxNumOutboundPeers} - {N of wal, db and other open files}
 	// 1024 - 40 - 10 - 50 = 924 = ~900
 	MaxOpenConnections int `toml:"max_open_connections" mapstructure:"max_open_connections"`
+
+	// Maximum size of request body, in bytes. Also limits the messages of the
+	// gRPC server and the total size of the txs of /broadcast_txs.
+	MaxBodyBytes int64 `toml:"max_body_bytes" mapstructure:"max_body_bytes"`
 }
 
 // DefaultRPCConfig returns a default configuration for the RPC server
@@ -365,6 +397,8 @@ func DefaultRPCConfig() *RPCConfig {
 
 		Unsafe:             false,
 		MaxOpenConnections: 900,
+
+		MaxBodyBytes: int64(1000000), // 1MB
 	}
 }
 
@@ -386,6 +420,9 @@ func (cfg *RPCConfig) ValidateBasic() error {
 	if cfg.MaxOpenConnections < 0 {
 		return errors.New("max_open_connections can't be negative")
 	}
+	if cfg.MaxBodyBytes < 0 {
+		return errors.New("max_body_bytes can't be negative")
+	}
 	return nil
 }
 
@@ -414,6 +451,10 @@ type P2PConfig struct {
 	// Address to listen for incoming connections
 	ListenAddress string `toml:"laddr" mapstructure:"laddr"`
 
+	// Address to listen for incoming WebSocket connections, over ws://,
+	// besides the tcp ones. Empty disables them.
+	WSListenAddress string `toml:"ws_laddr" mapstructure:"ws_laddr"`
+
 	// Address to advertise to peers for them to dial
 	ExternalAddress string `toml:"external_address" mapstructure:"external_address"`
 
@@ -477,6 +518,12 @@ type P2PConfig struct {
 	//HandshakeTimeout duration `toml:"handshake_timeout" mapstructure:"handshake_timeout"`//改为toml方式，需要使用自定义小写duration类型，该类型实现了TextUnmarshaler接口
 	//DialTimeout      duration `toml:"dial_timeout" mapstructure:"dial_timeout"`//改为toml方式，需要使用自定义小写duration类型，该类型实现了TextUnmarshaler接口
 
+	// Peers disconnected for a bad message while their trust score (0-100) is
+	// below this value are banned. 0 disables banning.
+	PeerBanThreshold int `toml:"peer_ban_threshold" mapstructure:"peer_ban_threshold"`
+	// Duration of the first ban of a peer, doubled for each following ban
+	PeerBanDuration time.Duration `toml:"peer_ban_duration" mapstructure:"peer_ban_duration"`
+
 	// Testing params.
 	// Force dial to fail
 	TestDialFail bool `toml:"test_dial_fail" mapstructure:"test_dial_fail"`
@@ -490,6 +537,7 @@ type P2PConfig struct {
 func DefaultP2PConfig() *P2PConfig {
 	return &P2PConfig{
 		ListenAddress:           "tcp://0.0.0.0:26656",
+		WSListenAddress:         "",
 		ExternalAddress:         "",
 		UPNP:                    false,
 		AddrBook:                defaultAddrBookPath,
@@ -505,6 +553,8 @@ func DefaultP2PConfig() *P2PConfig {
 		AllowDuplicateIP:        false,
 		HandshakeTimeout:        20 * time.Second,
 		DialTimeout:             3 * time.Second,
+		PeerBanThreshold:        20,
+		PeerBanDuration:         10 * time.Minute,
 		TestDialFail:            false,
 		TestFuzz:                false,
 		TestFuzzConfig:          DefaultFuzzConnConfig(),
@@ -548,6 +598,18 @@ func (cfg *P2PConfig) ValidateBasic() error {
 	if cfg.RecvRate < 0 {
 		return errors.New("recv_rate can't be negative")
 	}
+	if strings.HasPrefix(cfg.ListenAddress, "ws://") {
+		return errors.New("laddr can't be a ws:// address, use ws_laddr instead")
+	}
+	if cfg.WSListenAddress != "" && !strings.HasPrefix(cfg.WSListenAddress, "ws://") {
+		return errors.New("ws_laddr must be a ws:// address")
+	}
+	if cfg.PeerBanThreshold < 0 || cfg.PeerBanThreshold > 100 {
+		return errors.New("peer_ban_threshold must be between 0 and 100")
+	}
+	if cfg.PeerBanDuration < 0 {
+		return errors.New("peer_ban_duration can't be negative")
+	}
 	return nil
 }
 
@@ -581,7 +643,42 @@ type MempoolConfig struct {
 	WalPath   string `toml:"wal_dir" mapstructure:"wal_dir"`
 	Size      int    `toml:"size" mapstructure:"size"`
 	CacheSize int    `toml:"cache_size" mapstructure:"cache_size"`
-	Group     int32  `toml:"group" mapstructure:"group"`
+	// Txs are evicted once they have been in the mempool for TTLNumBlocks
+	// blocks or for TTLDuration. 0 disables the limit.
+	TTLNumBlocks int64         `toml:"ttl_num_blocks" mapstructure:"ttl_num_blocks"`
+	TTLDuration  time.Duration `toml:"ttl_duration" mapstructure:"ttl_duration"`
+	// Limits on the txs of a single sender, as returned by CheckTx.
+	// 0 disables the limit.
+	MaxTxsPerSender      int   `toml:"max_txs_per_sender" mapstructure:"max_txs_per_sender"`
+	MaxTxsBytesPerSender int64 `toml:"max_txs_bytes_per_sender" mapstructure:"max_txs_bytes_per_sender"`
+	// Maximum size of a single tx, in all the groups. 0 means the maximum
+	// size of a mempool message.
+	MaxTxBytes int `toml:"max_tx_bytes" mapstructure:"max_tx_bytes"`
+	// Bitmask of the groups to run besides group 0: bit i-1 enables group i.
+	// Deprecated: use Groups. In the config of a single mempool (see
+	// GroupConfig), it holds the id of that mempool's group.
+	Group int32 `toml:"group" mapstructure:"group"`
+
+	// Mempool groups run by this node, each with its own settings.
+	// If empty, the groups are taken from the Group bitmask and share the
+	// settings above.
+	Groups []*MempoolGroupConfig `toml:"groups" mapstructure:"groups"`
+}
+
+// MempoolGroupConfig defines the configuration of a single mempool group,
+// given as a [[mempool.groups]] entry.
+type MempoolGroupConfig struct {
+	ID                   int32         `toml:"id" mapstructure:"id"`
+	Name                 string        `toml:"name" mapstructure:"name"`
+	Recheck              bool          `toml:"recheck" mapstructure:"recheck"`
+	Broadcast            bool          `toml:"broadcast" mapstructure:"broadcast"`
+	WalPath              string        `toml:"wal_dir" mapstructure:"wal_dir"`
+	Size                 int           `toml:"size" mapstructure:"size"`
+	CacheSize            int           `toml:"cache_size" mapstructure:"cache_size"`
+	TTLNumBlocks         int64         `toml:"ttl_num_blocks" mapstructure:"ttl_num_blocks"`
+	TTLDuration          time.Duration `toml:"ttl_duration" mapstructure:"ttl_duration"`
+	MaxTxsPerSender      int           `toml:"max_txs_per_sender" mapstructure:"max_txs_per_sender"`
+	MaxTxsBytesPerSender int64         `toml:"max_txs_bytes_per_sender" mapstructure:"max_txs_bytes_per_sender"`
 }
 
 // DefaultMempoolConfig returns a default configuration for the Tendermint mempool
@@ -592,9 +689,10 @@ func DefaultMempoolConfig(group int32) *MempoolConfig {
 		WalPath:   "",
 		// Each signature verification takes .5ms, size reduced until we implement
 		// ABCI Recheck
-		Size:      5000,
-		CacheSize: 10000,
-		Group:     group,
+		Size:       5000,
+		CacheSize:  10000,
+		MaxTxBytes: 1024 * 1024, // 1MB
+		Group:      group,
 	}
 }
 
@@ -615,6 +713,95 @@ func (cfg *MempoolConfig) WalEnabled() bool {
 	return cfg.WalPath != ""
 }
 
+// GroupConfigs returns the groups to run, sorted by id. Without Groups
+// entries, it returns group 0 and the groups enabled in the Group bitmask,
+// all with the settings of the [mempool] section.
+func (cfg *MempoolConfig) GroupConfigs() []*MempoolGroupConfig {
+	var groups []*MempoolGroupConfig
+	if len(cfg.Groups) > 0 {
+		groups = make([]*MempoolGroupConfig, len(cfg.Groups))
+		copy(groups, cfg.Groups)
+	} else {
+		for i := int32(0); i <= 32; i++ {
+			if i != 0 && (cfg.Group>>uint32(i-1))&1 == 0 {
+				continue
+			}
+			groups = append(groups, cfg.defaultGroupConfig(i))
+		}
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].ID < groups[j].ID })
+	return groups
+}
+
+// OpenedGroupConfig returns the settings of a group opened while the node
+// runs: its [[mempool.groups]] entry if there is one, else the settings of
+// the [mempool] section. Only groups with an entry write a WAL, unless there
+// are no entries at all.
+func (cfg *MempoolConfig) OpenedGroupConfig(id int32) *MempoolGroupConfig {
+	for _, group := range cfg.Groups {
+		if group.ID == id {
+			return group
+		}
+	}
+	group := cfg.defaultGroupConfig(id)
+	if len(cfg.Groups) > 0 {
+		group.WalPath = ""
+	}
+	return group
+}
+
+// defaultGroupConfig returns the group with the settings of the [mempool]
+// section.
+func (cfg *MempoolConfig) defaultGroupConfig(id int32) *MempoolGroupConfig {
+	return &MempoolGroupConfig{
+		ID:                   id,
+		Name:                 fmt.Sprintf("group%d", id),
+		Recheck:              cfg.Recheck,
+		Broadcast:            cfg.Broadcast,
+		WalPath:              cfg.WalPath,
+		Size:                 cfg.Size,
+		CacheSize:            cfg.CacheSize,
+		TTLNumBlocks:         cfg.TTLNumBlocks,
+		TTLDuration:          cfg.TTLDuration,
+		MaxTxsPerSender:      cfg.MaxTxsPerSender,
+		MaxTxsBytesPerSender: cfg.MaxTxsBytesPerSender,
+	}
+}
+
+// GroupIDs returns the ids of the groups to run, in ascending order.
+func (cfg *MempoolConfig) GroupIDs() []int32 {
+	groups := cfg.GroupConfigs()
+	ids := make([]int32, len(groups))
+	for i, group := range groups {
+		ids[i] = group.ID
+	}
+	return ids
+}
+
+// GroupConfig returns the configuration of the mempool of the given group.
+// Its Group field holds the group id.
+func (cfg *MempoolConfig) GroupConfig(group *MempoolGroupConfig) *MempoolConfig {
+	walPath := group.WalPath
+	if walPath != "" && len(cfg.Groups) == 0 && group.ID != 0 {
+		// legacy groups share wal_dir, keep their WALs apart
+		walPath = filepath.Join(walPath, fmt.Sprintf("group%d", group.ID))
+	}
+	return &MempoolConfig{
+		RootDir:              cfg.RootDir,
+		Recheck:              group.Recheck,
+		Broadcast:            group.Broadcast,
+		WalPath:              walPath,
+		Size:                 group.Size,
+		CacheSize:            group.CacheSize,
+		TTLNumBlocks:         group.TTLNumBlocks,
+		TTLDuration:          group.TTLDuration,
+		MaxTxsPerSender:      group.MaxTxsPerSender,
+		MaxTxsBytesPerSender: group.MaxTxsBytesPerSender,
+		MaxTxBytes:           cfg.MaxTxBytes,
+		Group:                group.ID,
+	}
+}
+
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *MempoolConfig) ValidateBasic() error {
@@ -624,6 +811,84 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 	if cfg.CacheSize < 0 {
 		return errors.New("cache_size can't be negative")
 	}
+	if cfg.TTLNumBlocks < 0 {
+		return errors.New("ttl_num_blocks can't be negative")
+	}
+	if cfg.TTLDuration < 0 {
+		return errors.New("ttl_duration can't be negative")
+	}
+	if cfg.MaxTxsPerSender < 0 {
+		return errors.New("max_txs_per_sender can't be negative")
+	}
+	if cfg.MaxTxsBytesPerSender < 0 {
+		return errors.New("max_txs_bytes_per_sender can't be negative")
+	}
+	if cfg.MaxTxBytes < 0 {
+		return errors.New("max_tx_bytes can't be negative")
+	}
+	if len(cfg.Groups) == 0 {
+		return nil
+	}
+	if cfg.Group != 0 {
+		return errors.New("group and [[mempool.groups]] can't be used together")
+	}
+	ids := make(map[int32]bool, len(cfg.Groups))
+	names := make(map[string]bool, len(cfg.Groups))
+	wals := make(map[string]bool, len(cfg.Groups))
+	for i, group := range cfg.Groups {
+		if group == nil {
+			return fmt.Errorf("groups[%d] is empty", i)
+		}
+		if err := group.ValidateBasic(); err != nil {
+			return errors.Wrapf(err, "error in groups[%d]", i)
+		}
+		if ids[group.ID] {
+			return fmt.Errorf("duplicate group id %d", group.ID)
+		}
+		ids[group.ID] = true
+		if names[group.Name] {
+			return fmt.Errorf("duplicate group name %q", group.Name)
+		}
+		names[group.Name] = true
+		if group.WalPath != "" {
+			if wals[group.WalPath] {
+				return fmt.Errorf("group %d shares its wal_dir %q with another group", group.ID, group.WalPath)
+			}
+			wals[group.WalPath] = true
+		}
+	}
+	if !ids[0] {
+		return errors.New("group 0 is missing from [[mempool.groups]]")
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation of a single group.
+func (cfg *MempoolGroupConfig) ValidateBasic() error {
+	if cfg.ID < 0 {
+		return errors.New("id can't be negative")
+	}
+	if cfg.Name == "" {
+		return errors.New("name can't be empty")
+	}
+	if cfg.Size < 0 {
+		return errors.New("size can't be negative")
+	}
+	if cfg.CacheSize < 0 {
+		return errors.New("cache_size can't be negative")
+	}
+	if cfg.TTLNumBlocks < 0 {
+		return errors.New("ttl_num_blocks can't be negative")
+	}
+	if cfg.TTLDuration < 0 {
+		return errors.New("ttl_duration can't be negative")
+	}
+	if cfg.MaxTxsPerSender < 0 {
+		return errors.New("max_txs_per_sender can't be negative")
+	}
+	if cfg.MaxTxsBytesPerSender < 0 {
+		return errors.New("max_txs_bytes_per_sender can't be negative")
+	}
 	return nil
 }
 
@@ -672,6 +937,11 @@ type ConsensusConfig struct {
 	SkipTimeoutCommit bool `toml:"skip_timeout_commit" mapstructure:"skip_timeout_commit"`
 	// EmptyBlocks mode and possible interval between empty blocks
 	CreateEmptyBlocks bool `toml:"create_empty_blocks" mapstructure:"create_empty_blocks"`
+
+	// Policy deciding which mempool group fills each proposal block:
+	// round_robin | oldest_tx | backlog | abci
+	// All the validators of a chain must use the same policy.
+	GroupSelector string `toml:"group_selector" mapstructure:"group_selector"`
 }
 
 // DefaultConsensusConfig returns a default configuration for the consensus service
@@ -692,6 +962,7 @@ func DefaultConsensusConfig() *ConsensusConfig {
 		PeerGossipSleepDuration:     100 * time.Millisecond,
 		PeerQueryMaj23SleepDuration: 2000 * time.Millisecond,
 		BlockTimeIota:               1000 * time.Millisecond,
+		GroupSelector:               GroupSelectorRoundRobin,
 	}
 }
 
@@ -803,6 +1074,106 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.BlockTimeIota < 0 {
 		return errors.New("blocktime_iota can't be negative")
 	}
+	switch cfg.GroupSelector {
+	case GroupSelectorRoundRobin, GroupSelectorOldestTx, GroupSelectorBacklog, GroupSelectorABCI:
+	default:
+		return fmt.Errorf("unknown group_selector %q", cfg.GroupSelector)
+	}
+	return nil
+}
+
+//-----------------------------------------------------------------------------
+// StateSyncConfig
+
+// StateSyncConfig defines the configuration for bootstrapping a new node
+// from an application snapshot instead of replaying the chain from genesis.
+type StateSyncConfig struct {
+	// Enable state sync on a node without any state. The node will look for
+	// snapshots among its peers, restore the application from one and then
+	// continue with fast sync (if enabled) or consensus from its height.
+	Enable bool `mapstructure:"enable"`
+
+	// Temporary directory for the chunks of the snapshot being restored.
+	// Defaults to the OS temp directory.
+	TempDir string `mapstructure:"temp_dir"`
+
+	// List of RPC servers (at least two) used to obtain and
+	// verify the headers, validator sets and consensus params of the snapshot
+	// height via the light client. The first is the primary, the others are
+	// witnesses which must agree with it.
+	RPCServers []string `mapstructure:"rpc_servers"`
+
+	// A trusted height and the hash of its header, obtained out-of-band.
+	TrustHeight int64  `mapstructure:"trust_height"`
+	TrustHash   string `mapstructure:"trust_hash"`
+
+	// Time to spend discovering snapshots before picking one to restore.
+	DiscoveryTime time.Duration `mapstructure:"discovery_time"`
+
+	// Timeout for a single chunk request, after which it is retried
+	// from another peer.
+	ChunkRequestTimeout time.Duration `mapstructure:"chunk_request_timeout"`
+
+	// Number of chunks fetched concurrently.
+	ChunkFetchers int32 `mapstructure:"chunk_fetchers"`
+}
+
+// DefaultStateSyncConfig returns a default configuration for state sync.
+func DefaultStateSyncConfig() *StateSyncConfig {
+	return &StateSyncConfig{
+		DiscoveryTime:       15 * time.Second,
+		ChunkRequestTimeout: 10 * time.Second,
+		ChunkFetchers:       4,
+	}
+}
+
+// TestStateSyncConfig returns a default configuration for state sync testing.
+func TestStateSyncConfig() *StateSyncConfig {
+	return DefaultStateSyncConfig()
+}
+
+// TrustHashBytes returns the decoded trust hash.
+func (cfg *StateSyncConfig) TrustHashBytes() []byte {
+	// validated in ValidateBasic, so we can safely panic here
+	bytes, err := hex.DecodeString(cfg.TrustHash)
+	if err != nil {
+		panic(err)
+	}
+	return bytes
+}
+
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *StateSyncConfig) ValidateBasic() error {
+	if !cfg.Enable {
+		return nil
+	}
+	if len(cfg.RPCServers) < 2 {
+		return errors.New("at least two rpc_servers entries are required")
+	}
+	for _, server := range cfg.RPCServers {
+		if len(server) == 0 {
+			return errors.New("found empty rpc_servers entry")
+		}
+	}
+	if cfg.TrustHeight <= 0 {
+		return errors.New("trust_height is required")
+	}
+	if len(cfg.TrustHash) == 0 {
+		return errors.New("trust_hash is required")
+	}
+	if _, err := hex.DecodeString(cfg.TrustHash); err != nil {
+		return fmt.Errorf("invalid trust_hash: %v", err)
+	}
+	if cfg.DiscoveryTime < 0 {
+		return errors.New("discovery_time can't be negative")
+	}
+	if cfg.ChunkRequestTimeout <= 0 {
+		return errors.New("chunk_request_timeout must be positive")
+	}
+	if cfg.ChunkFetchers <= 0 {
+		return errors.New("chunk_fetchers must be positive")
+	}
 	return nil
 }
 
@@ -812,16 +1183,20 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 // TxIndexConfig defines the configuration for the transaction indexer,
 // including tags to index.
 type TxIndexConfig struct {
-	// What indexer to use for transactions
+	// What indexer to use for transactions and blocks
 	//
 	// Options:
 	//   1) "null"
 	//   2) "kv" (default) - the simplest possible indexer, backed by key-value storage (defaults to levelDB; see DBBackend).
+	//   3) "sql" - an indexer backed by an embedded SQLite database (data/tx_index.sqlite),
+	//   which supports richer queries and can be read with any SQLite client. Only available
+	//   in binaries built with the sqlite tag (make build_sqlite, Go 1.20 or later).
 	Indexer string `mapstructure:"indexer"`
 
 	// Comma-separated list of tags to index (by default the only tag is "tx.hash")
 	//
-	// You can also index transactions by height by adding "tx.height" tag here.
+	// You can also index transactions by height by adding "tx.height" tag here,
+	// and by mempool group by adding "tx.group".
 	//
 	// It's recommended to index only a subset of tags due to possible memory
 	// bloat. This is, of course, depends on the indexer's DB and the volume of
@@ -829,7 +1204,12 @@ type TxIndexConfig struct {
 	IndexTags string `mapstructure:"index_tags"`
 
 	// When set to true, tells indexer to index all tags (predefined tags:
-	// "tx.hash", "tx.height" and all tags from DeliverTx responses).
+	// "tx.hash", "tx.height", "tx.group" and all tags from DeliverTx
+	// responses).
+	//
+	// Blocks are indexed by the same tags, from BeginBlock and EndBlock
+	// responses (predefined tags: "block.height" and "block.group", always
+	// indexed).
 	//
 	// Note this may be not desirable (see the comment above). IndexTags has a
 	// precedence over IndexAllTags (i.e. when given both, IndexTags will be
@@ -12,7 +12,7 @@ func TestDefaultConfig(t *testing.T) {
 	assert := assert.New(t)
 
 	// set up some defaults
-	cfg := DefaultConfig()
+	cfg := DefaultConfig(0)
 	fmt.Println("---------- BaseConfig ----------")
 	fmt.Println(cfg.BaseConfig.ProxyApp)
 	fmt.Println(cfg.BaseConfig.Moniker)
@@ -56,10 +56,139 @@ func TestDefaultConfig(t *testing.T) {
 }
 
 func TestConfigValidateBasic(t *testing.T) {
-	cfg := DefaultConfig()
+	cfg := DefaultConfig(0)
 	assert.NoError(t, cfg.ValidateBasic())
 
 	// tamper with timeout_propose
 	cfg.Consensus.TimeoutPropose = -10 * time.Second
 	assert.Error(t, cfg.ValidateBasic())
+
+	// tamper with retain_blocks
+	cfg = DefaultConfig(0)
+	cfg.RetainBlocks = -1
+	assert.Error(t, cfg.ValidateBasic())
+
+	// tamper with laddr and ws_laddr
+	cfg = DefaultConfig(0)
+	cfg.P2P.ListenAddress = "ws://0.0.0.0:26656"
+	assert.Error(t, cfg.ValidateBasic())
+	cfg = DefaultConfig(0)
+	cfg.P2P.WSListenAddress = "tcp://0.0.0.0:26655"
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.P2P.WSListenAddress = "ws://0.0.0.0:26655"
+	assert.NoError(t, cfg.ValidateBasic())
+
+	// tamper with peer_ban_threshold
+	cfg = DefaultConfig(0)
+	cfg.P2P.PeerBanThreshold = 101
+	assert.Error(t, cfg.ValidateBasic())
+
+	// tamper with max_tx_bytes
+	cfg = DefaultConfig(0)
+	cfg.Mempool.MaxTxBytes = -1
+	assert.Error(t, cfg.ValidateBasic())
+
+	// tamper with max_body_bytes
+	cfg = DefaultConfig(0)
+	cfg.RPC.MaxBodyBytes = -1
+	assert.Error(t, cfg.ValidateBasic())
+}
+
+func TestMempoolConfigGroups(t *testing.T) {
+	// legacy bitmask: group 0, plus groups 1 and 3
+	cfg := DefaultMempoolConfig(5)
+	cfg.WalPath = "wal"
+	assert.NoError(t, cfg.ValidateBasic())
+	assert.Equal(t, []int32{0, 1, 3}, cfg.GroupIDs())
+	groups := cfg.GroupConfigs()
+	assert.Equal(t, cfg.Size, groups[1].Size)
+	assert.Equal(t, "wal", cfg.GroupConfig(groups[0]).WalPath)
+	assert.NotEqual(t, "wal", cfg.GroupConfig(groups[1]).WalPath)
+
+	cfg = DefaultMempoolConfig(0)
+	cfg.Groups = []*MempoolGroupConfig{
+		{ID: 2, Name: "payments", Size: 100, CacheSize: 200, Broadcast: true},
+		{ID: 0, Name: "default", Size: 5000, CacheSize: 10000, Recheck: true},
+	}
+	assert.NoError(t, cfg.ValidateBasic())
+	assert.Equal(t, []int32{0, 2}, cfg.GroupIDs())
+	conf := cfg.GroupConfig(cfg.GroupConfigs()[1])
+	assert.EqualValues(t, 2, conf.Group)
+	assert.Equal(t, 100, conf.Size)
+	assert.Equal(t, 200, conf.CacheSize)
+	assert.False(t, conf.Recheck)
+	assert.True(t, conf.Broadcast)
+
+	// groups opened at runtime use their entry, or the [mempool] settings
+	cfg.WalPath = "wal"
+	assert.Equal(t, "payments", cfg.OpenedGroupConfig(2).Name)
+	opened := cfg.OpenedGroupConfig(7)
+	assert.Equal(t, "group7", opened.Name)
+	assert.Equal(t, cfg.Size, opened.Size)
+	assert.Empty(t, opened.WalPath)
+
+	testCases := []struct {
+		name   string
+		mutate func(*MempoolConfig)
+	}{
+		{"bitmask too", func(c *MempoolConfig) { c.Group = 1 }},
+		{"no group 0", func(c *MempoolConfig) { c.Groups[1].ID = 1 }},
+		{"duplicate id", func(c *MempoolConfig) { c.Groups[1].ID = 2 }},
+		{"duplicate name", func(c *MempoolConfig) { c.Groups[1].Name = "payments" }},
+		{"no name", func(c *MempoolConfig) { c.Groups[0].Name = "" }},
+		{"negative id", func(c *MempoolConfig) { c.Groups[0].ID = -1 }},
+		{"negative size", func(c *MempoolConfig) { c.Groups[0].Size = -1 }},
+		{"negative cache_size", func(c *MempoolConfig) { c.Groups[0].CacheSize = -1 }},
+		{"negative ttl_num_blocks", func(c *MempoolConfig) { c.Groups[0].TTLNumBlocks = -1 }},
+		{"negative ttl_duration", func(c *MempoolConfig) { c.Groups[0].TTLDuration = -time.Second }},
+		{"negative max_txs_per_sender", func(c *MempoolConfig) { c.Groups[1].MaxTxsPerSender = -1 }},
+		{"negative max_txs_bytes_per_sender", func(c *MempoolConfig) { c.Groups[1].MaxTxsBytesPerSender = -1 }},
+		{"shared wal_dir", func(c *MempoolConfig) { c.Groups[0].WalPath = "wal"; c.Groups[1].WalPath = "wal" }},
+	}
+	for _, tc := range testCases {
+		cfg := DefaultMempoolConfig(0)
+		cfg.Groups = []*MempoolGroupConfig{
+			{ID: 2, Name: "payments"},
+			{ID: 0, Name: "default"},
+		}
+		tc.mutate(cfg)
+		assert.Error(t, cfg.ValidateBasic(), tc.name)
+	}
+}
+
+func TestStateSyncConfigValidateBasic(t *testing.T) {
+	// disabled state sync is always valid
+	cfg := DefaultStateSyncConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+
+	validConfig := func() *StateSyncConfig {
+		cfg := DefaultStateSyncConfig()
+		cfg.Enable = true
+		cfg.RPCServers = []string{"tcp://127.0.0.1:26657", "tcp://127.0.0.2:26657"}
+		cfg.TrustHeight = 100
+		cfg.TrustHash = "0123456789abcdef"
+		return cfg
+	}
+	cfg = validConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+	assert.Equal(t, []byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef}, cfg.TrustHashBytes())
+
+	testCases := []struct {
+		name   string
+		mutate func(*StateSyncConfig)
+	}{
+		{"one rpc server", func(c *StateSyncConfig) { c.RPCServers = c.RPCServers[:1] }},
+		{"empty rpc server", func(c *StateSyncConfig) { c.RPCServers[1] = "" }},
+		{"no trust_height", func(c *StateSyncConfig) { c.TrustHeight = 0 }},
+		{"no trust_hash", func(c *StateSyncConfig) { c.TrustHash = "" }},
+		{"invalid trust_hash", func(c *StateSyncConfig) { c.TrustHash = "xyz" }},
+		{"negative discovery_time", func(c *StateSyncConfig) { c.DiscoveryTime = -1 }},
+		{"no chunk_request_timeout", func(c *StateSyncConfig) { c.ChunkRequestTimeout = 0 }},
+		{"no chunk_fetchers", func(c *StateSyncConfig) { c.ChunkFetchers = 0 }},
+	}
+	for _, tc := range testCases {
+		cfg := validConfig()
+		tc.mutate(cfg)
+		assert.Error(t, cfg.ValidateBasic(), tc.name)
+	}
 }
@@ -121,6 +121,13 @@ prof_laddr = "{{ .BaseConfig.ProfListenAddress }}"
 # so the app can decide if we should keep the connection or not
 filter_peers = {{ .BaseConfig.FilterPeers }}
 
+# Number of recent blocks to keep: older blocks, and their states, are pruned.
+# 0 keeps all the blocks, unless the app prunes them with the RetainHeight of
+# its Commit responses. Nodes without the older blocks can't serve them to
+# peers catching up. The blocks within the evidence max age (in blocks and in
+# time, see the evidence consensus params) are always kept
+retain_blocks = {{ .BaseConfig.RetainBlocks }}
+
 ##### advanced configuration options #####
 
 ##### rpc server configuration options #####
@@ -165,12 +172,22 @@ unsafe = {{ .RPC.Unsafe }}
 # 1024 - 40 - 10 - 50 = 924 = ~900
 max_open_connections = {{ .RPC.MaxOpenConnections }}
 
+# Maximum size of request body, in bytes. Also limits the size of the gRPC
+# messages and the total size of the txs in a /broadcast_txs request.
+# 0 - default (1MB).
+max_body_bytes = {{ .RPC.MaxBodyBytes }}
+
 ##### peer to peer configuration options #####
 [p2p]
 
 # Address to listen for incoming connections
 laddr = "{{ .P2P.ListenAddress }}"
 
+# Address to listen for incoming WebSocket connections, besides the tcp ones,
+# e.g. for peers behind HTTP proxies. It must use the ws:// protocol, like
+# "ws://0.0.0.0:26655". Leave empty to not accept WebSocket connections.
+ws_laddr = "{{ .P2P.WSListenAddress }}"
+
 # Address to advertise to peers for them to dial
 # If empty, will use the same port as the laddr,
 # and will introspect on the listener or use UPnP
@@ -230,6 +247,13 @@ allow_duplicate_ip = {{ .P2P.AllowDuplicateIP }}
 handshake_timeout = "{{ .P2P.HandshakeTimeout }}"
 dial_timeout = "{{ .P2P.DialTimeout }}"
 
+# Peers are scored by their behaviour, from 0 to 100. A peer disconnected for
+# a bad message while its score is below peer_ban_threshold is banned: it is not
+# dialed nor accepted for peer_ban_duration, doubled for each following ban
+# (up to 24h). 0 disables banning.
+peer_ban_threshold = {{ .P2P.PeerBanThreshold }}
+peer_ban_duration = "{{ .P2P.PeerBanDuration }}"
+
 ##### mempool configuration options #####
 [mempool]
 
@@ -243,6 +267,40 @@ size = {{ .Mempool.Size }}
 # size of the cache (used to filter transactions we saw earlier)
 cache_size = {{ .Mempool.CacheSize }}
 
+# Transactions are evicted once they have been in the mempool for
+# ttl_num_blocks blocks or for ttl_duration. 0 disables the limit.
+ttl_num_blocks = {{ .Mempool.TTLNumBlocks }}
+ttl_duration = "{{ .Mempool.TTLDuration }}"
+
+# Limits on the number and the total size of the transactions of a single
+# sender, as returned by the application in CheckTx. 0 disables the limit.
+max_txs_per_sender = {{ .Mempool.MaxTxsPerSender }}
+max_txs_bytes_per_sender = {{ .Mempool.MaxTxsBytesPerSender }}
+
+# Maximum size of a single transaction, in all the groups. Larger
+# transactions are rejected by CheckTx and dropped when received from peers.
+# It can't exceed the maximum size of a mempool message (1MB), nor the size
+# of a block (BlockSize.MaxBytes).
+max_tx_bytes = {{ .Mempool.MaxTxBytes }}
+
+# Mempool groups run by this node. Each group has its own id, name and
+# settings; the options above are only used when no group is listed.
+# Group 0 is required.
+{{ range .Mempool.GroupConfigs }}
+[[mempool.groups]]
+id = {{ .ID }}
+name = "{{ js .Name }}"
+recheck = {{ .Recheck }}
+broadcast = {{ .Broadcast }}
+wal_dir = "{{ js .WalPath }}"
+size = {{ .Size }}
+cache_size = {{ .CacheSize }}
+ttl_num_blocks = {{ .TTLNumBlocks }}
+ttl_duration = "{{ .TTLDuration }}"
+max_txs_per_sender = {{ .MaxTxsPerSender }}
+max_txs_bytes_per_sender = {{ .MaxTxsBytesPerSender }}
+{{ end }}
+
 ##### consensus configuration options #####
 [consensus]
 
@@ -270,19 +328,62 @@ peer_query_maj23_sleep_duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 # Block time parameters. Corresponds to the minimum time increment between consecutive blocks.
 blocktime_iota = "{{ .Consensus.BlockTimeIota }}"
 
+# Policy deciding which mempool group fills each proposal block:
+#   1) "round_robin" (default) - groups take turns in order of their id
+#   2) "oldest_tx" - groups which had txs in the last block take turns
+#   3) "backlog" - a group is picked at random, weighted by its txs in the last block
+#   4) "abci" - the app sets the group in ResponseEndBlock.next_group
+# Validators reject blocks which don't follow the policy, so all the
+# validators of a chain must use the same one.
+group_selector = "{{ .Consensus.GroupSelector }}"
+
+##### state sync configuration options #####
+[statesync]
+
+# State sync rapidly bootstraps a new node by discovering, fetching, and restoring a
+# snapshot of the application state from peers, instead of replaying all blocks since
+# genesis. The node then continues with fast sync (if enabled) or consensus from the
+# snapshot height. Only nodes without any state can be state synced.
+#
+# The snapshot height is verified with the light client, which needs at least two RPC
+# servers (the first one is the primary, the others are witnesses) and a trusted
+# height and header hash obtained out-of-band, e.g. from a block explorer.
+enable = {{ .StateSync.Enable }}
+
+rpc_servers = [{{ range .StateSync.RPCServers }}{{ printf "%q, " . }}{{end}}]
+trust_height = {{ .StateSync.TrustHeight }}
+trust_hash = "{{ .StateSync.TrustHash }}"
+
+# Time to spend discovering snapshots before picking one to restore
+discovery_time = "{{ .StateSync.DiscoveryTime }}"
+
+# Temporary directory for the chunks of the snapshot being restored
+# (defaults to the OS temp directory)
+temp_dir = "{{ js .StateSync.TempDir }}"
+
+# Timeout for a single chunk request, after which it is retried from another peer
+chunk_request_timeout = "{{ .StateSync.ChunkRequestTimeout }}"
+
+# Number of chunks fetched concurrently
+chunk_fetchers = {{ .StateSync.ChunkFetchers }}
+
 ##### transactions indexer configuration options #####
 [tx_index]
 
-# What indexer to use for transactions
+# What indexer to use for transactions and blocks
 #
 # Options:
 #   1) "null"
 #   2) "kv" (default) - the simplest possible indexer, backed by key-value storage (defaults to levelDB; see DBBackend).
+#   3) "sql" - an indexer backed by an embedded SQLite database (data/tx_index.sqlite),
+#   which supports richer queries and can be read with any SQLite client. Only available
+#   in binaries built with the sqlite tag (make build_sqlite, Go 1.20 or later).
 indexer = "{{ .TxIndex.Indexer }}"
 
 # Comma-separated list of tags to index (by default the only tag is "tx.hash")
 #
-# You can also index transactions by height by adding "tx.height" tag here.
+# You can also index transactions by height by adding "tx.height" tag here,
+# and by mempool group by adding "tx.group".
 #
 # It's recommended to index only a subset of tags due to possible memory
 # bloat. This is, of course, depends on the indexer's DB and the volume of
@@ -290,7 +391,12 @@ indexer = "{{ .TxIndex.Indexer }}"
 index_tags = "{{ .TxIndex.IndexTags }}"
 
 # When set to true, tells indexer to index all tags (predefined tags:
-# "tx.hash", "tx.height" and all tags from DeliverTx responses).
+# "tx.hash", "tx.height", "tx.group" and all tags from DeliverTx
+# responses).
+#
+# Blocks are indexed by the same tags, from BeginBlock and EndBlock
+# responses (predefined tags: "block.height" and "block.group", always
+# indexed).
 #
 # Note this may be not desirable (see the comment above). IndexTags has a
 # precedence over IndexAllTags (i.e. when given both, IndexTags will be
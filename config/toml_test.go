@@ -6,7 +6,9 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -64,6 +66,31 @@ func TestEnsureTestRoot(t *testing.T) {
 	ensureFiles(t, rootDir, defaultDataDir, baseConfig.Genesis, baseConfig.PrivValidatorKey, baseConfig.PrivValidatorState)
 }
 
+func TestMempoolGroupsRoundTrip(t *testing.T) {
+	require := require.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "config-test")
+	require.Nil(err)
+	defer os.RemoveAll(tmpDir) // nolint: errcheck
+
+	conf := DefaultConfig(0)
+	conf.Mempool.Groups = []*MempoolGroupConfig{
+		{ID: 0, Name: "default", Size: 5000, CacheSize: 10000, Recheck: true, Broadcast: true},
+		{ID: 7, Name: "payments", Size: 100, CacheSize: 0, WalPath: "data/payments.wal",
+			TTLNumBlocks: 10, TTLDuration: time.Minute, MaxTxsPerSender: 4, MaxTxsBytesPerSender: 1024},
+	}
+	configFilePath := filepath.Join(tmpDir, "config.toml")
+	WriteConfigFile(configFilePath, conf)
+
+	v := viper.New()
+	v.SetConfigFile(configFilePath)
+	require.Nil(v.ReadInConfig())
+	loaded := DefaultConfig(0)
+	require.Nil(v.Unmarshal(loaded))
+	require.Nil(loaded.ValidateBasic())
+	assert.Equal(t, conf.Mempool.Groups, loaded.Mempool.Groups)
+}
+
 func checkConfig(configFile string) bool {
 	var valid bool
 
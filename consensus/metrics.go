@@ -38,7 +38,7 @@ type Metrics struct {
 	// Time between this and the last block.
 	BlockIntervalSeconds metrics.Gauge
 
-	// Number of transactions.
+	// Number of transactions, by mempool group.
 	NumTxs metrics.Gauge
 	// Size of the block.
 	BlockSizeBytes metrics.Gauge
@@ -124,7 +124,7 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Subsystem: MetricsSubsystem,
 			Name:      "num_txs",
 			Help:      "Number of transactions.",
-		}, labels).With(labelsAndValues...),
+		}, append(labels, "group")).With(labelsAndValues...),
 		BlockSizeBytes: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -14,6 +14,7 @@ import (
 	tmevents "github.com/tendermint/tendermint/libs/events"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/p2p"
+	bh "github.com/tendermint/tendermint/p2p/behaviour"
 	sm "github.com/tendermint/tendermint/state"
 	"github.com/tendermint/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
@@ -42,6 +43,7 @@ type ConsensusReactor struct {
 	mtx      sync.RWMutex
 	fastSync bool
 	eventBus *types.EventBus
+	reporter bh.Reporter
 
 	metrics *Metrics
 }
@@ -66,6 +68,13 @@ func NewConsensusReactor(consensusState *ConsensusState, fastSync bool, options
 	return conR
 }
 
+// SetSwitch implements Reactor. The behaviour of the peers is reported to the
+// switch.
+func (conR *ConsensusReactor) SetSwitch(sw *p2p.Switch) {
+	conR.BaseReactor.SetSwitch(sw)
+	conR.reporter = bh.NewSwitchReporter(sw)
+}
+
 // OnStart implements BaseService by subscribing to events, which later will be
 // broadcasted to other peers and starting state if we're not in fast sync.
 func (conR *ConsensusReactor) OnStart() error {
@@ -205,13 +214,13 @@ func (conR *ConsensusReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte)
 	msg, err := decodeMsg(msgBytes)
 	if err != nil {
 		conR.Logger.Error("Error decoding message", "src", src, "chId", chID, "msg", msg, "err", err, "bytes", msgBytes)
-		conR.Switch.StopPeerForError(src, err)
+		conR.report(bh.BadMessage(src.ID(), err.Error()))
 		return
 	}
 
 	if err = msg.ValidateBasic(); err != nil {
 		conR.Logger.Error("Peer sent us invalid msg", "peer", src, "msg", msg, "err", err)
-		conR.Switch.StopPeerForError(src, err)
+		conR.report(bh.BadMessage(src.ID(), err.Error()))
 		return
 	}
 
@@ -243,7 +252,7 @@ func (conR *ConsensusReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte)
 			// Peer claims to have a maj23 for some BlockID at H,R,S,
 			err := votes.SetPeerMaj23(msg.Round, msg.Type, ps.peer.ID(), msg.BlockID)
 			if err != nil {
-				conR.Switch.StopPeerForError(src, err)
+				conR.report(bh.BadMessage(src.ID(), err.Error()))
 				return
 			}
 			// Respond with a VoteSetBitsMessage showing which votes we have.
@@ -483,8 +492,11 @@ OUTER_LOOP:
 			}
 		}
 
-		// If the peer is on a previous height, help catch up.
-		if (0 < prs.Height) && (prs.Height < rs.Height) {
+		// If the peer is on a previous height we have the block of, help
+		// catch up (the blocks below the store base are pruned, or were
+		// skipped by state sync).
+		blockStoreBase := conR.conS.blockStore.Base()
+		if blockStoreBase > 0 && blockStoreBase <= prs.Height && prs.Height < rs.Height {
 			heightLogger := logger.With("height", prs.Height)
 
 			// if we never received the commit message from the peer, the block parts wont be initialized
@@ -827,12 +839,11 @@ func (conR *ConsensusReactor) peerStatsRoutine() {
 			switch msg.Msg.(type) {
 			case *VoteMessage:
 				if numVotes := ps.RecordVote(); numVotes%votesToContributeToBecomeGoodPeer == 0 {
-					conR.Switch.MarkPeerAsGood(peer)
+					conR.report(bh.ConsensusVote(peer.ID(), "vote"))
 				}
 			case *BlockPartMessage:
-
 				if numParts := ps.RecordBlockPart(); numParts%blocksToContributeToBecomeGoodPeer == 0 {
-					conR.Switch.MarkPeerAsGood(peer)
+					conR.report(bh.BlockPart(peer.ID(), "block part"))
 				}
 			}
 		case <-conR.conS.Quit():
@@ -844,6 +855,13 @@ func (conR *ConsensusReactor) peerStatsRoutine() {
 	}
 }
 
+// report reports the behaviour of a peer, which may have disconnected since.
+func (conR *ConsensusReactor) report(behaviour bh.PeerBehaviour) {
+	if err := conR.reporter.Report(behaviour); err != nil {
+		conR.Logger.Debug("Could not report peer behaviour", "peer", behaviour.PeerID(), "err", err)
+	}
+}
+
 // String returns a string representation of the ConsensusReactor.
 // NOTE: For now, it is just a hard-coded string to avoid accessing unprotected shared variables.
 // TODO: improve!
@@ -233,7 +233,7 @@ func (h *Handshaker) NBlocks() int {
 }
 
 // TODO: retry the handshake/replay if it fails ?
-func (h *Handshaker) Handshake(proxyApp proxy.AppConns, group int32) error {
+func (h *Handshaker) Handshake(proxyApp proxy.AppConns, groups []int32) error {
 
 	// Handshake is done via ABCI Info on the query conn.
 	res, err := proxyApp.Query().InfoSync(proxy.RequestInfo)
@@ -259,7 +259,7 @@ func (h *Handshaker) Handshake(proxyApp proxy.AppConns, group int32) error {
 	sm.SaveState(h.stateDB, h.initialState)
 
 	// Replay blocks up to the latest in the blockstore.
-	_, err = h.ReplayBlocks(h.initialState, appHash, blockHeight, group, proxyApp)
+	_, err = h.ReplayBlocks(h.initialState, appHash, blockHeight, groups, proxyApp)
 	if err != nil {
 		return fmt.Errorf("Error on replay: %v", err)
 	}
@@ -278,13 +278,24 @@ func (h *Handshaker) ReplayBlocks(
 	state sm.State,
 	appHash []byte,
 	appBlockHeight int64,
-	group int32,
+	groups []int32,
 	proxyApp proxy.AppConns,
 ) ([]byte, error) {
 	storeBlockHeight := h.store.Height()
 	stateBlockHeight := state.LastBlockHeight
 	h.logger.Info("ABCI Replay Blocks", "appHeight", appBlockHeight, "storeHeight", storeBlockHeight, "stateHeight", stateBlockHeight)
 
+	// The app can only be replayed from the blocks we have: none below the
+	// store base, and none at all before the first block after the snapshot
+	// height of a node bootstrapped by state sync is saved.
+	storeBase := h.store.Base()
+	if storeBase == 0 {
+		storeBase = stateBlockHeight + 1
+	}
+	if appBlockHeight < stateBlockHeight && appBlockHeight+1 < storeBase {
+		return appHash, sm.ErrAppBlockHeightTooLow{AppHeight: appBlockHeight, StoreBase: storeBase}
+	}
+
 	// If appBlockHeight == 0 it means that we are at genesis and hence should send InitChain.
 	if appBlockHeight == 0 {
 		validators := make([]*types.Validator, len(h.genDoc.Validators))
@@ -323,7 +334,10 @@ func (h *Handshaker) ReplayBlocks(
 			}
 
 			if res.ConsensusParams != nil {
+				// GroupValidators can only be set in the genesis.
+				groupValidators := state.ConsensusParams.Validator.GroupValidators
 				state.ConsensusParams = types.PB2TM.ConsensusParams(res.ConsensusParams)
+				state.ConsensusParams.Validator.GroupValidators = groupValidators
 			}
 			sm.SaveState(h.stateDB, state)
 		}
@@ -354,7 +368,7 @@ func (h *Handshaker) ReplayBlocks(
 		// Either the app is asking for replay, or we're all synced up.
 		if appBlockHeight < storeBlockHeight {
 			// the app is behind, so replay blocks, but no need to go through WAL (state is already synced to store)
-			return h.replayBlocks(state, proxyApp, appBlockHeight, storeBlockHeight, group, false)
+			return h.replayBlocks(state, proxyApp, appBlockHeight, storeBlockHeight, groups, false)
 
 		} else if appBlockHeight == storeBlockHeight {
 			// We're good!
@@ -367,7 +381,7 @@ func (h *Handshaker) ReplayBlocks(
 		if appBlockHeight < stateBlockHeight {
 			// the app is further behind than it should be, so replay blocks
 			// but leave the last block to go through the WAL
-			return h.replayBlocks(state, proxyApp, appBlockHeight, storeBlockHeight, group, true)
+			return h.replayBlocks(state, proxyApp, appBlockHeight, storeBlockHeight, groups, true)
 
 		} else if appBlockHeight == stateBlockHeight {
 			// We haven't run Commit (both the state and app are one block behind),
@@ -375,7 +389,7 @@ func (h *Handshaker) ReplayBlocks(
 			// NOTE: We could instead use the cs.WAL on cs.Start,
 			// but we'd have to allow the WAL to replay a block that wrote it's #ENDHEIGHT
 			h.logger.Info("Replay last block using real app")
-			state, err = h.replayBlock(state, storeBlockHeight, group, proxyApp.Consensus())
+			state, err = h.replayBlock(state, storeBlockHeight, groups, proxyApp.Consensus())
 			return state.AppHash, err
 
 		} else if appBlockHeight == storeBlockHeight {
@@ -386,7 +400,7 @@ func (h *Handshaker) ReplayBlocks(
 			}
 			mockApp := newMockProxyApp(appHash, abciResponses)
 			h.logger.Info("Replay last block using mock app")
-			state, err = h.replayBlock(state, storeBlockHeight, group, mockApp)
+			state, err = h.replayBlock(state, storeBlockHeight, groups, mockApp)
 			return state.AppHash, err
 		}
 
@@ -396,7 +410,7 @@ func (h *Handshaker) ReplayBlocks(
 	return nil, nil
 }
 
-func (h *Handshaker) replayBlocks(state sm.State, proxyApp proxy.AppConns, appBlockHeight, storeBlockHeight int64, group int32, mutateState bool) ([]byte, error) {
+func (h *Handshaker) replayBlocks(state sm.State, proxyApp proxy.AppConns, appBlockHeight, storeBlockHeight int64, groups []int32, mutateState bool) ([]byte, error) {
 	// App is further behind than it should be, so we need to replay blocks.
 	// We replay all blocks from appBlockHeight+1.
 	//
@@ -416,7 +430,11 @@ func (h *Handshaker) replayBlocks(state sm.State, proxyApp proxy.AppConns, appBl
 	for i := appBlockHeight + 1; i <= finalBlock; i++ {
 		h.logger.Info("Applying block", "height", i)
 		block := h.store.LoadBlock(i)
-		appHash, err = sm.ExecCommitBlock(proxyApp.Consensus(), block, h.logger, state.LastValidators, h.stateDB)
+		lastValidators := state.LastValidators
+		if state.GroupValidators() && i > 1 {
+			lastValidators = lastValidators.GroupSet(h.store.LoadBlockMeta(i - 1).Header.Group)
+		}
+		appHash, err = sm.ExecCommitBlock(proxyApp.Consensus(), block, h.logger, lastValidators, h.stateDB)
 		if err != nil {
 			return nil, err
 		}
@@ -426,7 +444,7 @@ func (h *Handshaker) replayBlocks(state sm.State, proxyApp proxy.AppConns, appBl
 
 	if mutateState {
 		// sync the final block
-		state, err = h.replayBlock(state, storeBlockHeight, group, proxyApp.Consensus())
+		state, err = h.replayBlock(state, storeBlockHeight, groups, proxyApp.Consensus())
 		if err != nil {
 			return nil, err
 		}
@@ -437,20 +455,17 @@ func (h *Handshaker) replayBlocks(state sm.State, proxyApp proxy.AppConns, appBl
 }
 
 // ApplyBlock on the proxyApp with the last block.
-func (h *Handshaker) replayBlock(state sm.State, height int64, group int32, proxyApp proxy.AppConnConsensus) (sm.State, error) {
+func (h *Handshaker) replayBlock(state sm.State, height int64, groups []int32, proxyApp proxy.AppConnConsensus) (sm.State, error) {
 	block := h.store.LoadBlock(height)
 	meta := h.store.LoadBlockMeta(height)
 
-	var i int32
-	mempools := make(map[int32]sm.Mempool)
-	for i = 0; i < 33; i++ {
-		if i != 0 && (group>>uint32(i-1))&1 == 0 {
-			continue
-		}
-		mempools[i] = sm.MockMempool{}
+	mempools := make(map[int32]sm.Mempool, len(groups))
+	for _, group := range groups {
+		mempools[group] = sm.MockMempool{}
 	}
 
-	blockExec := sm.NewBlockExecutor(h.stateDB, h.logger, proxyApp, mempools, sm.MockEvidencePool{})
+	blockExec := sm.NewBlockExecutor(h.stateDB, h.logger, proxyApp, mempools, sm.MockEvidencePool{},
+		sm.BlockExecutorWithBlockStore(h.store))
 	blockExec.SetEventBus(h.eventBus)
 
 	var err error
@@ -30,8 +30,8 @@ const (
 // replay messages interactively or all at once
 
 // replay the wal file
-func RunReplayFile(config cfg.BaseConfig, csConfig *cfg.ConsensusConfig, group int32, console bool) {
-	consensusState := newConsensusStateForReplay(config, csConfig, group)
+func RunReplayFile(config cfg.BaseConfig, csConfig *cfg.ConsensusConfig, groups []int32, console bool) {
+	consensusState := newConsensusStateForReplay(config, csConfig, groups)
 
 	if err := consensusState.ReplayFile(csConfig.WalFile(), console); err != nil {
 		cmn.Exit(fmt.Sprintf("Error during consensus replay: %v", err))
@@ -299,7 +299,7 @@ func (pb *playback) replayConsoleLoop() int {
 //--------------------------------------------------------------------------------
 
 // convenience for replay mode
-func newConsensusStateForReplay(config cfg.BaseConfig, csConfig *cfg.ConsensusConfig, group int32) *ConsensusState {
+func newConsensusStateForReplay(config cfg.BaseConfig, csConfig *cfg.ConsensusConfig, groups []int32) *ConsensusState {
 	dbType := dbm.DBBackendType(config.DBBackend)
 	// Get BlockStore
 	blockStoreDB := dbm.NewDB("blockstore", dbType, config.DBDir())
@@ -332,17 +332,20 @@ func newConsensusStateForReplay(config cfg.BaseConfig, csConfig *cfg.ConsensusCo
 	handshaker := NewHandshaker(stateDB, state, blockStore, gdoc)
 	handshaker.SetEventBus(eventBus)
 
-	err = handshaker.Handshake(proxyApp, group)
+	err = handshaker.Handshake(proxyApp, groups)
 	if err != nil {
 		cmn.Exit(fmt.Sprintf("Error on handshake: %v", err))
 	}
 
-	mempools := make(map[int32]sm.Mempool)
 	evpool := sm.MockEvidencePool{}
 	mempool := sm.MockMempool{}
-	mempools[0] = mempool
+	mempools := make(map[int32]sm.Mempool, len(groups))
+	for _, group := range groups {
+		mempools[group] = mempool
+	}
 
-	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(), mempools, evpool)
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(), mempools, evpool,
+		sm.BlockExecutorWithBlockStore(blockStore))
 
 	consensusState := NewConsensusState(csConfig, state.Copy(), blockExec,
 		blockStore, mempool, evpool)
@@ -373,7 +373,7 @@ func testHandshakeReplay(t *testing.T, config *cfg.Config, nBlocks int, mode uin
 		t.Fatalf("Error starting proxy app connections: %v", err)
 	}
 	defer proxyApp.Stop()
-	if err := handshaker.Handshake(proxyApp); err != nil {
+	if err := handshaker.Handshake(proxyApp, []int32{0}); err != nil {
 		t.Fatalf("Error on abci handshake: %v", err)
 	}
 
@@ -618,6 +618,7 @@ func NewMockBlockStore(config *cfg.Config, params types.ConsensusParams) *mockBl
 	return &mockBlockStore{config, params, nil, nil}
 }
 
+func (bs *mockBlockStore) Base() int64                         { return 1 }
 func (bs *mockBlockStore) Height() int64                       { return int64(len(bs.chain)) }
 func (bs *mockBlockStore) LoadBlock(height int64) *types.Block { return bs.chain[height-1] }
 func (bs *mockBlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
@@ -636,6 +637,7 @@ func (bs *mockBlockStore) LoadBlockCommit(height int64) *types.Commit {
 func (bs *mockBlockStore) LoadSeenCommit(height int64) *types.Commit {
 	return bs.commits[height-1]
 }
+func (bs *mockBlockStore) PruneBlocks(height int64) (uint64, error) { return 0, nil }
 
 //----------------------------------------
 
@@ -660,7 +662,7 @@ func TestInitChainUpdateValidators(t *testing.T) {
 		t.Fatalf("Error starting proxy app connections: %v", err)
 	}
 	defer proxyApp.Stop()
-	if err := handshaker.Handshake(proxyApp); err != nil {
+	if err := handshaker.Handshake(proxyApp, []int32{0}); err != nil {
 		t.Fatalf("Error on abci handshake: %v", err)
 	}
 
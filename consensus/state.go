@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"runtime/debug"
+	"strconv"
 	"sync"
 	"time"
 
@@ -91,6 +92,10 @@ type ConsensusState struct {
 	// when it's detected
 	evpool evidencePool
 
+	// amnesia found at the last height, added to the pool once its
+	// canonical commit is stored with the next block
+	amnesiaEvidence []types.Evidence
+
 	// internal state
 	mtx sync.RWMutex
 	cstypes.RoundState
@@ -484,7 +489,7 @@ func (cs *ConsensusState) reconstructLastCommit(state sm.State) {
 		return
 	}
 	seenCommit := cs.blockStore.LoadSeenCommit(state.LastBlockHeight)
-	lastPrecommits := types.NewVoteSet(state.ChainID, state.LastBlockHeight, seenCommit.Round(), types.PrecommitType, state.LastValidators)
+	lastPrecommits := types.NewVoteSet(state.ChainID, state.LastBlockHeight, seenCommit.Round(), types.PrecommitType, state.LastBlockValidators())
 	for _, precommit := range seenCommit.Precommits {
 		if precommit == nil {
 			continue
@@ -526,13 +531,16 @@ func (cs *ConsensusState) updateToState(state sm.State) {
 	}
 
 	// Reset fields based on state.
-	validators := state.Validators
+	validators := state.BlockValidators()
 	lastPrecommits := (*types.VoteSet)(nil)
 	if cs.CommitRound > -1 && cs.Votes != nil {
 		if !cs.Votes.Precommits(cs.CommitRound).HasTwoThirdsMajority() {
 			cmn.PanicSanity("updateToState(state) called but last Precommit round didn't have +2/3")
 		}
 		lastPrecommits = cs.Votes.Precommits(cs.CommitRound)
+	} else if cs.LastCommit != nil && cs.LastCommit.Height() == state.LastBlockHeight {
+		// reconstructed by reconstructLastCommit, e.g. on SwitchToConsensus
+		lastPrecommits = cs.LastCommit
 	}
 
 	// Next desired block height
@@ -565,7 +573,7 @@ func (cs *ConsensusState) updateToState(state sm.State) {
 	cs.Votes = cstypes.NewHeightVoteSet(state.ChainID, height, validators)
 	cs.CommitRound = -1
 	cs.LastCommit = lastPrecommits
-	cs.LastValidators = state.LastValidators
+	cs.LastValidators = state.LastBlockValidators()
 	cs.TriggeredTimeoutPrecommit = false
 
 	cs.state = state
@@ -836,6 +844,11 @@ func (cs *ConsensusState) needProofBlock(height int64) bool {
 	}
 
 	lastBlockMeta := cs.blockStore.LoadBlockMeta(height - 1)
+	if lastBlockMeta == nil {
+		// the last block is the snapshot height of a node bootstrapped by
+		// state sync
+		return true
+	}
 	return !bytes.Equal(cs.state.AppHash, lastBlockMeta.Header.AppHash)
 }
 
@@ -1333,6 +1346,15 @@ func (cs *ConsensusState) finalizeCommit(height int64) {
 
 	fail.Fail() // XXX
 
+	// The canonical commit of the last height is now stored, so the amnesia
+	// found at that height can be verified.
+	for _, ev := range cs.amnesiaEvidence {
+		if err := cs.evpool.AddEvidence(ev); err != nil {
+			cs.Logger.Info("Amnesia evidence isn't valid", "evidence", ev, "err", err)
+		}
+	}
+	cs.amnesiaEvidence = cs.findAmnesia(blockID)
+
 	// must be called before we update state
 	cs.recordMetrics(height, block)
 
@@ -1377,14 +1399,23 @@ func (cs *ConsensusState) recordMetrics(height int64, block *types.Block) {
 	}
 	cs.metrics.ByzantineValidatorsPower.Set(float64(byzantineValidatorsPower))
 
-	if height > 1 {
-		lastBlockMeta := cs.blockStore.LoadBlockMeta(height - 1)
+	if lastBlockMeta := cs.blockStore.LoadBlockMeta(height - 1); lastBlockMeta != nil {
 		cs.metrics.BlockIntervalSeconds.Set(
 			block.Time.Sub(lastBlockMeta.Header.Time).Seconds(),
 		)
 	}
 
-	cs.metrics.NumTxs.Set(float64(block.NumTxs))
+	// count the txs of each section, and none for the other open groups
+	numTxs := make(map[int32]int)
+	for _, group := range cs.state.OpenGroups() {
+		numTxs[group] = 0
+	}
+	for _, groupTxs := range block.GroupedTxs() {
+		numTxs[groupTxs.Group] += len(groupTxs.Txs)
+	}
+	for group, n := range numTxs {
+		cs.metrics.NumTxs.With("group", strconv.Itoa(int(group))).Set(float64(n))
+	}
 	cs.metrics.BlockSizeBytes.Set(float64(block.Size()))
 	cs.metrics.TotalTxs.Set(float64(block.TotalTxs))
 	cs.metrics.CommittedHeight.Set(float64(block.Height))
@@ -1525,6 +1556,50 @@ func (cs *ConsensusState) tryAddVote(vote *types.Vote, peerID p2p.ID) (bool, err
 	return added, nil
 }
 
+// findAmnesia returns evidence against the validators who precommitted the
+// committed block, and then voted for a different block in a later round of
+// the height. It can only be verified once the canonical commit of the height
+// is stored, with the next block.
+func (cs *ConsensusState) findAmnesia(blockID types.BlockID) []types.Evidence {
+	var evidence []types.Evidence
+	lastRound := cs.Votes.Round()
+	for i, val := range cs.Validators.Validators {
+		if cs.privValidator != nil && bytes.Equal(val.Address, cs.privValidator.GetPubKey().Address()) {
+			continue
+		}
+		ev := findValidatorAmnesia(cs.Votes, lastRound, i, blockID)
+		if ev != nil {
+			ev.PubKey = val.PubKey
+			evidence = append(evidence, ev)
+		}
+	}
+	return evidence
+}
+
+// findValidatorAmnesia returns evidence the validator at valIndex voted for a
+// block other than blockID after it precommitted blockID, if any.
+func findValidatorAmnesia(votes *cstypes.HeightVoteSet, lastRound, valIndex int,
+	blockID types.BlockID) *types.AmnesiaEvidence {
+
+	for round := 0; round < lastRound; round++ {
+		precommit := votes.Precommits(round).GetByIndex(valIndex)
+		if precommit == nil || !precommit.BlockID.Equals(blockID) {
+			continue
+		}
+		for later := round + 1; later <= lastRound; later++ {
+			for _, vote := range []*types.Vote{
+				votes.Prevotes(later).GetByIndex(valIndex),
+				votes.Precommits(later).GetByIndex(valIndex),
+			} {
+				if vote != nil && !vote.BlockID.IsZero() && !vote.BlockID.Equals(blockID) {
+					return &types.AmnesiaEvidence{Precommit: precommit, Vote: vote}
+				}
+			}
+		}
+	}
+	return nil
+}
+
 //-----------------------------------------------------------------------------
 
 func (cs *ConsensusState) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error) {
@@ -58,6 +58,7 @@ module.exports = {
           "/tendermint-core/rpc",
           "/tendermint-core/running-in-production",
           "/tendermint-core/fast-sync",
+          "/tendermint-core/state-sync",
           "/tendermint-core/how-to-read-logs",
           "/tendermint-core/block-structure",
           "/tendermint-core/light-client-protocol",
@@ -3,6 +3,7 @@ package evidence
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	clist "github.com/tendermint/tendermint/libs/clist"
 	dbm "github.com/tendermint/tendermint/libs/db"
@@ -20,18 +21,20 @@ type EvidencePool struct {
 	evidenceStore *EvidenceStore
 	evidenceList  *clist.CList // concurrent linked-list of evidence
 
-	// needed to load validators to verify evidence
-	stateDB dbm.DB
+	// needed to load validators and committed blocks to verify evidence
+	stateDB    dbm.DB
+	blockStore sm.BlockStoreRPC
 
 	// latest state
 	mtx   sync.Mutex
 	state sm.State
 }
 
-func NewEvidencePool(stateDB, evidenceDB dbm.DB) *EvidencePool {
+func NewEvidencePool(stateDB, evidenceDB dbm.DB, blockStore sm.BlockStoreRPC) *EvidencePool {
 	evidenceStore := NewEvidenceStore(evidenceDB)
 	evpool := &EvidencePool{
 		stateDB:       stateDB,
+		blockStore:    blockStore,
 		state:         sm.LoadState(stateDB),
 		logger:        log.NewNopLogger(),
 		evidenceStore: evidenceStore,
@@ -85,16 +88,22 @@ func (evpool *EvidencePool) Update(block *types.Block, state sm.State) {
 	evpool.mtx.Unlock()
 
 	// remove evidence from pending and mark committed
-	evpool.MarkEvidenceAsCommitted(block.Height, block.Evidence.Evidence)
+	evpool.MarkEvidenceAsCommitted(block.Height, block.Time, block.Evidence.Evidence)
 }
 
 // AddEvidence checks the evidence is valid and adds it to the pool.
+// ConflictingHeadersEvidence is split into evidence against each validator
+// who signed the header which isn't committed, which is added instead.
 func (evpool *EvidencePool) AddEvidence(evidence types.Evidence) (err error) {
 
 	// TODO: check if we already have evidence for this
 	// validator at this height so we dont get spammed
 
-	if err := sm.VerifyEvidence(evpool.stateDB, evpool.State(), evidence); err != nil {
+	if ev, ok := evidence.(*types.ConflictingHeadersEvidence); ok {
+		return evpool.addConflictingHeaders(ev)
+	}
+
+	if err := sm.VerifyEvidence(evpool.stateDB, evpool.blockStore, evpool.State(), evidence); err != nil {
 		return err
 	}
 
@@ -118,8 +127,31 @@ func (evpool *EvidencePool) AddEvidence(evidence types.Evidence) (err error) {
 	return nil
 }
 
-// MarkEvidenceAsCommitted marks all the evidence as committed and removes it from the queue.
-func (evpool *EvidencePool) MarkEvidenceAsCommitted(height int64, evidence []types.Evidence) {
+// addConflictingHeaders adds the valid evidence split from ev. It fails if
+// none is, as the headers are then no proof of misbehaviour.
+func (evpool *EvidencePool) addConflictingHeaders(ev *types.ConflictingHeadersEvidence) error {
+	split, err := sm.SplitConflictingHeaders(evpool.stateDB, evpool.blockStore, evpool.State(), ev)
+	if err != nil {
+		return err
+	}
+	added := 0
+	for _, evidence := range split {
+		if err := evpool.AddEvidence(evidence); err != nil {
+			evpool.logger.Info("Invalid evidence from conflicting headers", "evidence", evidence, "err", err)
+			continue
+		}
+		added++
+	}
+	if added == 0 {
+		return fmt.Errorf("No valid evidence in %v", ev)
+	}
+	return nil
+}
+
+// MarkEvidenceAsCommitted marks all the evidence as committed and removes it
+// from the queue, along with the evidence that expired as of the block at
+// height with the given time.
+func (evpool *EvidencePool) MarkEvidenceAsCommitted(height int64, lastBlockTime time.Time, evidence []types.Evidence) {
 	// make a map of committed evidence to remove from the clist
 	blockEvidenceMap := make(map[string]struct{})
 	for _, ev := range evidence {
@@ -127,10 +159,17 @@ func (evpool *EvidencePool) MarkEvidenceAsCommitted(height int64, evidence []typ
 		blockEvidenceMap[evMapKey(ev)] = struct{}{}
 	}
 
-	// remove committed evidence from the clist
-	maxAge := evpool.State().ConsensusParams.Evidence.MaxAge
-	evpool.removeEvidence(height, maxAge, blockEvidenceMap)
+	// expired evidence can't be committed anymore, so stop persisting it as
+	// pending, including the evidence loaded before a restart
+	params := evpool.State().ConsensusParams.Evidence
+	for _, ev := range evpool.evidenceStore.PendingEvidence(-1) {
+		if isExpired(ev.Height(), evpool.evidenceTime(ev), height, lastBlockTime, params) {
+			evpool.evidenceStore.MarkEvidenceAsExpired(ev)
+		}
+	}
 
+	// remove committed and expired evidence from the clist
+	evpool.removeEvidence(height, lastBlockTime, params, blockEvidenceMap)
 }
 
 // IsCommitted returns true if we have already seen this exact evidence and it is already marked as committed.
@@ -139,14 +178,16 @@ func (evpool *EvidencePool) IsCommitted(evidence types.Evidence) bool {
 	return ei.Evidence != nil && ei.Committed
 }
 
-func (evpool *EvidencePool) removeEvidence(height, maxAge int64, blockEvidenceMap map[string]struct{}) {
+func (evpool *EvidencePool) removeEvidence(height int64, lastBlockTime time.Time,
+	params types.EvidenceParams, blockEvidenceMap map[string]struct{}) {
+
 	for e := evpool.evidenceList.Front(); e != nil; e = e.Next() {
 		ev := e.Value.(types.Evidence)
 
 		// Remove the evidence if it's already in a block
 		// or if it's now too old.
 		if _, ok := blockEvidenceMap[evMapKey(ev)]; ok ||
-			ev.Height() < height-maxAge {
+			isExpired(ev.Height(), evpool.evidenceTime(ev), height, lastBlockTime, params) {
 
 			// remove from clist
 			evpool.evidenceList.Remove(e)
@@ -155,6 +196,23 @@ func (evpool *EvidencePool) removeEvidence(height, maxAge int64, blockEvidenceMa
 	}
 }
 
+// evidenceTime returns the time of the block at the height of ev (see
+// sm.EvidenceBlockTime).
+func (evpool *EvidencePool) evidenceTime(ev types.Evidence) time.Time {
+	return sm.EvidenceBlockTime(evpool.blockStore, evpool.State(), ev.Height())
+}
+
+// isExpired returns true if the evidence from the block at evHeight with time
+// evTime is older than both params.MaxAge blocks and params.MaxAgeDuration, as
+// of the block at height with the given time.
+func isExpired(evHeight int64, evTime time.Time, height int64, lastBlockTime time.Time,
+	params types.EvidenceParams) bool {
+
+	ageNumBlocks := height - evHeight
+	ageDuration := lastBlockTime.Sub(evTime)
+	return ageNumBlocks > params.MaxAge && ageDuration > params.MaxAgeDuration
+}
+
 func evMapKey(ev types.Evidence) string {
 	return string(ev.Hash())
 }
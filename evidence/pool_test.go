@@ -4,6 +4,7 @@ import (
 	"os"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -37,7 +38,8 @@ func initializeValidatorState(valAddr []byte, height int64) dbm.DB {
 		LastHeightValidatorsChanged: 1,
 		ConsensusParams: types.ConsensusParams{
 			Evidence: types.EvidenceParams{
-				MaxAge: 1000000,
+				MaxAge:         1000000,
+				MaxAgeDuration: 48 * time.Hour,
 			},
 		},
 	}
@@ -57,7 +59,7 @@ func TestEvidencePool(t *testing.T) {
 	height := int64(5)
 	stateDB := initializeValidatorState(valAddr, height)
 	evidenceDB := dbm.NewMemDB()
-	pool := NewEvidencePool(stateDB, evidenceDB)
+	pool := NewEvidencePool(stateDB, evidenceDB, nil)
 
 	goodEvidence := types.NewMockGoodEvidence(height, 0, valAddr)
 	badEvidence := types.MockBadEvidence{goodEvidence}
@@ -91,7 +93,7 @@ func TestEvidencePoolIsCommitted(t *testing.T) {
 	height := int64(42)
 	stateDB := initializeValidatorState(valAddr, height)
 	evidenceDB := dbm.NewMemDB()
-	pool := NewEvidencePool(stateDB, evidenceDB)
+	pool := NewEvidencePool(stateDB, evidenceDB, nil)
 
 	// evidence not seen yet:
 	evidence := types.NewMockGoodEvidence(height, 0, valAddr)
@@ -102,6 +104,57 @@ func TestEvidencePoolIsCommitted(t *testing.T) {
 	assert.False(t, pool.IsCommitted(evidence))
 
 	// evidence seen and committed:
-	pool.MarkEvidenceAsCommitted(height, []types.Evidence{evidence})
+	pool.MarkEvidenceAsCommitted(height, tmtime.Now(), []types.Evidence{evidence})
 	assert.True(t, pool.IsCommitted(evidence))
 }
+
+func TestEvidencePoolPrunesExpired(t *testing.T) {
+	valAddr := []byte("validator_address")
+	height := int64(42)
+	stateDB := initializeValidatorState(valAddr, height)
+	evidenceDB := dbm.NewMemDB()
+	pool := NewEvidencePool(stateDB, evidenceDB, nil)
+
+	// mock evidence has the zero time, so it's always old enough in time
+	evidence := types.NewMockGoodEvidence(height, 0, valAddr)
+	assert.NoError(t, pool.AddEvidence(evidence))
+
+	// not old enough in blocks
+	maxAge := pool.State().ConsensusParams.Evidence.MaxAge
+	pool.MarkEvidenceAsCommitted(height+maxAge, tmtime.Now(), nil)
+	assert.Equal(t, 1, pool.evidenceList.Len())
+	assert.Len(t, pool.PendingEvidence(-1), 1)
+
+	// old enough in both
+	pool.MarkEvidenceAsCommitted(height+maxAge+1, tmtime.Now(), nil)
+	assert.Equal(t, 0, pool.evidenceList.Len())
+	assert.Len(t, pool.PendingEvidence(-1), 0)
+	assert.False(t, pool.IsCommitted(evidence))
+
+	// it's not added again
+	assert.NoError(t, pool.AddEvidence(evidence))
+	assert.Equal(t, 0, pool.evidenceList.Len())
+}
+
+func TestIsExpired(t *testing.T) {
+	params := types.EvidenceParams{MaxAge: 10, MaxAgeDuration: time.Hour}
+	evTime := tmtime.Now()
+
+	testCases := []struct {
+		evTime        time.Time
+		height        int64
+		lastBlockTime time.Time
+		expired       bool
+	}{
+		{evTime, 110, evTime.Add(time.Hour), false},
+		{evTime, 111, evTime.Add(time.Hour), false},
+		{evTime, 110, evTime.Add(time.Hour + 1), false},
+		{evTime, 111, evTime.Add(time.Hour + 1), true},
+		// unknown block time
+		{time.Time{}, 110, evTime, false},
+		{time.Time{}, 111, evTime, true},
+	}
+	for i, tc := range testCases {
+		assert.Equal(t, tc.expired, isExpired(100, tc.evTime, tc.height, tc.lastBlockTime, params), "#%d", i)
+	}
+}
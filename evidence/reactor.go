@@ -173,17 +173,21 @@ func (evR EvidenceReactor) checkSendEvidenceMessage(peer p2p.Peer, ev types.Evid
 	}
 
 	// NOTE: We only send evidence to peers where
-	// peerHeight - maxAge < evidenceHeight < peerHeight
-	maxAge := evR.evpool.State().ConsensusParams.Evidence.MaxAge
+	// peerHeight - maxAge < evidenceHeight < peerHeight,
+	// unless the evidence is also younger than maxAgeDuration. We don't know
+	// the peer's block time, so we use ours.
+	state := evR.evpool.State()
+	params := state.ConsensusParams.Evidence
 	peerHeight := peerState.GetHeight()
 	if peerHeight < evHeight {
 		// peer is behind. sleep while he catches up
 		return nil, true
-	} else if peerHeight > evHeight+maxAge {
+	} else if isExpired(evHeight, evR.evpool.evidenceTime(ev), peerHeight, state.LastBlockTime, params) {
 		// evidence is too old, skip
 		// NOTE: if evidence is too old for an honest peer,
 		// then we're behind and either it already got committed or it never will!
-		evR.Logger.Info("Not sending peer old evidence", "peerHeight", peerHeight, "evHeight", evHeight, "maxAge", maxAge, "peer", peer)
+		evR.Logger.Info("Not sending peer old evidence", "peerHeight", peerHeight, "evHeight", evHeight,
+			"maxAge", params.MaxAge, "maxAgeDuration", params.MaxAgeDuration, "peer", peer)
 		return nil, false
 	}
 
@@ -38,7 +38,7 @@ func makeAndConnectEvidenceReactors(config *cfg.Config, stateDBs []dbm.DB) []*Ev
 	for i := 0; i < N; i++ {
 
 		evidenceDB := dbm.NewMemDB()
-		pool := NewEvidencePool(stateDBs[i], evidenceDB)
+		pool := NewEvidencePool(stateDBs[i], evidenceDB, nil)
 		reactors[i] = NewEvidenceReactor(pool)
 		reactors[i].SetLogger(logger.With("validator", i))
 	}
@@ -17,6 +17,7 @@ Impl:
 	- First commit atomically in outqueue, pending, lookup.
 	- Once broadcast, remove from outqueue. No need to sync
 	- Once committed, atomically remove from pending and update lookup.
+	- Once expired, remove from outqueue and pending.
 
 Schema for indexing evidence (note you need both height and hash to find a piece of evidence):
 
@@ -194,6 +195,15 @@ func (store *EvidenceStore) MarkEvidenceAsCommitted(evidence types.Evidence) {
 	store.db.SetSync(lookupKey, cdc.MustMarshalBinaryBare(ei))
 }
 
+// MarkEvidenceAsExpired removes evidence from pending and outqueue. It stays
+// in the lookup, uncommitted, so it isn't added again.
+func (store *EvidenceStore) MarkEvidenceAsExpired(evidence types.Evidence) {
+	store.MarkEvidenceAsBroadcasted(evidence)
+
+	pendingKey := keyPending(evidence)
+	store.db.Delete(pendingKey)
+}
+
 //---------------------------------------------------
 // utils
 
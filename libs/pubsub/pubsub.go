@@ -138,6 +138,44 @@ func (ts tagMap) Len() int {
 	return len(ts)
 }
 
+// MultiTagMap is a TagMap in which a tag can have several values. A query
+// condition on such a tag matches if any of its values matches.
+type MultiTagMap interface {
+	TagMap
+	// GetAll returns all the values for a key, or nil if no value is present.
+	GetAll(key string) []string
+}
+
+type multiTagMap map[string][]string
+
+var _ MultiTagMap = (*multiTagMap)(nil)
+
+// NewMultiTagMap constructs a new immutable tag set from a map of the values
+// of each tag.
+func NewMultiTagMap(data map[string][]string) MultiTagMap {
+	return multiTagMap(data)
+}
+
+// Get returns the first value for a key, or nil if no value is present.
+// The ok result indicates whether value was found in the tags.
+func (ts multiTagMap) Get(key string) (value string, ok bool) {
+	values := ts[key]
+	if len(values) == 0 {
+		return "", false
+	}
+	return values[0], true
+}
+
+// GetAll returns all the values for a key, or nil if no value is present.
+func (ts multiTagMap) GetAll(key string) []string {
+	return ts[key]
+}
+
+// Len returns the number of tags.
+func (ts multiTagMap) Len() int {
+	return len(ts)
+}
+
 // NewServer returns a new server. See the commentary on the Option functions
 // for a detailed description of how to configure buffering. If no options are
 // provided, the resulting server's queue is unbuffered.
@@ -240,12 +240,28 @@ func (q *Query) Matches(tags pubsub.TagMap) bool {
 // value from it to the operand using the operator.
 //
 // "tx.gas", "=", "7", { "tx.gas": 7, "tx.ID": "4AE393495334" }
+//
+// If tags is a MultiTagMap, any of the values of the tag can match.
 func match(tag string, op Operator, operand reflect.Value, tags pubsub.TagMap) bool {
+	if mtags, ok := tags.(pubsub.MultiTagMap); ok {
+		for _, value := range mtags.GetAll(tag) {
+			if matchValue(value, op, operand) {
+				return true
+			}
+		}
+		return false
+	}
+
 	// look up the tag from the query in tags
 	value, ok := tags.Get(tag)
 	if !ok {
 		return false
 	}
+	return matchValue(value, op, operand)
+}
+
+// matchValue compares the value of a tag to the operand using the operator.
+func matchValue(value string, op Operator, operand reflect.Value) bool {
 	switch operand.Kind() {
 	case reflect.Struct: // time
 		operandAsTime := operand.Interface().(time.Time)
@@ -60,6 +60,19 @@ func TestMatches(t *testing.T) {
 	}
 }
 
+func TestMatchesMultiTagMap(t *testing.T) {
+	tags := pubsub.NewMultiTagMap(map[string][]string{
+		"tm.event":    {"NewBlock"},
+		"block.group": {"2", "0", "5"},
+	})
+
+	assert.True(t, query.MustParse("tm.event='NewBlock' AND block.group=2").Matches(tags))
+	assert.True(t, query.MustParse("tm.event='NewBlock' AND block.group=5").Matches(tags))
+	assert.True(t, query.MustParse("block.group > 4").Matches(tags))
+	assert.False(t, query.MustParse("block.group=3").Matches(tags))
+	assert.False(t, query.MustParse("block.height=3").Matches(tags))
+}
+
 func TestMustParse(t *testing.T) {
 	assert.Panics(t, func() { query.MustParse("=") })
 	assert.NotPanics(t, func() { query.MustParse("tm.events.type='NewBlock'") })
@@ -2,6 +2,7 @@ package lite
 
 import (
 	"bytes"
+	"fmt"
 
 	cmn "github.com/tendermint/tendermint/libs/common"
 	lerr "github.com/tendermint/tendermint/lite/errors"
@@ -19,6 +20,11 @@ type BaseVerifier struct {
 	chainID string
 	height  int64
 	valset  *types.ValidatorSet
+
+	// see types.ValidatorParams.GroupValidators
+	groupValidators bool
+	// see SetLastHeader
+	lastHeader *types.Header
 }
 
 // NewBaseVerifier returns a new Verifier initialized with a validator set at
@@ -34,6 +40,21 @@ func NewBaseVerifier(chainID string, height int64, valset *types.ValidatorSet) *
 	}
 }
 
+// SetGroupValidators makes the verifier check commits against the validators
+// of the header's group only. It must match the chain's
+// ValidatorParams.GroupValidators.
+func (bv *BaseVerifier) SetGroupValidators(groupValidators bool) {
+	bv.groupValidators = groupValidators
+}
+
+// SetLastHeader sets the header preceding the header to verify. With group
+// validators, the group of a header must be the one following the group of
+// the previous header, so only the header right after lastHeader can be
+// verified, or the header at height 1 if lastHeader is nil.
+func (bv *BaseVerifier) SetLastHeader(lastHeader *types.Header) {
+	bv.lastHeader = lastHeader
+}
+
 // Implements Verifier.
 func (bv *BaseVerifier) ChainID() string {
 	return bv.chainID
@@ -67,7 +88,15 @@ func (bv *BaseVerifier) Verify(signedHeader types.SignedHeader) error {
 	}
 
 	// Check commit signatures.
-	err = bv.valset.VerifyCommit(
+	valset := bv.valset
+	if bv.groupValidators {
+		err = verifyGroup(valset, signedHeader.Header, bv.lastHeader)
+		if err != nil {
+			return cmn.ErrorWrap(err, "in verify")
+		}
+		valset = valset.GroupSet(signedHeader.Group)
+	}
+	err = valset.VerifyCommit(
 		bv.chainID, signedHeader.Commit.BlockID,
 		signedHeader.Height, signedHeader.Commit)
 	if err != nil {
@@ -76,3 +105,27 @@ func (bv *BaseVerifier) Verify(signedHeader types.SignedHeader) error {
 
 	return nil
 }
+
+// verifyGroup checks that the group of header is the one scheduled after the
+// group of lastHeader, the header preceding it, as state.validateBlock does
+// with group validators. vals are the validators of header. lastHeader must
+// be nil for the header at height 1, which follows the genesis group 0.
+func verifyGroup(vals *types.ValidatorSet, header, lastHeader *types.Header) error {
+	var lastGroup int32
+	if header.Height > 1 {
+		if lastHeader == nil || lastHeader.Height != header.Height-1 {
+			return fmt.Errorf("need the header at height %d to verify the group of the header at height %d",
+				header.Height-1, header.Height)
+		}
+		// header.LastBlockID authenticates lastHeader, and its group
+		if !bytes.Equal(lastHeader.Hash(), header.LastBlockID.Hash) {
+			return fmt.Errorf("header at height %d has hash %X, expected %X",
+				lastHeader.Height, lastHeader.Hash(), header.LastBlockID.Hash)
+		}
+		lastGroup = lastHeader.Group
+	}
+	if expected := vals.NextGroup(lastGroup); header.Group != expected {
+		return fmt.Errorf("wrong Header.Group. Expected %v, got %v", expected, header.Group)
+	}
+	return nil
+}
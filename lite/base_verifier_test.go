@@ -54,3 +54,73 @@ func TestBaseCert(t *testing.T) {
 		}
 	}
 }
+
+// groupVals returns a validator set of 4 validators of groups 1, 1, 2 and 2
+// for the keys.
+func groupVals(keys privKeys) *types.ValidatorSet {
+	vals := make([]*types.Validator, len(keys))
+	for i, key := range keys {
+		vals[i] = types.NewValidator(key.PubKey(), 10, int32(1+i/2))
+	}
+	return types.NewValidatorSet(vals)
+}
+
+// genGroupHeader returns a header of the given group following last, signed
+// by the validators of the group.
+func genGroupHeader(chainID string, height int64, group int32, last *types.Header,
+	keys privKeys, vals *types.ValidatorSet) types.SignedHeader {
+
+	header := genHeader(chainID, height, nil, vals, vals, []byte("foo"), []byte("params"), []byte("results"))
+	header.Group = group
+	if last != nil {
+		header.LastBlockID = types.BlockID{Hash: last.Hash()}
+	}
+
+	groupSet := vals.GroupSet(group)
+	commitSigs := make([]*types.CommitSig, groupSet.Size())
+	for _, key := range keys {
+		if idx, _ := groupSet.GetByAddress(key.PubKey().Address()); idx >= 0 {
+			commitSigs[idx] = makeVote(header, groupSet, key).CommitSig()
+		}
+	}
+	commit := types.NewCommit(types.BlockID{Hash: header.Hash()}, commitSigs)
+	return types.SignedHeader{Header: header, Commit: commit}
+}
+
+func TestBaseCertGroups(t *testing.T) {
+	keys := genPrivKeys(4)
+	vals := groupVals(keys)
+	chainID := "test-groups"
+
+	// groups 0, 1 and 2 take turns, starting after the genesis group 0
+	h1 := genGroupHeader(chainID, 1, 1, nil, keys, vals)
+	h2 := genGroupHeader(chainID, 2, 2, h1.Header, keys, vals)
+	otherH1 := genGroupHeader(chainID, 1, 2, nil, keys, vals)
+
+	cases := []struct {
+		name       string
+		sh         types.SignedHeader
+		lastHeader *types.Header
+		proper     bool
+	}{
+		{"first group after genesis", h1, nil, true},
+		{"wrong group after genesis", otherH1, nil, false},
+		{"next group", h2, h1.Header, true},
+		{"wrong group", genGroupHeader(chainID, 2, 1, h1.Header, keys, vals), h1.Header, false},
+		{"unknown last header", h2, nil, false},
+		{"last header not pointed to", h2, otherH1.Header, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cert := NewBaseVerifier(chainID, tc.sh.Height, vals)
+			cert.SetGroupValidators(true)
+			cert.SetLastHeader(tc.lastHeader)
+			err := cert.Verify(tc.sh)
+			if tc.proper {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -34,6 +34,19 @@ func NewFullCommit(signedHeader types.SignedHeader, valset, nextValset *types.Va
 // If > 2/3 did not sign the Commit from fc.Validators, it
 // is not a valid commit!
 func (fc FullCommit) ValidateFull(chainID string) error {
+	return fc.validateFull(chainID, false)
+}
+
+// ValidateFullGroup is like ValidateFull, but for chains with
+// ValidatorParams.GroupValidators: the Commit must be signed by > 2/3 of the
+// validators of the header's group. It doesn't check that the group is the
+// one scheduled after the group of the previous header, which the
+// DynamicVerifier does.
+func (fc FullCommit) ValidateFullGroup(chainID string) error {
+	return fc.validateFull(chainID, true)
+}
+
+func (fc FullCommit) validateFull(chainID string, groupValidators bool) error {
 	// Ensure that Validators exists and matches the header.
 	if fc.Validators.Size() == 0 {
 		return errors.New("need FullCommit.Validators")
@@ -65,6 +78,11 @@ func (fc FullCommit) ValidateFull(chainID string) error {
 	}
 	// Validate the signatures on the commit.
 	hdr, cmt := fc.SignedHeader.Header, fc.SignedHeader.Commit
+	if groupValidators {
+		return fc.Validators.VerifyGroupCommit(
+			hdr.ChainID, cmt.BlockID,
+			hdr.Height, hdr.Group, cmt)
+	}
 	return fc.Validators.VerifyCommit(
 		hdr.ChainID, cmt.BlockID,
 		hdr.Height, cmt)
@@ -0,0 +1,64 @@
+package lite
+
+import (
+	"bytes"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+// ConflictReport describes a witness having a validly signed header which
+// conflicts with the one being verified. Either the chain forked, or the
+// primary source or the witness is attacking the lite client; whichever it
+// is, it must be reported to the validators of the chain.
+type ConflictReport struct {
+	// Witness is the index of the witness, as passed to SetWitnesses.
+	Witness int
+
+	SignedHeader  types.SignedHeader
+	WitnessHeader types.SignedHeader
+
+	// DuplicateVotes is evidence against the validators who signed both
+	// headers in the same round.
+	DuplicateVotes []*types.DuplicateVoteEvidence
+}
+
+// Evidence returns the conflicting headers as evidence, which full nodes
+// split into evidence against the validators who signed the header which
+// isn't committed (see types.ConflictingHeadersEvidence).
+func (r ConflictReport) Evidence() *types.ConflictingHeadersEvidence {
+	h1, h2 := r.SignedHeader, r.WitnessHeader
+	return &types.ConflictingHeadersEvidence{H1: &h1, H2: &h2}
+}
+
+// duplicateVotes returns evidence against the validators of vals which
+// precommitted to different blocks in commitA and commitB, in the same round.
+func duplicateVotes(chainID string, vals *types.ValidatorSet,
+	commitA, commitB *types.Commit) []*types.DuplicateVoteEvidence {
+
+	var evidence []*types.DuplicateVoteEvidence
+	for _, precommitA := range commitA.Precommits {
+		if precommitA == nil {
+			continue
+		}
+		voteA := commitA.ToVote(precommitA)
+		for _, precommitB := range commitB.Precommits {
+			if precommitB == nil || !bytes.Equal(precommitB.ValidatorAddress, voteA.ValidatorAddress) {
+				continue
+			}
+			_, val := vals.GetByAddress(voteA.ValidatorAddress)
+			if val == nil {
+				break
+			}
+			ev := &types.DuplicateVoteEvidence{
+				PubKey: val.PubKey,
+				VoteA:  voteA,
+				VoteB:  commitB.ToVote(precommitB),
+			}
+			if ev.Verify(chainID, val.PubKey) == nil {
+				evidence = append(evidence, ev)
+			}
+			break
+		}
+	}
+	return evidence
+}
@@ -103,8 +103,9 @@ validator set V, and another block at height H' (H' > H) with validator set V'
 
 First, we get the new (unconfirmed) validator set V' and verify that H' is
 internally consistent and properly signed by this V'. Assuming it is a valid
-block, we check that at least 2/3 of the validators in V also signed it,
-meaning it would also be valid under our old assumptions.  Then, we accept H'
+block, we check that more than 1/3 (the trust level, see SetTrustLevel) of the
+validators in V also signed it, meaning at least one correct validator we
+trust vouches for it.  Then, we accept H'
 and V' as valid and trusted and use that to validate for heights X > H' until a
 more recent and updated validator set is found.
 
@@ -121,11 +122,24 @@ on our trusted validator set and cryptographic proofs. This makes it extremely
 important to verify that you have the proper validator set when initializing
 the client, as that is the root of all trust.
 
-The software currently assumes that the unbonding period is infinite in
-duration.  If the DynamicVerifier hasn't been updated in a while, you should
-manually verify the block headers using other sources.
-
-TODO: Update the software to handle cases around the unbonding period.
+Once the unbonding period of a header's validators has passed, they can sign
+conflicting headers without being punished. The DynamicVerifier therefore only
+trusts a header for a trusting period (see SetTrustingPeriod), which should be
+shorter than the unbonding period. If the DynamicVerifier hasn't been updated
+within the trusting period, Verify fails with ErrTrustedHeaderExpired and the
+trusted header must be verified again using other sources.
+
+Detecting Attacks
+
+Headers verified through the source can be cross-checked against witnesses,
+other Providers connected to different full nodes (see SetWitnesses). If a
+witness has a different header at the same height, which can be verified
+from the same trusted header (signed by more than the trust level of its next
+validators), the header isn't trusted and a ConflictReport is produced. It
+contains DuplicateVoteEvidence against the validators who signed both headers
+in the same round. Its Evidence, a ConflictingHeadersEvidence, can be
+submitted to a full node, which splits it into evidence against all the
+validators who signed the header which isn't committed.
 
 */
 package lite
@@ -4,14 +4,22 @@ import (
 	"bytes"
 	"fmt"
 	"sync"
+	"time"
 
 	log "github.com/tendermint/tendermint/libs/log"
 	lerr "github.com/tendermint/tendermint/lite/errors"
 	"github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
 )
 
 const sizeOfPendingMap = 1024
 
+// DefaultTrustLevel is the fraction of the trusted validators' voting power
+// which must have signed a non-adjacent header for the DynamicVerifier to
+// trust it without verifying the headers in between. 1/3 guarantees that at
+// least one correct validator signed it.
+var DefaultTrustLevel = [2]int64{1, 3}
+
 var _ Verifier = (*DynamicVerifier)(nil)
 
 // DynamicVerifier implements an auto-updating Verifier.  It uses a
@@ -34,6 +42,20 @@ type DynamicVerifier struct {
 	// pending map to synchronize concurrent verification requests
 	mtx                  sync.Mutex
 	pendingVerifications map[int64]chan struct{}
+
+	// see types.ValidatorParams.GroupValidators
+	groupValidators bool
+
+	// see SetTrustLevel
+	trustNumerator, trustDenominator int64
+
+	// see SetTrustingPeriod
+	trustingPeriod time.Duration
+	now            func() time.Time
+
+	// see SetWitnesses
+	witnesses  []Provider
+	onConflict func(ConflictReport)
 }
 
 // NewDynamicVerifier returns a new DynamicVerifier. It uses the
@@ -49,6 +71,9 @@ func NewDynamicVerifier(chainID string, trusted PersistentProvider, source Provi
 		trusted:              trusted,
 		source:               source,
 		pendingVerifications: make(map[int64]chan struct{}, sizeOfPendingMap),
+		trustNumerator:       DefaultTrustLevel[0],
+		trustDenominator:     DefaultTrustLevel[1],
+		now:                  tmtime.Now,
 	}
 }
 
@@ -59,6 +84,49 @@ func (dv *DynamicVerifier) SetLogger(logger log.Logger) {
 	dv.source.SetLogger(logger)
 }
 
+// SetGroupValidators makes the verifier check commits against the validators
+// of the header's group only. It must match the chain's
+// ValidatorParams.GroupValidators.
+func (dv *DynamicVerifier) SetGroupValidators(groupValidators bool) {
+	dv.groupValidators = groupValidators
+}
+
+// GroupValidators returns whether commits are checked against the validators
+// of the header's group only.
+func (dv *DynamicVerifier) GroupValidators() bool {
+	return dv.groupValidators
+}
+
+// SetTrustLevel sets the fraction of the trusted validators' voting power
+// which must have signed a non-adjacent header for it to be trusted. It must be
+// between 1/3 and 1; the default is DefaultTrustLevel.
+func (dv *DynamicVerifier) SetTrustLevel(numerator, denominator int64) error {
+	if denominator <= 0 || numerator*3 < denominator || numerator > denominator {
+		return fmt.Errorf("trust level must be within [1/3, 1], got %d/%d", numerator, denominator)
+	}
+	dv.trustNumerator, dv.trustDenominator = numerator, denominator
+	return nil
+}
+
+// SetTrustingPeriod sets how long a trusted header can be used to verify new
+// headers, measured from its time. It should be significantly less than the
+// unbonding period of the chain, after which the validators who signed a
+// header can no longer be punished for signing a conflicting one. Once the
+// latest trusted header expires, Verify returns ErrTrustedHeaderExpired and
+// trust must be initialized again. Zero, the default, disables expiry.
+func (dv *DynamicVerifier) SetTrustingPeriod(period time.Duration) {
+	dv.trustingPeriod = period
+}
+
+// SetWitnesses sets providers that newly verified headers are cross-checked
+// against. If a witness has a different header at the same height, verified
+// from the same trusted header, the header isn't trusted, Verify returns ErrConflictingHeaders and
+// onConflict, if not nil, is called with a report of the conflict.
+func (dv *DynamicVerifier) SetWitnesses(onConflict func(ConflictReport), witnesses ...Provider) {
+	dv.witnesses = witnesses
+	dv.onConflict = onConflict
+}
+
 // Implements Verifier.
 func (dv *DynamicVerifier) ChainID() string {
 	return dv.chainID
@@ -113,6 +181,9 @@ func (dv *DynamicVerifier) Verify(shdr types.SignedHeader) error {
 	if err != nil {
 		return err
 	}
+	if err := dv.checkExpired(trustedFC); err != nil {
+		return err
+	}
 
 	// sync up to the prevHeight and assert our latest NextValidatorSet
 	// is the ValidatorSet for the SignedHeader
@@ -147,11 +218,24 @@ func (dv *DynamicVerifier) Verify(shdr types.SignedHeader) error {
 
 	// Verify the signed header using the matching valset.
 	cert := NewBaseVerifier(dv.chainID, trustedFC.Height()+1, trustedFC.NextValidators)
+	cert.SetGroupValidators(dv.groupValidators)
+	if dv.groupValidators {
+		lastHeader, err := dv.lastHeader(dv.source, trustedFC, shdr)
+		if err != nil {
+			return err
+		}
+		cert.SetLastHeader(lastHeader)
+	}
 	err = cert.Verify(shdr)
 	if err != nil {
 		return err
 	}
 
+	// Make sure the witnesses agree before trusting it.
+	if err := dv.checkWitnesses(shdr, trustedFC); err != nil {
+		return err
+	}
+
 	// By now, the SignedHeader is fully validated and we're synced up to
 	// SignedHeader.Height - 1. To sync to SignedHeader.Height, we need
 	// the validator set at SignedHeader.Height + 1 so we can verify the
@@ -176,31 +260,136 @@ func (dv *DynamicVerifier) Verify(shdr types.SignedHeader) error {
 	}
 	// Validate the full commit.  This checks the cryptographic
 	// signatures of Commit against Validators.
-	if err := nfc.ValidateFull(dv.chainID); err != nil {
+	if err := dv.validateFull(nfc); err != nil {
 		return err
 	}
 	// Trust it.
 	return dv.trusted.SaveFullCommit(nfc)
 }
 
+// checkExpired returns ErrTrustedHeaderExpired if the trusting period of fc
+// has passed.
+func (dv *DynamicVerifier) checkExpired(fc FullCommit) error {
+	if dv.trustingPeriod == 0 {
+		return nil
+	}
+	expiresAt := fc.SignedHeader.Time.Add(dv.trustingPeriod)
+	if !expiresAt.After(dv.now()) {
+		return lerr.ErrTrustedHeaderExpired(fc.Height(), expiresAt)
+	}
+	return nil
+}
+
+// checkWitnesses fetches the header at the height of shdr from every witness.
+// A witness having another header, which can be verified from trustedFC like
+// shdr was, is a conflict, which is reported. Witnesses which fail to respond
+// or respond with invalid headers are ignored: a header signed by validators
+// unknown to trustedFC proves nothing.
+func (dv *DynamicVerifier) checkWitnesses(shdr types.SignedHeader, trustedFC FullCommit) error {
+	for i, witness := range dv.witnesses {
+		fc, err := witness.LatestFullCommit(dv.chainID, shdr.Height, shdr.Height)
+		if err != nil {
+			dv.logger.Info("Witness failed to provide the header", "witness", i, "height", shdr.Height, "err", err)
+			continue
+		}
+		if fc.Height() != shdr.Height || bytes.Equal(fc.SignedHeader.Hash(), shdr.Hash()) {
+			continue
+		}
+		if err := dv.validateFull(fc); err != nil {
+			dv.logger.Info("Witness provided an invalid header", "witness", i, "height", shdr.Height, "err", err)
+			continue
+		}
+		if err := dv.verifyTrusting(witness, trustedFC, fc); err != nil {
+			dv.logger.Info("Witness provided a header not signed by the trusted validators", "witness", i,
+				"height", shdr.Height, "trustedHeight", trustedFC.Height(), "err", err)
+			continue
+		}
+
+		dv.logger.Error("Witness has a conflicting header", "witness", i, "height", shdr.Height,
+			"hash", shdr.Hash(), "witnessHash", fc.SignedHeader.Hash())
+		if dv.onConflict != nil {
+			dv.onConflict(ConflictReport{
+				Witness:        i,
+				SignedHeader:   shdr,
+				WitnessHeader:  fc.SignedHeader,
+				DuplicateVotes: duplicateVotes(dv.chainID, trustedFC.NextValidators, shdr.Commit, fc.SignedHeader.Commit),
+			})
+		}
+		return lerr.ErrConflictingHeaders(shdr.Height)
+	}
+	return nil
+}
+
 // verifyAndSave will verify if this is a valid source full commit given the
 // best match trusted full commit, and if good, persist to dv.trusted.
-// Returns ErrTooMuchChange when >2/3 of trustedFC did not sign sourceFC.
+// Returns ErrTooMuchChange when no more than the trust level of trustedFC
+// signed sourceFC.
 // Panics if trustedFC.Height() >= sourceFC.Height().
 func (dv *DynamicVerifier) verifyAndSave(trustedFC, sourceFC FullCommit) error {
 	if trustedFC.Height() >= sourceFC.Height() {
 		panic("should not happen")
 	}
-	err := trustedFC.NextValidators.VerifyFutureCommit(
-		sourceFC.Validators,
+	if err := dv.verifyTrusting(dv.source, trustedFC, sourceFC); err != nil {
+		return err
+	}
+
+	return dv.trusted.SaveFullCommit(sourceFC)
+}
+
+// verifyTrusting checks that more than the trust level of the next
+// validators of trustedFC signed sourceFC. With group validators, the group
+// of sourceFC is checked against the header preceding it, fetched from
+// provider unless it is the one of trustedFC.
+func (dv *DynamicVerifier) verifyTrusting(provider Provider, trustedFC, sourceFC FullCommit) error {
+	oldVals, newVals := trustedFC.NextValidators, sourceFC.Validators
+	if dv.groupValidators {
+		lastHeader, err := dv.lastHeader(provider, trustedFC, sourceFC.SignedHeader)
+		if err != nil {
+			return err
+		}
+		if err := verifyGroup(newVals, sourceFC.SignedHeader.Header, lastHeader); err != nil {
+			return err
+		}
+		// the old validators of the group must have signed too
+		group := sourceFC.SignedHeader.Group
+		oldVals, newVals = oldVals.GroupSet(group), newVals.GroupSet(group)
+	}
+	return oldVals.VerifyCommitTrusting(
+		newVals,
 		dv.chainID, sourceFC.SignedHeader.Commit.BlockID,
 		sourceFC.SignedHeader.Height, sourceFC.SignedHeader.Commit,
+		dv.trustNumerator, dv.trustDenominator,
 	)
+}
+
+// lastHeader returns the header preceding shdr, needed to verify its group:
+// the header of trustedFC if it is adjacent, otherwise the one fetched from
+// provider. verifyGroup checks that shdr.LastBlockID points to it.
+func (dv *DynamicVerifier) lastHeader(provider Provider, trustedFC FullCommit, shdr types.SignedHeader) (*types.Header, error) {
+	prevHeight := shdr.Height - 1
+	if prevHeight == 0 {
+		return nil, nil
+	}
+	if trustedFC.Height() == prevHeight {
+		return trustedFC.SignedHeader.Header, nil
+	}
+	fc, err := provider.LatestFullCommit(dv.chainID, prevHeight, prevHeight)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if fc.Height() != prevHeight {
+		return nil, lerr.ErrCommitNotFound()
 	}
+	return fc.SignedHeader.Header, nil
+}
 
-	return dv.trusted.SaveFullCommit(sourceFC)
+// validateFull validates fc with FullCommit.ValidateFull or, for group
+// validators, FullCommit.ValidateFullGroup.
+func (dv *DynamicVerifier) validateFull(fc FullCommit) error {
+	if dv.groupValidators {
+		return fc.ValidateFullGroup(dv.chainID)
+	}
+	return fc.ValidateFull(dv.chainID)
 }
 
 // updateToHeight will use divide-and-conquer to find a path to h.
@@ -223,7 +412,7 @@ func (dv *DynamicVerifier) updateToHeight(h int64) (FullCommit, error) {
 
 	// Validate the full commit.  This checks the cryptographic
 	// signatures of Commit against Validators.
-	if err := sourceFC.ValidateFull(dv.chainID); err != nil {
+	if err := dv.validateFull(sourceFC); err != nil {
 		return FullCommit{}, err
 	}
 
@@ -239,6 +428,9 @@ FOR_LOOP:
 		if trustedFC.Height() == h {
 			return trustedFC, nil
 		}
+		if err := dv.checkExpired(trustedFC); err != nil {
+			return FullCommit{}, err
+		}
 
 		// Try to update to full commit with checks.
 		err = dv.verifyAndSave(trustedFC, sourceFC)
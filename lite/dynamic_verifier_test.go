@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	dbm "github.com/tendermint/tendermint/libs/db"
 	log "github.com/tendermint/tendermint/libs/log"
+	lerr "github.com/tendermint/tendermint/lite/errors"
 	"github.com/tendermint/tendermint/types"
 )
 
@@ -124,6 +126,174 @@ func TestDynamicVerify(t *testing.T) {
 
 }
 
+func TestDynamicVerifySkipping(t *testing.T) {
+	chainID := "dynamic-verifier"
+	power := int64(10)
+	keys1 := genPrivKeys(5)
+	vals1 := keys1.ToValidators(power, 0)
+	// 2 of the 5 validators remain, i.e. 40% of the trusted voting power.
+	keys2 := append(keys1[:2:2], genPrivKeys(4)...)
+	vals2 := keys2.ToValidators(power, 0)
+
+	// The source only has the headers before and after the change.
+	first := makeFullCommit(0, keys1, vals1, vals1, chainID)
+	before := makeFullCommit(8, keys2, vals2, vals2, chainID)
+	last := makeFullCommit(9, keys2, vals2, vals2, chainID)
+
+	newVerifier := func() *DynamicVerifier {
+		trust := NewDBProvider("trust", dbm.NewMemDB())
+		source := NewDBProvider("source", dbm.NewMemDB())
+		require.NoError(t, trust.SaveFullCommit(first))
+		for _, fc := range []FullCommit{first, before, last} {
+			require.NoError(t, source.SaveFullCommit(fc))
+		}
+		ver := NewDynamicVerifier(chainID, trust, source)
+		ver.SetLogger(log.TestingLogger())
+		return ver
+	}
+
+	// More than 1/3 of the trusted validators signed it.
+	ver := newVerifier()
+	require.NoError(t, ver.Verify(last.SignedHeader))
+	assert.Equal(t, last.Height(), ver.LastTrustedHeight())
+
+	// Requiring 2/3 of them, we need to bisect, but the source doesn't have
+	// the headers in between.
+	ver = newVerifier()
+	require.NoError(t, ver.SetTrustLevel(2, 3))
+	err := ver.Verify(last.SignedHeader)
+	assert.True(t, lerr.IsErrCommitNotFound(err), "%+v", err)
+
+	assert.Error(t, ver.SetTrustLevel(1, 4))
+	assert.Error(t, ver.SetTrustLevel(4, 3))
+}
+
+func TestDynamicVerifyTrustingPeriod(t *testing.T) {
+	chainID := "dynamic-verifier"
+	keys := genPrivKeys(5)
+	vals := keys.ToValidators(10, 0)
+	trust := NewDBProvider("trust", dbm.NewMemDB())
+	source := NewDBProvider("source", dbm.NewMemDB())
+	fcz := make([]FullCommit, 3)
+	for i := range fcz {
+		fcz[i] = makeFullCommit(int64(i), keys, vals, vals, chainID)
+		require.NoError(t, source.SaveFullCommit(fcz[i]))
+	}
+	require.NoError(t, trust.SaveFullCommit(fcz[0]))
+
+	ver := NewDynamicVerifier(chainID, trust, source)
+	ver.SetLogger(log.TestingLogger())
+	ver.SetTrustingPeriod(time.Hour)
+	trustedTime := fcz[0].SignedHeader.Time
+
+	ver.now = func() time.Time { return trustedTime.Add(2 * time.Hour) }
+	err := ver.Verify(fcz[2].SignedHeader)
+	assert.True(t, lerr.IsErrTrustedHeaderExpired(err), "%+v", err)
+	err = ver.Verify(fcz[1].SignedHeader)
+	assert.True(t, lerr.IsErrTrustedHeaderExpired(err), "%+v", err)
+
+	ver.now = func() time.Time { return trustedTime.Add(30 * time.Minute) }
+	require.NoError(t, ver.Verify(fcz[2].SignedHeader))
+}
+
+func TestDynamicVerifyWitnesses(t *testing.T) {
+	chainID := "dynamic-verifier"
+	keys := genPrivKeys(5)
+	vals := keys.ToValidators(10, 0)
+	fcz := make([]FullCommit, 3)
+	for i := range fcz {
+		fcz[i] = makeFullCommit(int64(i), keys, vals, vals, chainID)
+	}
+	// The validators sign a second header at height 2.
+	fork := keys.GenFullCommit(chainID, 2, nil, vals, vals,
+		[]byte("fork"), []byte("special-params"), []byte("res=2"), 0, len(keys))
+	// Only one validator signs a third one.
+	invalid := keys.GenFullCommit(chainID, 2, nil, vals, vals,
+		[]byte("invalid"), []byte("special-params"), []byte("res=2"), 0, 1)
+	// Unknown validators sign a fourth one.
+	otherKeys := genPrivKeys(5)
+	otherVals := otherKeys.ToValidators(10, 0)
+	unknown := otherKeys.GenFullCommit(chainID, 2, nil, otherVals, otherVals,
+		[]byte("unknown"), []byte("special-params"), []byte("res=2"), 0, len(otherKeys))
+
+	newProvider := func(fcs ...FullCommit) PersistentProvider {
+		p := NewDBProvider("provider", dbm.NewMemDB())
+		for _, fc := range fcs {
+			require.NoError(t, p.SaveFullCommit(fc))
+		}
+		return p
+	}
+	newVerifier := func() *DynamicVerifier {
+		ver := NewDynamicVerifier(chainID, newProvider(fcz[0]), newProvider(fcz...))
+		ver.SetLogger(log.TestingLogger())
+		return ver
+	}
+
+	// Witnesses agreeing, lacking the header or having an invalid one, or one
+	// not signed by the trusted validators, don't prevent verification.
+	ver := newVerifier()
+	ver.SetWitnesses(func(ConflictReport) { t.Fatal("unexpected conflict") },
+		newProvider(fcz...), newProvider(fcz[0]), newProvider(invalid), newProvider(unknown))
+	require.NoError(t, ver.Verify(fcz[1].SignedHeader))
+
+	// A witness with a conflicting header is reported.
+	var reports []ConflictReport
+	ver = newVerifier()
+	ver.SetWitnesses(func(r ConflictReport) { reports = append(reports, r) },
+		newProvider(fcz...), newProvider(fork))
+	err := ver.Verify(fcz[1].SignedHeader)
+	assert.True(t, lerr.IsErrConflictingHeaders(err), "%+v", err)
+	require.Len(t, reports, 1)
+	assert.Equal(t, 1, reports[0].Witness)
+	assert.Equal(t, fork.SignedHeader.Hash(), reports[0].WitnessHeader.Hash())
+	require.Len(t, reports[0].DuplicateVotes, len(keys))
+	for _, ev := range reports[0].DuplicateVotes {
+		assert.NoError(t, ev.Verify(chainID, ev.PubKey))
+	}
+	assert.Equal(t, fcz[0].Height(), ver.LastTrustedHeight())
+}
+
+func TestDynamicVerifyGroups(t *testing.T) {
+	chainID := "dynamic-verifier"
+	keys := genPrivKeys(4)
+	vals := groupVals(keys)
+
+	// groups 1, 2, 0 and 1 take turns
+	shs := make([]types.SignedHeader, 4)
+	var last *types.Header
+	for i, group := range []int32{1, 2, 0, 1} {
+		shs[i] = genGroupHeader(chainID, int64(i+1), group, last, keys, vals)
+		last = shs[i].Header
+	}
+	// The validators of group 2 sign a header at height 4 of their group.
+	forged := genGroupHeader(chainID, 4, 2, shs[2].Header, keys, vals)
+
+	newProvider := func(shs ...types.SignedHeader) PersistentProvider {
+		p := NewDBProvider("provider", dbm.NewMemDB())
+		for _, sh := range shs {
+			require.NoError(t, p.SaveFullCommit(NewFullCommit(sh, vals, vals)))
+		}
+		return p
+	}
+	newVerifier := func() *DynamicVerifier {
+		ver := NewDynamicVerifier(chainID, newProvider(shs[0]), newProvider(shs...))
+		ver.SetLogger(log.TestingLogger())
+		ver.SetGroupValidators(true)
+		return ver
+	}
+
+	// The header preceding a non-adjacent header is fetched from the source
+	// to check its group.
+	assert.Error(t, newVerifier().Verify(forged))
+	require.NoError(t, newVerifier().Verify(shs[3]))
+
+	// So is the one preceding a header verified by skipping.
+	ver := newVerifier()
+	trusted := NewFullCommit(shs[0], vals, vals)
+	assert.NoError(t, ver.verifyTrusting(ver.source, trusted, NewFullCommit(shs[3], vals, vals)))
+	assert.Error(t, ver.verifyTrusting(ver.source, trusted, NewFullCommit(forged, vals, vals)))
+}
+
 func makeFullCommit(height int64, keys privKeys, vals, nextVals *types.ValidatorSet, chainID string) FullCommit {
 	height += 1
 	consHash := []byte("special-params")
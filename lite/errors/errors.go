@@ -2,6 +2,7 @@ package errors
 
 import (
 	"fmt"
+	"time"
 
 	cmn "github.com/tendermint/tendermint/libs/common"
 )
@@ -41,6 +42,24 @@ func (e errEmptyTree) Error() string {
 	return "Tree is empty"
 }
 
+type errTrustedHeaderExpired struct {
+	height    int64
+	expiredAt time.Time
+}
+
+func (e errTrustedHeaderExpired) Error() string {
+	return fmt.Sprintf("Trusted header at height %d expired at %v, trust must be re-initialized",
+		e.height, e.expiredAt)
+}
+
+type errConflictingHeaders struct {
+	height int64
+}
+
+func (e errConflictingHeaders) Error() string {
+	return fmt.Sprintf("A witness has a conflicting header at height %d", e.height)
+}
+
 //----------------------------------------
 // Methods for above error types
 
@@ -109,3 +128,38 @@ func IsErrEmptyTree(err error) bool {
 	}
 	return false
 }
+
+//-----------------
+// ErrTrustedHeaderExpired
+
+// ErrTrustedHeaderExpired indicates that the latest trusted header is older
+// than the trusting period, so it can't be used to verify new headers.
+func ErrTrustedHeaderExpired(height int64, expiredAt time.Time) error {
+	return cmn.ErrorWrap(errTrustedHeaderExpired{height, expiredAt}, "")
+}
+
+func IsErrTrustedHeaderExpired(err error) bool {
+	if err_, ok := err.(cmn.Error); ok {
+		_, ok := err_.Data().(errTrustedHeaderExpired)
+		return ok
+	}
+	return false
+}
+
+//-----------------
+// ErrConflictingHeaders
+
+// ErrConflictingHeaders indicates that a witness has a valid header which
+// differs from the one being verified, i.e. the chain has forked or the
+// lite client is under attack.
+func ErrConflictingHeaders(height int64) error {
+	return cmn.ErrorWrap(errConflictingHeaders{height}, "")
+}
+
+func IsErrConflictingHeaders(err error) bool {
+	if err_, ok := err.(cmn.Error); ok {
+		_, ok := err_.Data().(errConflictingHeaders)
+		return ok
+	}
+	return false
+}
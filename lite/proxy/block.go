@@ -3,7 +3,9 @@ package proxy
 import (
 	"bytes"
 	"errors"
+	"fmt"
 
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	"github.com/tendermint/tendermint/types"
 )
 
@@ -46,3 +48,100 @@ func ValidateHeader(head *types.Header, sh types.SignedHeader) error {
 	}
 	return nil
 }
+
+// ValidateTx checks that the tx is included in the block of the given header,
+// using its proof.
+func ValidateTx(res *ctypes.ResultTx, sh types.SignedHeader) error {
+	if sh.Header == nil {
+		return errors.New("unexpected empty SignedHeader")
+	}
+	if res.Height != sh.Height {
+		return errors.New("Tx and header heights mismatched")
+	}
+	if !bytes.Equal(res.Hash, res.Tx.Hash()) || !bytes.Equal(res.Tx.Hash(), res.Proof.Leaf()) {
+		return errors.New("Tx doesn't match its hash or proof")
+	}
+	if res.Proof.Proof.Index != int(res.Index) {
+		return errors.New("Tx doesn't match the index of its proof")
+	}
+	return res.Proof.Validate(sh.DataHash)
+}
+
+// ValidateTxResult checks the code and data of the result of the tx against
+// the LastResultsHash of the header of the next block, using its result proof.
+//
+// NOTE: the other fields of the result (log, tags, gas...) aren't committed
+// to by the header and can't be verified.
+func ValidateTxResult(res *ctypes.ResultTx, sh types.SignedHeader) error {
+	if sh.Header == nil {
+		return errors.New("unexpected empty SignedHeader")
+	}
+	if res.Height+1 != sh.Height {
+		return errors.New("Tx result must be checked against the next header")
+	}
+	if res.ResultProof.Index != int(res.Index) {
+		return errors.New("Tx result doesn't match the index of its proof")
+	}
+	result := types.NewResultFromResponse(&res.TxResult)
+	if err := res.ResultProof.Verify(sh.LastResultsHash, result.Bytes()); err != nil {
+		return fmt.Errorf("Tx result doesn't match header: %v", err)
+	}
+	return nil
+}
+
+// ValidateValidators checks the validators at res.BlockHeight against the
+// given header, which is either the header at that height or the previous one.
+func ValidateValidators(res *ctypes.ResultValidators, sh types.SignedHeader) error {
+	if sh.Header == nil {
+		return errors.New("unexpected empty SignedHeader")
+	}
+	var valsHash []byte
+	switch sh.Height {
+	case res.BlockHeight:
+		valsHash = sh.ValidatorsHash
+	case res.BlockHeight - 1:
+		valsHash = sh.NextValidatorsHash
+	default:
+		return errors.New("Validators and header heights mismatched")
+	}
+	vals := &types.ValidatorSet{Validators: res.Validators}
+	if !bytes.Equal(vals.Hash(), valsHash) {
+		return fmt.Errorf("Validators hash %X doesn't match header %X", vals.Hash(), valsHash)
+	}
+	return nil
+}
+
+// ValidateBlockResults checks the DeliverTx results of the block at
+// res.Height against the header of the next block.
+func ValidateBlockResults(res *ctypes.ResultBlockResults, sh types.SignedHeader) error {
+	if res.Results == nil {
+		return errors.New("expecting non-nil Results")
+	}
+	if sh.Header == nil {
+		return errors.New("unexpected empty SignedHeader")
+	}
+	if res.Height+1 != sh.Height {
+		return errors.New("Results must be checked against the next header")
+	}
+	resultsHash := res.Results.ResultsHash()
+	if !bytes.Equal(resultsHash, sh.LastResultsHash) {
+		return fmt.Errorf("Results hash %X doesn't match header %X", resultsHash, sh.LastResultsHash)
+	}
+	return nil
+}
+
+// ValidateConsensusParams checks the consensus params at res.BlockHeight
+// against the header at that height.
+func ValidateConsensusParams(res *ctypes.ResultConsensusParams, sh types.SignedHeader) error {
+	if sh.Header == nil {
+		return errors.New("unexpected empty SignedHeader")
+	}
+	if res.BlockHeight != sh.Height {
+		return errors.New("Consensus params and header heights mismatched")
+	}
+	paramsHash := res.ConsensusParams.Hash()
+	if !bytes.Equal(paramsHash, sh.ConsensusHash) {
+		return fmt.Errorf("Consensus params hash %X doesn't match header %X", paramsHash, sh.ConsensusHash)
+	}
+	return nil
+}
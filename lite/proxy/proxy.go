@@ -39,11 +39,12 @@ func StartProxy(c rpcclient.Client, listenAddr string, logger log.Logger, maxOpe
 	core.SetLogger(logger)
 	mux.HandleFunc(wsEndpoint, wm.WebsocketHandler)
 
-	l, err := rpcserver.Listen(listenAddr, rpcserver.Config{MaxOpenConnections: maxOpenConnections})
+	config := rpcserver.Config{MaxOpenConnections: maxOpenConnections}
+	l, err := rpcserver.Listen(listenAddr, config)
 	if err != nil {
 		return err
 	}
-	return rpcserver.StartHTTPServer(l, mux, logger)
+	return rpcserver.StartHTTPServer(l, mux, logger, config)
 }
 
 // RPCRoutes just routes everything to the given client, as if it were
@@ -60,18 +61,23 @@ func RPCRoutes(c rpcclient.Client) map[string]*rpcserver.RPCFunc {
 		"unsubscribe": rpcserver.NewWSRPCFunc(core.Unsubscribe, "query"),
 
 		// info API
-		"status":     rpcserver.NewRPCFunc(c.Status, ""),
-		"blockchain": rpcserver.NewRPCFunc(c.BlockchainInfo, "minHeight,maxHeight"),
-		"genesis":    rpcserver.NewRPCFunc(c.Genesis, ""),
-		"block":      rpcserver.NewRPCFunc(c.Block, "height"),
-		"commit":     rpcserver.NewRPCFunc(c.Commit, "height"),
-		"tx":         rpcserver.NewRPCFunc(c.Tx, "hash,prove"),
-		"validators": rpcserver.NewRPCFunc(c.Validators, ""),
+		"status":           rpcserver.NewRPCFunc(c.Status, ""),
+		"blockchain":       rpcserver.NewRPCFunc(c.BlockchainInfo, "minHeight,maxHeight"),
+		"genesis":          rpcserver.NewRPCFunc(c.Genesis, ""),
+		"block":            rpcserver.NewRPCFunc(c.Block, "height"),
+		"block_results":    rpcserver.NewRPCFunc(c.BlockResults, "height"),
+		"commit":           rpcserver.NewRPCFunc(c.Commit, "height"),
+		"tx":               rpcserver.NewRPCFunc(c.Tx, "hash,prove"),
+		"tx_search":        rpcserver.NewRPCFunc(c.TxSearch, "query,prove,page,per_page,order_by,cursor"),
+		"validators":       rpcserver.NewRPCFunc(c.Validators, "height,group"),
+		"consensus_params": rpcserver.NewRPCFunc(c.ConsensusParams, "height"),
 
 		// broadcast API
 		"broadcast_tx_commit": rpcserver.NewRPCFunc(c.BroadcastTxCommit, "tx,group"),
 		"broadcast_tx_sync":   rpcserver.NewRPCFunc(c.BroadcastTxSync, "tx,group"),
 		"broadcast_tx_async":  rpcserver.NewRPCFunc(c.BroadcastTxAsync, "tx,group"),
+		"broadcast_txs":       rpcserver.NewRPCFunc(c.BroadcastTxs, "txs"),
+		"broadcast_evidence":  rpcserver.NewRPCFunc(c.BroadcastEvidence, "evidence"),
 
 		// abci API
 		"abci_query": rpcserver.NewRPCFunc(c.ABCIQuery, "group,path,data,prove"),
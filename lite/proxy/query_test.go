@@ -69,7 +69,7 @@ func _TestAppProofs(t *testing.T) {
 	k := []byte("my-key")
 	v := []byte("my-value")
 	tx := kvstoreTx(k, v)
-	br, err := cl.BroadcastTxCommit(tx)
+	br, err := cl.BroadcastTxCommit(tx, 0)
 	require.NoError(err, "%#v", err)
 	require.EqualValues(0, br.CheckTx.Code, "%#v", br.CheckTx)
 	require.EqualValues(0, br.DeliverTx.Code)
@@ -128,7 +128,7 @@ func TestTxProofs(t *testing.T) {
 	client.WaitForHeight(cl, 1, nil)
 
 	tx := kvstoreTx([]byte("key-a"), []byte("value-a"))
-	br, err := cl.BroadcastTxCommit(tx)
+	br, err := cl.BroadcastTxCommit(tx, 0)
 	require.NoError(err, "%#v", err)
 	require.EqualValues(0, br.CheckTx.Code, "%#v", br.CheckTx)
 	require.EqualValues(0, br.DeliverTx.Code)
@@ -6,7 +6,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/lite/proxy"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	sm "github.com/tendermint/tendermint/state"
 	"github.com/tendermint/tendermint/types"
 )
 
@@ -209,3 +212,219 @@ func TestValidateBlockMeta(t *testing.T) {
 		assert.Nil(t, err, "#%d: expecting a nil error", i)
 	}
 }
+
+func assertValidateErr(t *testing.T, i int, err error, wantErr string) {
+	if wantErr != "" {
+		if assert.Error(t, err, "#%d: wanted error %q", i, wantErr) {
+			assert.Contains(t, err.Error(), wantErr, "#%d should contain the substring\n\n", i)
+		}
+		return
+	}
+	assert.Nil(t, err, "#%d: expecting a nil error", i)
+}
+
+func TestValidateTx(t *testing.T) {
+	tx := deadBeefTxs[1]
+	res := func(height int64, hash []byte, proof types.TxProof) *ctypes.ResultTx {
+		return &ctypes.ResultTx{Height: height, Index: 1, Hash: hash, Tx: tx, Proof: proof}
+	}
+	otherIndex := res(11, tx.Hash(), deadBeefTxs.Proof(1))
+	otherIndex.Index = 0
+	header := &types.Header{Height: 11, DataHash: deadBeefHash}
+
+	tests := []struct {
+		res          *ctypes.ResultTx
+		signedHeader types.SignedHeader
+		wantErr      string
+	}{
+		{
+			res: res(11, tx.Hash(), deadBeefTxs.Proof(1)), wantErr: "unexpected empty SignedHeader",
+		},
+		{
+			res:          res(10, tx.Hash(), deadBeefTxs.Proof(1)),
+			signedHeader: types.SignedHeader{Header: header},
+			wantErr:      "heights mismatched",
+		},
+		{
+			res:          res(11, deadBeefTxs[0].Hash(), deadBeefTxs.Proof(1)),
+			signedHeader: types.SignedHeader{Header: header},
+			wantErr:      "doesn't match its hash or proof",
+		},
+		{
+			res:          res(11, tx.Hash(), deadBeefTxs.Proof(2)),
+			signedHeader: types.SignedHeader{Header: header},
+			wantErr:      "doesn't match its hash or proof",
+		},
+		{
+			res:          otherIndex,
+			signedHeader: types.SignedHeader{Header: header},
+			wantErr:      "index of its proof",
+		},
+		{
+			res:          res(11, tx.Hash(), deadBeefTxs.Proof(1)),
+			signedHeader: types.SignedHeader{Header: &types.Header{Height: 11, DataHash: []byte("other")}},
+			wantErr:      "different data hash",
+		},
+		{
+			res:          res(11, tx.Hash(), deadBeefTxs.Proof(1)),
+			signedHeader: types.SignedHeader{Header: header},
+		},
+	}
+
+	for i, tt := range tests {
+		assertValidateErr(t, i, proxy.ValidateTx(tt.res, tt.signedHeader), tt.wantErr)
+	}
+}
+
+func TestValidateTxResult(t *testing.T) {
+	deliverTxs := []*abci.ResponseDeliverTx{{Code: 0, Data: []byte("ok")}, {Code: 1, Log: "failed"}}
+	results := types.NewResults(deliverTxs)
+	res := func(index uint32, result abci.ResponseDeliverTx) *ctypes.ResultTx {
+		return &ctypes.ResultTx{Height: 11, Index: index, TxResult: result, ResultProof: results.ProveResult(1)}
+	}
+	header := &types.Header{Height: 12, LastResultsHash: results.Hash()}
+
+	tests := []struct {
+		res          *ctypes.ResultTx
+		signedHeader types.SignedHeader
+		wantErr      string
+	}{
+		{
+			res: res(1, *deliverTxs[1]), wantErr: "unexpected empty SignedHeader",
+		},
+		{
+			res:          res(1, *deliverTxs[1]),
+			signedHeader: types.SignedHeader{Header: &types.Header{Height: 11, LastResultsHash: results.Hash()}},
+			wantErr:      "next header",
+		},
+		{
+			res:          res(0, *deliverTxs[1]),
+			signedHeader: types.SignedHeader{Header: header},
+			wantErr:      "index of its proof",
+		},
+		{
+			res:          res(1, abci.ResponseDeliverTx{Code: 0, Log: "failed"}),
+			signedHeader: types.SignedHeader{Header: header},
+			wantErr:      "doesn't match header",
+		},
+		{
+			res:          res(1, *deliverTxs[1]),
+			signedHeader: types.SignedHeader{Header: &types.Header{Height: 12, LastResultsHash: []byte("other")}},
+			wantErr:      "doesn't match header",
+		},
+		{
+			// the log isn't committed to
+			res:          res(1, abci.ResponseDeliverTx{Code: 1, Log: "other"}),
+			signedHeader: types.SignedHeader{Header: header},
+		},
+	}
+
+	for i, tt := range tests {
+		assertValidateErr(t, i, proxy.ValidateTxResult(tt.res, tt.signedHeader), tt.wantErr)
+	}
+}
+
+func TestValidateValidators(t *testing.T) {
+	vals, _ := types.RandValidatorSet(3, 10)
+	otherVals, _ := types.RandValidatorSet(3, 10)
+	res := &ctypes.ResultValidators{BlockHeight: 11, Validators: vals.Validators}
+
+	tests := []struct {
+		signedHeader types.SignedHeader
+		wantErr      string
+	}{
+		{
+			wantErr: "unexpected empty SignedHeader",
+		},
+		{
+			signedHeader: types.SignedHeader{Header: &types.Header{Height: 9, ValidatorsHash: vals.Hash()}},
+			wantErr:      "heights mismatched",
+		},
+		{
+			signedHeader: types.SignedHeader{Header: &types.Header{Height: 11, ValidatorsHash: otherVals.Hash()}},
+			wantErr:      "doesn't match header",
+		},
+		{
+			signedHeader: types.SignedHeader{Header: &types.Header{Height: 10, ValidatorsHash: vals.Hash()}},
+			wantErr:      "doesn't match header",
+		},
+		{
+			signedHeader: types.SignedHeader{Header: &types.Header{Height: 11, ValidatorsHash: vals.Hash()}},
+		},
+		{
+			signedHeader: types.SignedHeader{Header: &types.Header{Height: 10, NextValidatorsHash: vals.Hash()}},
+		},
+	}
+
+	for i, tt := range tests {
+		assertValidateErr(t, i, proxy.ValidateValidators(res, tt.signedHeader), tt.wantErr)
+	}
+}
+
+func TestValidateBlockResults(t *testing.T) {
+	deliverTxs := []*abci.ResponseDeliverTx{{Code: 0, Data: []byte("ok")}, {Code: 1, Log: "failed"}}
+	resultsHash := types.NewResults(deliverTxs).Hash()
+	res := &ctypes.ResultBlockResults{Height: 11, Results: &sm.ABCIResponses{DeliverTx: deliverTxs}}
+
+	tests := []struct {
+		res          *ctypes.ResultBlockResults
+		signedHeader types.SignedHeader
+		wantErr      string
+	}{
+		{
+			res: &ctypes.ResultBlockResults{Height: 11}, wantErr: "non-nil Results",
+		},
+		{
+			res: res, wantErr: "unexpected empty SignedHeader",
+		},
+		{
+			res:          res,
+			signedHeader: types.SignedHeader{Header: &types.Header{Height: 11, LastResultsHash: resultsHash}},
+			wantErr:      "next header",
+		},
+		{
+			res:          res,
+			signedHeader: types.SignedHeader{Header: &types.Header{Height: 12, LastResultsHash: []byte("other")}},
+			wantErr:      "doesn't match header",
+		},
+		{
+			res:          res,
+			signedHeader: types.SignedHeader{Header: &types.Header{Height: 12, LastResultsHash: resultsHash}},
+		},
+	}
+
+	for i, tt := range tests {
+		assertValidateErr(t, i, proxy.ValidateBlockResults(tt.res, tt.signedHeader), tt.wantErr)
+	}
+}
+
+func TestValidateConsensusParams(t *testing.T) {
+	params := types.DefaultConsensusParams()
+	otherParams := types.DefaultConsensusParams()
+	otherParams.BlockSize.MaxGas = 100
+	res := &ctypes.ResultConsensusParams{BlockHeight: 11, ConsensusParams: *params}
+
+	tests := []struct {
+		signedHeader types.SignedHeader
+		wantErr      string
+	}{
+		{
+			wantErr: "unexpected empty SignedHeader",
+		},
+		{
+			signedHeader: types.SignedHeader{Header: &types.Header{Height: 10, ConsensusHash: params.Hash()}},
+			wantErr:      "heights mismatched",
+		},
+		{
+			signedHeader: types.SignedHeader{Header: &types.Header{Height: 11, ConsensusHash: otherParams.Hash()}},
+			wantErr:      "doesn't match header",
+		},
+		{
+			signedHeader: types.SignedHeader{Header: &types.Header{Height: 11, ConsensusHash: params.Hash()}},
+		},
+	}
+
+	for i, tt := range tests {
+		assertValidateErr(t, i, proxy.ValidateConsensusParams(res, tt.signedHeader), tt.wantErr)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/tendermint/tendermint/lite"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/tendermint/tendermint/types"
 )
 
 var _ rpcclient.Client = Wrapper{}
@@ -48,19 +49,152 @@ func (w Wrapper) ABCIQuery(group int32, path string, data cmn.HexBytes) (*ctypes
 	return w.ABCIQueryWithOptions(group, path, data, rpcclient.DefaultABCIQueryOptions)
 }
 
-// Tx queries for a given tx and verifies the proof if it was requested
+// Tx queries for a given tx and verifies it is included in its block, and
+// its result against the next header. Proofs are always requested from the
+// node, and dropped from the result unless prove is set.
+//
+// NOTE: only the code and data of the result are committed to by the header:
+// its log, tags and gas are passed through unverified.
 func (w Wrapper) Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
-	res, err := w.Client.Tx(hash, prove)
-	if !prove || err != nil {
-		return res, err
+	res, err := w.Client.Tx(hash, true)
+	if err != nil {
+		return nil, err
+	}
+	if err := w.validateTx(res); err != nil {
+		return nil, err
+	}
+	if !prove {
+		res.Proof, res.ResultProof = types.TxProof{}, merkle.SimpleProof{}
+	}
+	return res, nil
+}
+
+// TxSearch searches for txs and verifies every tx found, like Tx. Proofs
+// are always requested from the node, and dropped from the result unless
+// prove is set.
+//
+// NOTE: only the code and data of the results are committed to by the
+// headers: their log, tags and gas are passed through unverified, so is the
+// total count.
+func (w Wrapper) TxSearch(query string, prove bool, page, perPage int,
+	orderBy, cursor string) (*ctypes.ResultTxSearch, error) {
+
+	res, err := w.Client.TxSearch(query, true, page, perPage, orderBy, cursor)
+	if err != nil {
+		return nil, err
+	}
+	return res, w.validateTxSearch(res, prove)
+}
+
+// TxSearch_BS is like TxSearch, for TxSearch_BS.
+func (w Wrapper) TxSearch_BS(query string, prove bool, page, perPage int,
+	orderBy, cursor string) (*ctypes.ResultTxSearch, error) {
+
+	res, err := w.Client.TxSearch_BS(query, true, page, perPage, orderBy, cursor)
+	if err != nil {
+		return nil, err
+	}
+	return res, w.validateTxSearch(res, prove)
+}
+
+func (w Wrapper) validateTxSearch(res *ctypes.ResultTxSearch, prove bool) error {
+	for _, tx := range res.Txs {
+		if err := w.validateTx(tx); err != nil {
+			return err
+		}
+		if !prove {
+			tx.Proof, tx.ResultProof = types.TxProof{}, merkle.SimpleProof{}
+		}
+	}
+	return nil
+}
+
+// validateTx checks res against the certified header at its height, and its
+// result against the next one.
+func (w Wrapper) validateTx(res *ctypes.ResultTx) error {
+	sh, err := GetCertifiedCommit(res.Height, w.Client, w.cert)
+	if err != nil {
+		return err
+	}
+	if err := ValidateTx(res, sh); err != nil {
+		return err
+	}
+	next, err := GetCertifiedCommit(res.Height+1, w.Client, w.cert)
+	if err != nil {
+		return err
+	}
+	return ValidateTxResult(res, next)
+}
+
+// Validators returns the validator set at the given height, checked against
+// the certified header. For chains with group validators, the validators of
+// the given group are taken from the verified set.
+func (w Wrapper) Validators(height *int64, group *int32) (*ctypes.ResultValidators, error) {
+	res, err := w.Client.Validators(height, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// The validators of the latest height are only committed to by the
+	// NextValidatorsHash of the previous header.
+	h := res.BlockHeight
+	if h > 1 {
+		h--
 	}
-	h := int64(res.Height)
 	sh, err := GetCertifiedCommit(h, w.Client, w.cert)
 	if err != nil {
-		return res, err
+		return nil, err
 	}
-	err = res.Proof.Validate(sh.DataHash)
-	return res, err
+	if err := ValidateValidators(res, sh); err != nil {
+		return nil, err
+	}
+
+	if group != nil && w.cert.GroupValidators() {
+		vals := &types.ValidatorSet{Validators: res.Validators}
+		res.Validators = vals.GroupSet(*group).Validators
+	}
+	return res, nil
+}
+
+// BlockResults returns the results of the txs in the block at the given
+// height, checked against the LastResultsHash of the next certified header.
+//
+// NOTE: only the code and data of the DeliverTx results are committed to by
+// the header: their log, tags and gas, like the BeginBlock and EndBlock
+// responses, are passed through unverified and must not be trusted.
+func (w Wrapper) BlockResults(height *int64) (*ctypes.ResultBlockResults, error) {
+	res, err := w.Client.BlockResults(height)
+	if err != nil {
+		return nil, err
+	}
+	sh, err := GetCertifiedCommit(res.Height+1, w.Client, w.cert)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateBlockResults(res, sh); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ConsensusParams returns the consensus params at the given height, checked
+// against the ConsensusHash of the certified header.
+//
+// NOTE: only the fields included in ConsensusParams.Hash are committed to by
+// the header, the others are passed through unverified.
+func (w Wrapper) ConsensusParams(height *int64) (*ctypes.ResultConsensusParams, error) {
+	res, err := w.Client.ConsensusParams(height)
+	if err != nil {
+		return nil, err
+	}
+	sh, err := GetCertifiedCommit(res.BlockHeight, w.Client, w.cert)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateConsensusParams(res, sh); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 // BlockchainInfo requests a list of headers and verifies them all...
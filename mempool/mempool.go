@@ -5,6 +5,7 @@ import (
 	"container/list"
 	"crypto/sha256"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -35,7 +36,14 @@ type PostCheckFunc func(types.Tx, *abci.ResponseCheckTx) error
 
 The mempool pushes new txs onto the proxyAppConn.
 It gets a stream of (req, res) tuples from the proxy.
-The mempool stores good txs in a concurrent linked-list.
+The mempool stores good txs in a concurrent linked-list, in the order they
+arrived, which is the order they are gossiped in. It also indexes them by the
+priority the app gives them in ResponseCheckTx: they are reaped from the highest
+priority, and the lowest are evicted to make room when the mempool is full. A
+tx replaces the tx of the same sender and nonce if it has a higher priority.
+A sender can only have so many txs in the mempool (see max_txs_per_sender and
+max_txs_bytes_per_sender). Txs older than the TTL are evicted on Update().
+Evicted txs fire an EvictedTx event.
 
 Multiple concurrent go-routines can traverse this linked-list
 safely by calling .NextWait() on each element.
@@ -63,11 +71,59 @@ var (
 	// ErrTxInCache is returned to the client if we saw tx earlier
 	ErrTxInCache = errors.New("Tx already exists in cache")
 
-	// ErrMempoolIsFull means Tendermint & an application can't handle that much load
-	ErrMempoolIsFull = errors.New("Mempool is full")
+	// ErrSenderLimit means the sender of the tx reached its limit of txs in
+	// the mempool
+	ErrSenderLimit = errors.New("Sender has reached its limit of txs in the mempool")
 
-	// ErrTxTooLarge means the tx is too big to be sent in a message to other peers
-	ErrTxTooLarge = fmt.Errorf("Tx too large. Max size is %d", maxTxSize)
+	// ErrTxNotReplaced means the mempool has a tx of the same sender and
+	// nonce, with a higher or equal priority
+	ErrTxNotReplaced = errors.New("Tx of the same sender and nonce has a higher or equal priority")
+)
+
+// ErrTxTooLarge means the tx is larger than max_tx_bytes, or too big to be
+// sent in a message to other peers or to fit in a block.
+type ErrTxTooLarge struct {
+	Max    int
+	Actual int
+}
+
+func (e ErrTxTooLarge) Error() string {
+	return fmt.Sprintf("Tx too large. Max size is %d, but got %d", e.Max, e.Actual)
+}
+
+// ErrMempoolIsFull means Tendermint & an application can't handle that much
+// load, and the tx doesn't have a higher priority than the txs in the mempool.
+type ErrMempoolIsFull struct {
+	NumTxs   int
+	MaxTxs   int
+	TxsBytes int64
+}
+
+func (e ErrMempoolIsFull) Error() string {
+	return fmt.Sprintf("Mempool is full: number of txs %d (max: %d), total txs bytes %d",
+		e.NumTxs, e.MaxTxs, e.TxsBytes)
+}
+
+// ErrGroupNotExist is returned for a mempool group the node doesn't run.
+type ErrGroupNotExist struct {
+	Group int32
+}
+
+func (e ErrGroupNotExist) Error() string {
+	return fmt.Sprintf("Mempool group %d does not exist", e.Group)
+}
+
+// Reasons of the EvictedTx events.
+const (
+	// EvictReasonFull means the tx was evicted for a tx of a higher priority
+	// when the mempool was full.
+	EvictReasonFull = "full"
+	// EvictReasonReplaced means the tx was replaced by a tx of the same
+	// sender and nonce, and a higher priority.
+	EvictReasonReplaced = "replaced"
+	// EvictReasonExpired means the tx was in the mempool for longer than the
+	// TTL.
+	EvictReasonExpired = "expired"
 )
 
 // ErrPreCheck is returned when tx is too big
@@ -86,7 +142,8 @@ func IsPreCheckError(err error) bool {
 }
 
 // PreCheckAminoMaxBytes checks that the size of the transaction plus the amino
-// overhead is smaller or equal to the expected maxBytes.
+// overhead is smaller or equal to the expected maxBytes, and returns
+// ErrTxTooLarge otherwise.
 func PreCheckAminoMaxBytes(maxBytes int64) PreCheckFunc {
 	return func(tx types.Tx) error {
 		// We have to account for the amino overhead in the tx size as well
@@ -97,8 +154,7 @@ func PreCheckAminoMaxBytes(maxBytes int64) PreCheckFunc {
 		aminoOverhead := types.ComputeAminoOverhead(tx, 1)
 		txSize := int64(len(tx)) + aminoOverhead
 		if txSize > maxBytes {
-			return fmt.Errorf("Tx size (including amino overhead) is too big: %d, max: %d",
-				txSize, maxBytes)
+			return ErrTxTooLarge{Max: int(maxBytes - aminoOverhead), Actual: len(tx)}
 		}
 		return nil
 	}
@@ -133,6 +189,9 @@ func TxID(tx []byte) string {
 // added to the pool. The Mempool uses a concurrent list structure for storing transactions that
 // can be efficiently accessed by multiple concurrent readers.
 type Mempool struct {
+	// Atomic integers
+	txsBytes int64 // total size of the txs in the mempool, in bytes
+
 	config *cfg.MempoolConfig
 
 	proxyMtx             sync.Mutex
@@ -151,12 +210,19 @@ type Mempool struct {
 	// This reduces the pressure on the proxyApp.
 	cache txCache
 
+	// Index of the txs by priority and by sender.
+	// Protected by idxMtx, as the CheckTx responses may come concurrently.
+	idxMtx     sync.Mutex
+	byPriority []*clist.CElement            // highest priority first, then in arrival order
+	bySender   map[string][]*clist.CElement // txs with a sender, in arrival order
+
 	// A log of mempool txs
 	wal *auto.AutoFile
 
 	logger log.Logger
 
-	metrics *Metrics
+	eventBus types.MempoolEventPublisher
+	metrics  *Metrics
 }
 
 // MempoolOption sets an optional parameter on the Mempool.
@@ -177,7 +243,9 @@ func NewMempool(
 		rechecking:    0,
 		recheckCursor: nil,
 		recheckEnd:    nil,
+		bySender:      make(map[string][]*clist.CElement),
 		logger:        log.NewNopLogger(),
+		eventBus:      types.NopEventBus{},
 		metrics:       NopMetrics(),
 	}
 	if config.CacheSize > 0 {
@@ -216,9 +284,15 @@ func WithPostCheck(f PostCheckFunc) MempoolOption {
 	return func(mem *Mempool) { mem.postCheck = f }
 }
 
-// WithMetrics sets the metrics.
+// WithMetrics sets the metrics, labeled with the group of the mempool.
 func WithMetrics(metrics *Metrics) MempoolOption {
-	return func(mem *Mempool) { mem.metrics = metrics }
+	return func(mem *Mempool) { mem.metrics = metrics.Group(mem.config.Group) }
+}
+
+// WithEventBus sets the event bus the EvictedTx events are published on.
+// Without it, they are dropped.
+func WithEventBus(eventBus types.MempoolEventPublisher) MempoolOption {
+	return func(mem *Mempool) { mem.eventBus = eventBus }
 }
 
 // InitWAL creates a directory for the WAL file and opens a file itself.
@@ -265,6 +339,19 @@ func (mem *Mempool) Size() int {
 	return mem.txs.Len()
 }
 
+// TxsBytes returns the total size of the transactions in the mempool.
+func (mem *Mempool) TxsBytes() int64 {
+	return atomic.LoadInt64(&mem.txsBytes)
+}
+
+// maxTxBytes returns the size limit of a single tx.
+func (mem *Mempool) maxTxBytes() int {
+	if mem.config.MaxTxBytes > 0 && mem.config.MaxTxBytes < maxTxSize {
+		return mem.config.MaxTxBytes
+	}
+	return maxTxSize
+}
+
 // Flushes the mempool connection to ensure async resCb calls are done e.g.
 // from CheckTx.
 func (mem *Mempool) FlushAppConn() error {
@@ -279,9 +366,18 @@ func (mem *Mempool) Flush() {
 	mem.cache.Reset()
 
 	for e := mem.txs.Front(); e != nil; e = e.Next() {
-		mem.txs.Remove(e)
-		e.DetachPrev()
+		mem.removeTx(e, false)
+	}
+}
+
+// OldestTxTime returns the time the oldest transaction still in the mempool
+// was added, or the zero time if the mempool is empty.
+func (mem *Mempool) OldestTxTime() time.Time {
+	front := mem.txs.Front()
+	if front == nil {
+		return time.Time{}
 	}
+	return front.Value.(*mempoolTx).timestamp
 }
 
 // TxsFront returns the first transaction in the ordered list for peer
@@ -304,23 +400,37 @@ func (mem *Mempool) TxsWaitChan() <-chan struct{} {
 //     It gets called from another goroutine.
 // CONTRACT: Either cb will get called, or err returned.
 func (mem *Mempool) CheckTx(tx types.Tx, cb func(*abci.Response)) (err error) {
+	var cbWithError func(*abci.Response, error)
+	if cb != nil {
+		cbWithError = func(res *abci.Response, _ error) { cb(res) }
+	}
+	return mem.CheckTxWithError(tx, cbWithError)
+}
+
+// CheckTxWithError is like CheckTx, but cb also gets the error of the mempool
+// if the app accepted the tx and the mempool did not add it, e.g. because it
+// is full.
+func (mem *Mempool) CheckTxWithError(tx types.Tx, cb func(*abci.Response, error)) (err error) {
 	mem.proxyMtx.Lock()
 	// use defer to unlock mutex because application (*local client*) might panic
 	defer mem.proxyMtx.Unlock()
 
-	if mem.Size() >= mem.config.Size {
-		return ErrMempoolIsFull
-	}
+	// NOTE: the mempool being full is checked on the response, as the tx may
+	// evict a tx of a lower priority.
 
 	// The size of the corresponding amino-encoded TxMessage
 	// can't be larger than the maxMsgSize, otherwise we can't
 	// relay it to peers.
-	if len(tx) > maxTxSize {
-		return ErrTxTooLarge
+	if max := mem.maxTxBytes(); len(tx) > max {
+		return ErrTxTooLarge{Max: max, Actual: len(tx)}
 	}
 
 	if mem.preCheck != nil {
 		if err := mem.preCheck(tx); err != nil {
+			// the block size caps max_tx_bytes
+			if _, ok := err.(ErrTxTooLarge); ok {
+				return err
+			}
 			return ErrPreCheck{err}
 		}
 	}
@@ -350,32 +460,41 @@ func (mem *Mempool) CheckTx(tx types.Tx, cb func(*abci.Response)) (err error) {
 		return err
 	}
 	reqRes := mem.proxyAppConn.CheckTxAsync(tx, mem.config.Group)
-	if cb != nil {
-		reqRes.SetCallback(cb)
-	}
+	// NOTE: the response is handled here rather than in the resCb, so that cb
+	// gets the mempool error, whichever the abci client.
+	reqRes.SetCallback(mem.reqResCb(tx, cb))
 
 	return nil
 }
 
-// ABCI callback function
+// reqResCb returns the callback of a new tx, which handles its response and
+// passes it on to the cb of the caller of CheckTx, if any.
+func (mem *Mempool) reqResCb(tx types.Tx, cb func(*abci.Response, error)) func(*abci.Response) {
+	return func(res *abci.Response) {
+		err := mem.resCbNormal(tx, res)
+		mem.metrics.Size.Set(float64(mem.Size()))
+		if cb != nil {
+			cb(res, err)
+		}
+	}
+}
+
+// ABCI callback function, handling the responses of rechecked txs.
 func (mem *Mempool) resCb(req *abci.Request, res *abci.Response) {
 	if mem.recheckCursor == nil {
-		mem.resCbNormal(req, res)
-	} else {
-		mem.metrics.RecheckTimes.Add(1)
-		mem.resCbRecheck(req, res)
+		// new txs are handled by reqResCb
+		return
 	}
+	mem.metrics.RecheckTimes.Add(1)
+	mem.resCbRecheck(req, res)
 	mem.metrics.Size.Set(float64(mem.Size()))
 }
 
-func (mem *Mempool) resCbNormal(req *abci.Request, res *abci.Response) {
+// resCbNormal handles the response of a new tx, and returns the error of the
+// mempool if the app accepted the tx but the mempool did not add it.
+func (mem *Mempool) resCbNormal(tx types.Tx, res *abci.Response) error {
 	switch r := res.Value.(type) {
 	case *abci.Response_CheckTx:
-		if req.GetCheckTx().Group != mem.config.Group {
-			return
-		}
-
-		tx := req.GetCheckTx().Tx
 		var postCheckErr error
 		if mem.postCheck != nil {
 			postCheckErr = mem.postCheck(tx, r.CheckTx)
@@ -384,11 +503,20 @@ func (mem *Mempool) resCbNormal(req *abci.Request, res *abci.Response) {
 		if (r.CheckTx.Code == abci.CodeTypeOK) && postCheckErr == nil {
 			memTx := &mempoolTx{
 				height:    mem.height,
+				timestamp: time.Now(),
 				gasWanted: r.CheckTx.GasWanted,
+				priority:  r.CheckTx.Priority,
+				sender:    r.CheckTx.Sender,
+				nonce:     r.CheckTx.Nonce,
 				tx:        tx,
 			}
 
-			mem.txs.PushBack(memTx)
+			if err := mem.addTx(memTx); err != nil {
+				mem.logger.Info("Rejected good transaction", "tx", TxID(tx), "res", r, "err", err)
+				// remove from cache (it might fit later)
+				mem.cache.Remove(tx)
+				return err
+			}
 			mem.logger.Info("Added good transaction",
 				"tx", TxID(tx),
 				"res", r,
@@ -403,10 +531,14 @@ func (mem *Mempool) resCbNormal(req *abci.Request, res *abci.Response) {
 			mem.metrics.FailedTxs.Add(1)
 			// remove from cache (it might be good later)
 			mem.cache.Remove(tx)
+			if r.CheckTx.Code == abci.CodeTypeOK {
+				return postCheckErr
+			}
 		}
 	default:
 		// ignore other messages
 	}
+	return nil
 }
 
 func (mem *Mempool) resCbRecheck(req *abci.Request, res *abci.Response) {
@@ -433,11 +565,8 @@ func (mem *Mempool) resCbRecheck(req *abci.Request, res *abci.Response) {
 		} else {
 			// Tx became invalidated due to newly committed block.
 			mem.logger.Info("Tx is no longer valid", "tx", TxID(tx), "res", r, "err", postCheckErr)
-			mem.txs.Remove(mem.recheckCursor)
-			mem.recheckCursor.DetachPrev()
-
 			// remove from cache (it might be good later)
-			mem.cache.Remove(tx)
+			mem.removeTx(mem.recheckCursor, true)
 		}
 		if mem.recheckCursor == mem.recheckEnd {
 			mem.recheckCursor = nil
@@ -480,8 +609,9 @@ func (mem *Mempool) notifyTxsAvailable() {
 	}
 }
 
-// ReapMaxBytesMaxGas reaps transactions from the mempool up to maxBytes bytes total
-// with the condition that the total gasWanted must be less than maxGas.
+// ReapMaxBytesMaxGas reaps transactions from the mempool, from the highest
+// priority, up to maxBytes bytes total with the condition that the total
+// gasWanted must be less than maxGas.
 // If both maxes are negative, there is no cap on the size of all returned
 // transactions (~ all available transactions).
 func (mem *Mempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
@@ -498,8 +628,9 @@ func (mem *Mempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 	// TODO: we will get a performance boost if we have a good estimate of avg
 	// size per tx, and set the initial capacity based off of that.
 	// txs := make([]types.Tx, 0, cmn.MinInt(mem.txs.Len(), max/mem.avgTxSize))
-	txs := make([]types.Tx, 0, mem.txs.Len())
-	for e := mem.txs.Front(); e != nil; e = e.Next() {
+	byPriority := mem.txsByPriority()
+	txs := make([]types.Tx, 0, len(byPriority))
+	for _, e := range byPriority {
 		memTx := e.Value.(*mempoolTx)
 		// Check total size requirement
 		aminoOverhead := types.ComputeAminoOverhead(memTx.tx, 1)
@@ -521,7 +652,8 @@ func (mem *Mempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 	return txs
 }
 
-// ReapMaxTxs reaps up to max transactions from the mempool.
+// ReapMaxTxs reaps up to max transactions from the mempool, from the highest
+// priority.
 // If max is negative, there is no cap on the size of all returned
 // transactions (~ all available transactions).
 func (mem *Mempool) ReapMaxTxs(max int) types.Txs {
@@ -537,8 +669,12 @@ func (mem *Mempool) ReapMaxTxs(max int) types.Txs {
 		time.Sleep(time.Millisecond * 10)
 	}
 
-	txs := make([]types.Tx, 0, cmn.MinInt(mem.txs.Len(), max))
-	for e := mem.txs.Front(); e != nil && len(txs) <= max; e = e.Next() {
+	byPriority := mem.txsByPriority()
+	txs := make([]types.Tx, 0, cmn.MinInt(len(byPriority), max))
+	for _, e := range byPriority {
+		if len(txs) > max {
+			break
+		}
 		memTx := e.Value.(*mempoolTx)
 		txs = append(txs, memTx.tx)
 	}
@@ -570,8 +706,8 @@ func (mem *Mempool) Update(
 		_ = mem.cache.Push(tx)
 	}
 
-	// Remove committed transactions.
-	txsLeft := mem.removeTxs(txs)
+	// Remove committed and expired transactions.
+	txsLeft := mem.removeTxs(txs, height, time.Now())
 
 	// Either recheck non-committed txs to see if they became invalid
 	// or just notify there're some txs left.
@@ -593,7 +729,9 @@ func (mem *Mempool) Update(
 	return nil
 }
 
-func (mem *Mempool) removeTxs(txs types.Txs) []types.Tx {
+// removeTxs removes the committed txs, and evicts the txs that expired as of
+// the given height and time. It returns the txs left.
+func (mem *Mempool) removeTxs(txs types.Txs, height int64, now time.Time) []types.Tx {
 	// Build a map for faster lookups.
 	txsMap := make(map[string]struct{}, len(txs))
 	for _, tx := range txs {
@@ -601,22 +739,37 @@ func (mem *Mempool) removeTxs(txs types.Txs) []types.Tx {
 	}
 
 	txsLeft := make([]types.Tx, 0, mem.txs.Len())
+	var evicted []types.EventDataEvictedTx
 	for e := mem.txs.Front(); e != nil; e = e.Next() {
 		memTx := e.Value.(*mempoolTx)
 		// Remove the tx if it's already in a block.
 		if _, ok := txsMap[string(memTx.tx)]; ok {
-			// remove from clist
-			mem.txs.Remove(e)
-			e.DetachPrev()
-
+			mem.metrics.TxLatencySeconds.Observe(time.Since(memTx.timestamp).Seconds())
 			// NOTE: we don't remove committed txs from the cache.
+			mem.removeTx(e, false)
+			continue
+		}
+		if mem.isExpired(memTx, height, now) {
+			mem.idxMtx.Lock()
+			evicted = append(evicted, mem.evictTxLocked(e, EvictReasonExpired))
+			mem.idxMtx.Unlock()
 			continue
 		}
 		txsLeft = append(txsLeft, memTx.tx)
 	}
+	mem.fireEvictedTxs(evicted)
 	return txsLeft
 }
 
+// isExpired returns true if the tx has been in the mempool for more than
+// TTLNumBlocks blocks or TTLDuration.
+func (mem *Mempool) isExpired(memTx *mempoolTx, height int64, now time.Time) bool {
+	if mem.config.TTLNumBlocks > 0 && height-memTx.Height() > mem.config.TTLNumBlocks {
+		return true
+	}
+	return mem.config.TTLDuration > 0 && now.Sub(memTx.timestamp) > mem.config.TTLDuration
+}
+
 // NOTE: pass in txs because mem.txs can mutate concurrently.
 func (mem *Mempool) recheckTxs(txs []types.Tx) {
 	if len(txs) == 0 {
@@ -634,13 +787,178 @@ func (mem *Mempool) recheckTxs(txs []types.Tx) {
 	mem.proxyAppConn.FlushAsync()
 }
 
+// addTx adds the tx to the mempool, replacing the tx of the same sender and
+// nonce or evicting the tx of the lowest priority if the mempool is full. It
+// returns an error if the tx doesn't have a higher priority than the tx it
+// would replace or evict, or if the sender reached its limits.
+func (mem *Mempool) addTx(memTx *mempoolTx) error {
+	mem.idxMtx.Lock()
+	evicted, err := mem.addTxLocked(memTx)
+	mem.idxMtx.Unlock()
+	mem.fireEvictedTxs(evicted)
+	return err
+}
+
+func (mem *Mempool) addTxLocked(memTx *mempoolTx) ([]types.EventDataEvictedTx, error) {
+	var replaced *clist.CElement
+	if memTx.sender != "" {
+		replaced = mem.senderTxWithNonce(memTx.sender, memTx.nonce)
+		if replaced != nil && replaced.Value.(*mempoolTx).priority >= memTx.priority {
+			return nil, ErrTxNotReplaced
+		}
+		if mem.overSenderLimits(memTx, replaced) {
+			return nil, ErrSenderLimit
+		}
+	}
+	var lowest *clist.CElement
+	if replaced == nil && mem.Size() >= mem.config.Size {
+		if len(mem.byPriority) == 0 {
+			return nil, mem.errIsFull()
+		}
+		lowest = mem.byPriority[len(mem.byPriority)-1]
+		if lowest.Value.(*mempoolTx).priority >= memTx.priority {
+			return nil, mem.errIsFull()
+		}
+	}
+
+	// the evicted txs may be good later, with a higher priority
+	var evicted []types.EventDataEvictedTx
+	if replaced != nil {
+		evicted = append(evicted, mem.evictTxLocked(replaced, EvictReasonReplaced))
+	}
+	if lowest != nil {
+		evicted = append(evicted, mem.evictTxLocked(lowest, EvictReasonFull))
+	}
+
+	e := mem.txs.PushBack(memTx)
+	atomic.AddInt64(&mem.txsBytes, int64(len(memTx.tx)))
+	// insert after the txs of a higher or equal priority
+	i := sort.Search(len(mem.byPriority), func(i int) bool {
+		return mem.byPriority[i].Value.(*mempoolTx).priority < memTx.priority
+	})
+	mem.byPriority = append(mem.byPriority, nil)
+	copy(mem.byPriority[i+1:], mem.byPriority[i:])
+	mem.byPriority[i] = e
+	if memTx.sender != "" {
+		mem.bySender[memTx.sender] = append(mem.bySender[memTx.sender], e)
+	}
+	return evicted, nil
+}
+
+func (mem *Mempool) errIsFull() error {
+	return ErrMempoolIsFull{
+		NumTxs:   mem.Size(),
+		MaxTxs:   mem.config.Size,
+		TxsBytes: mem.TxsBytes(),
+	}
+}
+
+// senderTxWithNonce returns the tx of the sender with the given nonce, if any.
+func (mem *Mempool) senderTxWithNonce(sender string, nonce uint64) *clist.CElement {
+	for _, e := range mem.bySender[sender] {
+		if e.Value.(*mempoolTx).nonce == nonce {
+			return e
+		}
+	}
+	return nil
+}
+
+// overSenderLimits returns true if adding memTx, in place of replaced if it
+// isn't nil, takes its sender over max_txs_per_sender or
+// max_txs_bytes_per_sender.
+func (mem *Mempool) overSenderLimits(memTx *mempoolTx, replaced *clist.CElement) bool {
+	maxTxs, maxBytes := mem.config.MaxTxsPerSender, mem.config.MaxTxsBytesPerSender
+	numTxs, numBytes := 1, int64(len(memTx.tx))
+	for _, e := range mem.bySender[memTx.sender] {
+		if e != replaced {
+			numTxs++
+			numBytes += int64(len(e.Value.(*mempoolTx).tx))
+		}
+	}
+	return (maxTxs > 0 && numTxs > maxTxs) || (maxBytes > 0 && numBytes > maxBytes)
+}
+
+// evictTxLocked removes the tx from the mempool and the cache, and returns the
+// EvictedTx event to fire once idxMtx is released.
+func (mem *Mempool) evictTxLocked(e *clist.CElement, reason string) types.EventDataEvictedTx {
+	memTx := e.Value.(*mempoolTx)
+	mem.logger.Info("Evicted transaction", "tx", TxID(memTx.tx), "priority", memTx.priority,
+		"sender", memTx.sender, "reason", reason)
+	mem.metrics.EvictedTxs.With("reason", reason).Add(1)
+	mem.removeTxLocked(e, true)
+	return types.EventDataEvictedTx{Tx: memTx.tx, Group: mem.config.Group, Reason: reason}
+}
+
+func (mem *Mempool) fireEvictedTxs(evicted []types.EventDataEvictedTx) {
+	for _, ev := range evicted {
+		if err := mem.eventBus.PublishEventEvictedTx(ev); err != nil {
+			mem.logger.Error("Error publishing evicted tx", "tx", TxID(ev.Tx), "err", err)
+		}
+	}
+}
+
+// removeTx removes the tx from the mempool, and from the cache if
+// removeFromCache is true.
+func (mem *Mempool) removeTx(e *clist.CElement, removeFromCache bool) {
+	mem.idxMtx.Lock()
+	defer mem.idxMtx.Unlock()
+	mem.removeTxLocked(e, removeFromCache)
+}
+
+func (mem *Mempool) removeTxLocked(e *clist.CElement, removeFromCache bool) {
+	memTx := e.Value.(*mempoolTx)
+	mem.txs.Remove(e)
+	e.DetachPrev()
+	atomic.AddInt64(&mem.txsBytes, -int64(len(memTx.tx)))
+
+	i := sort.Search(len(mem.byPriority), func(i int) bool {
+		return mem.byPriority[i].Value.(*mempoolTx).priority <= memTx.priority
+	})
+	for ; i < len(mem.byPriority); i++ {
+		if mem.byPriority[i] == e {
+			mem.byPriority = append(mem.byPriority[:i], mem.byPriority[i+1:]...)
+			break
+		}
+	}
+	if memTx.sender != "" {
+		senderTxs := mem.bySender[memTx.sender]
+		for i := range senderTxs {
+			if senderTxs[i] == e {
+				senderTxs = append(senderTxs[:i], senderTxs[i+1:]...)
+				break
+			}
+		}
+		if len(senderTxs) == 0 {
+			delete(mem.bySender, memTx.sender)
+		} else {
+			mem.bySender[memTx.sender] = senderTxs
+		}
+	}
+
+	if removeFromCache {
+		mem.cache.Remove(memTx.tx)
+	}
+}
+
+// txsByPriority returns the txs from the highest priority, in the order they
+// arrived for the same priority.
+func (mem *Mempool) txsByPriority() []*clist.CElement {
+	mem.idxMtx.Lock()
+	defer mem.idxMtx.Unlock()
+	return append([]*clist.CElement(nil), mem.byPriority...)
+}
+
 //--------------------------------------------------------------------------------
 
 // mempoolTx is a transaction that successfully ran
 type mempoolTx struct {
-	height    int64    // height that this tx had been validated in
-	gasWanted int64    // amount of gas this tx states it will require
-	tx        types.Tx //
+	height    int64     // height that this tx had been validated in
+	timestamp time.Time // time that this tx was added to the mempool
+	gasWanted int64     // amount of gas this tx states it will require
+	priority  int64     // priority of the tx, see ResponseCheckTx
+	sender    string    // sender of the tx, see ResponseCheckTx
+	nonce     uint64    // replacement key of the tx for its sender
+	tx        types.Tx  //
 }
 
 // Height returns the height for this transaction
@@ -8,6 +8,8 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -74,7 +76,7 @@ func checkTxs(t *testing.T, mempool *Mempool, count int) types.Txs {
 			// Skip invalid txs.
 			// TestMempoolFilters will fail otherwise. It asserts a number of txs
 			// returned.
-			if IsPreCheckError(err) {
+			if _, ok := err.(ErrTxTooLarge); ok || IsPreCheckError(err) {
 				continue
 			}
 			t.Fatalf("CheckTx failed: %v while checking #%d tx", err, i)
@@ -285,7 +287,7 @@ func TestSerialReap(t *testing.T) {
 		for i := start; i < end; i++ {
 			txBytes := make([]byte, 8)
 			binary.BigEndian.PutUint64(txBytes, uint64(i))
-			res, err := appConnCon.DeliverTxSync(txBytes)
+			res, err := appConnCon.DeliverTxSync(txBytes, 0)
 			if err != nil {
 				t.Errorf("Client error committing tx: %v", err)
 			}
@@ -360,6 +362,206 @@ func TestCacheRemove(t *testing.T) {
 	}
 }
 
+// priorityApp accepts txs of the form "sender:nonce/priority/id", where an
+// empty sender means none, and the nonce is optional.
+type priorityApp struct {
+	abci.BaseApplication
+}
+
+func (priorityApp) CheckTx(tx []byte) abci.ResponseCheckTx {
+	parts := strings.Split(string(tx), "/")
+	priority, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return abci.ResponseCheckTx{Code: 1}
+	}
+	sender := strings.Split(parts[0], ":")
+	var nonce uint64
+	if len(sender) > 1 {
+		if nonce, err = strconv.ParseUint(sender[1], 10, 64); err != nil {
+			return abci.ResponseCheckTx{Code: 1}
+		}
+	}
+	return abci.ResponseCheckTx{Sender: sender[0], Nonce: nonce, Priority: priority}
+}
+
+// checkPriorityTx checks the tx, which the app accepts, and returns the error
+// of the mempool.
+func checkPriorityTx(t *testing.T, mempool *Mempool, tx string) error {
+	var (
+		res    *abci.ResponseCheckTx
+		memErr error
+	)
+	err := mempool.CheckTxWithError(types.Tx(tx), func(r *abci.Response, err error) {
+		res, memErr = r.GetCheckTx(), err
+	})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, abci.CodeTypeOK, res.Code)
+	return memErr
+}
+
+func TestMempoolReapByPriority(t *testing.T) {
+	mempool, cleanup := newMempoolWithApp(proxy.NewLocalClientCreator(priorityApp{}))
+	defer cleanup()
+
+	for _, tx := range []string{"/1/a", "/3/b", "/2/c", "/3/d", "/1/e"} {
+		checkPriorityTx(t, mempool, tx)
+	}
+	expected := types.Txs{
+		types.Tx("/3/b"), types.Tx("/3/d"), types.Tx("/2/c"), types.Tx("/1/a"), types.Tx("/1/e"),
+	}
+	assert.Equal(t, expected, mempool.ReapMaxTxs(-1))
+	assert.Equal(t, expected, mempool.ReapMaxBytesMaxGas(-1, -1))
+	assert.Equal(t, expected[:2], mempool.ReapMaxBytesMaxGas(2*(int64(len(expected[0]))+types.ComputeAminoOverhead(expected[0], 1)), -1))
+
+	// gossiped in the order they arrived
+	var gossiped types.Txs
+	for e := mempool.TxsFront(); e != nil; e = e.Next() {
+		gossiped = append(gossiped, e.Value.(*mempoolTx).tx)
+	}
+	assert.Equal(t, types.Txs{
+		types.Tx("/1/a"), types.Tx("/3/b"), types.Tx("/2/c"), types.Tx("/3/d"), types.Tx("/1/e"),
+	}, gossiped)
+
+	// committed txs are removed from the index
+	mempool.Lock()
+	err := mempool.Update(1, types.Txs{types.Tx("/3/b"), types.Tx("/1/a")}, nil, nil)
+	mempool.Unlock()
+	require.NoError(t, err)
+	assert.Equal(t, types.Txs{types.Tx("/3/d"), types.Tx("/2/c"), types.Tx("/1/e")}, mempool.ReapMaxTxs(-1))
+}
+
+func TestMempoolEvictsLowestPriority(t *testing.T) {
+	mempool, cleanup := newMempoolWithApp(proxy.NewLocalClientCreator(priorityApp{}))
+	defer cleanup()
+	mempool.config.Size = 2
+
+	require.NoError(t, checkPriorityTx(t, mempool, "/2/a"))
+	require.NoError(t, checkPriorityTx(t, mempool, "/1/b"))
+
+	// not higher than the lowest
+	full := ErrMempoolIsFull{NumTxs: 2, MaxTxs: 2, TxsBytes: 8}
+	assert.Equal(t, full, checkPriorityTx(t, mempool, "/1/c"))
+	assert.Equal(t, types.Txs{types.Tx("/2/a"), types.Tx("/1/b")}, mempool.ReapMaxTxs(-1))
+
+	assert.NoError(t, checkPriorityTx(t, mempool, "/3/d"))
+	assert.Equal(t, types.Txs{types.Tx("/3/d"), types.Tx("/2/a")}, mempool.ReapMaxTxs(-1))
+
+	// the evicted tx can be checked again
+	assert.Equal(t, full, checkPriorityTx(t, mempool, "/1/b"))
+}
+
+func TestMempoolReplacesSenderTx(t *testing.T) {
+	mempool, cleanup := newMempoolWithApp(proxy.NewLocalClientCreator(priorityApp{}))
+	defer cleanup()
+	recorder := &evictedTxsRecorder{}
+	mempool.eventBus = recorder
+	mempool.config.MaxTxsPerSender = 2
+
+	require.NoError(t, checkPriorityTx(t, mempool, "alice:1/2/a"))
+	require.NoError(t, checkPriorityTx(t, mempool, "bob:1/1/b"))
+
+	// not higher than the one of alice with the same nonce
+	assert.Equal(t, ErrTxNotReplaced, checkPriorityTx(t, mempool, "alice:1/2/c"))
+	assert.Equal(t, types.Txs{types.Tx("alice:1/2/a"), types.Tx("bob:1/1/b")}, mempool.ReapMaxTxs(-1))
+
+	// another nonce doesn't replace it, whatever the priority
+	assert.NoError(t, checkPriorityTx(t, mempool, "alice:2/1/d"))
+	assert.Equal(t, types.Txs{types.Tx("alice:1/2/a"), types.Tx("bob:1/1/b"), types.Tx("alice:2/1/d")},
+		mempool.ReapMaxTxs(-1))
+
+	assert.NoError(t, checkPriorityTx(t, mempool, "alice:1/3/e"))
+	assert.Equal(t, types.Txs{types.Tx("alice:1/3/e"), types.Tx("bob:1/1/b"), types.Tx("alice:2/1/d")},
+		mempool.ReapMaxTxs(-1))
+
+	// a full mempool still takes the replacement
+	mempool.config.Size = 3
+	assert.NoError(t, checkPriorityTx(t, mempool, "bob:1/4/f"))
+	assert.Equal(t, types.Txs{types.Tx("bob:1/4/f"), types.Tx("alice:1/3/e"), types.Tx("alice:2/1/d")},
+		mempool.ReapMaxTxs(-1))
+
+	assert.Equal(t, []types.EventDataEvictedTx{
+		{Tx: types.Tx("alice:1/2/a"), Reason: EvictReasonReplaced},
+		{Tx: types.Tx("bob:1/1/b"), Reason: EvictReasonReplaced},
+	}, recorder.evicted)
+}
+
+// evictedTxsRecorder records the EvictedTx events of a mempool.
+type evictedTxsRecorder struct {
+	evicted []types.EventDataEvictedTx
+}
+
+func (r *evictedTxsRecorder) PublishEventEvictedTx(ev types.EventDataEvictedTx) error {
+	r.evicted = append(r.evicted, ev)
+	return nil
+}
+
+func TestMempoolSenderLimits(t *testing.T) {
+	mempool, cleanup := newMempoolWithApp(proxy.NewLocalClientCreator(priorityApp{}))
+	defer cleanup()
+	mempool.config.MaxTxsPerSender = 2
+	mempool.config.MaxTxsBytesPerSender = 30
+
+	require.NoError(t, checkPriorityTx(t, mempool, "alice:1/2/a"))
+	require.NoError(t, checkPriorityTx(t, mempool, "alice:2/1/b"))
+	require.NoError(t, checkPriorityTx(t, mempool, "bob:1/1/c"))
+
+	// over the count limit, whatever the priority
+	assert.Equal(t, ErrSenderLimit, checkPriorityTx(t, mempool, "alice:3/5/d"))
+
+	// a replacement doesn't count
+	assert.NoError(t, checkPriorityTx(t, mempool, "alice:2/3/e"))
+	assert.Equal(t, types.Txs{types.Tx("alice:2/3/e"), types.Tx("alice:1/2/a"), types.Tx("bob:1/1/c")},
+		mempool.ReapMaxTxs(-1))
+
+	// over the bytes limit with the replacement
+	assert.Equal(t, ErrSenderLimit, checkPriorityTx(t, mempool, "alice:2/4/"+strings.Repeat("f", 15)))
+	assert.NoError(t, checkPriorityTx(t, mempool, "bob:2/1/"+strings.Repeat("f", 12)))
+
+	// never fits
+	assert.Equal(t, ErrSenderLimit, checkPriorityTx(t, mempool, "carol/5/"+strings.Repeat("g", 30)))
+}
+
+func TestMempoolEvictsExpiredTxs(t *testing.T) {
+	mempool, cleanup := newMempoolWithApp(proxy.NewLocalClientCreator(priorityApp{}))
+	defer cleanup()
+	recorder := &evictedTxsRecorder{}
+	mempool.eventBus = recorder
+	mempool.config.TTLNumBlocks = 2
+	mempool.config.TTLDuration = time.Hour
+
+	update := func(height int64, now time.Time) {
+		mempool.Lock()
+		defer mempool.Unlock()
+		mempool.height = height
+		mempool.removeTxs(nil, height, now)
+	}
+
+	checkPriorityTx(t, mempool, "/1/a")
+	update(1, time.Now())
+	checkPriorityTx(t, mempool, "/1/b")
+	update(2, time.Now())
+	checkPriorityTx(t, mempool, "/1/c")
+	assert.Equal(t, 3, mempool.Size())
+
+	// a was added at height 0
+	update(3, time.Now())
+	assert.Equal(t, types.Txs{types.Tx("/1/b"), types.Tx("/1/c")}, mempool.ReapMaxTxs(-1))
+
+	update(3, time.Now().Add(2*time.Hour))
+	assert.Equal(t, 0, mempool.Size())
+
+	assert.Equal(t, []types.EventDataEvictedTx{
+		{Tx: types.Tx("/1/a"), Reason: EvictReasonExpired},
+		{Tx: types.Tx("/1/b"), Reason: EvictReasonExpired},
+		{Tx: types.Tx("/1/c"), Reason: EvictReasonExpired},
+	}, recorder.evicted)
+
+	// expired txs are removed from the cache
+	assert.NoError(t, checkPriorityTx(t, mempool, "/1/a"))
+	assert.Equal(t, 1, mempool.Size())
+}
+
 func TestMempoolCloseWAL(t *testing.T) {
 	// 1. Create the temporary directory for mempool and WAL testing.
 	rootDir, err := ioutil.TempDir("", "mempool-test")
@@ -372,7 +574,7 @@ func TestMempoolCloseWAL(t *testing.T) {
 	require.Equal(t, 0, len(m1), "no matches yet")
 
 	// 3. Create the mempool
-	wcfg := cfg.DefaultMempoolConfig()
+	wcfg := cfg.DefaultMempoolConfig(0)
 	wcfg.RootDir = rootDir
 	defer os.RemoveAll(wcfg.RootDir)
 	app := kvstore.NewKVStoreApplication()
@@ -448,7 +650,7 @@ func TestMempoolMaxMsgSize(t *testing.T) {
 
 		tx := cmn.RandBytes(testCase.len)
 		err := mempl.CheckTx(tx, nil)
-		msg := &TxMessage{tx}
+		msg := &TxMessage{Tx: tx}
 		encoded := cdc.MustMarshalBinaryBare(msg)
 		require.Equal(t, len(encoded), txMessageSize(tx), caseString)
 		if !testCase.err {
@@ -456,12 +658,38 @@ func TestMempoolMaxMsgSize(t *testing.T) {
 			require.NoError(t, err, caseString)
 		} else {
 			require.True(t, len(encoded) > maxMsgSize, caseString)
-			require.Equal(t, err, ErrTxTooLarge, caseString)
+			require.Equal(t, ErrTxTooLarge{Max: maxTxSize, Actual: testCase.len}, err, caseString)
 		}
 	}
 
 }
 
+func TestMempoolMaxTxBytes(t *testing.T) {
+	app := kvstore.NewKVStoreApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mempool, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+	mempool.config.MaxTxBytes = 10
+
+	err := mempool.CheckTx(cmn.RandBytes(11), nil)
+	assert.Equal(t, ErrTxTooLarge{Max: 10, Actual: 11}, err)
+	assert.Equal(t, 0, mempool.Size())
+
+	require.NoError(t, mempool.CheckTx(cmn.RandBytes(10), nil))
+	require.NoError(t, mempool.CheckTx(cmn.RandBytes(5), nil))
+	assert.Equal(t, 2, mempool.Size())
+	assert.EqualValues(t, 15, mempool.TxsBytes())
+
+	mempool.Flush()
+	assert.EqualValues(t, 0, mempool.TxsBytes())
+
+	// the block size caps it
+	mempool.preCheck = PreCheckAminoMaxBytes(8)
+	err = mempool.CheckTx(cmn.RandBytes(8), nil)
+	assert.Equal(t, ErrTxTooLarge{Max: 6, Actual: 8}, err)
+	require.NoError(t, mempool.CheckTx(cmn.RandBytes(6), nil))
+}
+
 func checksumIt(data []byte) string {
 	h := sha256.New()
 	h.Write(data)
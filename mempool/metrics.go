@@ -1,6 +1,8 @@
 package mempool
 
 import (
+	"strconv"
+
 	"github.com/go-kit/kit/metrics"
 	"github.com/go-kit/kit/metrics/discard"
 	"github.com/go-kit/kit/metrics/prometheus"
@@ -15,6 +17,7 @@ const (
 
 // Metrics contains metrics exposed by this package.
 // see MetricsProvider for descriptions.
+// All metrics are labeled with the mempool group, see Group.
 type Metrics struct {
 	// Size of the mempool.
 	Size metrics.Gauge
@@ -22,8 +25,13 @@ type Metrics struct {
 	TxSizeBytes metrics.Histogram
 	// Number of failed transactions.
 	FailedTxs metrics.Counter
+	// Number of transactions evicted from the mempool, labeled with the
+	// reason, see EvictReasonFull and co.
+	EvictedTxs metrics.Counter
 	// Number of times transactions are rechecked in the mempool.
 	RecheckTimes metrics.Counter
+	// Histogram of the time between CheckTx and the commit of transactions.
+	TxLatencySeconds metrics.Histogram
 }
 
 // PrometheusMetrics returns Metrics build using Prometheus client library.
@@ -40,35 +48,63 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Subsystem: MetricsSubsystem,
 			Name:      "size",
 			Help:      "Size of the mempool (number of uncommitted transactions).",
-		}, labels).With(labelsAndValues...),
+		}, append(labels, "group")).With(labelsAndValues...),
 		TxSizeBytes: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
 			Name:      "tx_size_bytes",
 			Help:      "Transaction sizes in bytes.",
 			Buckets:   stdprometheus.ExponentialBuckets(1, 3, 17),
-		}, labels).With(labelsAndValues...),
+		}, append(labels, "group")).With(labelsAndValues...),
 		FailedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
 			Name:      "failed_txs",
 			Help:      "Number of failed transactions.",
-		}, labels).With(labelsAndValues...),
+		}, append(labels, "group")).With(labelsAndValues...),
+		EvictedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "evicted_txs",
+			Help:      "Number of transactions evicted from the mempool, by reason.",
+		}, append(labels, "group", "reason")).With(labelsAndValues...),
 		RecheckTimes: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
 			Name:      "recheck_times",
 			Help:      "Number of times transactions are rechecked in the mempool.",
-		}, labels).With(labelsAndValues...),
+		}, append(labels, "group")).With(labelsAndValues...),
+		TxLatencySeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "tx_latency_seconds",
+			Help:      "Time between CheckTx and the commit of transactions in seconds.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.1, 2, 12),
+		}, append(labels, "group")).With(labelsAndValues...),
 	}
 }
 
 // NopMetrics returns no-op Metrics.
 func NopMetrics() *Metrics {
 	return &Metrics{
-		Size:         discard.NewGauge(),
-		TxSizeBytes:  discard.NewHistogram(),
-		FailedTxs:    discard.NewCounter(),
-		RecheckTimes: discard.NewCounter(),
+		Size:             discard.NewGauge(),
+		TxSizeBytes:      discard.NewHistogram(),
+		FailedTxs:        discard.NewCounter(),
+		EvictedTxs:       discard.NewCounter(),
+		RecheckTimes:     discard.NewCounter(),
+		TxLatencySeconds: discard.NewHistogram(),
+	}
+}
+
+// Group returns the metrics of the given mempool group.
+func (m *Metrics) Group(group int32) *Metrics {
+	lv := strconv.Itoa(int(group))
+	return &Metrics{
+		Size:             m.Size.With("group", lv),
+		TxSizeBytes:      m.TxSizeBytes.With("group", lv),
+		FailedTxs:        m.FailedTxs.With("group", lv),
+		EvictedTxs:       m.EvictedTxs.With("group", lv),
+		RecheckTimes:     m.RecheckTimes.With("group", lv),
+		TxLatencySeconds: m.TxLatencySeconds.With("group", lv),
 	}
 }
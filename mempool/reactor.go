@@ -1,8 +1,11 @@
 package mempool
 
 import (
+	"bytes"
 	"fmt"
 	"reflect"
+	"sort"
+	"sync"
 	"time"
 
 	amino "github.com/tendermint/go-amino"
@@ -11,22 +14,38 @@ import (
 
 	cfg "github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/p2p"
+	bh "github.com/tendermint/tendermint/p2p/behaviour"
 	"github.com/tendermint/tendermint/types"
 )
 
 const (
 	MempoolChannel = byte(0x30)
+	// MempoolGroupsChannel carries the GroupsMessages. Older nodes, which
+	// don't run mempool groups, don't have it.
+	MempoolGroupsChannel = byte(0x31)
 
 	maxMsgSize = 1048576        // 1MB TODO make it configurable
 	maxTxSize  = maxMsgSize - 8 // account for amino overhead of TxMessage
 
 	peerCatchupSleepIntervalMS = 100 // If peer is behind, sleep this amount
+
+	// key of the peer's *peerGroups
+	peerGroupsKey = "MempoolReactor.peerGroups"
 )
 
 // MempoolReactor handles mempool tx broadcasting amongst peers.
 type MempoolReactor struct {
 	p2p.BaseReactor
-	Mempools map[int32] /*group id*/ *MempoolItem
+
+	mtx      sync.RWMutex
+	mempools map[int32] /*group id*/ *MempoolItem
+	// closed and replaced whenever a group is added or removed
+	groupsChanged chan struct{}
+
+	// guards the creation of the peers' groups
+	peerMtx sync.Mutex
+
+	reporter bh.Reporter
 }
 
 type MempoolItem struct {
@@ -36,10 +55,12 @@ type MempoolItem struct {
 
 // NewMempoolReactor returns a new MempoolReactor with the given config and mempool.
 func NewMempoolReactor(items []*MempoolItem) *MempoolReactor {
-	memR := &MempoolReactor{}
-	memR.Mempools = make(map[int32]*MempoolItem, len(items))
+	memR := &MempoolReactor{
+		groupsChanged: make(chan struct{}),
+	}
+	memR.mempools = make(map[int32]*MempoolItem, len(items))
 	for _, item := range items {
-		memR.Mempools[item.Config.Group] = &MempoolItem{
+		memR.mempools[item.Config.Group] = &MempoolItem{
 			Config:  item.Config,
 			Mempool: item.Mempool,
 		}
@@ -53,22 +74,88 @@ func NewMempoolReactor(items []*MempoolItem) *MempoolReactor {
 func (memR *MempoolReactor) SetLogger(l log.Logger) {
 	memR.Logger = l
 
-	for _, item := range memR.Mempools {
-		item.Mempool.SetLogger(l)
+	for _, item := range memR.Items() {
+		item.Mempool.SetLogger(l.With("group", item.Config.Group))
 	}
 }
 
+// SetSwitch implements Reactor. The behaviour of the peers is reported to the
+// switch.
+func (memR *MempoolReactor) SetSwitch(sw *p2p.Switch) {
+	memR.BaseReactor.SetSwitch(sw)
+	memR.reporter = bh.NewSwitchReporter(sw)
+}
+
 // OnStart implements p2p.BaseReactor.
 func (memR *MempoolReactor) OnStart() error {
-	for i, item := range memR.Mempools {
+	for _, item := range memR.Items() {
 		if !item.Config.Broadcast {
-			memR.Logger.Info("Tx broadcasting is disabled. Mempool id is %d", i)
+			memR.Logger.Info("Tx broadcasting is disabled", "group", item.Config.Group)
 		}
 	}
 
 	return nil
 }
 
+// Mempool returns the mempool of the given group, if this node runs it.
+func (memR *MempoolReactor) Mempool(group int32) (*MempoolItem, bool) {
+	memR.mtx.RLock()
+	defer memR.mtx.RUnlock()
+	item, ok := memR.mempools[group]
+	return item, ok
+}
+
+// Items returns the mempools of all groups, sorted by group.
+func (memR *MempoolReactor) Items() []*MempoolItem {
+	items, _ := memR.items()
+	return items
+}
+
+func (memR *MempoolReactor) items() ([]*MempoolItem, <-chan struct{}) {
+	memR.mtx.RLock()
+	defer memR.mtx.RUnlock()
+	items := make([]*MempoolItem, 0, len(memR.mempools))
+	for _, item := range memR.mempools {
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Config.Group < items[j].Config.Group })
+	return items, memR.groupsChanged
+}
+
+// AddMempool starts receiving and broadcasting the txs of a new group.
+func (memR *MempoolReactor) AddMempool(item *MempoolItem) error {
+	memR.mtx.Lock()
+	if _, ok := memR.mempools[item.Config.Group]; ok {
+		memR.mtx.Unlock()
+		return fmt.Errorf("Mempool group %d already exists", item.Config.Group)
+	}
+	memR.mempools[item.Config.Group] = item
+	close(memR.groupsChanged)
+	memR.groupsChanged = make(chan struct{})
+	memR.mtx.Unlock()
+
+	memR.broadcastGroups()
+	return nil
+}
+
+// RemoveMempool stops receiving and broadcasting the txs of a group, and
+// returns its mempool.
+func (memR *MempoolReactor) RemoveMempool(group int32) (*MempoolItem, error) {
+	memR.mtx.Lock()
+	item, ok := memR.mempools[group]
+	if !ok {
+		memR.mtx.Unlock()
+		return nil, ErrGroupNotExist{Group: group}
+	}
+	delete(memR.mempools, group)
+	close(memR.groupsChanged)
+	memR.groupsChanged = make(chan struct{})
+	memR.mtx.Unlock()
+
+	memR.broadcastGroups()
+	return item, nil
+}
+
 // GetChannels implements Reactor.
 // It returns the list of channels for this reactor.
 func (memR *MempoolReactor) GetChannels() []*p2p.ChannelDescriptor {
@@ -77,13 +164,68 @@ func (memR *MempoolReactor) GetChannels() []*p2p.ChannelDescriptor {
 			ID:       MempoolChannel,
 			Priority: 5,
 		},
+		{
+			ID:       MempoolGroupsChannel,
+			Priority: 1,
+		},
 	}
 }
 
 // AddPeer implements Reactor.
-// It starts a broadcast routine ensuring all txs are forwarded to the given peer.
+// It tells the peer which groups we accept, unless it's an older node without
+// MempoolGroupsChannel, and starts a broadcast routine ensuring all txs of the
+// groups the peer accepts are forwarded to it.
 func (memR *MempoolReactor) AddPeer(peer p2p.Peer) {
-	go memR.broadcastTxRoutine(peer)
+	groups := memR.groupIDs()
+	peer.TrySend(MempoolGroupsChannel, cdc.MustMarshalBinaryBare(&GroupsMessage{Groups: groups}))
+	go memR.broadcastTxRoutine(peer, memR.peerGroups(peer))
+}
+
+// peerGroups returns the groups of the peer, initially those of its NodeInfo.
+// Older nodes, without MempoolGroupsChannel, only accept group 0.
+func (memR *MempoolReactor) peerGroups(peer p2p.Peer) *peerGroups {
+	memR.peerMtx.Lock()
+	defer memR.peerMtx.Unlock()
+	if pg, ok := peer.Get(peerGroupsKey).(*peerGroups); ok {
+		return pg
+	}
+	var groups []int32
+	if nodeInfo, ok := peer.NodeInfo().(p2p.DefaultNodeInfo); ok {
+		groups = nodeInfo.Other.MempoolGroups
+		if !bytes.Contains(nodeInfo.Channels, []byte{MempoolGroupsChannel}) {
+			groups = []int32{0}
+		}
+	}
+	pg := newPeerGroups(groups)
+	peer.Set(peerGroupsKey, pg)
+	return pg
+}
+
+// groupIDs returns the groups of this node in ascending order.
+func (memR *MempoolReactor) groupIDs() []int32 {
+	items := memR.Items()
+	groups := make([]int32, len(items))
+	for i, item := range items {
+		groups[i] = item.Config.Group
+	}
+	return groups
+}
+
+// broadcastGroups tells all peers with MempoolGroupsChannel which groups we
+// accept.
+func (memR *MempoolReactor) broadcastGroups() {
+	if memR.Switch == nil {
+		return
+	}
+	msg := &GroupsMessage{Groups: memR.groupIDs()}
+	memR.Switch.Broadcast(MempoolGroupsChannel, cdc.MustMarshalBinaryBare(msg))
+}
+
+// report reports the behaviour of a peer, which may have disconnected since.
+func (memR *MempoolReactor) report(behaviour bh.PeerBehaviour) {
+	if err := memR.reporter.Report(behaviour); err != nil {
+		memR.Logger.Debug("Could not report peer behaviour", "peer", behaviour.PeerID(), "err", err)
+	}
 }
 
 // RemovePeer implements Reactor.
@@ -97,18 +239,33 @@ func (memR *MempoolReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 	msg, err := decodeMsg(msgBytes)
 	if err != nil {
 		memR.Logger.Error("Error decoding message", "src", src, "chId", chID, "msg", msg, "err", err, "bytes", msgBytes)
-		memR.Switch.StopPeerForError(src, err)
+		memR.report(bh.BadMessage(src.ID(), err.Error()))
 		return
 	}
 	memR.Logger.Debug("Receive", "src", src, "chId", chID, "msg", msg)
 
 	switch msg := msg.(type) {
 	case *TxMessage:
-		err := memR.Mempools[msg.Group].Mempool.CheckTx(msg.Tx, nil)
+		item, ok := memR.Mempool(msg.Group)
+		if !ok {
+			memR.Logger.Debug("Received tx of unknown group", "src", src, "group", msg.Group)
+			return
+		}
+		// the peer may run with a larger max_tx_bytes
+		if max := item.Mempool.maxTxBytes(); len(msg.Tx) > max {
+			memR.Logger.Info("Dropping too large tx", "src", src, "tx", TxID(msg.Tx),
+				"err", ErrTxTooLarge{Max: max, Actual: len(msg.Tx)})
+			return
+		}
+		// txs rejected by the app are not reported: they may have been valid
+		// when the peer checked them
+		err := item.Mempool.CheckTx(msg.Tx, nil)
 		if err != nil {
 			memR.Logger.Info("Could not check tx", "tx", TxID(msg.Tx), "err", err)
 		}
 		// broadcasting happens from go routines per peer
+	case *GroupsMessage:
+		memR.peerGroups(src).set(msg.Groups)
 	default:
 		memR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
 	}
@@ -120,42 +277,25 @@ type PeerState interface {
 }
 
 // Send new mempool txs to peer.
-func (memR *MempoolReactor) broadcastTxRoutine(peer p2p.Peer) {
-	var next *clist.CElement
+// Every group has its own cursor, and the groups take turns sending one tx
+// each, so that a busy group doesn't delay the others. peer.Send blocks while
+// the peer's send queue is full.
+func (memR *MempoolReactor) broadcastTxRoutine(peer p2p.Peer, pg *peerGroups) {
+	var (
+		cursors       []*groupCursor
+		groupsChanged <-chan struct{}
+		peerChanged   <-chan struct{}
+	)
+	refresh := true
 	for {
-		var selectGroup int32
-		// This happens because the CElement we were looking at got garbage
-		// collected (removed). That is, .NextWait() returned nil. Go ahead and
-		// start from the beginning.
-		if next == nil {
-
-			// todo order the mempool
-			for key, item := range memR.Mempools {
-				if !item.Config.Broadcast {
-					continue
-				}
-				select {
-				// need modify need lock
-				case <-item.Mempool.TxsWaitChan(): // Wait until a tx is available
-					if next = item.Mempool.TxsFront(); next == nil {
-						continue
-					} else {
-						selectGroup = key
-						break
-					}
-				case <-peer.Quit():
-					return
-				case <-memR.Quit():
-					return
-				}
-			}
-			if next == nil {
-				continue
-			}
+		if refresh {
+			var items []*MempoolItem
+			items, groupsChanged = memR.items()
+			peerChanged = pg.changedChan()
+			cursors = updateCursors(cursors, items, pg)
+			refresh = false
 		}
 
-		memTx := next.Value.(*mempoolTx)
-
 		// make sure the peer is up to date
 		peerState, ok := peer.Get(types.PeerStateKey).(PeerState)
 		if !ok {
@@ -167,29 +307,156 @@ func (memR *MempoolReactor) broadcastTxRoutine(peer p2p.Peer) {
 			time.Sleep(peerCatchupSleepIntervalMS * time.Millisecond)
 			continue
 		}
-		if peerState.GetHeight() < memTx.Height()-1 { // Allow for a lag of 1 block
-			time.Sleep(peerCatchupSleepIntervalMS * time.Millisecond)
+
+		// send the next tx of each group
+		sent, behind := false, false
+		for _, c := range cursors {
+			memTx := c.pending()
+			if memTx == nil {
+				continue
+			}
+			if peerState.GetHeight() < memTx.Height()-1 { // Allow for a lag of 1 block
+				behind = true
+				continue
+			}
+			msg := &TxMessage{Tx: memTx.tx, Group: c.item.Config.Group}
+			if !peer.Send(MempoolChannel, cdc.MustMarshalBinaryBare(msg)) {
+				behind = true
+				continue
+			}
+			c.sent = true
+			sent = true
+		}
+		if sent {
 			continue
 		}
 
-		// send memTx
-		msg := &TxMessage{Tx: memTx.tx, Group: selectGroup}
-		success := peer.Send(MempoolChannel, cdc.MustMarshalBinaryBare(msg))
-		if !success {
-			time.Sleep(peerCatchupSleepIntervalMS * time.Millisecond)
+		// wait for a new tx in any group
+		cases := make([]reflect.SelectCase, 0, len(cursors)+5)
+		for _, c := range cursors {
+			cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(c.waitChan())})
+		}
+		for _, ch := range []<-chan struct{}{groupsChanged, peerChanged, peer.Quit(), memR.Quit()} {
+			cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ch)})
+		}
+		if behind {
+			timer := time.After(peerCatchupSleepIntervalMS * time.Millisecond)
+			cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(timer)})
+		}
+		switch chosen, _, _ := reflect.Select(cases); chosen - len(cursors) {
+		case 0, 1:
+			// a group was added or removed, here or on the peer
+			refresh = true
+		case 2, 3:
+			return
+		}
+	}
+}
+
+// groupCursor tracks the txs of a group sent to a peer.
+type groupCursor struct {
+	item *MempoolItem
+	next *clist.CElement // next tx to send, nil to start at the front
+	sent bool            // whether next was sent already
+}
+
+// updateCursors returns the cursors of the groups broadcast to the peer,
+// keeping the positions of the existing ones.
+func updateCursors(cursors []*groupCursor, items []*MempoolItem, pg *peerGroups) []*groupCursor {
+	existing := make(map[*MempoolItem]*groupCursor, len(cursors))
+	for _, c := range cursors {
+		existing[c.item] = c
+	}
+	next := make([]*groupCursor, 0, len(items))
+	for _, item := range items {
+		if !item.Config.Broadcast || !pg.accepts(item.Config.Group) {
 			continue
 		}
+		c, ok := existing[item]
+		if !ok {
+			c = &groupCursor{item: item}
+		}
+		next = append(next, c)
+	}
+	return next
+}
 
-		select {
-		case <-next.NextWaitChan():
-			// see the start of the for loop for nil check
-			next = next.Next()
-		case <-peer.Quit():
-			return
-		case <-memR.Quit():
-			return
+// pending moves the cursor past the sent tx, and returns the tx to send, if
+// any.
+func (c *groupCursor) pending() *mempoolTx {
+	if c.next != nil && (c.sent || c.next.Removed()) {
+		if next := c.next.Next(); next != nil {
+			c.next, c.sent = next, false
+		} else if c.next.Removed() {
+			// the tx was removed at the end of the list, start over
+			c.next = nil
 		}
 	}
+	if c.next == nil {
+		c.next, c.sent = c.item.Mempool.TxsFront(), false
+	}
+	if c.next == nil || c.sent {
+		return nil
+	}
+	return c.next.Value.(*mempoolTx)
+}
+
+// waitChan returns a channel which is closed when the group may have a new tx
+// to send.
+func (c *groupCursor) waitChan() <-chan struct{} {
+	if c.next == nil {
+		return c.item.Mempool.TxsWaitChan()
+	}
+	if c.sent {
+		return c.next.NextWaitChan()
+	}
+	// the peer is behind, wait for the timer
+	return nil
+}
+
+// peerGroups holds the mempool groups a peer accepts txs of.
+type peerGroups struct {
+	mtx    sync.RWMutex
+	groups map[int32]struct{} // nil if the peer didn't tell
+	// closed and replaced whenever the groups change
+	changed chan struct{}
+}
+
+func newPeerGroups(groups []int32) *peerGroups {
+	pg := &peerGroups{changed: make(chan struct{})}
+	if len(groups) > 0 {
+		pg.set(groups)
+	}
+	return pg
+}
+
+// accepts returns whether the peer runs the given group. Peers which don't
+// advertise their groups accept all of them.
+func (pg *peerGroups) accepts(group int32) bool {
+	pg.mtx.RLock()
+	defer pg.mtx.RUnlock()
+	if pg.groups == nil {
+		return true
+	}
+	_, ok := pg.groups[group]
+	return ok
+}
+
+func (pg *peerGroups) set(groups []int32) {
+	pg.mtx.Lock()
+	defer pg.mtx.Unlock()
+	pg.groups = make(map[int32]struct{}, len(groups))
+	for _, group := range groups {
+		pg.groups[group] = struct{}{}
+	}
+	close(pg.changed)
+	pg.changed = make(chan struct{})
+}
+
+func (pg *peerGroups) changedChan() <-chan struct{} {
+	pg.mtx.RLock()
+	defer pg.mtx.RUnlock()
+	return pg.changed
 }
 
 //-----------------------------------------------------------------------------
@@ -201,6 +468,7 @@ type MempoolMessage interface{}
 func RegisterMempoolMessages(cdc *amino.Codec) {
 	cdc.RegisterInterface((*MempoolMessage)(nil), nil)
 	cdc.RegisterConcrete(&TxMessage{}, "tendermint/mempool/TxMessage", nil)
+	cdc.RegisterConcrete(&GroupsMessage{}, "tendermint/mempool/GroupsMessage", nil)
 }
 
 func decodeMsg(bz []byte) (msg MempoolMessage, err error) {
@@ -223,3 +491,16 @@ type TxMessage struct {
 func (m *TxMessage) String() string {
 	return fmt.Sprintf("[TxMessage %v][TxGroup %d]", m.Tx, m.Group)
 }
+
+//-------------------------------------
+
+// GroupsMessage is a MempoolMessage telling the peer which groups we accept
+// txs of. It's sent when the peer is added, and whenever the groups change.
+type GroupsMessage struct {
+	Groups []int32
+}
+
+// String returns a string representation of the GroupsMessage.
+func (m *GroupsMessage) String() string {
+	return fmt.Sprintf("[GroupsMessage %v]", m.Groups)
+}
@@ -2,6 +2,7 @@ package mempool
 
 import (
 	"fmt"
+	"os"
 	"sync"
 	"testing"
 	"time"
@@ -12,11 +13,14 @@ import (
 
 	"github.com/go-kit/kit/log/term"
 
+	"github.com/tendermint/tendermint/abci/example/counter"
 	"github.com/tendermint/tendermint/abci/example/kvstore"
+	cmn "github.com/tendermint/tendermint/libs/common"
 	"github.com/tendermint/tendermint/libs/log"
 
 	cfg "github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/p2p"
+	bh "github.com/tendermint/tendermint/p2p/behaviour"
 	"github.com/tendermint/tendermint/proxy"
 	"github.com/tendermint/tendermint/types"
 )
@@ -44,15 +48,25 @@ func mempoolLogger() log.Logger {
 
 // connect N mempool reactors through N switches
 func makeAndConnectMempoolReactors(config *cfg.Config, N int) []*MempoolReactor {
+	groups := make([][]int32, N)
+	for i := range groups {
+		groups[i] = []int32{0}
+	}
+	return makeAndConnectGroupReactors(config, groups)
+}
+
+// connect mempool reactors running the given groups through switches
+func makeAndConnectGroupReactors(config *cfg.Config, groups [][]int32) []*MempoolReactor {
+	N := len(groups)
 	reactors := make([]*MempoolReactor, N)
 	logger := mempoolLogger()
 	for i := 0; i < N; i++ {
 		app := kvstore.NewKVStoreApplication()
 		cc := proxy.NewLocalClientCreator(app)
-		mempool, cleanup := newMempoolWithApp(cc)
+		items, cleanup := newGroupMempoolsWithApp(cc, groups[i])
 		defer cleanup()
 
-		reactors[i] = NewMempoolReactor(config.Mempool, mempool) // so we dont start the consensus states
+		reactors[i] = NewMempoolReactor(items) // so we dont start the consensus states
 		reactors[i].SetLogger(logger.With("validator", i))
 	}
 
@@ -64,13 +78,58 @@ func makeAndConnectMempoolReactors(config *cfg.Config, N int) []*MempoolReactor
 	return reactors
 }
 
+// newGroupMempoolsWithApp creates a mempool for each group, sharing one
+// connection to the app.
+func newGroupMempoolsWithApp(cc proxy.ClientCreator, groups []int32) ([]*MempoolItem, cleanupFunc) {
+	config := cfg.ResetTestRoot("mempool_test")
+
+	appConnMem, _ := cc.NewABCIClient()
+	appConnMem.SetLogger(log.TestingLogger().With("module", "abci-client", "connection", "mempool"))
+	err := appConnMem.Start()
+	if err != nil {
+		panic(err)
+	}
+	items := make([]*MempoolItem, len(groups))
+	for i, group := range groups {
+		conf := *config.Mempool
+		conf.Group = group
+		items[i] = &MempoolItem{Config: &conf, Mempool: NewMempool(&conf, appConnMem, 0)}
+	}
+	return items, func() { os.RemoveAll(config.RootDir) }
+}
+
+// waitFor waits until cond is true.
+func waitFor(t *testing.T, cond func() bool) {
+	timer := time.After(TIMEOUT)
+	for !cond() {
+		select {
+		case <-timer:
+			t.Fatal("Timed out waiting for condition")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func groupMempool(memR *MempoolReactor, group int32) *Mempool {
+	item, ok := memR.Mempool(group)
+	if !ok {
+		panic(fmt.Sprintf("no mempool for group %d", group))
+	}
+	return item.Mempool
+}
+
 // wait for all txs on all reactors
 func waitForTxs(t *testing.T, txs types.Txs, reactors []*MempoolReactor) {
+	waitForGroupTxs(t, txs, 0, reactors)
+}
+
+// wait for all txs of the group on all reactors
+func waitForGroupTxs(t *testing.T, txs types.Txs, group int32, reactors []*MempoolReactor) {
 	// wait for the txs in all mempools
 	wg := new(sync.WaitGroup)
 	for i := 0; i < len(reactors); i++ {
 		wg.Add(1)
-		go _waitForTxs(t, wg, txs, i, reactors)
+		go _waitForTxs(t, wg, txs, groupMempool(reactors[i], group), i)
 	}
 
 	done := make(chan struct{})
@@ -88,9 +147,8 @@ func waitForTxs(t *testing.T, txs types.Txs, reactors []*MempoolReactor) {
 }
 
 // wait for all txs on a single mempool
-func _waitForTxs(t *testing.T, wg *sync.WaitGroup, txs types.Txs, reactorIdx int, reactors []*MempoolReactor) {
+func _waitForTxs(t *testing.T, wg *sync.WaitGroup, txs types.Txs, mempool *Mempool, reactorIdx int) {
 
-	mempool := reactors[reactorIdx].Mempool
 	for mempool.Size() != len(txs) {
 		time.Sleep(time.Millisecond * 100)
 	}
@@ -124,10 +182,126 @@ func TestReactorBroadcastTxMessage(t *testing.T) {
 
 	// send a bunch of txs to the first reactor's mempool
 	// and wait for them all to be received in the others
-	txs := checkTxs(t, reactors[0].Mempool, NUM_TXS)
+	txs := checkTxs(t, groupMempool(reactors[0], 0), NUM_TXS)
 	waitForTxs(t, txs, reactors)
 }
 
+func TestReactorBroadcastGroups(t *testing.T) {
+	config := cfg.TestConfig()
+	reactors := makeAndConnectGroupReactors(config, [][]int32{{0, 1, 2}, {0, 1}, {0, 1}})
+	defer func() {
+		for _, r := range reactors {
+			r.Stop()
+		}
+	}()
+	for _, r := range reactors {
+		for _, peer := range r.Switch.Peers().List() {
+			peer.Set(types.PeerStateKey, peerState{1})
+		}
+	}
+
+	// the other reactors don't run group 2
+	for _, peer := range reactors[0].Switch.Peers().List() {
+		pg := reactors[0].peerGroups(peer)
+		waitFor(t, func() bool { return !pg.accepts(2) })
+		assert.True(t, pg.accepts(1))
+	}
+
+	// a busy group doesn't hold back a quiet one
+	txs1 := checkTxs(t, groupMempool(reactors[0], 1), NUM_TXS)
+	txs0 := checkTxs(t, groupMempool(reactors[0], 0), 10)
+	checkTxs(t, groupMempool(reactors[0], 2), 10)
+	waitForGroupTxs(t, txs0, 0, reactors)
+	waitForGroupTxs(t, txs1, 1, reactors)
+}
+
+func TestReactorAddMempoolTellsPeers(t *testing.T) {
+	config := cfg.TestConfig()
+	reactors := makeAndConnectMempoolReactors(config, 2)
+	defer func() {
+		for _, r := range reactors {
+			r.Stop()
+		}
+	}()
+	for _, r := range reactors {
+		for _, peer := range r.Switch.Peers().List() {
+			peer.Set(types.PeerStateKey, peerState{1})
+		}
+	}
+
+	cc := proxy.NewLocalClientCreator(kvstore.NewKVStoreApplication())
+	for _, r := range reactors {
+		items, cleanup := newGroupMempoolsWithApp(cc, []int32{1})
+		defer cleanup()
+		items[0].Mempool.SetLogger(log.TestingLogger())
+		assert.NoError(t, r.AddMempool(items[0]))
+	}
+
+	peer := reactors[0].Switch.Peers().List()[0]
+	pg := reactors[0].peerGroups(peer)
+	waitFor(t, func() bool { return pg.accepts(1) && !pg.accepts(2) })
+
+	txs := checkTxs(t, groupMempool(reactors[0], 1), 10)
+	waitForGroupTxs(t, txs, 1, reactors)
+}
+
+// nodeInfoPeer is a peer with the given NodeInfo.
+type nodeInfoPeer struct {
+	p2p.Peer
+	nodeInfo p2p.NodeInfo
+	data     *cmn.CMap
+}
+
+func (p nodeInfoPeer) NodeInfo() p2p.NodeInfo        { return p.nodeInfo }
+func (p nodeInfoPeer) Get(key string) interface{}    { return p.data.Get(key) }
+func (p nodeInfoPeer) Set(key string, v interface{}) { p.data.Set(key, v) }
+
+func TestReactorPeerGroups(t *testing.T) {
+	memR := NewMempoolReactor(nil)
+	newPeer := func(channels []byte, groups []int32) p2p.Peer {
+		return nodeInfoPeer{
+			nodeInfo: p2p.DefaultNodeInfo{
+				Channels: channels,
+				Other:    p2p.DefaultNodeInfoOther{MempoolGroups: groups},
+			},
+			data: cmn.NewCMap(),
+		}
+	}
+
+	// older nodes only run group 0
+	pg := memR.peerGroups(newPeer([]byte{MempoolChannel}, nil))
+	assert.True(t, pg.accepts(0))
+	assert.False(t, pg.accepts(1))
+
+	pg = memR.peerGroups(newPeer([]byte{MempoolChannel, MempoolGroupsChannel}, []int32{0, 2}))
+	assert.True(t, pg.accepts(2))
+	assert.False(t, pg.accepts(1))
+}
+
+func TestReactorReportsBadPeers(t *testing.T) {
+	cc := proxy.NewLocalClientCreator(counter.NewCounterApplication(true))
+	items, cleanup := newGroupMempoolsWithApp(cc, []int32{0})
+	defer cleanup()
+
+	memR := NewMempoolReactor(items)
+	memR.SetLogger(log.TestingLogger())
+	reporter := bh.NewMockReporter()
+	memR.reporter = reporter
+	peer := p2p.CreateRandomPeer(false)
+
+	// a valid tx is not reported
+	memR.Receive(MempoolChannel, peer, cdc.MustMarshalBinaryBare(&TxMessage{Tx: types.Tx{0x00}}))
+	assert.Empty(t, reporter.GetBehaviours(peer.ID()))
+
+	// nor is a tx rejected by the app, which rejects txs longer than 8 bytes
+	memR.Receive(MempoolChannel, peer, cdc.MustMarshalBinaryBare(&TxMessage{Tx: make(types.Tx, 9)}))
+	assert.Empty(t, reporter.GetBehaviours(peer.ID()))
+
+	// but a malformed message is
+	memR.Receive(MempoolChannel, peer, []byte{0x01, 0x02, 0x03})
+	assert.Len(t, reporter.GetBehaviours(peer.ID()), 1)
+}
+
 func TestBroadcastTxForPeerStopsWhenPeerStops(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping test in short mode.")
@@ -0,0 +1,27 @@
+// +build sqlite
+
+package node
+
+import (
+	"path/filepath"
+
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/state/txindex/sqlite"
+)
+
+func init() {
+	createSQLIndexers = func(config *cfg.Config) (txindex.TxIndexer, txindex.BlockIndexer, error) {
+		var options []func(*sqlite.TxIndex)
+		if config.TxIndex.IndexTags != "" {
+			options = append(options, sqlite.IndexTags(splitAndTrimEmpty(config.TxIndex.IndexTags, ",", " ")))
+		} else if config.TxIndex.IndexAllTags {
+			options = append(options, sqlite.IndexAllTags())
+		}
+		sqlIndexer, err := sqlite.NewTxIndex(filepath.Join(config.DBDir(), "tx_index.sqlite"), options...)
+		if err != nil {
+			return nil, nil, err
+		}
+		return sqlIndexer, sqlIndexer.BlockIndex(), nil
+	}
+}
@@ -0,0 +1,170 @@
+package node
+
+import (
+	"fmt"
+	"sync"
+
+	cfg "github.com/tendermint/tendermint/config"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+	mempl "github.com/tendermint/tendermint/mempool"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+)
+
+// mempoolGroups adds and removes mempool groups while the node is running,
+// keeping the mempool reactor, the block executor and the consensus tx
+// notifier in sync. It follows the groups opened and closed by the
+// application through sm.GroupListener.
+type mempoolGroups struct {
+	mtx sync.Mutex
+
+	config       *cfg.MempoolConfig
+	proxyApp     proxy.AppConnMempool
+	stateDB      dbm.DB
+	metrics      *mempl.Metrics
+	eventBus     types.MempoolEventPublisher
+	txsAvailable bool
+	logger       log.Logger
+
+	reactor   *mempl.MempoolReactor
+	blockExec *sm.BlockExecutor
+	notifier  *groupsTxNotifier
+
+	names map[int32]string // names of the running groups
+}
+
+var _ sm.GroupListener = (*mempoolGroups)(nil)
+
+// newMempool creates the mempool of a group, as of the given state.
+func (mg *mempoolGroups) newMempool(conf *cfg.MempoolConfig, name string, state sm.State) *mempl.Mempool {
+	mem := mempl.NewMempool(
+		conf,
+		mg.proxyApp,
+		state.LastBlockHeight,
+		mempl.WithMetrics(mg.metrics),
+		mempl.WithEventBus(mg.eventBus),
+		mempl.WithPreCheck(sm.TxPreCheck(state)),
+		mempl.WithPostCheck(sm.TxPostCheck(state)),
+	)
+	mem.SetLogger(mg.logger.With("group", name))
+	if conf.WalEnabled() {
+		mem.InitWAL() // no need to have the mempool wal during tests
+	}
+	if mg.txsAvailable {
+		mem.EnableTxsAvailable()
+	}
+	return mem
+}
+
+// GroupConfig returns the settings of the given group when it is added:
+// its [[mempool.groups]] entry, or the settings of the [mempool] section.
+func (mg *mempoolGroups) GroupConfig(group int32) *cfg.MempoolGroupConfig {
+	groupConfig := *mg.config.OpenedGroupConfig(group)
+	return &groupConfig
+}
+
+// AddGroup starts a mempool for the given group, which must be open on chain.
+func (mg *mempoolGroups) AddGroup(groupConfig *cfg.MempoolGroupConfig) error {
+	if err := groupConfig.ValidateBasic(); err != nil {
+		return err
+	}
+
+	mg.mtx.Lock()
+	defer mg.mtx.Unlock()
+
+	state := sm.LoadState(mg.stateDB)
+	if !state.GroupOpen(groupConfig.ID) {
+		return fmt.Errorf("Mempool group %d is not open on chain", groupConfig.ID)
+	}
+	if _, ok := mg.reactor.Mempool(groupConfig.ID); ok {
+		return fmt.Errorf("Mempool group %d already exists", groupConfig.ID)
+	}
+	for _, name := range mg.names {
+		if name == groupConfig.Name {
+			return fmt.Errorf("Mempool group name %q is already used", groupConfig.Name)
+		}
+	}
+
+	conf := mg.config.GroupConfig(groupConfig)
+	mem := mg.newMempool(conf, groupConfig.Name, state)
+	item := &mempl.MempoolItem{Config: conf, Mempool: mem}
+	if err := mg.reactor.AddMempool(item); err != nil {
+		return err
+	}
+	mg.blockExec.AddMempool(conf.Group, mem)
+	mg.notifier.addGroup(item)
+	mg.names[conf.Group] = groupConfig.Name
+
+	mg.logger.Info("Added mempool group", "group", conf.Group, "name", groupConfig.Name)
+	return nil
+}
+
+// RemoveGroup stops the mempool of the given group and drops its txs.
+// Group 0 can't be removed.
+func (mg *mempoolGroups) RemoveGroup(group int32) error {
+	if group == 0 {
+		return fmt.Errorf("Mempool group 0 can't be removed")
+	}
+
+	mg.mtx.Lock()
+	defer mg.mtx.Unlock()
+
+	item, err := mg.reactor.RemoveMempool(group)
+	if err != nil {
+		return err
+	}
+	mg.blockExec.RemoveMempool(group)
+	mg.notifier.removeGroup(group)
+	mg.proxyApp.SetResponseCallback(group, nil)
+	if item.Config.WalEnabled() {
+		item.Mempool.CloseWAL()
+	}
+	item.Mempool.Flush()
+	delete(mg.names, group)
+
+	mg.logger.Info("Removed mempool group", "group", group)
+	return nil
+}
+
+// OpenGroup implements sm.GroupListener. Groups opened by the application
+// run with their [[mempool.groups]] entry, or the settings of the [mempool]
+// section.
+func (mg *mempoolGroups) OpenGroup(group int32) {
+	if _, ok := mg.reactor.Mempool(group); ok {
+		return
+	}
+	if err := mg.AddGroup(mg.GroupConfig(group)); err != nil {
+		mg.logger.Error("Failed to add mempool group opened on chain", "group", group, "err", err)
+	}
+}
+
+// CloseGroup implements sm.GroupListener.
+func (mg *mempoolGroups) CloseGroup(group int32) {
+	if _, ok := mg.reactor.Mempool(group); !ok {
+		return
+	}
+	if err := mg.RemoveGroup(group); err != nil {
+		mg.logger.Error("Failed to remove mempool group closed on chain", "group", group, "err", err)
+	}
+}
+
+// Mempool returns the mempool of the given group, if it is running.
+func (mg *mempoolGroups) Mempool(group int32) (*mempl.Mempool, bool) {
+	item, ok := mg.reactor.Mempool(group)
+	if !ok {
+		return nil, false
+	}
+	return item.Mempool, true
+}
+
+// Mempools returns the mempools of the running groups, sorted by group.
+func (mg *mempoolGroups) Mempools() []*mempl.Mempool {
+	items := mg.reactor.Items()
+	mems := make([]*mempl.Mempool, len(items))
+	for i, item := range items {
+		mems[i] = item.Mempool
+	}
+	return mems
+}
@@ -9,6 +9,7 @@ import (
 	_ "net/http/pprof"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -29,6 +30,7 @@ import (
 	mempl "github.com/tendermint/tendermint/mempool"
 	"github.com/tendermint/tendermint/p2p"
 	"github.com/tendermint/tendermint/p2p/pex"
+	"github.com/tendermint/tendermint/p2p/trust"
 	"github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
 	rpccore "github.com/tendermint/tendermint/rpc/core"
@@ -39,6 +41,7 @@ import (
 	"github.com/tendermint/tendermint/state/txindex"
 	"github.com/tendermint/tendermint/state/txindex/kv"
 	"github.com/tendermint/tendermint/state/txindex/null"
+	"github.com/tendermint/tendermint/statesync"
 	"github.com/tendermint/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
 	"github.com/tendermint/tendermint/version"
@@ -147,6 +150,7 @@ type Node struct {
 
 	// network
 	transport   *p2p.MultiplexTransport
+	wsTransport *p2p.WSTransport
 	sw          *p2p.Switch  // p2p connections
 	addrBook    pex.AddrBook // known peers
 	nodeInfo    p2p.NodeInfo
@@ -156,19 +160,68 @@ type Node struct {
 	// services
 	eventBus         *types.EventBus // pub/sub for services
 	stateDB          dbm.DB
-	blockStore       *bc.BlockStore         // store the blockchain to disk
-	bcReactor        *bc.BlockchainReactor  // for fast-syncing
-	mempoolReactor   *mempl.MempoolReactor  // for gossipping transactions
-	consensusState   *cs.ConsensusState     // latest consensus state
-	consensusReactor *cs.ConsensusReactor   // for participating in the consensus
-	evidencePool     *evidence.EvidencePool // tracking evidence
-	proxyApp         proxy.AppConns         // connection to the application
-	rpcListeners     []net.Listener         // rpc servers
+	blockStore       *bc.BlockStore              // store the blockchain to disk
+	bcReactor        *bc.BlockchainReactor       // for fast-syncing
+	mempoolReactor   *mempl.MempoolReactor       // for gossipping transactions
+	mempoolGroups    *mempoolGroups              // for adding and removing mempool groups
+	consensusState   *cs.ConsensusState          // latest consensus state
+	consensusReactor *cs.ConsensusReactor        // for participating in the consensus
+	stateSyncReactor *statesync.StateSyncReactor // for restoring the app from a snapshot
+	stateSync        bool                        // whether the node should state sync on startup
+	evidencePool     *evidence.EvidencePool      // tracking evidence
+	proxyApp         proxy.AppConns              // connection to the application
+	rpcListeners     []net.Listener              // rpc servers
 	txIndexer        txindex.TxIndexer
+	blockIndexer     txindex.BlockIndexer
 	indexerService   *txindex.IndexerService
 	prometheusSrv    *http.Server
 }
 
+// createSQLIndexers returns the indexers backed by SQLite. It is only set
+// when building with the sqlite tag (see indexer_sqlite.go), as the SQLite
+// driver requires Go 1.20 or later.
+var createSQLIndexers func(config *cfg.Config) (txindex.TxIndexer, txindex.BlockIndexer, error)
+
+// CreateIndexers returns the tx and block indexers set up by the
+// [tx_index] section of the config. Exported so other CLI tools can use it.
+func CreateIndexers(config *cfg.Config, dbProvider DBProvider) (txindex.TxIndexer, txindex.BlockIndexer, error) {
+	var (
+		txIndexer    txindex.TxIndexer
+		blockIndexer txindex.BlockIndexer
+	)
+	switch config.TxIndex.Indexer {
+	case "kv":
+		store, err := dbProvider(&DBContext{"tx_index", config})
+		if err != nil {
+			return nil, nil, err
+		}
+		blockIndexStore, err := dbProvider(&DBContext{"block_index", config})
+		if err != nil {
+			return nil, nil, err
+		}
+		if config.TxIndex.IndexTags != "" {
+			tags := splitAndTrimEmpty(config.TxIndex.IndexTags, ",", " ")
+			txIndexer = kv.NewTxIndex(store, kv.IndexTags(tags))
+			blockIndexer = kv.NewBlockIndex(blockIndexStore, kv.BlockIndexTags(tags))
+		} else if config.TxIndex.IndexAllTags {
+			txIndexer = kv.NewTxIndex(store, kv.IndexAllTags())
+			blockIndexer = kv.NewBlockIndex(blockIndexStore, kv.BlockIndexAllTags())
+		} else {
+			txIndexer = kv.NewTxIndex(store)
+			blockIndexer = kv.NewBlockIndex(blockIndexStore)
+		}
+	case "sql":
+		if createSQLIndexers == nil {
+			return nil, nil, errors.New(`the "sql" indexer requires building with the sqlite tag`)
+		}
+		return createSQLIndexers(config)
+	default:
+		txIndexer = &null.TxIndex{}
+		blockIndexer = &null.BlockIndex{}
+	}
+	return txIndexer, blockIndexer, nil
+}
+
 // NewNode returns a new, ready to go, Tendermint Node.
 func NewNode(config *cfg.Config,
 	privValidator types.PrivValidator,
@@ -229,26 +282,13 @@ func NewNode(config *cfg.Config,
 		return nil, err
 	}
 
-	// Transaction indexing
-	var txIndexer txindex.TxIndexer
-	switch config.TxIndex.Indexer {
-	case "kv":
-		store, err := dbProvider(&DBContext{"tx_index", config})
-		if err != nil {
-			return nil, err
-		}
-		if config.TxIndex.IndexTags != "" {
-			txIndexer = kv.NewTxIndex(store, kv.IndexTags(splitAndTrimEmpty(config.TxIndex.IndexTags, ",", " ")))
-		} else if config.TxIndex.IndexAllTags {
-			txIndexer = kv.NewTxIndex(store, kv.IndexAllTags())
-		} else {
-			txIndexer = kv.NewTxIndex(store)
-		}
-	default:
-		txIndexer = &null.TxIndex{}
+	// Transaction and block indexing
+	txIndexer, blockIndexer, err := CreateIndexers(config, dbProvider)
+	if err != nil {
+		return nil, err
 	}
 
-	indexerService := txindex.NewIndexerService(txIndexer, eventBus)
+	indexerService := txindex.NewIndexerService(txIndexer, blockIndexer, eventBus)
 	indexerService.SetLogger(logger.With("module", "txindex"))
 
 	err = indexerService.Start()
@@ -256,20 +296,31 @@ func NewNode(config *cfg.Config,
 		return nil, err
 	}
 
+	// State sync only bootstraps nodes without any state, and we don't state
+	// sync when the only validator is us (there's nobody to sync from).
+	stateSync := config.StateSync.Enable && !onlyValidatorIsUs(state, privValidator)
+	if stateSync && state.LastBlockHeight > 0 {
+		logger.Info("Found local state with non-zero height, skipping state sync")
+		stateSync = false
+	}
+
 	// Create the handshaker, which calls RequestInfo, sets the AppVersion on the state,
 	// and replays any blocks as necessary to sync tendermint with the app.
+	// When state syncing, the app is restored from a snapshot instead.
 	consensusLogger := logger.With("module", "consensus")
-	handshaker := cs.NewHandshaker(stateDB, state, blockStore, genDoc)
-	handshaker.SetLogger(consensusLogger)
-	handshaker.SetEventBus(eventBus)
-	if err := handshaker.Handshake(proxyApp, config.Mempool.Group); err != nil {
-		return nil, fmt.Errorf("Error during handshake: %v", err)
-	}
+	if !stateSync {
+		handshaker := cs.NewHandshaker(stateDB, state, blockStore, genDoc)
+		handshaker.SetLogger(consensusLogger)
+		handshaker.SetEventBus(eventBus)
+		if err := handshaker.Handshake(proxyApp, config.Mempool.GroupIDs()); err != nil {
+			return nil, fmt.Errorf("Error during handshake: %v", err)
+		}
 
-	// Reload the state. It will have the Version.Consensus.App set by the
-	// Handshake, and may have other modifications as well (ie. depending on
-	// what happened during block replay).
-	state = sm.LoadState(stateDB)
+		// Reload the state. It will have the Version.Consensus.App set by the
+		// Handshake, and may have other modifications as well (ie. depending on
+		// what happened during block replay).
+		state = sm.LoadState(stateDB)
+	}
 
 	// Log the version info.
 	logger.Info("Version info",
@@ -298,14 +349,7 @@ func NewNode(config *cfg.Config,
 
 	// Decide whether to fast-sync or not
 	// We don't fast-sync when the only validator is us.
-	fastSync := config.FastSync
-	if state.Validators.Size() == 1 {
-		addr, _ := state.Validators.GetByIndex(0)
-		privValAddr := privValidator.GetPubKey().Address()
-		if bytes.Equal(privValAddr, addr) {
-			fastSync = false
-		}
-	}
+	fastSync := config.FastSync && !onlyValidatorIsUs(state, privValidator)
 
 	pubKey := privValidator.GetPubKey()
 	addr := pubKey.Address()
@@ -319,52 +363,30 @@ func NewNode(config *cfg.Config,
 	csMetrics, p2pMetrics, memplMetrics, smMetrics := metricsProvider(genDoc.ChainID)
 
 	mempoolLogger := logger.With("module", "mempool")
-	// Make MempoolReactor
-	groupNum := 1
-	for i := 0; i < 32; i++ {
-		if (config.Mempool.Group>>uint32(i))&1 == 1 {
-			groupNum++
-		}
+	memGroups := &mempoolGroups{
+		config:       config.Mempool,
+		proxyApp:     proxyApp.Mempool(),
+		stateDB:      stateDB,
+		metrics:      memplMetrics,
+		eventBus:     eventBus,
+		txsAvailable: config.Consensus.WaitForTxs(),
+		logger:       mempoolLogger,
+		names:        make(map[int32]string),
 	}
 
-	mempoolItems := make([]*mempl.MempoolItem, groupNum)
-	var mem *mempl.Mempool
-
-	var i int32
-	var j int32 = 0
-	for i = 0; i < 33; i++ {
-		if i != 0 && (config.Mempool.Group>>uint32(i-1))&1 == 0 {
-			continue
-		}
-
-		conf := *config.Mempool
-		conf.Group = i
-		mem = mempl.NewMempool(
-			&conf,
-			proxyApp.Mempool(),
-			state.LastBlockHeight,
-			mempl.WithMetrics(memplMetrics),
-			mempl.WithPreCheck(sm.TxPreCheck(state)),
-			mempl.WithPostCheck(sm.TxPostCheck(state)),
-		)
-
-		mem.SetLogger(mempoolLogger)
-		if conf.WalEnabled() {
-			mem.InitWAL() // no need to have the mempool wal during tests
-		}
-
-		mempoolItems[j] = &mempl.MempoolItem{Config: &conf, Mempool: mem}
-		j++
+	// Make MempoolReactor
+	groupConfigs := config.Mempool.GroupConfigs()
+	mempoolItems := make([]*mempl.MempoolItem, len(groupConfigs))
+	for i, groupConfig := range groupConfigs {
+		conf := config.Mempool.GroupConfig(groupConfig)
+		mem := memGroups.newMempool(conf, groupConfig.Name, state)
+		mempoolItems[i] = &mempl.MempoolItem{Config: conf, Mempool: mem}
+		memGroups.names[conf.Group] = groupConfig.Name
 	}
 
 	mempoolReactor := mempl.NewMempoolReactor(mempoolItems)
 	mempoolReactor.SetLogger(mempoolLogger)
-
-	if config.Consensus.WaitForTxs() {
-		for _, item := range mempoolItems {
-			item.Mempool.EnableTxsAvailable()
-		}
-	}
+	memGroups.reactor = mempoolReactor
 
 	// Make Evidence Reactor
 	evidenceDB, err := dbProvider(&DBContext{"evidence", config})
@@ -372,7 +394,7 @@ func NewNode(config *cfg.Config,
 		return nil, err
 	}
 	evidenceLogger := logger.With("module", "evidence")
-	evidencePool := evidence.NewEvidencePool(stateDB, evidenceDB)
+	evidencePool := evidence.NewEvidencePool(stateDB, evidenceDB, blockStore)
 	evidencePool.SetLogger(evidenceLogger)
 	evidenceReactor := evidence.NewEvidenceReactor(evidencePool)
 	evidenceReactor.SetLogger(evidenceLogger)
@@ -384,6 +406,18 @@ func NewNode(config *cfg.Config,
 		tmpMem[item.Config.Group] = item.Mempool
 	}
 
+	var groupSelector sm.GroupSelector
+	switch config.Consensus.GroupSelector {
+	case cfg.GroupSelectorOldestTx:
+		groupSelector = sm.NewOldestTxGroupSelector()
+	case cfg.GroupSelectorBacklog:
+		groupSelector = sm.NewBacklogGroupSelector()
+	case cfg.GroupSelectorABCI:
+		groupSelector = sm.NewABCIGroupSelector()
+	default:
+		groupSelector = sm.NewRoundRobinGroupSelector()
+	}
+
 	blockExec := sm.NewBlockExecutor(
 		stateDB,
 		blockExecLogger,
@@ -391,10 +425,16 @@ func NewNode(config *cfg.Config,
 		tmpMem,
 		evidencePool,
 		sm.BlockExecutorWithMetrics(smMetrics),
+		sm.BlockExecutorWithGroupSelector(groupSelector),
+		sm.BlockExecutorWithGroupListener(memGroups),
+		sm.BlockExecutorWithPruning(blockStore, config.RetainBlocks),
 	)
+	memGroups.blockExec = blockExec
+	memGroups.notifier = newGroupsTxNotifier(mempoolItems)
 
-	// Make BlockchainReactor
-	bcReactor := bc.NewBlockchainReactor(state.Copy(), blockExec, blockStore, fastSync)
+	// Make BlockchainReactor. Fast sync is switched on by the state sync
+	// reactor once the app is restored, when state syncing.
+	bcReactor := bc.NewBlockchainReactor(state.Copy(), blockExec, blockStore, fastSync && !stateSync)
 	bcReactor.SetLogger(logger.With("module", "blockchain"))
 
 	// Make ConsensusReactor
@@ -403,7 +443,7 @@ func NewNode(config *cfg.Config,
 		state.Copy(),
 		blockExec,
 		blockStore,
-		mempoolItems[0].Mempool,
+		memGroups.notifier,
 		evidencePool,
 		cs.StateMetrics(csMetrics),
 	)
@@ -411,13 +451,18 @@ func NewNode(config *cfg.Config,
 	if privValidator != nil {
 		consensusState.SetPrivValidator(privValidator)
 	}
-	consensusReactor := cs.NewConsensusReactor(consensusState, fastSync, cs.ReactorMetrics(csMetrics))
+	consensusReactor := cs.NewConsensusReactor(consensusState, fastSync || stateSync, cs.ReactorMetrics(csMetrics))
 	consensusReactor.SetLogger(consensusLogger)
 
 	// services which will be publishing and/or subscribing for messages (events)
 	// consensusReactor will set it on consensusState and blockExecutor
 	consensusReactor.SetEventBus(eventBus)
 
+	// Make StateSyncReactor. It always serves the snapshots of the app to
+	// other nodes, and restores the app from one on startup when state syncing.
+	stateSyncReactor := statesync.NewStateSyncReactor(config.StateSync, proxyApp.Snapshot(), proxyApp.Query())
+	stateSyncReactor.SetLogger(logger.With("module", "statesync"))
+
 	p2pLogger := logger.With("module", "p2p")
 	nodeInfo, err := makeNodeInfo(
 		config,
@@ -434,10 +479,12 @@ func NewNode(config *cfg.Config,
 		return nil, err
 	}
 
-	// Setup Transport.
+	// Setup Transports. Peers are dialed with the transport of the protocol
+	// of their address, tcp by default.
 	var (
 		mConnConfig = p2p.MConnConfig(config.P2P)
 		transport   = p2p.NewMultiplexTransport(nodeInfo, *nodeKey, mConnConfig)
+		wsTransport = p2p.NewWSTransport(nodeInfo, *nodeKey, mConnConfig)
 		connFilters = []p2p.ConnFilterFunc{}
 		peerFilters = []p2p.PeerFilterFunc{}
 	)
@@ -487,19 +534,31 @@ func NewNode(config *cfg.Config,
 	}
 
 	p2p.MultiplexTransportConnFilters(connFilters...)(transport)
+	p2p.MultiplexTransportConnFilters(connFilters...)(wsTransport.MultiplexTransport)
+
+	// The trust metrics of the peers, used to ban the untrusted ones.
+	trustHistoryDB, err := dbProvider(&DBContext{"trusthistory", config})
+	if err != nil {
+		return nil, err
+	}
+	trustMetricStore := trust.NewTrustMetricStore(trustHistoryDB, trust.DefaultConfig())
+	trustMetricStore.SetLogger(p2pLogger)
 
 	// Setup Switch.
 	sw := p2p.NewSwitch(
 		config.P2P,
 		transport,
 		p2p.WithMetrics(p2pMetrics),
+		p2p.WithTrustMetricStore(trustMetricStore),
 		p2p.SwitchPeerFilters(peerFilters...),
+		p2p.SwitchTransport(p2p.ProtocolWS, wsTransport),
 	)
 	sw.SetLogger(p2pLogger)
 	sw.AddReactor("MEMPOOL", mempoolReactor)
 	sw.AddReactor("BLOCKCHAIN", bcReactor)
 	sw.AddReactor("CONSENSUS", consensusReactor)
 	sw.AddReactor("EVIDENCE", evidenceReactor)
+	sw.AddReactor("STATESYNC", stateSyncReactor)
 	sw.SetNodeInfo(nodeInfo)
 	sw.SetNodeKey(nodeKey)
 
@@ -549,21 +608,26 @@ func NewNode(config *cfg.Config,
 		genesisDoc:    genDoc,
 		privValidator: privValidator,
 
-		transport: transport,
-		sw:        sw,
-		addrBook:  addrBook,
-		nodeInfo:  nodeInfo,
-		nodeKey:   nodeKey,
+		transport:   transport,
+		wsTransport: wsTransport,
+		sw:          sw,
+		addrBook:    addrBook,
+		nodeInfo:    nodeInfo,
+		nodeKey:     nodeKey,
 
 		stateDB:          stateDB,
 		blockStore:       blockStore,
 		bcReactor:        bcReactor,
 		mempoolReactor:   mempoolReactor,
+		mempoolGroups:    memGroups,
 		consensusState:   consensusState,
 		consensusReactor: consensusReactor,
+		stateSyncReactor: stateSyncReactor,
+		stateSync:        stateSync,
 		evidencePool:     evidencePool,
 		proxyApp:         proxyApp,
 		txIndexer:        txIndexer,
+		blockIndexer:     blockIndexer,
 		indexerService:   indexerService,
 		eventBus:         eventBus,
 	}
@@ -598,7 +662,8 @@ func (n *Node) OnStart() error {
 		n.prometheusSrv = n.startPrometheusServer(n.config.Instrumentation.PrometheusListenAddr)
 	}
 
-	// Start the transport.
+	// Start the transports: tcp on the listen address and, if set, WebSocket
+	// on the WebSocket listen address.
 	addr, err := p2p.NewNetAddressStringWithOptionalID(n.config.P2P.ListenAddress)
 	if err != nil {
 		return err
@@ -606,6 +671,15 @@ func (n *Node) OnStart() error {
 	if err := n.transport.Listen(*addr); err != nil {
 		return err
 	}
+	if n.config.P2P.WSListenAddress != "" {
+		wsAddr, err := p2p.NewNetAddressStringWithOptionalID(n.config.P2P.WSListenAddress)
+		if err != nil {
+			return err
+		}
+		if err := n.wsTransport.Listen(*wsAddr); err != nil {
+			return err
+		}
+	}
 
 	n.isListening = true
 
@@ -623,9 +697,53 @@ func (n *Node) OnStart() error {
 		}
 	}
 
+	// Run state sync
+	if n.stateSync {
+		stateProvider, err := statesync.NewLightClientStateProvider(
+			n.genesisDoc,
+			n.config.StateSync.RPCServers,
+			n.config.StateSync.TrustHeight,
+			n.config.StateSync.TrustHashBytes(),
+			n.Logger.With("module", "lite"),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to set up state provider: %v", err)
+		}
+		go n.startStateSync(stateProvider)
+	}
+
 	return nil
 }
 
+// startStateSync restores the app from a snapshot, bootstraps the state and
+// block store with the snapshot height, and switches to fast sync or
+// consensus from there.
+func (n *Node) startStateSync(stateProvider statesync.StateProvider) {
+	state, commit, err := n.stateSyncReactor.Sync(stateProvider, n.config.StateSync.DiscoveryTime)
+	if err != nil {
+		n.Logger.Error("State sync failed", "err", err)
+		return
+	}
+	if err := sm.BootstrapState(n.stateDB, state); err != nil {
+		n.Logger.Error("Failed to bootstrap node with new state", "err", err)
+		return
+	}
+	n.blockStore.SaveSeenCommit(state.LastBlockHeight, commit)
+
+	// run the mempools of the groups open in the restored app
+	for _, group := range state.Groups {
+		n.mempoolGroups.OpenGroup(group)
+	}
+
+	if n.config.FastSync {
+		if err := n.bcReactor.SwitchToFastSync(state); err != nil {
+			n.Logger.Error("Failed to switch to fast sync", "err", err)
+		}
+		return
+	}
+	n.consensusReactor.SwitchToConsensus(state, 0)
+}
+
 // OnStop stops the Node. It implements cmn.Service.
 func (n *Node) OnStop() {
 	n.BaseService.OnStop()
@@ -641,7 +759,7 @@ func (n *Node) OnStop() {
 	n.sw.Stop()
 
 	// stop mempool WAL
-	for _, item := range n.mempoolReactor.Mempools {
+	for _, item := range n.mempoolReactor.Items() {
 		if item.Config.WalEnabled() {
 			item.Mempool.CloseWAL()
 		}
@@ -650,6 +768,9 @@ func (n *Node) OnStop() {
 	if err := n.transport.Close(); err != nil {
 		n.Logger.Error("Error closing transport", "err", err)
 	}
+	if err := n.wsTransport.Close(); err != nil {
+		n.Logger.Error("Error closing websocket transport", "err", err)
+	}
 
 	n.isListening = false
 
@@ -679,12 +800,7 @@ func (n *Node) ConfigureRPC() {
 	rpccore.SetStateDB(n.stateDB)
 	rpccore.SetBlockStore(n.blockStore)
 	rpccore.SetConsensusState(n.consensusState)
-	mempools := make(map[int32]*mempl.Mempool, len(n.mempoolReactor.Mempools))
-	for _, item := range n.mempoolReactor.Mempools {
-		mempools[item.Config.Group] = item.Mempool
-	}
-
-	rpccore.SetMempool(mempools)
+	rpccore.SetMempoolGroups(n.mempoolGroups)
 	rpccore.SetEvidencePool(n.evidencePool)
 	rpccore.SetP2PPeers(n.sw)
 	rpccore.SetP2PTransport(n)
@@ -694,8 +810,10 @@ func (n *Node) ConfigureRPC() {
 	rpccore.SetAddrBook(n.addrBook)
 	rpccore.SetProxyAppQuery(n.proxyApp.Query())
 	rpccore.SetTxIndexer(n.txIndexer)
+	rpccore.SetBlockIndexer(n.blockIndexer)
 	rpccore.SetConsensusReactor(n.consensusReactor)
 	rpccore.SetEventBus(n.eventBus)
+	rpccore.SetConfig(*n.config.RPC)
 	rpccore.SetLogger(n.Logger.With("module", "rpc"))
 }
 
@@ -714,15 +832,19 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 	for i, listenAddr := range listenAddrs {
 		mux := http.NewServeMux()
 		rpcLogger := n.Logger.With("module", "rpc-server")
-		wm := rpcserver.NewWebsocketManager(rpccore.Routes, coreCodec, rpcserver.EventSubscriber(n.eventBus))
+		wm := rpcserver.NewWebsocketManager(rpccore.Routes, coreCodec,
+			rpcserver.EventSubscriber(n.eventBus),
+			rpcserver.ReadLimit(n.config.RPC.MaxBodyBytes),
+		)
 		wm.SetLogger(rpcLogger.With("protocol", "websocket"))
 		mux.HandleFunc("/websocket", wm.WebsocketHandler)
 		rpcserver.RegisterRPCFuncs(mux, rpccore.Routes, coreCodec, rpcLogger)
 
-		listener, err := rpcserver.Listen(
-			listenAddr,
-			rpcserver.Config{MaxOpenConnections: 5000},
-		)
+		config := rpcserver.Config{
+			MaxOpenConnections: 5000,
+			MaxBodyBytes:       n.config.RPC.MaxBodyBytes,
+		}
+		listener, err := rpcserver.Listen(listenAddr, config)
 		if err != nil {
 			return nil, err
 		}
@@ -741,6 +863,7 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 			listener,
 			rootHandler,
 			rpcLogger,
+			config,
 		)
 		listeners[i] = listener
 	}
@@ -753,7 +876,7 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		if err != nil {
 			return nil, err
 		}
-		go grpccore.StartGRPCServer(listener)
+		go grpccore.StartGRPCServer(listener, grpccore.Config{MaxBodyBytes: n.config.RPC.MaxBodyBytes})
 		listeners = append(listeners, listener)
 	}
 
@@ -873,13 +996,15 @@ func makeNodeInfo(
 		Channels: []byte{
 			bc.BlockchainChannel,
 			cs.StateChannel, cs.DataChannel, cs.VoteChannel, cs.VoteSetBitsChannel,
-			mempl.MempoolChannel,
+			mempl.MempoolChannel, mempl.MempoolGroupsChannel,
 			evidence.EvidenceChannel,
+			statesync.SnapshotChannel, statesync.ChunkChannel,
 		},
 		Moniker: config.Moniker,
 		Other: p2p.DefaultNodeInfoOther{
-			TxIndex:    txIndexerStatus,
-			RPCAddress: config.RPC.ListenAddress,
+			TxIndex:       txIndexerStatus,
+			RPCAddress:    config.RPC.ListenAddress,
+			MempoolGroups: config.Mempool.GroupIDs(),
 		},
 	}
 
@@ -905,6 +1030,70 @@ var (
 	genesisDocKey = []byte("genesisDoc")
 )
 
+// groupsTxNotifier fires when txs are available in any mempool group,
+// since a block can carry txs of all groups.
+type groupsTxNotifier struct {
+	mtx          sync.Mutex
+	txsAvailable chan struct{}
+	quit         map[int32]chan struct{}
+}
+
+func newGroupsTxNotifier(mempoolItems []*mempl.MempoolItem) *groupsTxNotifier {
+	n := &groupsTxNotifier{quit: make(map[int32]chan struct{})}
+	for _, item := range mempoolItems {
+		if item.Mempool.TxsAvailable() != nil {
+			n.txsAvailable = make(chan struct{}, 1)
+			break
+		}
+	}
+	for _, item := range mempoolItems {
+		n.addGroup(item)
+	}
+	return n
+}
+
+// addGroup starts forwarding the notifications of a group's mempool.
+func (n *groupsTxNotifier) addGroup(item *mempl.MempoolItem) {
+	ch := item.Mempool.TxsAvailable()
+	if ch == nil || n.txsAvailable == nil {
+		// EnableTxsAvailable was not called
+		return
+	}
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	quit := make(chan struct{})
+	n.quit[item.Config.Group] = quit
+	go func() {
+		for {
+			select {
+			case <-ch:
+				select {
+				case n.txsAvailable <- struct{}{}:
+				default:
+				}
+			case <-quit:
+				return
+			}
+		}
+	}()
+}
+
+// removeGroup stops forwarding the notifications of a group's mempool.
+func (n *groupsTxNotifier) removeGroup(group int32) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	if quit, ok := n.quit[group]; ok {
+		close(quit)
+		delete(n.quit, group)
+	}
+}
+
+// TxsAvailable returns a channel which fires when any group has txs available.
+// NOTE: the returned channel is nil if EnableTxsAvailable was not called.
+func (n *groupsTxNotifier) TxsAvailable() <-chan struct{} {
+	return n.txsAvailable
+}
+
 // panics if failed to unmarshal bytes
 func loadGenesisDoc(db dbm.DB) (*types.GenesisDoc, error) {
 	bytes := db.Get(genesisDocKey)
@@ -981,3 +1170,13 @@ func splitAndTrimEmpty(s, sep, cutset string) []string {
 	}
 	return nonEmptyStrings
 }
+
+// onlyValidatorIsUs returns true if our validator is the only one of the
+// state, in which case there's nobody to sync from.
+func onlyValidatorIsUs(state sm.State, privVal types.PrivValidator) bool {
+	if state.Validators.Size() > 1 {
+		return false
+	}
+	addr, _ := state.Validators.GetByIndex(0)
+	return bytes.Equal(privVal.GetPubKey().Address(), addr)
+}
@@ -104,6 +104,31 @@ func TestNodeDelayedStart(t *testing.T) {
 	assert.Equal(t, true, startTime.After(n.GenesisDoc().GenesisTime))
 }
 
+func TestNodeListensOnTCPAndWS(t *testing.T) {
+	config := cfg.ResetTestRoot("node_listens_on_tcp_and_ws_test")
+	defer os.RemoveAll(config.RootDir)
+
+	tcpPort, err := cmn.GetFreePort()
+	require.NoError(t, err)
+	wsPort, err := cmn.GetFreePort()
+	require.NoError(t, err)
+	config.P2P.ListenAddress = fmt.Sprintf("tcp://127.0.0.1:%d", tcpPort)
+	config.P2P.WSListenAddress = fmt.Sprintf("ws://127.0.0.1:%d", wsPort)
+	config.RPC.ListenAddress = ""
+
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	require.NoError(t, n.Start())
+	defer n.Stop()
+
+	for _, port := range []int{tcpPort, wsPort} {
+		conn, err := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+		if assert.NoError(t, err, "expected a listener on port %d", port) {
+			conn.Close()
+		}
+	}
+}
+
 func TestNodeSetAppVersion(t *testing.T) {
 	config := cfg.ResetTestRoot("node_app_version_test")
 	defer os.RemoveAll(config.RootDir)
@@ -246,7 +271,7 @@ func TestCreateProposalBlock(t *testing.T) {
 	types.RegisterMockEvidencesGlobal() // XXX!
 	evidence.RegisterMockEvidences()
 	evidenceDB := dbm.NewMemDB()
-	evidencePool := evidence.NewEvidencePool(stateDB, evidenceDB)
+	evidencePool := evidence.NewEvidencePool(stateDB, evidenceDB, nil)
 	evidencePool.SetLogger(logger)
 
 	// fill the evidence pool with more evidence
@@ -0,0 +1,24 @@
+/*
+Package behaviour provides a mechanism for reactors to report the behaviour
+of peers.
+
+Instead of a reactor calling the switch directly, it creates a PeerBehaviour
+and reports it with a Reporter:
+
+	reporter.Report(behaviour.BadMessage(peerID, "Invalid block part"))
+
+The SwitchReporter feeds the behaviours to the trust metric of the peer in
+the Switch: useful behaviours (consensus votes, block parts) raise the trust
+score of the peer and bad ones lower it. Bad or out of order messages also
+disconnect from the peer. After a bad message, the peer is banned if its
+trust score is below the ban threshold (see `p2p.peer_ban_threshold`); peers
+are never banned for transport errors.
+
+A tx failing CheckTx is not a bad tx: it may have been valid when the peer
+checked it, or the peer may run with different mempool limits. BadTx is only
+meant for txs which can never be valid.
+
+The MockReporter records the behaviours in memory, so that reactor tests can
+check which behaviours were reported.
+*/
+package behaviour
@@ -0,0 +1,68 @@
+package behaviour_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/config"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/p2p"
+	bh "github.com/tendermint/tendermint/p2p/behaviour"
+	"github.com/tendermint/tendermint/p2p/trust"
+)
+
+// TestMockReporter tests the MockReporter's ability to store reported
+// peer behaviour in memory indexed by the peerID.
+func TestMockReporter(t *testing.T) {
+	var peerID p2p.ID = "MockPeer"
+	pr := bh.NewMockReporter()
+
+	behaviours := pr.GetBehaviours(peerID)
+	assert.Empty(t, behaviours, "expected no behaviours for %s", peerID)
+
+	badMessage := bh.BadMessage(peerID, "bad message")
+	pr.Report(badMessage)
+	behaviours = pr.GetBehaviours(peerID)
+	require.Len(t, behaviours, 1, "expected 1 behaviour for %s", peerID)
+	assert.Equal(t, badMessage, behaviours[0])
+	assert.Equal(t, peerID, behaviours[0].PeerID())
+}
+
+// TestSwitchReporter tests that the SwitchReporter scores the peers and
+// disconnects from and bans those which sent bad messages.
+func TestSwitchReporter(t *testing.T) {
+	cfg := config.DefaultP2PConfig()
+	switches := p2p.MakeConnectedSwitches(cfg, 2, func(i int, sw *p2p.Switch) *p2p.Switch {
+		if i == 0 {
+			tms := trust.NewTrustMetricStore(dbm.NewMemDB(), trust.DefaultConfig())
+			p2p.WithTrustMetricStore(tms)(sw)
+		}
+		return sw
+	}, p2p.Connect2Switches)
+	defer func() {
+		for _, sw := range switches {
+			sw.Stop()
+		}
+	}()
+
+	sw := switches[0]
+	pr := bh.NewSwitchReporter(sw)
+	peerID := switches[1].NodeInfo().ID()
+	require.NotNil(t, sw.Peers().Get(peerID))
+
+	err := pr.Report(bh.ConsensusVote("unknown", "vote"))
+	assert.Error(t, err, "expected an error for an unknown peer")
+
+	require.NoError(t, pr.Report(bh.ConsensusVote(peerID, "vote")))
+	assert.Equal(t, 100, sw.PeerTrustScore(peerID))
+
+	require.NoError(t, pr.Report(bh.BadTx(peerID, "bad tx")))
+	assert.True(t, sw.PeerTrustScore(peerID) < 100, "expected a lower trust score")
+	assert.NotNil(t, sw.Peers().Get(peerID), "expected the peer to stay connected")
+
+	require.NoError(t, pr.Report(bh.BadMessage(peerID, "bad message")))
+	assert.Nil(t, sw.Peers().Get(peerID), "expected the peer to be disconnected")
+	assert.True(t, sw.IsPeerBanned(peerID), "expected the peer to be banned")
+}
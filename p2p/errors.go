@@ -20,6 +20,7 @@ type ErrRejected struct {
 	err               error
 	id                ID
 	isAuthFailure     bool
+	isBanned          bool
 	isDuplicate       bool
 	isFiltered        bool
 	isIncompatible    bool
@@ -37,6 +38,10 @@ func (e ErrRejected) Error() string {
 		return fmt.Sprintf("auth failure: %s", e.err)
 	}
 
+	if e.isBanned {
+		return fmt.Sprintf("banned ID<%v>", e.id)
+	}
+
 	if e.isDuplicate {
 		if e.conn != nil {
 			return fmt.Sprintf(
@@ -81,6 +86,9 @@ func (e ErrRejected) Error() string {
 // IsAuthFailure when Peer authentication was unsuccessful.
 func (e ErrRejected) IsAuthFailure() bool { return e.isAuthFailure }
 
+// IsBanned when Peer ID is banned for its bad behaviour.
+func (e ErrRejected) IsBanned() bool { return e.isBanned }
+
 // IsDuplicate when Peer ID or IP are present already.
 func (e ErrRejected) IsDuplicate() bool { return e.isDuplicate }
 
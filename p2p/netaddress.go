@@ -18,13 +18,25 @@ import (
 	cmn "github.com/tendermint/tendermint/libs/common"
 )
 
+const (
+	// ProtocolTCP is the protocol of the addresses dialed by the
+	// MultiplexTransport. It is the default one.
+	ProtocolTCP = "tcp"
+	// ProtocolWS is the protocol of the addresses dialed by the WSTransport.
+	ProtocolWS = "ws"
+)
+
 // NetAddress defines information about a peer on the network
-// including its ID, IP address, and port.
+// including its ID, IP address, port and protocol.
 type NetAddress struct {
 	ID   ID     `json:"id"`
 	IP   net.IP `json:"ip"`
 	Port uint16 `json:"port"`
 
+	// Protocol selects the transport used to dial the address. It is empty
+	// for tcp addresses.
+	Protocol string `json:"protocol,omitempty"`
+
 	// TODO:
 	// Name string `json:"name"` // optional DNS name
 
@@ -32,11 +44,15 @@ type NetAddress struct {
 	str string
 }
 
-// IDAddressString returns id@hostPort. It strips the leading
-// protocol from protocolHostPort if it exists.
+// IDAddressString returns id@hostPort. It strips the leading tcp
+// protocol from protocolHostPort if it exists, but keeps the other ones,
+// as in ws://id@hostPort.
 func IDAddressString(id ID, protocolHostPort string) string {
-	hostPort := removeProtocolIfDefined(protocolHostPort)
-	return fmt.Sprintf("%s@%s", id, hostPort)
+	protocol, hostPort := splitProtocol(protocolHostPort)
+	if protocol == "" {
+		return fmt.Sprintf("%s@%s", id, hostPort)
+	}
+	return fmt.Sprintf("%s://%s@%s", protocol, id, hostPort)
 }
 
 // NewNetAddress returns a new NetAddress using the provided TCP
@@ -45,6 +61,11 @@ func IDAddressString(id ID, protocolHostPort string) string {
 // panic.
 // TODO: socks proxies?
 func NewNetAddress(id ID, addr net.Addr) *NetAddress {
+	if wsAddr, ok := addr.(wsAddr); ok {
+		na := NewNetAddress(id, wsAddr.Addr)
+		na.Protocol = ProtocolWS
+		return na
+	}
 	tcpAddr, ok := addr.(*net.TCPAddr)
 	if !ok {
 		if flag.Lookup("test.v") == nil { // normal run
@@ -63,7 +84,8 @@ func NewNetAddress(id ID, addr net.Addr) *NetAddress {
 }
 
 // NewNetAddressString returns a new NetAddress using the provided address in
-// the form of "ID@IP:Port".
+// the form of "ID@IP:Port", optionally prefixed by a protocol as in
+// "ws://ID@IP:Port".
 // Also resolves the host if host is not an IP.
 // Errors are of type ErrNetAddressXxx where Xxx is in (NoID, Invalid, Lookup)
 func NewNetAddressString(addr string) (*NetAddress, error) {
@@ -78,7 +100,12 @@ func NewNetAddressString(addr string) (*NetAddress, error) {
 // provided address in the form of "ID@IP:Port", where the ID is optional.
 // Also resolves the host if host is not an IP.
 func NewNetAddressStringWithOptionalID(addr string) (*NetAddress, error) {
-	addrWithoutProtocol := removeProtocolIfDefined(addr)
+	protocol, addrWithoutProtocol := splitProtocol(addr)
+	if protocol != "" && protocol != ProtocolWS {
+		return nil, ErrNetAddressInvalid{
+			addr,
+			fmt.Errorf("unsupported protocol %q", protocol)}
+	}
 
 	var id ID
 	spl := strings.Split(addrWithoutProtocol, "@")
@@ -123,6 +150,7 @@ func NewNetAddressStringWithOptionalID(addr string) (*NetAddress, error) {
 
 	na := NewNetAddressIPPort(ip, uint16(port))
 	na.ID = id
+	na.Protocol = protocol
 	return na, nil
 }
 
@@ -173,13 +201,17 @@ func (na *NetAddress) Same(other interface{}) bool {
 	return false
 }
 
-// String representation: <ID>@<IP>:<PORT>
+// String representation: <ID>@<IP>:<PORT>, prefixed by the protocol if it is
+// not tcp, as in ws://<ID>@<IP>:<PORT>.
 func (na *NetAddress) String() string {
 	if na == nil {
 		return "<nil-NetAddress>"
 	}
 	if na.str == "" {
 		addrStr := na.DialString()
+		if na.Protocol != "" {
+			addrStr = na.Protocol + "://" + addrStr
+		}
 		if na.ID != "" {
 			addrStr = IDAddressString(na.ID, addrStr)
 		}
@@ -333,10 +365,16 @@ func (na *NetAddress) RFC4862() bool { return rfc4862.Contains(na.IP) }
 func (na *NetAddress) RFC6052() bool { return rfc6052.Contains(na.IP) }
 func (na *NetAddress) RFC6145() bool { return rfc6145.Contains(na.IP) }
 
-func removeProtocolIfDefined(addr string) string {
+// splitProtocol splits the leading protocol from addr if it exists. The tcp
+// protocol, which is the default one, is returned as empty.
+func splitProtocol(addr string) (protocol, addrWithoutProtocol string) {
 	if strings.Contains(addr, "://") {
-		return strings.Split(addr, "://")[1]
+		spl := strings.SplitN(addr, "://", 2)
+		protocol, addrWithoutProtocol = spl[0], spl[1]
+		if protocol == ProtocolTCP {
+			protocol = ""
+		}
+		return protocol, addrWithoutProtocol
 	}
-	return addr
-
+	return "", addr
 }
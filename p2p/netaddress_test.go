@@ -18,6 +18,10 @@ func TestNewNetAddress(t *testing.T) {
 	assert.NotPanics(t, func() {
 		NewNetAddress("", &net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: 8000})
 	}, "Calling NewNetAddress with UDPAddr should not panic in testing")
+
+	addr = NewNetAddress("", wsAddr{tcpAddr})
+	assert.Equal(t, ProtocolWS, addr.Protocol)
+	assert.Equal(t, "ws://127.0.0.1:8080", addr.String())
 }
 
 func TestNewNetAddressStringWithOptionalID(t *testing.T) {
@@ -29,7 +33,8 @@ func TestNewNetAddressStringWithOptionalID(t *testing.T) {
 	}{
 		{"no node id, no protocol", "127.0.0.1:8080", "127.0.0.1:8080", true},
 		{"no node id, tcp input", "tcp://127.0.0.1:8080", "127.0.0.1:8080", true},
-		{"no node id, udp input", "udp://127.0.0.1:8080", "127.0.0.1:8080", true},
+		{"no node id, ws input", "ws://127.0.0.1:8080", "ws://127.0.0.1:8080", true},
+		{"no node id, unsupported udp input", "udp://127.0.0.1:8080", "", false},
 		{"malformed udp input", "udp//127.0.0.1:8080", "", false},
 		// {"127.0.0:8080", false},
 		{"invalid host", "notahost", "", false},
@@ -47,6 +52,7 @@ func TestNewNetAddressStringWithOptionalID(t *testing.T) {
 		{"too short notHex nodeId w/tcp", "tcp://this-isnot-hex@127.0.0.1:8080", "", false},
 		{"notHex nodeId w/tcp", "tcp://xxxxbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080", "", false},
 		{"correct nodeId w/tcp", "tcp://deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080", "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080", true},
+		{"correct nodeId w/ws", "ws://deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080", "ws://deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080", true},
 
 		{"no node id when expected", "tcp://@127.0.0.1:8080", "", false},
 		{"no node id or IP", "tcp://@", "", false},
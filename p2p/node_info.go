@@ -96,6 +96,8 @@ type DefaultNodeInfo struct {
 type DefaultNodeInfoOther struct {
 	TxIndex    string `json:"tx_index"`
 	RPCAddress string `json:"rpc_address"`
+	// mempool groups the node accepts txs of, empty for older nodes
+	MempoolGroups []int32 `json:"mempool_groups"`
 }
 
 // ID returns the node's peer ID.
@@ -166,6 +168,11 @@ func (info DefaultNodeInfo) Validate() error {
 	if len(rpcAddr) > 0 && (!cmn.IsASCIIText(rpcAddr) || cmn.ASCIITrim(rpcAddr) == "") {
 		return fmt.Errorf("info.Other.RPCAddress=%v must be valid ASCII text without tabs", rpcAddr)
 	}
+	for _, group := range other.MempoolGroups {
+		if group < 0 {
+			return fmt.Errorf("info.Other.MempoolGroups contains negative group %v", group)
+		}
+	}
 
 	return nil
 }
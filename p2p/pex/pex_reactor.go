@@ -413,30 +413,42 @@ func (r *PEXReactor) ensurePeers() {
 	// NOTE: range here is [10, 90]. Too high ?
 	newBias := cmn.MinInt(out, 8)*10 + 10
 
-	toDial := make(map[p2p.ID]*p2p.NetAddress)
-	// Try maxAttempts times to pick numToDial addresses to dial
+	var (
+		picked = make(map[p2p.ID]struct{})
+		toDial []*p2p.NetAddress
+	)
+	// Try maxAttempts times to pick addresses to dial
 	maxAttempts := numToDial * 3
 
-	for i := 0; i < maxAttempts && len(toDial) < numToDial; i++ {
+	for i := 0; i < maxAttempts; i++ {
 		try := r.book.PickAddress(newBias)
 		if try == nil {
 			continue
 		}
-		if _, selected := toDial[try.ID]; selected {
+		if _, selected := picked[try.ID]; selected {
 			continue
 		}
-		if r.Switch.IsDialingOrExistingAddress(try) {
+		if r.Switch.IsDialingOrExistingAddress(try) || r.Switch.IsPeerBanned(try.ID) {
 			continue
 		}
 		// TODO: consider moving some checks from toDial into here
 		// so we don't even consider dialing peers that we want to wait
 		// before dialling again, or have dialed too many times already
-		r.Logger.Info("Will dial address", "addr", try)
-		toDial[try.ID] = try
+		picked[try.ID] = struct{}{}
+		toDial = append(toDial, try)
+	}
+
+	// Prefer the most trusted of the picked addresses
+	sort.SliceStable(toDial, func(i, j int) bool {
+		return r.Switch.PeerTrustScore(toDial[i].ID) > r.Switch.PeerTrustScore(toDial[j].ID)
+	})
+	if len(toDial) > numToDial {
+		toDial = toDial[:numToDial]
 	}
 
 	// Dial picked addresses
 	for _, addr := range toDial {
+		r.Logger.Info("Will dial address", "addr", addr)
 		go r.dialPeer(addr)
 	}
 
@@ -9,6 +9,7 @@ import (
 	"github.com/tendermint/tendermint/config"
 	cmn "github.com/tendermint/tendermint/libs/common"
 	"github.com/tendermint/tendermint/p2p/conn"
+	"github.com/tendermint/tendermint/p2p/trust"
 )
 
 const (
@@ -25,6 +26,9 @@ const (
 	// ie. 3**10 = 16hrs
 	reconnectBackOffAttempts    = 10
 	reconnectBackOffBaseSeconds = 3
+
+	// the ban of a peer doubles each time, up to this duration
+	maxPeerBanDuration = 24 * time.Hour
 )
 
 // MConnConfig returns an MConnConfig with fields updated
@@ -76,16 +80,28 @@ type Switch struct {
 	nodeKey      *NodeKey // our node privkey
 	addrBook     AddrBook
 
-	transport Transport
+	transport  Transport
+	transports map[string]Transport // by protocol, besides the default one
 
 	filterTimeout time.Duration
 	peerFilters   []PeerFilterFunc
 
 	rng *cmn.Rand // seed for randomizing dial times and orders
 
+	// trust metrics of the peers, fed by their good and bad behaviour
+	trustStore *trust.TrustMetricStore
+	banMtx     sync.Mutex
+	bans       map[ID]*peerBan
+
 	metrics *Metrics
 }
 
+// peerBan is the current or last ban of a peer.
+type peerBan struct {
+	until time.Time
+	count int // number of bans so far
+}
+
 // SwitchOption sets an optional parameter on the Switch.
 type SwitchOption func(*Switch)
 
@@ -103,8 +119,10 @@ func NewSwitch(
 		peers:         NewPeerSet(),
 		dialing:       cmn.NewCMap(),
 		reconnecting:  cmn.NewCMap(),
+		bans:          make(map[ID]*peerBan),
 		metrics:       NopMetrics(),
 		transport:     transport,
+		transports:    make(map[string]Transport),
 		filterTimeout: defaultFilterTimeout,
 	}
 
@@ -135,6 +153,19 @@ func WithMetrics(metrics *Metrics) SwitchOption {
 	return func(sw *Switch) { sw.metrics = metrics }
 }
 
+// SwitchTransport sets the transport used to dial and accept the peers of
+// the given protocol, like ProtocolWS, besides the default transport.
+func SwitchTransport(protocol string, transport Transport) SwitchOption {
+	return func(sw *Switch) { sw.transports[protocol] = transport }
+}
+
+// WithTrustMetricStore sets the store of the trust metrics of the peers,
+// which is started and stopped with the switch. Without it, the peers are
+// not scored nor banned.
+func WithTrustMetricStore(tms *trust.TrustMetricStore) SwitchOption {
+	return func(sw *Switch) { sw.trustStore = tms }
+}
+
 //---------------------------------------------------------------------
 // Switch setup
 
@@ -192,6 +223,12 @@ func (sw *Switch) SetNodeKey(nodeKey *NodeKey) {
 
 // OnStart implements BaseService. It starts all the reactors and peers.
 func (sw *Switch) OnStart() error {
+	if sw.trustStore != nil {
+		if err := sw.trustStore.Start(); err != nil {
+			return cmn.ErrorWrap(err, "failed to start trust metric store")
+		}
+	}
+
 	// Start reactors
 	for _, reactor := range sw.reactors {
 		err := reactor.Start()
@@ -201,7 +238,10 @@ func (sw *Switch) OnStart() error {
 	}
 
 	// Start accepting Peers.
-	go sw.acceptRoutine()
+	go sw.acceptRoutine(sw.transport)
+	for _, transport := range sw.transports {
+		go sw.acceptRoutine(transport)
+	}
 
 	return nil
 }
@@ -210,7 +250,7 @@ func (sw *Switch) OnStart() error {
 func (sw *Switch) OnStop() {
 	// Stop peers
 	for _, p := range sw.peers.List() {
-		sw.transport.Cleanup(p)
+		sw.cleanupPeer(p)
 		p.Stop()
 		if sw.peers.Remove(p) {
 			sw.metrics.Peers.Add(float64(-1))
@@ -222,6 +262,10 @@ func (sw *Switch) OnStop() {
 	for _, reactor := range sw.reactors {
 		reactor.Stop()
 	}
+
+	if sw.trustStore != nil {
+		sw.trustStore.Stop()
+	}
 }
 
 //---------------------------------------------------------------------
@@ -278,7 +322,6 @@ func (sw *Switch) Peers() IPeerSet {
 
 // StopPeerForError disconnects from a peer due to external error.
 // If the peer is persistent, it will attempt to reconnect.
-// TODO: make record depending on reason.
 func (sw *Switch) StopPeerForError(peer Peer, reason interface{}) {
 	sw.Logger.Error("Stopping peer for error", "peer", peer, "err", reason)
 	sw.stopAndRemovePeer(peer, reason)
@@ -294,6 +337,18 @@ func (sw *Switch) StopPeerForError(peer Peer, reason interface{}) {
 	}
 }
 
+// StopPeerForBadMessage disconnects from a peer which sent a bad message,
+// like StopPeerForError. A peer which is not persistent is also banned if its
+// trust score is below the ban threshold. Transport errors must go through
+// StopPeerForError instead, as they say nothing about the peer's honesty.
+func (sw *Switch) StopPeerForBadMessage(peer Peer, reason interface{}) {
+	sw.StopPeerForError(peer, reason)
+
+	if !peer.IsPersistent() {
+		sw.banPeerIfUntrusted(peer)
+	}
+}
+
 // StopPeerGracefully disconnects from a peer gracefully.
 // TODO: handle graceful disconnects.
 func (sw *Switch) StopPeerGracefully(peer Peer) {
@@ -305,8 +360,11 @@ func (sw *Switch) stopAndRemovePeer(peer Peer, reason interface{}) {
 	if sw.peers.Remove(peer) {
 		sw.metrics.Peers.Add(float64(-1))
 	}
-	sw.transport.Cleanup(peer)
+	sw.cleanupPeer(peer)
 	peer.Stop()
+	if sw.trustStore != nil {
+		sw.trustStore.PeerDisconnected(string(peer.ID()))
+	}
 	for _, reactor := range sw.reactors {
 		reactor.RemovePeer(peer, reason)
 	}
@@ -380,6 +438,71 @@ func (sw *Switch) MarkPeerAsGood(peer Peer) {
 	if sw.addrBook != nil {
 		sw.addrBook.MarkGood(peer.NodeInfo().NetAddress())
 	}
+	if sw.trustStore != nil {
+		sw.trustStore.GetPeerTrustMetric(string(peer.ID())).GoodEvents(1)
+	}
+}
+
+// MarkPeerAsBad lowers the trust score of the given peer when it did
+// something undesirable, like sending an invalid tx. Unlike StopPeerForError,
+// it does not disconnect from the peer.
+func (sw *Switch) MarkPeerAsBad(peer Peer) {
+	if sw.trustStore != nil {
+		sw.trustStore.GetPeerTrustMetric(string(peer.ID())).BadEvents(1)
+	}
+}
+
+// PeerTrustScore returns the trust score, between 0 and 100, of the peer with
+// the given ID. Peers we know nothing about have a score of 100.
+func (sw *Switch) PeerTrustScore(id ID) int {
+	if sw.trustStore == nil {
+		return 100
+	}
+	tm, ok := sw.trustStore.LookupPeerTrustMetric(string(id))
+	if !ok {
+		return 100
+	}
+	return tm.TrustScore()
+}
+
+// IsPeerBanned returns true if the peer with the given ID is banned.
+func (sw *Switch) IsPeerBanned(id ID) bool {
+	sw.banMtx.Lock()
+	defer sw.banMtx.Unlock()
+	ban, ok := sw.bans[id]
+	return ok && time.Now().Before(ban.until)
+}
+
+// banPeerIfUntrusted bans the peer if its trust score is below the ban
+// threshold. Each ban lasts twice as long as the previous one.
+func (sw *Switch) banPeerIfUntrusted(peer Peer) {
+	if sw.trustStore == nil || sw.config.PeerBanThreshold <= 0 {
+		return
+	}
+	score := sw.PeerTrustScore(peer.ID())
+	if score >= sw.config.PeerBanThreshold {
+		return
+	}
+
+	sw.banMtx.Lock()
+	ban, ok := sw.bans[peer.ID()]
+	if !ok {
+		ban = &peerBan{}
+		sw.bans[peer.ID()] = ban
+	}
+	duration := sw.config.PeerBanDuration
+	for i := 0; i < ban.count && duration < maxPeerBanDuration; i++ {
+		duration *= 2
+	}
+	if duration > maxPeerBanDuration {
+		duration = maxPeerBanDuration
+	}
+	ban.count++
+	ban.until = time.Now().Add(duration)
+	sw.banMtx.Unlock()
+
+	sw.Logger.Info("Banned peer", "peer", peer, "score", score, "duration", duration)
+	sw.metrics.PeerBans.Add(1)
 }
 
 //---------------------------------------------------------------------
@@ -452,6 +575,9 @@ func (sw *Switch) DialPeersAsync(addrBook AddrBook, peers []string, persistent b
 // DialPeerWithAddress dials the given peer and runs sw.addPeer if it connects and authenticates successfully.
 // If `persistent == true`, the switch will always try to reconnect to this peer if the connection ever fails.
 func (sw *Switch) DialPeerWithAddress(addr *NetAddress, persistent bool) error {
+	if sw.IsPeerBanned(addr.ID) {
+		return ErrRejected{id: addr.ID, isBanned: true}
+	}
 	sw.dialing.Set(string(addr.ID), addr)
 	defer sw.dialing.Delete(string(addr.ID))
 	return sw.addOutboundPeerWithConfig(addr, sw.config, persistent)
@@ -471,9 +597,27 @@ func (sw *Switch) IsDialingOrExistingAddress(addr *NetAddress) bool {
 		(!sw.config.AllowDuplicateIP && sw.peers.HasIP(addr.IP))
 }
 
-func (sw *Switch) acceptRoutine() {
+// transportFor returns the transport of the given protocol.
+func (sw *Switch) transportFor(protocol string) Transport {
+	if transport, ok := sw.transports[protocol]; ok {
+		return transport
+	}
+	return sw.transport
+}
+
+// cleanupPeer cleans the peer up with the transport it came from, which is
+// told by the network of its connection.
+func (sw *Switch) cleanupPeer(p Peer) {
+	protocol := ""
+	if addr := p.RemoteAddr(); addr != nil {
+		protocol = addr.Network()
+	}
+	sw.transportFor(protocol).Cleanup(p)
+}
+
+func (sw *Switch) acceptRoutine(transport Transport) {
 	for {
-		p, err := sw.transport.Accept(peerConfig{
+		p, err := transport.Accept(peerConfig{
 			chDescs:      sw.chDescs,
 			onPeerError:  sw.StopPeerForError,
 			reactorsByCh: sw.reactorsByCh,
@@ -529,13 +673,13 @@ func (sw *Switch) acceptRoutine() {
 				"max", sw.config.MaxNumInboundPeers,
 			)
 
-			sw.transport.Cleanup(p)
+			transport.Cleanup(p)
 
 			continue
 		}
 
 		if err := sw.addPeer(p); err != nil {
-			sw.transport.Cleanup(p)
+			transport.Cleanup(p)
 			if p.IsRunning() {
 				_ = p.Stop()
 			}
@@ -566,7 +710,8 @@ func (sw *Switch) addOutboundPeerWithConfig(
 		return fmt.Errorf("dial err (peerConfig.DialFail == true)")
 	}
 
-	p, err := sw.transport.Dial(*addr, peerConfig{
+	transport := sw.transportFor(addr.Protocol)
+	p, err := transport.Dial(*addr, peerConfig{
 		chDescs:      sw.chDescs,
 		onPeerError:  sw.StopPeerForError,
 		persistent:   persistent,
@@ -596,7 +741,7 @@ func (sw *Switch) addOutboundPeerWithConfig(
 	}
 
 	if err := sw.addPeer(p); err != nil {
-		sw.transport.Cleanup(p)
+		transport.Cleanup(p)
 		if p.IsRunning() {
 			_ = p.Stop()
 		}
@@ -612,6 +757,10 @@ func (sw *Switch) filterPeer(p Peer) error {
 		return ErrRejected{id: p.ID(), isDuplicate: true}
 	}
 
+	if sw.IsPeerBanned(p.ID()) {
+		return ErrRejected{id: p.ID(), isBanned: true}
+	}
+
 	errc := make(chan error, len(sw.peerFilters))
 
 	for _, f := range sw.peerFilters {
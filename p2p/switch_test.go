@@ -14,14 +14,16 @@ import (
 	"testing"
 	"time"
 
-	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/crypto/ed25519"
+	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/p2p/conn"
+	"github.com/tendermint/tendermint/p2p/trust"
 )
 
 var (
@@ -344,7 +346,7 @@ func TestSwitchStopsNonPersistentPeerOnError(t *testing.T) {
 }
 
 func TestSwitchStopPeerForError(t *testing.T) {
-	s := httptest.NewServer(stdprometheus.UninstrumentedHandler())
+	s := httptest.NewServer(promhttp.Handler())
 	defer s.Close()
 
 	scrapeMetrics := func() string {
@@ -391,6 +393,107 @@ func TestSwitchStopPeerForError(t *testing.T) {
 	assert.EqualValues(t, 0, peersMetricValue())
 }
 
+func TestSwitchBansUntrustedPeer(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+
+	tms := trust.NewTrustMetricStore(dbm.NewMemDB(), trust.DefaultConfig())
+	sw := MakeSwitch(cfg, 1, "testing", "123.123.123", initSwitchFunc, WithTrustMetricStore(tms))
+	err := sw.Start()
+	require.Nil(err)
+	defer sw.Stop()
+
+	// simulate remote peer
+	rp := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: cfg}
+	rp.Start()
+	defer rp.Stop()
+
+	err = sw.DialPeerWithAddress(rp.Addr(), false)
+	require.Nil(err)
+	p := sw.Peers().Get(rp.ID())
+	require.NotNil(p)
+	assert.Equal(100, sw.PeerTrustScore(rp.ID()))
+
+	// a trusted peer is not banned
+	sw.MarkPeerAsGood(p)
+	sw.StopPeerForError(p, "some err")
+	assert.False(sw.IsPeerBanned(rp.ID()))
+
+	err = sw.DialPeerWithAddress(rp.Addr(), false)
+	require.Nil(err)
+	p = sw.Peers().Get(rp.ID())
+	require.NotNil(p)
+
+	// nor is an untrusted one stopped for a transport error
+	for i := 0; i < 10; i++ {
+		sw.MarkPeerAsBad(p)
+	}
+	require.True(sw.PeerTrustScore(rp.ID()) < cfg.PeerBanThreshold)
+	sw.StopPeerForError(p, "some err")
+	assert.False(sw.IsPeerBanned(rp.ID()))
+
+	err = sw.DialPeerWithAddress(rp.Addr(), false)
+	require.Nil(err)
+	p = sw.Peers().Get(rp.ID())
+	require.NotNil(p)
+
+	// but an untrusted one which sent a bad message is
+	sw.StopPeerForBadMessage(p, "bad message")
+	assert.True(sw.IsPeerBanned(rp.ID()))
+	assert.Nil(sw.Peers().Get(rp.ID()))
+
+	err = sw.DialPeerWithAddress(rp.Addr(), false)
+	if assert.Error(err) {
+		assert.True(err.(ErrRejected).IsBanned())
+	}
+
+	// the next ban lasts twice as long
+	firstUntil := sw.bans[rp.ID()].until
+	sw.banPeerIfUntrusted(p)
+	assert.Equal(2, sw.bans[rp.ID()].count)
+	assert.True(sw.bans[rp.ID()].until.Sub(firstUntil) >= cfg.PeerBanDuration)
+}
+
+func TestSwitchDialsPeerWithTransportOfProtocol(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+
+	makeSwitch := func(i int) (*Switch, *WSTransport) {
+		nodeKey := NodeKey{PrivKey: ed25519.GenPrivKey()}
+		nodeInfo := testNodeInfo(nodeKey.ID(), fmt.Sprintf("node%d", i))
+		wt := NewWSTransport(nodeInfo, nodeKey, MConnConfig(cfg))
+		sw := NewSwitch(cfg, newMultiplexTransport(nodeInfo, nodeKey), SwitchTransport(ProtocolWS, wt))
+		sw = initSwitchFunc(i, sw)
+		sw.SetLogger(log.TestingLogger().With("switch", i))
+		sw.SetNodeKey(&nodeKey)
+		sw.SetNodeInfo(nodeInfo)
+		return sw, wt
+	}
+	sw1, _ := makeSwitch(0)
+	sw2, wt := makeSwitch(1)
+
+	// sw2 only listens for WebSocket connections
+	addr, err := NewNetAddressStringWithOptionalID("ws://127.0.0.1:0")
+	require.Nil(err)
+	require.Nil(wt.Listen(*addr))
+	defer wt.Close()
+
+	require.Nil(sw1.Start())
+	defer sw1.Stop()
+	require.Nil(sw2.Start())
+	defer sw2.Stop()
+
+	wsAddr := NewNetAddress(sw2.NodeInfo().ID(), wt.listener.Addr())
+	require.Nil(sw1.DialPeerWithAddress(wsAddr, false))
+
+	p := sw1.Peers().Get(sw2.NodeInfo().ID())
+	require.NotNil(p)
+	assert.Equal(ProtocolWS, p.RemoteAddr().Network())
+	assert.True(p.IsOutbound())
+
+	// the peer is cleaned up by the transport it came from
+	sw1.StopPeerForError(p, "some err")
+	assert.Nil(sw1.Peers().Get(sw2.NodeInfo().ID()))
+}
+
 func TestSwitchReconnectsToPersistentPeer(t *testing.T) {
 	assert, require := assert.New(t), require.New(t)
 
@@ -189,6 +189,15 @@ func (mt *MultiplexTransport) Dial(
 		return nil, err
 	}
 
+	return mt.dialPeer(c, addr, cfg)
+}
+
+// dialPeer filters and upgrades the dialed connection to a peer.
+func (mt *MultiplexTransport) dialPeer(
+	c net.Conn,
+	addr NetAddress,
+	cfg peerConfig,
+) (Peer, error) {
 	// TODO(xla): Evaluate if we should apply filters if we explicitly dial.
 	if err := mt.filterConn(c); err != nil {
 		return nil, err
@@ -224,11 +233,16 @@ func (mt *MultiplexTransport) Listen(addr NetAddress) error {
 		return err
 	}
 
+	mt.listen(ln)
+
+	return nil
+}
+
+// listen accepts the connections of the listener and upgrades them to peers.
+func (mt *MultiplexTransport) listen(ln net.Listener) {
 	mt.listener = ln
 
 	go mt.acceptPeers()
-
-	return nil
 }
 
 func (mt *MultiplexTransport) acceptPeers() {
@@ -0,0 +1,247 @@
+package p2p
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/tendermint/tendermint/p2p/conn"
+)
+
+// wsPath is the HTTP path on which the WSTransport accepts connections.
+const wsPath = "/p2p"
+
+// WSTransport accepts and dials WebSocket connections and upgrades them to
+// multiplexed peers, for nodes which can only reach each other through HTTP
+// proxies. Over the WebSocket connection, peers authenticate with a
+// SecretConnection and exchange their NodeInfo as with the MultiplexTransport,
+// whose filters and options apply to the embedded MultiplexTransport.
+type WSTransport struct {
+	*MultiplexTransport
+}
+
+// Test wsTransport for interface completeness.
+var _ Transport = (*WSTransport)(nil)
+var _ transportLifecycle = (*WSTransport)(nil)
+
+// NewWSTransport returns a WebSocket connected multiplexed peer.
+func NewWSTransport(
+	nodeInfo NodeInfo,
+	nodeKey NodeKey,
+	mConfig conn.MConnConfig,
+) *WSTransport {
+	return &WSTransport{
+		MultiplexTransport: NewMultiplexTransport(nodeInfo, nodeKey, mConfig),
+	}
+}
+
+// Dial implements Transport. The connection goes through the proxy set by the
+// HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables, if any.
+func (wt *WSTransport) Dial(
+	addr NetAddress,
+	cfg peerConfig,
+) (Peer, error) {
+	dialer := websocket.Dialer{
+		NetDial: func(network, address string) (net.Conn, error) {
+			return net.DialTimeout(network, address, wt.dialTimeout)
+		},
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: wt.handshakeTimeout,
+	}
+	u := url.URL{Scheme: ProtocolWS, Host: addr.DialString(), Path: wsPath}
+
+	ws, _, err := dialer.Dial(u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return wt.dialPeer(newWSConn(ws), addr, cfg)
+}
+
+// Listen implements transportLifecycle.
+func (wt *WSTransport) Listen(addr NetAddress) error {
+	ln, err := net.Listen("tcp", addr.DialString())
+	if err != nil {
+		return err
+	}
+
+	wt.listen(newWSListener(ln, wt.handshakeTimeout))
+
+	return nil
+}
+
+// wsListener is a net.Listener which accepts WebSocket connections on wsPath.
+type wsListener struct {
+	ln  net.Listener
+	srv *http.Server
+
+	connc     chan net.Conn
+	closec    chan struct{}
+	closeOnce sync.Once
+}
+
+var _ net.Listener = (*wsListener)(nil)
+
+func newWSListener(ln net.Listener, handshakeTimeout time.Duration) *wsListener {
+	wl := &wsListener{
+		ln:     ln,
+		connc:  make(chan net.Conn),
+		closec: make(chan struct{}),
+	}
+
+	upgrader := websocket.Upgrader{
+		HandshakeTimeout: handshakeTimeout,
+		// Peers are authenticated by the SecretConnection, whatever the
+		// origin of the request.
+		CheckOrigin: func(*http.Request) bool { return true },
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(wsPath, func(w http.ResponseWriter, r *http.Request) {
+		ws, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// The upgrader already replied with an HTTP error.
+			return
+		}
+
+		select {
+		case wl.connc <- newWSConn(ws):
+		case <-wl.closec:
+			_ = ws.Close()
+		}
+	})
+
+	wl.srv = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: handshakeTimeout,
+	}
+	go wl.srv.Serve(ln) // nolint: errcheck
+
+	return wl
+}
+
+// Accept implements net.Listener.
+func (wl *wsListener) Accept() (net.Conn, error) {
+	select {
+	case c := <-wl.connc:
+		return c, nil
+	case <-wl.closec:
+		return nil, errors.New("websocket listener closed")
+	}
+}
+
+// Close implements net.Listener.
+func (wl *wsListener) Close() error {
+	var err error
+	wl.closeOnce.Do(func() {
+		close(wl.closec)
+		err = wl.srv.Close()
+	})
+	return err
+}
+
+// Addr implements net.Listener.
+func (wl *wsListener) Addr() net.Addr {
+	return wsAddr{wl.ln.Addr()}
+}
+
+// wsAddr is the address of a WebSocket connection. Its network tells the
+// Switch which transport the connection belongs to.
+type wsAddr struct {
+	net.Addr // of the underlying tcp connection
+}
+
+// Network implements net.Addr.
+func (wsAddr) Network() string {
+	return ProtocolWS
+}
+
+// wsConn is a net.Conn which writes binary WebSocket messages and reads the
+// received ones as a stream.
+type wsConn struct {
+	ws *websocket.Conn
+
+	r    io.Reader // of the message being read
+	wmtx sync.Mutex
+}
+
+var _ net.Conn = (*wsConn)(nil)
+
+func newWSConn(ws *websocket.Conn) *wsConn {
+	return &wsConn{ws: ws}
+}
+
+// Read implements net.Conn.
+func (c *wsConn) Read(b []byte) (int, error) {
+	for {
+		if c.r == nil {
+			typ, r, err := c.ws.NextReader()
+			if err != nil {
+				return 0, err
+			}
+			if typ != websocket.BinaryMessage {
+				continue
+			}
+			c.r = r
+		}
+
+		n, err := c.r.Read(b)
+		if err == io.EOF {
+			c.r = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+// Write implements net.Conn. Each write is sent as one message.
+func (c *wsConn) Write(b []byte) (int, error) {
+	c.wmtx.Lock()
+	defer c.wmtx.Unlock()
+
+	if err := c.ws.WriteMessage(websocket.BinaryMessage, b); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+// Close implements net.Conn.
+func (c *wsConn) Close() error {
+	return c.ws.Close()
+}
+
+// LocalAddr implements net.Conn.
+func (c *wsConn) LocalAddr() net.Addr {
+	return wsAddr{c.ws.LocalAddr()}
+}
+
+// RemoteAddr implements net.Conn.
+func (c *wsConn) RemoteAddr() net.Addr {
+	return wsAddr{c.ws.RemoteAddr()}
+}
+
+// SetDeadline implements net.Conn.
+func (c *wsConn) SetDeadline(t time.Time) error {
+	if err := c.ws.SetReadDeadline(t); err != nil {
+		return err
+	}
+	return c.ws.SetWriteDeadline(t)
+}
+
+// SetReadDeadline implements net.Conn.
+func (c *wsConn) SetReadDeadline(t time.Time) error {
+	return c.ws.SetReadDeadline(t)
+}
+
+// SetWriteDeadline implements net.Conn.
+func (c *wsConn) SetWriteDeadline(t time.Time) error {
+	return c.ws.SetWriteDeadline(t)
+}
@@ -0,0 +1,141 @@
+package p2p
+
+import (
+	"testing"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/p2p/conn"
+)
+
+func TestTransportWSDialAccept(t *testing.T) {
+	wt := testSetupWSTransport(t)
+	defer wt.Close()
+
+	var (
+		pv     = ed25519.GenPrivKey()
+		id     = PubKeyToID(pv.PubKey())
+		dialer = NewWSTransport(
+			testNodeInfo(id, defaultNodeName),
+			NodeKey{
+				PrivKey: pv,
+			},
+			conn.DefaultMConnConfig(),
+		)
+		errc = make(chan error)
+	)
+
+	go func() {
+		addr := testWSTransportAddr(t, wt)
+
+		p, err := dialer.Dial(*addr, peerConfig{})
+		if err != nil {
+			errc <- err
+			return
+		}
+		if have, want := p.ID(), wt.nodeKey.ID(); have != want {
+			t.Errorf("have %v, want %v", have, want)
+		}
+		if have, want := p.RemoteAddr().Network(), ProtocolWS; have != want {
+			t.Errorf("have %v, want %v", have, want)
+		}
+
+		close(errc)
+	}()
+
+	if err := <-errc; err != nil {
+		t.Fatalf("connection failed: %v", err)
+	}
+
+	p, err := wt.Accept(peerConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if have, want := p.ID(), id; have != want {
+		t.Errorf("have %v, want %v", have, want)
+	}
+	if have, want := p.RemoteAddr().Network(), ProtocolWS; have != want {
+		t.Errorf("have %v, want %v", have, want)
+	}
+	if p.IsOutbound() {
+		t.Errorf("expected peer to be inbound")
+	}
+}
+
+func TestTransportWSDialRejectWrongID(t *testing.T) {
+	wt := testSetupWSTransport(t)
+	defer wt.Close()
+
+	var (
+		pv     = ed25519.GenPrivKey()
+		dialer = NewWSTransport(
+			testNodeInfo(PubKeyToID(pv.PubKey()), defaultNodeName),
+			NodeKey{
+				PrivKey: pv,
+			},
+			conn.DefaultMConnConfig(),
+		)
+	)
+
+	addr := testWSTransportAddr(t, wt)
+	addr.ID = PubKeyToID(ed25519.GenPrivKey().PubKey()) // wrong id
+
+	_, err := dialer.Dial(*addr, peerConfig{})
+	if err, ok := err.(ErrRejected); ok {
+		if !err.IsAuthFailure() {
+			t.Errorf("expected auth failure")
+		}
+	} else {
+		t.Errorf("expected ErrRejected, got %v", err)
+	}
+}
+
+func TestTransportWSListenerClose(t *testing.T) {
+	wt := testSetupWSTransport(t)
+
+	if err := wt.Close(); err != nil {
+		t.Fatalf("close errored: %v", err)
+	}
+
+	if _, err := wt.Accept(peerConfig{}); err == nil {
+		t.Errorf("expected an error")
+	} else if _, ok := err.(*ErrTransportClosed); !ok {
+		t.Errorf("expected ErrTransportClosed, got %v", err)
+	}
+}
+
+func testSetupWSTransport(t *testing.T) *WSTransport {
+	var (
+		pv = ed25519.GenPrivKey()
+		id = PubKeyToID(pv.PubKey())
+		wt = NewWSTransport(
+			testNodeInfo(
+				id, "transport",
+			),
+			NodeKey{
+				PrivKey: pv,
+			},
+			conn.DefaultMConnConfig(),
+		)
+	)
+
+	addr, err := NewNetAddressStringWithOptionalID(IDAddressString(id, "ws://127.0.0.1:0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := wt.Listen(*addr); err != nil {
+		t.Fatal(err)
+	}
+
+	return wt
+}
+
+// testWSTransportAddr returns the address to dial the listening transport.
+func testWSTransportAddr(t *testing.T, wt *WSTransport) *NetAddress {
+	addr := NewNetAddress(wt.nodeKey.ID(), wt.listener.Addr())
+	if addr.Protocol != ProtocolWS {
+		t.Fatalf("expected a %v address, got %v", ProtocolWS, addr)
+	}
+	return addr
+}
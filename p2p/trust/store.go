@@ -114,6 +114,16 @@ func (tms *TrustMetricStore) GetPeerTrustMetric(key string) *TrustMetric {
 	return tm
 }
 
+// LookupPeerTrustMetric returns the trust metric of a peer if it has one,
+// without creating it
+func (tms *TrustMetricStore) LookupPeerTrustMetric(key string) (*TrustMetric, bool) {
+	tms.mtx.Lock()
+	defer tms.mtx.Unlock()
+
+	tm, ok := tms.peerMetrics[key]
+	return tm, ok
+}
+
 // PeerDisconnected pauses the trust metric associated with the peer identified by the key
 func (tms *TrustMetricStore) PeerDisconnected(key string) {
 	tms.mtx.Lock()
@@ -114,8 +114,17 @@ func TestTrustMetricStoreLookup(t *testing.T) {
 		// Check that the trust metric was successfully entered
 		ktm := store.peerMetrics[key]
 		assert.NotNil(t, ktm, "Expected to find TrustMetric %s but wasn't there.", key)
+
+		ltm, ok := store.LookupPeerTrustMetric(key)
+		assert.True(t, ok)
+		assert.Equal(t, ktm, ltm)
 	}
 
+	// Looking up an unknown peer does not create its trust metric
+	_, ok := store.LookupPeerTrustMetric("peer_100")
+	assert.False(t, ok)
+	assert.Equal(t, 100, store.Size())
+
 	store.Stop()
 }
 
@@ -1,6 +1,7 @@
 package client_test
 
 import (
+	"context"
 	"reflect"
 	"testing"
 	"time"
@@ -96,7 +97,7 @@ func TestTxEventsSentWithBroadcastTxAsync(t *testing.T) {
 			evtTyp := types.EventTx
 
 			// send async
-			txres, err := c.BroadcastTxAsync(tx)
+			txres, err := c.BroadcastTxAsync(tx, 0)
 			require.Nil(t, err, "%+v", err)
 			require.Equal(t, txres.Code, abci.CodeTypeOK) // FIXME
 
@@ -131,7 +132,7 @@ func TestTxEventsSentWithBroadcastTxSync(t *testing.T) {
 			evtTyp := types.EventTx
 
 			// send sync
-			txres, err := c.BroadcastTxSync(tx)
+			txres, err := c.BroadcastTxSync(tx, 0)
 			require.Nil(t, err, "%+v", err)
 			require.Equal(t, txres.Code, abci.CodeTypeOK) // FIXME
 
@@ -147,3 +148,40 @@ func TestTxEventsSentWithBroadcastTxSync(t *testing.T) {
 		})
 	}
 }
+
+func TestGroupTxEvents(t *testing.T) {
+	c := getHTTPClient()
+	err := c.Start()
+	require.Nil(t, err, "%+v", err)
+	defer c.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), waitForEventTimeout)
+	defer cancel()
+	defer c.UnsubscribeAll(context.Background(), "test")
+
+	group0Evts := make(chan interface{}, 1)
+	err = c.SubscribeGroup(ctx, "test", types.EventTx, 0, group0Evts)
+	require.Nil(t, err, "%+v", err)
+	group1Evts := make(chan interface{}, 1)
+	err = c.SubscribeGroup(ctx, "test", types.EventTx, 1, group1Evts)
+	require.Nil(t, err, "%+v", err)
+
+	_, _, tx := MakeTxKV()
+	_, err = c.BroadcastTxAsync(tx, 0)
+	require.Nil(t, err, "%+v", err)
+
+	select {
+	case evt := <-group0Evts:
+		txe, ok := evt.(types.EventDataTx)
+		require.True(t, ok, "%#v", evt)
+		require.EqualValues(t, tx, txe.Tx)
+		require.EqualValues(t, 0, txe.Group)
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for event")
+	}
+	select {
+	case evt := <-group1Evts:
+		t.Fatalf("unexpected event of group 1: %#v", evt)
+	default:
+	}
+}
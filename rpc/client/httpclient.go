@@ -100,6 +100,15 @@ func (c *HTTP) BroadcastTxSync(tx types.Tx, group int32) (*ctypes.ResultBroadcas
 	return c.broadcastTX("broadcast_tx_sync", tx, group)
 }
 
+func (c *HTTP) BroadcastTxs(txs []ctypes.TxWithGroup) (*ctypes.ResultBroadcastTxs, error) {
+	result := new(ctypes.ResultBroadcastTxs)
+	_, err := c.rpc.Call("broadcast_txs", map[string]interface{}{"txs": txs}, result)
+	if err != nil {
+		return nil, errors.Wrap(err, "broadcast_txs")
+	}
+	return result, nil
+}
+
 func (c *HTTP) broadcastTX(route string, tx types.Tx, group int32) (*ctypes.ResultBroadcastTx, error) {
 	result := new(ctypes.ResultBroadcastTx)
 	_, err := c.rpc.Call(route, map[string]interface{}{"tx": tx, "group": group}, result)
@@ -127,6 +136,24 @@ func (c *HTTP) NumUnconfirmedTxs(group int32) (*ctypes.ResultUnconfirmedTxs, err
 	return result, nil
 }
 
+func (c *HTTP) BroadcastEvidence(ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
+	result := new(ctypes.ResultBroadcastEvidence)
+	_, err := c.rpc.Call("broadcast_evidence", map[string]interface{}{"evidence": ev}, result)
+	if err != nil {
+		return nil, errors.Wrap(err, "BroadcastEvidence")
+	}
+	return result, nil
+}
+
+func (c *HTTP) PendingEvidence() (*ctypes.ResultPendingEvidence, error) {
+	result := new(ctypes.ResultPendingEvidence)
+	_, err := c.rpc.Call("pending_evidence", map[string]interface{}{}, result)
+	if err != nil {
+		return nil, errors.Wrap(err, "PendingEvidence")
+	}
+	return result, nil
+}
+
 func (c *HTTP) NetInfo() (*ctypes.ResultNetInfo, error) {
 	result := new(ctypes.ResultNetInfo)
 	_, err := c.rpc.Call("net_info", map[string]interface{}{}, result)
@@ -223,13 +250,15 @@ func (c *HTTP) Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
 	return result, nil
 }
 
-func (c *HTTP) TxSearch(query string, prove bool, page, perPage int) (*ctypes.ResultTxSearch, error) {
+func (c *HTTP) TxSearch(query string, prove bool, page, perPage int, orderBy, cursor string) (*ctypes.ResultTxSearch, error) {
 	result := new(ctypes.ResultTxSearch)
 	params := map[string]interface{}{
 		"query":    query,
 		"prove":    prove,
 		"page":     page,
 		"per_page": perPage,
+		"order_by": orderBy,
+		"cursor":   cursor,
 	}
 	_, err := c.rpc.Call("tx_search", params, result)
 	if err != nil {
@@ -238,13 +267,15 @@ func (c *HTTP) TxSearch(query string, prove bool, page, perPage int) (*ctypes.Re
 	return result, nil
 }
 
-func (c *HTTP) TxSearch_BS(query string, prove bool, page, perPage int) (*ctypes.ResultTxSearch, error) {
+func (c *HTTP) TxSearch_BS(query string, prove bool, page, perPage int, orderBy, cursor string) (*ctypes.ResultTxSearch, error) {
 	result := new(ctypes.ResultTxSearch)
 	params := map[string]interface{}{
 		"query":    query,
 		"prove":    prove,
 		"page":     page,
 		"per_page": perPage,
+		"order_by": orderBy,
+		"cursor":   cursor,
 	}
 	_, err := c.rpc.Call("tx_search_bs", params, result)
 	if err != nil {
@@ -252,15 +283,40 @@ func (c *HTTP) TxSearch_BS(query string, prove bool, page, perPage int) (*ctypes
 	}
 	return result, nil
 }
-func (c *HTTP) Validators(height *int64) (*ctypes.ResultValidators, error) {
+
+func (c *HTTP) BlockSearch(query string, page, perPage int, orderBy string) (*ctypes.ResultBlockSearch, error) {
+	result := new(ctypes.ResultBlockSearch)
+	params := map[string]interface{}{
+		"query":    query,
+		"page":     page,
+		"per_page": perPage,
+		"order_by": orderBy,
+	}
+	_, err := c.rpc.Call("block_search", params, result)
+	if err != nil {
+		return nil, errors.Wrap(err, "BlockSearch")
+	}
+	return result, nil
+}
+
+func (c *HTTP) Validators(height *int64, group *int32) (*ctypes.ResultValidators, error) {
 	result := new(ctypes.ResultValidators)
-	_, err := c.rpc.Call("validators", map[string]interface{}{"height": height}, result)
+	_, err := c.rpc.Call("validators", map[string]interface{}{"height": height, "group": group}, result)
 	if err != nil {
 		return nil, errors.Wrap(err, "Validators")
 	}
 	return result, nil
 }
 
+func (c *HTTP) ConsensusParams(height *int64) (*ctypes.ResultConsensusParams, error) {
+	result := new(ctypes.ResultConsensusParams)
+	_, err := c.rpc.Call("consensus_params", map[string]interface{}{"height": height}, result)
+	if err != nil {
+		return nil, errors.Wrap(err, "ConsensusParams")
+	}
+	return result, nil
+}
+
 /** websocket event stuff here... **/
 
 type WSEvents struct {
@@ -326,6 +382,16 @@ func (w *WSEvents) Subscribe(ctx context.Context, subscriber string, query tmpub
 	return nil
 }
 
+// SubscribeGroup subscribes to the events of the given type and mempool group.
+// Only Tx, NewBlock and NewBlockHeader events have a group.
+func (w *WSEvents) SubscribeGroup(ctx context.Context, subscriber string, eventType string, group int32, out chan<- interface{}) error {
+	query, err := types.QueryForGroupEvent(eventType, group)
+	if err != nil {
+		return err
+	}
+	return w.Subscribe(ctx, subscriber, query, out)
+}
+
 func (w *WSEvents) Unsubscribe(ctx context.Context, subscriber string, query tmpubsub.Query) error {
 	q := query.String()
 
@@ -40,6 +40,7 @@ type ABCIClient interface {
 	BroadcastTxCommit(tx types.Tx, group int32) (*ctypes.ResultBroadcastTxCommit, error)
 	BroadcastTxAsync(tx types.Tx, group int32) (*ctypes.ResultBroadcastTx, error)
 	BroadcastTxSync(tx types.Tx, group int32) (*ctypes.ResultBroadcastTx, error)
+	BroadcastTxs(txs []ctypes.TxWithGroup) (*ctypes.ResultBroadcastTxs, error)
 }
 
 // SignClient groups together the interfaces need to get valid
@@ -48,10 +49,12 @@ type SignClient interface {
 	Block(height *int64) (*ctypes.ResultBlock, error)
 	BlockResults(height *int64) (*ctypes.ResultBlockResults, error)
 	Commit(height *int64) (*ctypes.ResultCommit, error)
-	Validators(height *int64) (*ctypes.ResultValidators, error)
+	Validators(height *int64, group *int32) (*ctypes.ResultValidators, error)
+	ConsensusParams(height *int64) (*ctypes.ResultConsensusParams, error)
 	Tx(hash []byte, prove bool) (*ctypes.ResultTx, error)
-	TxSearch(query string, prove bool, page, perPage int) (*ctypes.ResultTxSearch, error)
-	TxSearch_BS(query string, prove bool, page, perPage int) (*ctypes.ResultTxSearch, error)
+	TxSearch(query string, prove bool, page, perPage int, orderBy, cursor string) (*ctypes.ResultTxSearch, error)
+	TxSearch_BS(query string, prove bool, page, perPage int, orderBy, cursor string) (*ctypes.ResultTxSearch, error)
+	BlockSearch(query string, page, perPage int, orderBy string) (*ctypes.ResultBlockSearch, error)
 }
 
 // HistoryClient shows us data from genesis to now in large chunks.
@@ -75,6 +78,7 @@ type Client interface {
 	HistoryClient
 	StatusClient
 	EventsClient
+	EvidenceClient
 }
 
 // NetworkClient is general info about the network state.  May not
@@ -95,6 +99,13 @@ type EventsClient interface {
 	types.EventBusSubscriber
 }
 
+// EvidenceClient is used for submitting evidence of malicious behaviour and
+// inspecting the evidence that is not yet committed.
+type EvidenceClient interface {
+	BroadcastEvidence(ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error)
+	PendingEvidence() (*ctypes.ResultPendingEvidence, error)
+}
+
 // MempoolClient shows us data about current mempool state.
 type MempoolClient interface {
 	UnconfirmedTxs(limit int, group int32) (*ctypes.ResultUnconfirmedTxs, error)
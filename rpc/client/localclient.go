@@ -76,6 +76,10 @@ func (Local) BroadcastTxSync(tx types.Tx, group int32) (*ctypes.ResultBroadcastT
 	return core.BroadcastTxSync(tx, group)
 }
 
+func (Local) BroadcastTxs(txs []ctypes.TxWithGroup) (*ctypes.ResultBroadcastTxs, error) {
+	return core.BroadcastTxs(txs)
+}
+
 func (Local) UnconfirmedTxs(limit int, group int32) (*ctypes.ResultUnconfirmedTxs, error) {
 	return core.UnconfirmedTxs(limit, group)
 }
@@ -84,6 +88,14 @@ func (Local) NumUnconfirmedTxs(group int32) (*ctypes.ResultUnconfirmedTxs, error
 	return core.NumUnconfirmedTxs(group)
 }
 
+func (Local) BroadcastEvidence(ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
+	return core.BroadcastEvidence(ev)
+}
+
+func (Local) PendingEvidence() (*ctypes.ResultPendingEvidence, error) {
+	return core.PendingEvidence()
+}
+
 func (Local) NetInfo() (*ctypes.ResultNetInfo, error) {
 	return core.NetInfo()
 }
@@ -128,20 +140,28 @@ func (Local) Commit(height *int64) (*ctypes.ResultCommit, error) {
 	return core.Commit(height)
 }
 
-func (Local) Validators(height *int64) (*ctypes.ResultValidators, error) {
-	return core.Validators(height)
+func (Local) Validators(height *int64, group *int32) (*ctypes.ResultValidators, error) {
+	return core.Validators(height, group)
+}
+
+func (Local) ConsensusParams(height *int64) (*ctypes.ResultConsensusParams, error) {
+	return core.ConsensusParams(height)
 }
 
 func (Local) Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
 	return core.Tx(hash, prove)
 }
 
-func (Local) TxSearch(query string, prove bool, page, perPage int) (*ctypes.ResultTxSearch, error) {
-	return core.TxSearch(query, prove, page, perPage)
+func (Local) TxSearch(query string, prove bool, page, perPage int, orderBy, cursor string) (*ctypes.ResultTxSearch, error) {
+	return core.TxSearch(query, prove, page, perPage, orderBy, cursor)
+}
+
+func (Local) TxSearch_BS(query string, prove bool, page, perPage int, orderBy, cursor string) (*ctypes.ResultTxSearch, error) {
+	return core.TxSearch_BS(query, prove, page, perPage, orderBy, cursor)
 }
 
-func (Local) TxSearch_BS(query string, prove bool, page, perPage int) (*ctypes.ResultTxSearch, error) {
-	return core.TxSearch_BS(query, prove, page, perPage)
+func (Local) BlockSearch(query string, page, perPage int, orderBy string) (*ctypes.ResultBlockSearch, error) {
+	return core.BlockSearch(query, page, perPage, orderBy)
 }
 
 func (c *Local) Subscribe(ctx context.Context, subscriber string, query tmpubsub.Query, out chan<- interface{}) error {
@@ -73,6 +73,24 @@ func (a ABCIApp) BroadcastTxSync(tx types.Tx, group int32) (*ctypes.ResultBroadc
 	return &ctypes.ResultBroadcastTx{Code: c.Code, Data: c.Data, Log: c.Log, Hash: tx.Hash()}, nil
 }
 
+func (a ABCIApp) BroadcastTxs(txs []ctypes.TxWithGroup) (*ctypes.ResultBroadcastTxs, error) {
+	return broadcastTxs(a, txs), nil
+}
+
+// broadcastTxs runs BroadcastTxSync for each of the txs
+func broadcastTxs(c client.ABCIClient, txs []ctypes.TxWithGroup) *ctypes.ResultBroadcastTxs {
+	results := make([]ctypes.BroadcastTxsResult, len(txs))
+	for i, tx := range txs {
+		res, err := c.BroadcastTxSync(tx.Tx, tx.Group)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		results[i].Result = res
+	}
+	return &ctypes.ResultBroadcastTxs{Results: results}
+}
+
 // ABCIMock will send all abci related request to the named app,
 // so you can test app behavior from a client without needing
 // an entire tendermint node
@@ -128,6 +146,10 @@ func (m ABCIMock) BroadcastTxSync(tx types.Tx, group int32) (*ctypes.ResultBroad
 	return res.(*ctypes.ResultBroadcastTx), nil
 }
 
+func (m ABCIMock) BroadcastTxs(txs []ctypes.TxWithGroup) (*ctypes.ResultBroadcastTxs, error) {
+	return broadcastTxs(m, txs), nil
+}
+
 // ABCIRecorder can wrap another type (ABCIApp, ABCIMock, or Client)
 // and record all ABCI related calls.
 type ABCIRecorder struct {
@@ -216,3 +238,14 @@ func (r *ABCIRecorder) BroadcastTxSync(tx types.Tx, group int32) (*ctypes.Result
 	})
 	return res, err
 }
+
+func (r *ABCIRecorder) BroadcastTxs(txs []ctypes.TxWithGroup) (*ctypes.ResultBroadcastTxs, error) {
+	res, err := r.Client.BroadcastTxs(txs)
+	r.addCall(Call{
+		Name:     "broadcast_txs",
+		Args:     txs,
+		Response: res,
+		Error:    err,
+	})
+	return res, err
+}
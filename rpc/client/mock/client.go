@@ -35,6 +35,7 @@ type Client struct {
 	client.HistoryClient
 	client.StatusClient
 	client.EventsClient
+	client.EvidenceClient
 	cmn.Service
 }
 
@@ -101,7 +102,19 @@ func (c Client) BroadcastTxAsync(tx types.Tx, group int32) (*ctypes.ResultBroadc
 
 func (c Client) BroadcastTxSync(tx types.Tx, group int32) (*ctypes.ResultBroadcastTx, error) {
 	return core.BroadcastTxSync(tx, group)
+}
+
+func (c Client) BroadcastTxs(txs []ctypes.TxWithGroup) (*ctypes.ResultBroadcastTxs, error) {
+	return core.BroadcastTxs(txs)
+}
+
+func (c Client) BroadcastEvidence(ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
+	return core.BroadcastEvidence(ev)
+}
 
+func (c Client) PendingEvidence() (*ctypes.ResultPendingEvidence, error) {
+	return core.PendingEvidence()
+}
 
 func (c Client) NetInfo() (*ctypes.ResultNetInfo, error) {
 	return core.NetInfo()
@@ -131,6 +144,10 @@ func (c Client) Commit(height *int64) (*ctypes.ResultCommit, error) {
 	return core.Commit(height)
 }
 
-func (c Client) Validators(height *int64) (*ctypes.ResultValidators, error) {
-	return core.Validators(height)
+func (c Client) Validators(height *int64, group *int32) (*ctypes.ResultValidators, error) {
+	return core.Validators(height, group)
+}
+
+func (c Client) ConsensusParams(height *int64) (*ctypes.ResultConsensusParams, error) {
+	return core.ConsensusParams(height)
 }
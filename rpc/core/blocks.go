@@ -4,8 +4,11 @@ import (
 	"fmt"
 
 	cmn "github.com/tendermint/tendermint/libs/common"
+	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/state/txindex/null"
 	"github.com/tendermint/tendermint/types"
 )
 
@@ -74,7 +77,7 @@ func BlockchainInfo(minHeight, maxHeight int64) (*ctypes.ResultBlockchainInfo, e
 	// const limit int64 = 20
 	const limit int64 = 100
 	var err error
-	minHeight, maxHeight, err = filterMinMax(blockStore.Height(), minHeight, maxHeight, limit)
+	minHeight, maxHeight, err = filterMinMax(blockStore.Base(), blockStore.Height(), minHeight, maxHeight, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +98,8 @@ func BlockchainInfo(minHeight, maxHeight int64) (*ctypes.ResultBlockchainInfo, e
 // if 0, use 1 for min, latest block height for max
 // enforce limit.
 // error if min > max
-func filterMinMax(height, min, max, limit int64) (int64, int64, error) {
+// min is raised to base, the lowest height not pruned.
+func filterMinMax(base, height, min, max, limit int64) (int64, int64, error) {
 	// filter negatives
 	if min < 0 || max < 0 {
 		return min, max, fmt.Errorf("heights must be non-negative")
@@ -105,6 +109,7 @@ func filterMinMax(height, min, max, limit int64) (int64, int64, error) {
 	if min == 0 {
 		min = 1
 	}
+	min = cmn.MaxInt64(min, base)
 	if max == 0 {
 		max = height
 	}
@@ -228,8 +233,7 @@ func filterMinMax(height, min, max, limit int64) (int64, int64, error) {
 // }
 // ```
 func Block(heightPtr *int64) (*ctypes.ResultBlock, error) {
-	storeHeight := blockStore.Height()
-	height, err := getHeight(storeHeight, heightPtr)
+	height, err := getHeight(blockStore.Base(), blockStore.Height(), heightPtr)
 	if err != nil {
 		return nil, err
 	}
@@ -316,7 +320,7 @@ func Block(heightPtr *int64) (*ctypes.ResultBlock, error) {
 // ```
 func Commit(heightPtr *int64) (*ctypes.ResultCommit, error) {
 	storeHeight := blockStore.Height()
-	height, err := getHeight(storeHeight, heightPtr)
+	height, err := getHeight(blockStore.Base(), storeHeight, heightPtr)
 	if err != nil {
 		return nil, err
 	}
@@ -374,8 +378,7 @@ func Commit(heightPtr *int64) (*ctypes.ResultCommit, error) {
 // }
 // ```
 func BlockResults(heightPtr *int64) (*ctypes.ResultBlockResults, error) {
-	storeHeight := blockStore.Height()
-	height, err := getHeight(storeHeight, heightPtr)
+	height, err := getHeight(blockStore.Base(), blockStore.Height(), heightPtr)
 	if err != nil {
 		return nil, err
 	}
@@ -393,7 +396,130 @@ func BlockResults(heightPtr *int64) (*ctypes.ResultBlockResults, error) {
 	return res, nil
 }
 
-func getHeight(currentHeight int64, heightPtr *int64) (int64, error) {
+// BlockSearch searches for blocks by the tags of their BeginBlock and
+// EndBlock responses, and by the predefined `block.height` and `block.group`
+// tags, a block matching `block.group` for its group and those of its tx
+// sections. It returns a list of block metas (maximum ?per_page entries),
+// ordered by height, and the total count.
+//
+// ```shell
+// curl "localhost:26657/block_search?query=\"group.switch=1\"&order_by=\"desc\""
+// ```
+//
+// ```go
+// client := client.NewHTTP("tcp://0.0.0.0:26657", "/websocket")
+// err := client.Start()
+// if err != nil {
+//   // handle error
+// }
+// defer client.Stop()
+// res, err := client.BlockSearch("group.switch = 1 AND block.group = 2", 1, 30, "asc")
+// ```
+//
+// > The above command returns JSON structured like this:
+//
+// ```json
+// {
+//   "jsonrpc": "2.0",
+//   "id": "",
+//   "result": {
+//     "blocks": [
+//       {
+//         "header": {
+//           "app_hash": "",
+//           "chain_id": "test-chain-6UTNIN",
+//           "height": "10",
+//           "time": "2017-05-29T15:05:53.877Z",
+//           "num_txs": "0",
+//           "last_block_id": {
+//             "parts": {
+//               "hash": "3C78F00658E06744A88F24FF97A0A5011139F34A",
+//               "total": "1"
+//             },
+//             "hash": "F70588DAB36BDA5A953D548A16F7D48C6C2DFD78"
+//           },
+//           "last_commit_hash": "F31CC4282E50B3F2A58D763D233D76F26D26CABE",
+//           "data_hash": "",
+//           "validators_hash": "9365FC80F234C967BD233F5A3E2AB2F1E4B0E5AA"
+//         },
+//         "block_id": {
+//           "parts": {
+//             "hash": "277A4DBEF91483A18B85F2F5677ABF9694DFA40F",
+//             "total": "1"
+//           },
+//           "hash": "96B1D2F2D201BA4BC383EB8224139DB1294944E5"
+//         }
+//       }
+//     ],
+//     "total_count": "1"
+//   }
+// }
+// ```
+//
+// ### Query Parameters
+//
+// | Parameter | Type   | Default | Required | Description                           |
+// |-----------+--------+---------+----------+---------------------------------------|
+// | query     | string | ""      | true     | Query                                 |
+// | page      | int    | 1       | false    | Page number (1-based)                 |
+// | per_page  | int    | 30      | false    | Number of entries per page (max: 100) |
+// | order_by  | string | "asc"   | false    | Order of the blocks: "asc" or "desc"  |
+//
+// ### Returns
+//
+// - `total_count`: `int` - number of blocks matching the query
+// - `blocks`: the `types.BlockMeta` of each block
+//
+// <aside class="notice">Only the first 10000 blocks can be read.</aside>
+func BlockSearch(query string, page, perPage int, orderBy string) (*ctypes.ResultBlockSearch, error) {
+	// if index is disabled, return error
+	if _, ok := blockIndexer.(*null.BlockIndex); ok {
+		return nil, fmt.Errorf("Block indexing is disabled")
+	}
+
+	q, err := tmquery.New(query)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := txindex.SearchOptions{Limit: validatePerPage(perPage)}
+	switch orderBy {
+	case "asc", "":
+	case "desc":
+		opts.Descending = true
+	default:
+		return nil, fmt.Errorf("expected order_by to be either `asc` or `desc` or empty")
+	}
+	opts.Skip = validateSkipCount(page, opts.Limit)
+	if opts.Skip+opts.Limit > maxBlockSearchDepth {
+		return nil, fmt.Errorf("page %d is too deep, only %d blocks can be read", page, maxBlockSearchDepth)
+	}
+
+	res, err := blockIndexer.Search(q, opts)
+	if err != nil {
+		return nil, err
+	}
+	// past the last page, return the last page
+	if len(res.Heights) == 0 && res.Total > 0 {
+		page = validatePage(page, opts.Limit, res.Total)
+		opts.Skip = validateSkipCount(page, opts.Limit)
+		if res, err = blockIndexer.Search(q, opts); err != nil {
+			return nil, err
+		}
+	}
+
+	blockMetas := make([]*types.BlockMeta, 0, len(res.Heights))
+	for _, height := range res.Heights {
+		// blocks indexed before being saved, e.g. on a crash, are skipped
+		if blockMeta := blockStore.LoadBlockMeta(height); blockMeta != nil {
+			blockMetas = append(blockMetas, blockMeta)
+		}
+	}
+
+	return &ctypes.ResultBlockSearch{Blocks: blockMetas, TotalCount: res.Total}, nil
+}
+
+func getHeight(currentBase int64, currentHeight int64, heightPtr *int64) (int64, error) {
 	if heightPtr != nil {
 		height := *heightPtr
 		if height <= 0 {
@@ -402,6 +528,9 @@ func getHeight(currentHeight int64, heightPtr *int64) (int64, error) {
 		if height > currentHeight {
 			return 0, fmt.Errorf("Height must be less than or equal to the current blockchain height")
 		}
+		if height < currentBase {
+			return 0, fmt.Errorf("Height %v is not available, blocks pruned at height %v", height, currentBase)
+		}
 		return height, nil
 	}
 	return currentHeight, nil
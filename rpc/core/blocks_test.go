@@ -46,7 +46,7 @@ func TestBlockchainInfo(t *testing.T) {
 
 	for i, c := range cases {
 		caseString := fmt.Sprintf("test %d failed", i)
-		min, max, err := filterMinMax(c.height, c.min, c.max, c.limit)
+		min, max, err := filterMinMax(0, c.height, c.min, c.max, c.limit)
 		if c.wantErr {
 			require.Error(t, err, caseString)
 		} else {
@@ -55,4 +55,28 @@ func TestBlockchainInfo(t *testing.T) {
 		}
 	}
 
+	// heights below the base are pruned
+	min, max, err := filterMinMax(10, 20, 0, 0, 20)
+	require.NoError(t, err)
+	require.EqualValues(t, 10, min)
+	require.EqualValues(t, 20, max)
+	_, _, err = filterMinMax(10, 20, 1, 5, 20)
+	require.Error(t, err)
+}
+
+func TestGetHeight(t *testing.T) {
+	height := func(h int64) *int64 { return &h }
+
+	h, err := getHeight(10, 20, nil)
+	require.NoError(t, err)
+	require.EqualValues(t, 20, h)
+
+	h, err = getHeight(10, 20, height(10))
+	require.NoError(t, err)
+	require.EqualValues(t, 10, h)
+
+	for _, bad := range []int64{-1, 0, 9, 21} {
+		_, err = getHeight(10, 20, height(bad))
+		require.Error(t, err, "height %d", bad)
+	}
 }
@@ -9,9 +9,13 @@ import (
 
 // Get the validator set at the given block height.
 // If no height is provided, it will fetch the current validator set.
+// If a group is provided and the chain runs with group validators, only the
+// validators signing the blocks of that group are returned (all of them for
+// group 0).
 //
 // ```shell
 // curl 'localhost:26657/validators'
+// curl 'localhost:26657/validators?group=1'
 // ```
 //
 // ```go
@@ -21,7 +25,7 @@ import (
 //   // handle error
 // }
 // defer client.Stop()
-// state, err := client.Validators()
+// state, err := client.Validators(nil, nil)
 // ```
 //
 // The above command returns JSON structured like this:
@@ -47,11 +51,11 @@ import (
 // 	"jsonrpc": "2.0"
 // }
 // ```
-func Validators(heightPtr *int64) (*ctypes.ResultValidators, error) {
+func Validators(heightPtr *int64, groupPtr *int32) (*ctypes.ResultValidators, error) {
 	// The latest validator that we know is the
 	// NextValidator of the last block.
 	height := consensusState.GetState().LastBlockHeight + 1
-	height, err := getHeight(height, heightPtr)
+	height, err := getHeight(blockStore.Base(), height, heightPtr)
 	if err != nil {
 		return nil, err
 	}
@@ -60,6 +64,15 @@ func Validators(heightPtr *int64) (*ctypes.ResultValidators, error) {
 	if err != nil {
 		return nil, err
 	}
+	if groupPtr != nil {
+		consensusParams, err := sm.LoadConsensusParams(stateDB, height)
+		if err != nil {
+			return nil, err
+		}
+		if consensusParams.Validator.GroupValidators {
+			validators = validators.GroupSet(*groupPtr)
+		}
+	}
 	return &ctypes.ResultValidators{
 		BlockHeight: height,
 		Validators:  validators.Validators}, nil
@@ -314,7 +327,8 @@ func ConsensusState() (*ctypes.ResultConsensusState, error) {
 //         "max_gas": "-1"
 //       },
 //       "evidence_params": {
-//         "max_age": "100000"
+//         "max_age": "100000",
+//         "max_age_duration": "172800000000000"
 //       }
 //     }
 //   }
@@ -322,7 +336,7 @@ func ConsensusState() (*ctypes.ResultConsensusState, error) {
 // ```
 func ConsensusParams(heightPtr *int64) (*ctypes.ResultConsensusParams, error) {
 	height := consensusState.GetState().LastBlockHeight + 1
-	height, err := getHeight(height, heightPtr)
+	height, err := getHeight(blockStore.Base(), height, heightPtr)
 	if err != nil {
 		return nil, err
 	}
@@ -8,13 +8,53 @@ import (
 )
 
 func UnsafeFlushMempool() (*ctypes.ResultUnsafeFlushMempool, error) {
-	for _, item := range mempool {
-		item.Flush()
+	for _, mem := range mempools.Mempools() {
+		mem.Flush()
 	}
 
 	return &ctypes.ResultUnsafeFlushMempool{}, nil
 }
 
+// UnsafeAddMempoolGroup starts a mempool for the given group on this node.
+// The group must be open on chain. It runs with its [[mempool.groups]] entry,
+// or the settings of the [mempool] section, overridden by the non-zero
+// name, size, cache_size and wal_dir and by recheck and broadcast if set.
+func UnsafeAddMempoolGroup(group int32, name string, size, cacheSize int,
+	recheck, broadcast *bool, walDir string) (*ctypes.ResultUnsafeAddMempoolGroup, error) {
+	groupConfig := mempools.GroupConfig(group)
+	if name != "" {
+		groupConfig.Name = name
+	}
+	if size != 0 {
+		groupConfig.Size = size
+	}
+	if cacheSize != 0 {
+		groupConfig.CacheSize = cacheSize
+	}
+	if walDir != "" {
+		groupConfig.WalPath = walDir
+	}
+	if recheck != nil {
+		groupConfig.Recheck = *recheck
+	}
+	if broadcast != nil {
+		groupConfig.Broadcast = *broadcast
+	}
+	if err := mempools.AddGroup(groupConfig); err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultUnsafeAddMempoolGroup{}, nil
+}
+
+// UnsafeRemoveMempoolGroup stops the mempool of the given group on this node
+// and drops its txs. Group 0 can't be removed.
+func UnsafeRemoveMempoolGroup(group int32) (*ctypes.ResultUnsafeRemoveMempoolGroup, error) {
+	if err := mempools.RemoveGroup(group); err != nil {
+		return nil, mempoolError(err)
+	}
+	return &ctypes.ResultUnsafeRemoveMempoolGroup{}, nil
+}
+
 var profFile *os.File
 
 func UnsafeStartCPUProfiler(filename string) (*ctypes.ResultUnsafeProfile, error) {
@@ -0,0 +1,50 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/tendermint/tendermint/config"
+)
+
+// configMempoolGroups configures every group with config and records the
+// config of the last added group.
+type configMempoolGroups struct {
+	mempoolGroups
+	config cfg.MempoolGroupConfig
+	added  *cfg.MempoolGroupConfig
+}
+
+func (mg *configMempoolGroups) GroupConfig(group int32) *cfg.MempoolGroupConfig {
+	groupConfig := mg.config
+	groupConfig.ID = group
+	return &groupConfig
+}
+
+func (mg *configMempoolGroups) AddGroup(groupConfig *cfg.MempoolGroupConfig) error {
+	mg.added = groupConfig
+	return nil
+}
+
+func TestUnsafeAddMempoolGroup(t *testing.T) {
+	mg := &configMempoolGroups{config: cfg.MempoolGroupConfig{Recheck: true, Broadcast: true, Size: 100}}
+	SetMempoolGroups(mg)
+	defer SetMempoolGroups(nil)
+
+	// the configured values are kept unless overridden
+	_, err := UnsafeAddMempoolGroup(1, "", 0, 0, nil, nil, "")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, mg.added.ID)
+	assert.True(t, mg.added.Recheck)
+	assert.True(t, mg.added.Broadcast)
+	assert.Equal(t, 100, mg.added.Size)
+
+	off := false
+	_, err = UnsafeAddMempoolGroup(2, "", 50, 0, &off, &off, "")
+	require.NoError(t, err)
+	assert.False(t, mg.added.Recheck)
+	assert.False(t, mg.added.Broadcast)
+	assert.Equal(t, 50, mg.added.Size)
+}
@@ -61,6 +61,34 @@ JSONRPC requests can be POST'd to the root RPC endpoint via HTTP (e.g. `http://l
 JSONRPC requests can be made via websocket. The websocket endpoint is at `/websocket`, e.g. `localhost:26657/websocket`.  Asynchronous RPC functions like event `subscribe` and `unsubscribe` are only available via websockets.
 
 
+## Errors
+
+Errors of the mempool are returned with stable JSON-RPC error codes, the details of the error being in `data`:
+
+| Code   | Error                                                     |
+|--------+-----------------------------------------------------------|
+| -32001 | The tx already exists in the cache                        |
+| -32002 | The tx is larger than `max_tx_bytes` or the block size    |
+| -32003 | The mempool is full                                       |
+| -32004 | The mempool group does not exist                          |
+| -32005 | The sender of the tx reached its limits                   |
+| -32006 | The tx failed the pre check                               |
+| -32007 | The tx of the same sender and nonce has a higher priority |
+
+Other errors of the RPC functions are returned as internal errors (-32603).
+
+```json
+{
+	"jsonrpc": "2.0",
+	"id": "",
+	"error": {
+		"code": -32001,
+		"message": "Tx already exists in cache",
+		"data": "Tx already exists in cache"
+	}
+}
+```
+
 ## More Examples
 
 See the various bash tests using curl in `test/`, and examples using the `Go` API in `rpc/client/`.
@@ -82,6 +110,7 @@ Available endpoints:
 /genesis
 /net_info
 /num_unconfirmed_txs
+/pending_evidence
 /status
 /health
 /unconfirmed_txs
@@ -93,6 +122,7 @@ Endpoints that require arguments:
 /abci_query?path=_&data=_&prove=_
 /block?height=_
 /blockchain?minHeight=_&maxHeight=_
+/broadcast_evidence?evidence=_
 /broadcast_tx_async?tx=_
 /broadcast_tx_commit?tx=_
 /broadcast_tx_sync?tx=_
@@ -101,6 +131,8 @@ Endpoints that require arguments:
 /dial_persistent_peers?persistent_peers=_
 /subscribe?event=_
 /tx?hash=_&prove=_
+/unsafe_add_mempool_group?group=_&name=_&size=_&cache_size=_&recheck=_&broadcast=_&wal_dir=_
+/unsafe_remove_mempool_group?group=_
 /unsafe_start_cpu_profiler?filename=_
 /unsafe_write_heap_profile?filename=_
 /unsubscribe?event=_
@@ -0,0 +1,96 @@
+package core
+
+import (
+	"fmt"
+
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+// Get the evidence that was verified but not yet committed, including its
+// number.
+//
+// ```shell
+// curl 'localhost:26657/pending_evidence'
+// ```
+//
+// ```go
+// client := client.NewHTTP("tcp://0.0.0.0:26657", "/websocket")
+// err := client.Start()
+// if err != nil {
+//   // handle error
+// }
+// defer client.Stop()
+// result, err := client.PendingEvidence()
+// ```
+//
+// > The above command returns JSON structured like this:
+//
+// ```json
+// {
+//   "error": "",
+//   "result": {
+//     "evidence": [],
+//     "n_evidence": "0"
+//   },
+//   "id": "",
+//   "jsonrpc": "2.0"
+// }
+// ```
+func PendingEvidence() (*ctypes.ResultPendingEvidence, error) {
+	evidence := evidencePool.PendingEvidence(-1)
+	return &ctypes.ResultPendingEvidence{
+		N:        len(evidence),
+		Evidence: evidence,
+	}, nil
+}
+
+// Broadcast evidence of misbehavior. The evidence is verified against the
+// state, added to the evidence pool and gossiped to the peers.
+// ConflictingHeadersEvidence is split into evidence against the validators
+// who signed the header which isn't committed, which is added instead.
+//
+// ```shell
+// curl -X POST --data '{"jsonrpc":"2.0","id":"","method":"broadcast_evidence","params":{"evidence":{"type":"tendermint/DuplicateVoteEvidence","value":{...}}}}' localhost:26657
+// ```
+//
+// ```go
+// client := client.NewHTTP("tcp://0.0.0.0:26657", "/websocket")
+// err := client.Start()
+// if err != nil {
+//   // handle error
+// }
+// defer client.Stop()
+// result, err := client.BroadcastEvidence(ev)
+// ```
+//
+// > The above command returns JSON structured like this:
+//
+// ```json
+// {
+//   "error": "",
+//   "result": {
+//     "hash": "8DB1A3BE1C5A1D4B3AE5E3F6B4B5E7F4A4C37F3F8B6A0F4F0B8E5C3A2D1E0F9A"
+//   },
+//   "id": "",
+//   "jsonrpc": "2.0"
+// }
+// ```
+//
+// ### Query Parameters
+//
+// | Parameter | Type     | Default | Required | Description                 |
+// |-----------+----------+---------+----------+-----------------------------|
+// | evidence  | Evidence | nil     | true     | Amino JSON encoded evidence |
+func BroadcastEvidence(ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
+	if ev == nil {
+		return nil, fmt.Errorf("Evidence is required")
+	}
+	if err := ev.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if err := evidencePool.AddEvidence(ev); err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultBroadcastEvidence{Hash: ev.Hash()}, nil
+}
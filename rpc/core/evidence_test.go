@@ -0,0 +1,55 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+)
+
+// verifyingEvidencePool keeps the evidence passing Verify.
+type verifyingEvidencePool struct {
+	sm.MockEvidencePool
+	evidence []types.Evidence
+}
+
+func (p *verifyingEvidencePool) AddEvidence(ev types.Evidence) error {
+	if err := ev.Verify("", nil); err != nil {
+		return err
+	}
+	p.evidence = append(p.evidence, ev)
+	return nil
+}
+
+func (p *verifyingEvidencePool) PendingEvidence(int64) []types.Evidence {
+	return p.evidence
+}
+
+func TestBroadcastAndPendingEvidence(t *testing.T) {
+	evpool := &verifyingEvidencePool{}
+	SetEvidencePool(evpool)
+	defer SetEvidencePool(sm.MockEvidencePool{})
+
+	res, err := PendingEvidence()
+	require.NoError(t, err)
+	assert.Equal(t, 0, res.N)
+
+	_, err = BroadcastEvidence(nil)
+	assert.Error(t, err)
+
+	goodEvidence := types.NewMockGoodEvidence(1, 0, []byte("val1"))
+	_, err = BroadcastEvidence(types.MockBadEvidence{MockGoodEvidence: goodEvidence})
+	assert.Error(t, err)
+
+	bres, err := BroadcastEvidence(goodEvidence)
+	require.NoError(t, err)
+	assert.EqualValues(t, goodEvidence.Hash(), bres.Hash)
+
+	res, err = PendingEvidence()
+	require.NoError(t, err)
+	assert.Equal(t, 1, res.N)
+	assert.Equal(t, []types.Evidence{goodEvidence}, res.Evidence)
+}
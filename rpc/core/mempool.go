@@ -3,20 +3,25 @@ package core
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	mempl "github.com/tendermint/tendermint/mempool"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	rpcserver "github.com/tendermint/tendermint/rpc/lib/server"
+	rpctypes "github.com/tendermint/tendermint/rpc/lib/types"
 	"github.com/tendermint/tendermint/types"
 )
 
 //-----------------------------------------------------------------------------
 // NOTE: tx should be signed, but this is only checked at the app level (not by Tendermint!)
 
-// Returns right away, with no response
+// Returns right away, with no response. The errors of the mempool once the app
+// accepted the tx, e.g. it is full, are not returned: use broadcast_tx_sync to
+// get them.
 //
 // ```shell
 // curl 'localhost:26657/broadcast_tx_async?tx="123"'
@@ -54,12 +59,13 @@ import (
 // |-----------+------+---------+----------+-----------------|
 // | tx        | Tx   | nil     | true     | The transaction |
 func BroadcastTxAsync(tx types.Tx, group int32) (*ctypes.ResultBroadcastTx, error) {
-	if _, ok := mempool[group]; !ok {
-		return nil, errors.New("Mempool group is not exist.")
+	mem, err := getMempool(group)
+	if err != nil {
+		return nil, mempoolError(err)
 	}
-	err := mempool[group].CheckTx(tx, nil)
+	err = mem.CheckTx(tx, nil)
 	if err != nil {
-		return nil, err
+		return nil, mempoolError(err)
 	}
 	return &ctypes.ResultBroadcastTx{Hash: tx.Hash()}, nil
 }
@@ -104,27 +110,124 @@ func BroadcastTxAsync(tx types.Tx, group int32) (*ctypes.ResultBroadcastTx, erro
 func BroadcastTxSync(tx types.Tx, group int32) (*ctypes.ResultBroadcastTx, error) {
 	resCh := make(chan *abci.Response, 1)
 
-	if _, ok := mempool[group]; !ok {
-		return nil, errors.New("Mempool group is not exist.")
+	mem, err := getMempool(group)
+	if err != nil {
+		return nil, mempoolError(err)
 	}
-	err := mempool[group].CheckTx(tx, func(res *abci.Response) {
+	var memErr error
+	err = mem.CheckTxWithError(tx, func(res *abci.Response, err error) {
+		memErr = err
 		resCh <- res
 	})
 	if err != nil {
-		return nil, err
+		return nil, mempoolError(err)
 	}
 	res := <-resCh
-	r := res.GetCheckTx()
-	return &ctypes.ResultBroadcastTx{
-		Code: r.Code,
-		Data: r.Data,
-		Log:  r.Log,
-		Hash: tx.Hash(),
-	}, nil
+	if memErr != nil {
+		// the app accepted the tx, but the mempool did not
+		return nil, mempoolError(memErr)
+	}
+	return broadcastTxResult(tx, res.GetCheckTx()), nil
 }
 
-// CONTRACT: only returns error if mempool.CheckTx() errs or if we timeout
-// waiting for tx to commit.
+// Checks a batch of txs, each in its own mempool group, and returns with the
+// CheckTx responses in the order of the txs. All the txs are submitted before
+// waiting for the responses. The total size of the txs can't exceed the
+// `max_body_bytes` of the RPC config.
+//
+// A tx which could not be checked (e.g. it is already in the cache) or which
+// the mempool rejected (e.g. it is full) does not fail the whole batch, its
+// error is returned in its result instead, with the code of the error.
+//
+// NOTE: the txs are passed in the body, so only POST requests are supported.
+//
+// ```shell
+// curl -X POST localhost:26657 -d '{"jsonrpc":"2.0","id":"","method":"broadcast_txs","params":{"txs":[{"tx":"MTIz","group":0},{"tx":"NDU2","group":2}]}}'
+// ```
+//
+// ```go
+// client := client.NewHTTP("tcp://0.0.0.0:26657", "/websocket")
+// err := client.Start()
+// if err != nil {
+//   // handle error
+// }
+// defer client.Stop()
+// result, err := client.BroadcastTxs([]ctypes.TxWithGroup{{Tx: []byte("123")}, {Tx: []byte("456"), Group: 2}})
+// ```
+//
+// > The above command returns JSON structured like this:
+//
+// ```json
+// {
+// 	"jsonrpc": "2.0",
+// 	"id": "",
+// 	"result": {
+// 		"results": [
+// 			{
+// 				"result": {
+// 					"code": "0",
+// 					"data": "",
+// 					"log": "",
+// 					"hash": "A665A45920422F9D417E4867EFDC4FB8A04A1F3FFF1FA07E998E86F7F7A27AE3"
+// 				},
+// 				"error": "",
+// 				"error_code": 0
+// 			},
+// 			{
+// 				"result": null,
+// 				"error": "Tx already exists in cache",
+// 				"error_code": -32001
+// 			}
+// 		]
+// 	}
+// }
+// ```
+//
+// ### Query Parameters
+//
+// | Parameter | Type          | Default | Required | Description                   |
+// |-----------+---------------+---------+----------+-------------------------------|
+// | txs       | []TxWithGroup | nil     | true     | The transactions, with groups |
+func BroadcastTxs(txs []ctypes.TxWithGroup) (*ctypes.ResultBroadcastTxs, error) {
+	if config.MaxBodyBytes > 0 {
+		size := int64(0)
+		for _, t := range txs {
+			size += int64(len(t.Tx))
+		}
+		if size > config.MaxBodyBytes {
+			return nil, fmt.Errorf("Txs are too big: %d bytes, max: %d", size, config.MaxBodyBytes)
+		}
+	}
+
+	results := make([]ctypes.BroadcastTxsResult, len(txs))
+	var wg sync.WaitGroup
+	for i, t := range txs {
+		i, tx := i, t.Tx
+		mem, err := getMempool(t.Group)
+		if err != nil {
+			results[i] = broadcastTxsError(err)
+			continue
+		}
+		wg.Add(1)
+		err = mem.CheckTxWithError(tx, func(res *abci.Response, err error) {
+			if err != nil {
+				results[i] = broadcastTxsError(err)
+			} else {
+				results[i].Result = broadcastTxResult(tx, res.GetCheckTx())
+			}
+			wg.Done()
+		})
+		if err != nil {
+			results[i] = broadcastTxsError(err)
+			wg.Done()
+		}
+	}
+	wg.Wait()
+	return &ctypes.ResultBroadcastTxs{Results: results}, nil
+}
+
+// CONTRACT: only returns error if mempool.CheckTx() errs, if the mempool
+// rejects the tx the app accepted, or if we timeout waiting for tx to commit.
 //
 // If CheckTx or DeliverTx fail, no error will be returned, but the returned result
 // will contain a non-OK ABCI code.
@@ -173,8 +276,9 @@ func BroadcastTxSync(tx types.Tx, group int32) (*ctypes.ResultBroadcastTx, error
 // |-----------+------+---------+----------+-----------------|
 // | tx        | Tx   | nil     | true     | The transaction |
 func BroadcastTxCommit(tx types.Tx, group int32) (*ctypes.ResultBroadcastTxCommit, error) {
-	if _, ok := mempool[group]; !ok {
-		return nil, errors.New("Mempool group is not exist.")
+	mem, err := getMempool(group)
+	if err != nil {
+		return nil, mempoolError(err)
 	}
 
 	// Subscribe to tx being committed in block.
@@ -182,7 +286,7 @@ func BroadcastTxCommit(tx types.Tx, group int32) (*ctypes.ResultBroadcastTxCommi
 	defer cancel()
 	deliverTxResCh := make(chan interface{}, 1)
 	q := types.EventQueryTxFor(tx)
-	err := eventBus.Subscribe(ctx, "mempool", q, deliverTxResCh)
+	err = eventBus.Subscribe(ctx, "mempool", q, deliverTxResCh)
 	if err != nil {
 		err = errors.Wrap(err, "failed to subscribe to tx")
 		logger.Error("Error on broadcast_tx_commit", "err", err)
@@ -203,15 +307,22 @@ func BroadcastTxCommit(tx types.Tx, group int32) (*ctypes.ResultBroadcastTxCommi
 
 	// Broadcast tx and wait for CheckTx result
 	checkTxResCh := make(chan *abci.Response, 1)
-	err = mempool[group].CheckTx(tx, func(res *abci.Response) {
+	var memErr error
+	err = mem.CheckTxWithError(tx, func(res *abci.Response, err error) {
+		memErr = err
 		checkTxResCh <- res
 	})
 	if err != nil {
 		logger.Error("Error on broadcastTxCommit", "err", err)
-		return nil, fmt.Errorf("Error on broadcastTxCommit: %v", err)
+		return nil, mempoolError(err)
 	}
 	checkTxResMsg := <-checkTxResCh
 	checkTxRes := checkTxResMsg.GetCheckTx()
+	if memErr != nil {
+		// the tx won't be committed
+		logger.Error("Error on broadcastTxCommit", "err", memErr)
+		return nil, mempoolError(memErr)
+	}
 	if checkTxRes.Code != abci.CodeTypeOK {
 		return &ctypes.ResultBroadcastTxCommit{
 			CheckTx:   *checkTxRes,
@@ -282,14 +393,15 @@ func BroadcastTxCommit(tx types.Tx, group int32) (*ctypes.ResultBroadcastTxCommi
 // | limit     | int  | 30      | false    | Maximum number of entries (max: 100) |
 // ```
 func UnconfirmedTxs(limit int, group int32) (*ctypes.ResultUnconfirmedTxs, error) {
-	if _, ok := mempool[group]; !ok {
-		return nil, errors.New("Mempool group is not exist.")
+	mem, err := getMempool(group)
+	if err != nil {
+		return nil, mempoolError(err)
 	}
 
 	// reuse per_page validator
 	limit = validatePerPage(limit)
 
-	txs := mempool[group].ReapMaxTxs(limit)
+	txs := mem.ReapMaxTxs(limit)
 	return &ctypes.ResultUnconfirmedTxs{N: len(txs), Txs: txs}, nil
 }
 
@@ -323,8 +435,67 @@ func UnconfirmedTxs(limit int, group int32) (*ctypes.ResultUnconfirmedTxs, error
 // }
 // ```
 func NumUnconfirmedTxs(group int32) (*ctypes.ResultUnconfirmedTxs, error) {
-	if _, ok := mempool[group]; !ok {
-		return nil, errors.New("Mempool group is not exist.")
+	mem, err := getMempool(group)
+	if err != nil {
+		return nil, mempoolError(err)
+	}
+	return &ctypes.ResultUnconfirmedTxs{N: mem.Size()}, nil
+}
+
+// getMempool returns the mempool of the given group.
+func getMempool(group int32) (*mempl.Mempool, error) {
+	mem, ok := mempools.Mempool(group)
+	if !ok {
+		return nil, mempl.ErrGroupNotExist{Group: group}
+	}
+	return mem, nil
+}
+
+// broadcastTxResult returns the result of the CheckTx response of tx.
+func broadcastTxResult(tx types.Tx, r *abci.ResponseCheckTx) *ctypes.ResultBroadcastTx {
+	return &ctypes.ResultBroadcastTx{
+		Code: r.Code,
+		Data: r.Data,
+		Log:  r.Log,
+		Hash: tx.Hash(),
+	}
+}
+
+// broadcastTxsError returns the result of a tx of a batch which could not be
+// checked or added to the mempool, with the code of the error of the mempool.
+func broadcastTxsError(err error) ctypes.BroadcastTxsResult {
+	res := ctypes.BroadcastTxsResult{Error: err.Error()}
+	if rpcErr, ok := mempoolError(err).(*rpctypes.RPCError); ok {
+		res.ErrorCode = rpcErr.Code
+	}
+	return res
+}
+
+// mempoolError returns an error of the mempool as an RPC error with a stable
+// code (see core_types), other errors are returned as is.
+func mempoolError(err error) error {
+	var code int
+	var msg string
+	switch err.(type) {
+	case mempl.ErrTxTooLarge:
+		code, msg = ctypes.CodeTxTooLarge, "Tx too large"
+	case mempl.ErrMempoolIsFull:
+		code, msg = ctypes.CodeMempoolIsFull, "Mempool is full"
+	case mempl.ErrGroupNotExist:
+		code, msg = ctypes.CodeGroupNotExist, "Mempool group does not exist"
+	case mempl.ErrPreCheck:
+		code, msg = ctypes.CodePreCheck, "Tx failed the pre check"
+	default:
+		switch err {
+		case mempl.ErrTxInCache:
+			code, msg = ctypes.CodeTxInCache, "Tx already exists in cache"
+		case mempl.ErrSenderLimit:
+			code, msg = ctypes.CodeSenderLimit, "Sender limit reached"
+		case mempl.ErrTxNotReplaced:
+			code, msg = ctypes.CodeTxNotReplaced, "Tx not replaced"
+		default:
+			return err
+		}
 	}
-	return &ctypes.ResultUnconfirmedTxs{N: mempool[group].Size()}, nil
+	return &rpctypes.RPCError{Code: code, Message: msg, Data: err.Error()}
 }
@@ -0,0 +1,112 @@
+package core
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	cfg "github.com/tendermint/tendermint/config"
+	mempl "github.com/tendermint/tendermint/mempool"
+	"github.com/tendermint/tendermint/proxy"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpctypes "github.com/tendermint/tendermint/rpc/lib/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestMempoolError(t *testing.T) {
+	testCases := []struct {
+		err  error
+		code int
+	}{
+		{mempl.ErrTxInCache, ctypes.CodeTxInCache},
+		{mempl.ErrTxTooLarge{Max: 10, Actual: 11}, ctypes.CodeTxTooLarge},
+		{mempl.ErrMempoolIsFull{NumTxs: 1, MaxTxs: 1, TxsBytes: 10}, ctypes.CodeMempoolIsFull},
+		{mempl.ErrGroupNotExist{Group: 1}, ctypes.CodeGroupNotExist},
+		{mempl.ErrSenderLimit, ctypes.CodeSenderLimit},
+		{mempl.ErrTxNotReplaced, ctypes.CodeTxNotReplaced},
+		{mempl.ErrPreCheck{Reason: errors.New("too big")}, ctypes.CodePreCheck},
+	}
+	for _, tc := range testCases {
+		err := mempoolError(tc.err)
+		require.IsType(t, &rpctypes.RPCError{}, err, tc.err.Error())
+		rpcErr := err.(*rpctypes.RPCError)
+		assert.Equal(t, tc.code, rpcErr.Code, tc.err.Error())
+		assert.Equal(t, tc.err.Error(), rpcErr.Data)
+	}
+
+	// other errors are returned as is
+	err := errors.New("app is down")
+	assert.Equal(t, err, mempoolError(err))
+}
+
+// senderApp accepts txs of the form "sender/nonce".
+type senderApp struct {
+	abci.BaseApplication
+}
+
+func (senderApp) CheckTx(tx []byte) abci.ResponseCheckTx {
+	parts := strings.Split(string(tx), "/")
+	nonce, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return abci.ResponseCheckTx{Code: 1}
+	}
+	return abci.ResponseCheckTx{Sender: parts[0], Nonce: nonce}
+}
+
+// singleMempool runs a single mempool, as group 0.
+type singleMempool struct {
+	mempoolGroups
+	mem *mempl.Mempool
+}
+
+func (mg singleMempool) Mempool(group int32) (*mempl.Mempool, bool) {
+	return mg.mem, group == 0
+}
+
+func TestBroadcastTxMempoolErrors(t *testing.T) {
+	appConn, err := proxy.NewLocalClientCreator(senderApp{}).NewABCIClient()
+	require.NoError(t, err)
+	require.NoError(t, appConn.Start())
+	defer appConn.Stop()
+
+	conf := cfg.TestMempoolConfig()
+	conf.Size = 2
+	conf.MaxTxsPerSender = 1
+	SetMempoolGroups(singleMempool{mem: mempl.NewMempool(conf, appConn, 0)})
+	defer SetMempoolGroups(nil)
+
+	requireCode := func(code int, err error) {
+		require.IsType(t, &rpctypes.RPCError{}, err)
+		assert.Equal(t, code, err.(*rpctypes.RPCError).Code)
+	}
+
+	_, err = BroadcastTxSync(types.Tx("alice/1"), 0)
+	require.NoError(t, err)
+	_, err = BroadcastTxSync(types.Tx("alice/1"), 0)
+	requireCode(ctypes.CodeTxInCache, err)
+	_, err = BroadcastTxSync(types.Tx("alice/2"), 0)
+	requireCode(ctypes.CodeSenderLimit, err)
+	_, err = BroadcastTxSync(types.Tx("alice/01"), 0)
+	requireCode(ctypes.CodeTxNotReplaced, err)
+	_, err = BroadcastTxSync(types.Tx("bob/1"), 0)
+	require.NoError(t, err)
+	_, err = BroadcastTxSync(types.Tx("carol/1"), 0)
+	requireCode(ctypes.CodeMempoolIsFull, err)
+	_, err = BroadcastTxSync(types.Tx("carol/1"), 1)
+	requireCode(ctypes.CodeGroupNotExist, err)
+
+	res, err := BroadcastTxs([]ctypes.TxWithGroup{
+		{Tx: types.Tx("carol/1")},
+		{Tx: types.Tx("carol/1"), Group: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Results, 2)
+	assert.Nil(t, res.Results[0].Result)
+	assert.Equal(t, ctypes.CodeMempoolIsFull, res.Results[0].ErrorCode)
+	assert.Equal(t, ctypes.CodeGroupNotExist, res.Results[1].ErrorCode)
+}
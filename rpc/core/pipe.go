@@ -1,6 +1,7 @@
 package core
 
 import (
+	cfg "github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/consensus"
 	"github.com/tendermint/tendermint/crypto"
 	dbm "github.com/tendermint/tendermint/libs/db"
@@ -18,6 +19,12 @@ const (
 	// see README
 	defaultPerPage = 30
 	maxPerPage     = 100
+
+	// deepest tx reachable by page number in tx_search, the cursor must be
+	// used to read further
+	maxTxSearchDepth = 10000
+	// deepest block reachable by page number in block_search
+	maxBlockSearchDepth = 10000
 )
 
 var subscribeTimeout = rpcserver.WriteTimeout / 2
@@ -45,6 +52,14 @@ type peers interface {
 	Peers() p2p.IPeerSet
 }
 
+type mempoolGroups interface {
+	Mempool(group int32) (*mempl.Mempool, bool)
+	Mempools() []*mempl.Mempool
+	GroupConfig(group int32) *cfg.MempoolGroupConfig
+	AddGroup(*cfg.MempoolGroupConfig) error
+	RemoveGroup(group int32) error
+}
+
 //----------------------------------------------
 // These package level globals come with setters
 // that are expected to be called only once, on startup
@@ -66,11 +81,14 @@ var (
 	genDoc           *types.GenesisDoc // cache the genesis structure
 	addrBook         p2p.AddrBook
 	txIndexer        txindex.TxIndexer
+	blockIndexer     txindex.BlockIndexer
 	consensusReactor *consensus.ConsensusReactor
 	eventBus         *types.EventBus // thread safe
-	mempool          map[int32]*mempl.Mempool
+	mempools         mempoolGroups   // thread safe
 
 	logger log.Logger
+
+	config cfg.RPCConfig
 )
 
 func SetStateDB(db dbm.DB) {
@@ -81,8 +99,8 @@ func SetBlockStore(bs sm.BlockStore) {
 	blockStore = bs
 }
 
-func SetMempool(mem map[int32]*mempl.Mempool) {
-	mempool = mem
+func SetMempoolGroups(mg mempoolGroups) {
+	mempools = mg
 }
 
 func SetEvidencePool(evpool sm.EvidencePool) {
@@ -121,6 +139,10 @@ func SetTxIndexer(indexer txindex.TxIndexer) {
 	txIndexer = indexer
 }
 
+func SetBlockIndexer(indexer txindex.BlockIndexer) {
+	blockIndexer = indexer
+}
+
 func SetConsensusReactor(conR *consensus.ConsensusReactor) {
 	consensusReactor = conR
 }
@@ -133,6 +155,11 @@ func SetEventBus(b *types.EventBus) {
 	eventBus = b
 }
 
+// SetConfig sets an RPCConfig.
+func SetConfig(c cfg.RPCConfig) {
+	config = c
+}
+
 func validatePage(page, perPage, totalCount int) int {
 	if perPage < 1 {
 		return 1
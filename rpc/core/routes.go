@@ -22,19 +22,23 @@ var Routes = map[string]*rpc.RPCFunc{
 	"block_results":        rpc.NewRPCFunc(BlockResults, "height"),
 	"commit":               rpc.NewRPCFunc(Commit, "height"),
 	"tx":                   rpc.NewRPCFunc(Tx, "hash,prove"),
-	"tx_search":            rpc.NewRPCFunc(TxSearch, "query,prove,page,per_page"),
-	"tx_search_bs":         rpc.NewRPCFunc(TxSearch_BS, "query,prove,page,per_page"),
-	"validators":           rpc.NewRPCFunc(Validators, "height"),
+	"tx_search":            rpc.NewRPCFunc(TxSearch, "query,prove,page,per_page,order_by,cursor"),
+	"tx_search_bs":         rpc.NewRPCFunc(TxSearch_BS, "query,prove,page,per_page,order_by,cursor"),
+	"block_search":         rpc.NewRPCFunc(BlockSearch, "query,page,per_page,order_by"),
+	"validators":           rpc.NewRPCFunc(Validators, "height,group"),
 	"dump_consensus_state": rpc.NewRPCFunc(DumpConsensusState, ""),
 	"consensus_state":      rpc.NewRPCFunc(ConsensusState, ""),
 	"consensus_params":     rpc.NewRPCFunc(ConsensusParams, "height"),
 	"unconfirmed_txs":      rpc.NewRPCFunc(UnconfirmedTxs, "limit"),
 	"num_unconfirmed_txs":  rpc.NewRPCFunc(NumUnconfirmedTxs, ""),
+	"pending_evidence":     rpc.NewRPCFunc(PendingEvidence, ""),
 
 	// broadcast API
 	"broadcast_tx_commit": rpc.NewRPCFunc(BroadcastTxCommit, "tx,group"),
 	"broadcast_tx_sync":   rpc.NewRPCFunc(BroadcastTxSync, "tx,group"),
 	"broadcast_tx_async":  rpc.NewRPCFunc(BroadcastTxAsync, "tx,group"),
+	"broadcast_txs":       rpc.NewRPCFunc(BroadcastTxs, "txs"),
+	"broadcast_evidence":  rpc.NewRPCFunc(BroadcastEvidence, "evidence"),
 
 	// abci API
 	"abci_query": rpc.NewRPCFunc(ABCIQuery, "group,path,data,height,prove"),
@@ -46,6 +50,8 @@ func AddUnsafeRoutes() {
 	Routes["dial_seeds"] = rpc.NewRPCFunc(UnsafeDialSeeds, "seeds")
 	Routes["dial_peers"] = rpc.NewRPCFunc(UnsafeDialPeers, "peers,persistent")
 	Routes["unsafe_flush_mempool"] = rpc.NewRPCFunc(UnsafeFlushMempool, "")
+	Routes["unsafe_add_mempool_group"] = rpc.NewRPCFunc(UnsafeAddMempoolGroup, "group,name,size,cache_size,recheck,broadcast,wal_dir")
+	Routes["unsafe_remove_mempool_group"] = rpc.NewRPCFunc(UnsafeRemoveMempoolGroup, "group")
 
 	// profiler API
 	Routes["unsafe_start_cpu_profiler"] = rpc.NewRPCFunc(UnsafeStartCPUProfiler, "filename")
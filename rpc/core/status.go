@@ -57,6 +57,10 @@ import (
 //   		"latest_app_hash": "0000000000000000",
 //   		"latest_block_height": "18",
 //   		"latest_block_time": "2018-09-17T11:42:19.149920551Z",
+//   		"earliest_block_hash": "790BA84C3545FCCC49A5C629CEE6EA58A6E875C3862175BDC11EE7AF54703501",
+//   		"earliest_app_hash": "",
+//   		"earliest_block_height": "1",
+//   		"earliest_block_time": "2018-09-17T11:41:59.123374126Z",
 //   		"catching_up": false
 //   	},
 //   	"validator_info": {
@@ -92,6 +96,20 @@ func Status() (*ctypes.ResultStatus, error) {
 
 	latestBlockTime := time.Unix(0, latestBlockTimeNano)
 
+	var (
+		earliestBlockHeight   = blockStore.Base()
+		earliestBlockHash     cmn.HexBytes
+		earliestAppHash       cmn.HexBytes
+		earliestBlockTimeNano int64
+	)
+	if earliestBlockMeta := blockStore.LoadBlockMeta(earliestBlockHeight); earliestBlockMeta != nil {
+		earliestBlockHash = earliestBlockMeta.BlockID.Hash
+		earliestAppHash = earliestBlockMeta.Header.AppHash
+		earliestBlockTimeNano = earliestBlockMeta.Header.Time.UnixNano()
+	}
+
+	earliestBlockTime := time.Unix(0, earliestBlockTimeNano)
+
 	var votingPower int64
 	if val := validatorAtHeight(latestHeight); val != nil {
 		votingPower = val.VotingPower
@@ -104,7 +122,13 @@ func Status() (*ctypes.ResultStatus, error) {
 			LatestAppHash:     latestAppHash,
 			LatestBlockHeight: latestHeight,
 			LatestBlockTime:   latestBlockTime,
-			CatchingUp:        consensusReactor.FastSync(),
+
+			EarliestBlockHash:   earliestBlockHash,
+			EarliestAppHash:     earliestAppHash,
+			EarliestBlockHeight: earliestBlockHeight,
+			EarliestBlockTime:   earliestBlockTime,
+
+			CatchingUp: consensusReactor.FastSync(),
 		},
 		ValidatorInfo: ctypes.ValidatorInfo{
 			Address:     pubKey.Address(),
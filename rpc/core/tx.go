@@ -3,10 +3,11 @@ package core
 import (
 	"fmt"
 
-	cmn "github.com/tendermint/tendermint/libs/common"
-
+	"github.com/tendermint/tendermint/crypto/merkle"
 	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/state/txindex"
 	"github.com/tendermint/tendermint/state/txindex/null"
 	"github.com/tendermint/tendermint/types"
 )
@@ -71,11 +72,14 @@ import (
 // ### Returns
 //
 // - `proof`: the `types.TxProof` object
+// - `result_proof`: the `merkle.SimpleProof` of the code and data of the
+// result in the `LastResultsHash` of the next block
 // - `tx`: `[]byte` - the transaction
 // - `tx_result`: the `abci.Result` object
 // - `index`: `int` - index of the transaction
 // - `height`: `int` - height of the block where this transaction was in
 // - `hash`: `[]byte` - hash of the transaction
+// - `group`: `int32` - mempool group of the transaction
 func Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
 
 	// if index is disabled, return error
@@ -95,24 +99,54 @@ func Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
 	height := r.Height
 	index := r.Index
 
-	var proof types.TxProof
+	var (
+		proof       types.TxProof
+		resultProof merkle.SimpleProof
+	)
 	if prove {
-		block := blockStore.LoadBlock(height)
-		proof = block.Data.Txs.Proof(int(index)) // XXX: overflow on 32-bit machines
+		if proof, resultProof, err = txProofs(height, index); err != nil {
+			return nil, err
+		}
 	}
 
 	return &ctypes.ResultTx{
-		Hash:     hash,
-		Height:   height,
-		Index:    uint32(index),
-		TxResult: r.Result,
-		Tx:       r.Tx,
-		Proof:    proof,
+		Hash:        hash,
+		Height:      height,
+		Index:       uint32(index),
+		TxResult:    r.Result,
+		Tx:          r.Tx,
+		Proof:       proof,
+		ResultProof: resultProof,
+		Group:       r.Group,
 	}, nil
 }
 
+// txProofs returns the proof of the tx at index in the block at height, and
+// the proof of its result in the LastResultsHash of the next block.
+func txProofs(height int64, index uint32) (types.TxProof, merkle.SimpleProof, error) {
+	block := blockStore.LoadBlock(height)
+	if block == nil {
+		return types.TxProof{}, merkle.SimpleProof{}, fmt.Errorf("Block %d not found", height)
+	}
+	abciResponses, err := sm.LoadABCIResponses(stateDB, height)
+	if err != nil {
+		return types.TxProof{}, merkle.SimpleProof{}, err
+	}
+	results := types.NewResults(abciResponses.DeliverTx)
+	if int(index) >= len(block.Data.Txs) || int(index) >= len(results) {
+		return types.TxProof{}, merkle.SimpleProof{}, fmt.Errorf("Tx %d not found in block %d", index, height)
+	}
+	// XXX: overflow on 32-bit machines
+	return block.Data.Txs.Proof(int(index)), results.ProveResult(int(index)), nil
+}
+
 // TxSearch allows you to query for multiple transactions results. It returns a
-// list of transactions (maximum ?per_page entries) and the total count.
+// list of transactions (maximum ?per_page entries), ordered by height and
+// index, and the total count.
+//
+// Pages can be read by number, up to the first 10000 transactions, or with
+// the cursor: when more transactions follow the returned ones, `next_cursor`
+// is set, and passing it as ?cursor returns the next page.
 //
 // ```shell
 // curl "localhost:26657/tx_search?query=\"account.owner='Ivan'\"&prove=true"
@@ -126,7 +160,7 @@ func Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
 // }
 // defer client.Stop()
 // q, err := tmquery.New("account.owner='Ivan'")
-// tx, err := client.TxSearch(q, true)
+// tx, err := client.TxSearch(q.String(), true, 1, 30, "asc", "")
 // ```
 //
 // > The above command returns JSON structured like this:
@@ -160,7 +194,8 @@ func Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
 //         "hash": "2B8EC32BA2579B3B8606E42C06DE2F7AFA2556EF"
 //       }
 //     ],
-//     "total_count": "1"
+//     "total_count": "1",
+//     "next_cursor": ""
 //   }
 // }
 // ```
@@ -173,16 +208,26 @@ func Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
 // | prove     | bool   | false   | false    | Include proofs of the transactions inclusion in the block |
 // | page      | int    | 1       | false    | Page number (1-based)                                     |
 // | per_page  | int    | 30      | false    | Number of entries per page (max: 100)                     |
+// | order_by  | string | "asc"   | false    | Order of the transactions: "asc" or "desc"                |
+// | cursor    | string | ""      | false    | Continue after the given `next_cursor` (page is ignored)  |
 //
 // ### Returns
 //
+// - `total_count`: `int` - number of transactions matching the query
+// - `next_cursor`: `string` - cursor of the next page, empty on the last one
+//
+// And for each transaction:
+//
 // - `proof`: the `types.TxProof` object
+// - `result_proof`: the `merkle.SimpleProof` of the code and data of the
+// result in the `LastResultsHash` of the next block
 // - `tx`: `[]byte` - the transaction
 // - `tx_result`: the `abci.Result` object
 // - `index`: `int` - index of the transaction
 // - `height`: `int` - height of the block where this transaction was in
 // - `hash`: `[]byte` - hash of the transaction
-func TxSearch(query string, prove bool, page, perPage int) (*ctypes.ResultTxSearch, error) {
+// - `group`: `int32` - mempool group of the transaction
+func TxSearch(query string, prove bool, page, perPage int, orderBy, cursor string) (*ctypes.ResultTxSearch, error) {
 	// if index is disabled, return error
 	if _, ok := txIndexer.(*null.TxIndex); ok {
 		return nil, fmt.Errorf("Transaction indexing is disabled")
@@ -193,86 +238,77 @@ func TxSearch(query string, prove bool, page, perPage int) (*ctypes.ResultTxSear
 		return nil, err
 	}
 
-	results, err := txIndexer.Search(q)
-	if err != nil {
-		return nil, err
+	opts := txindex.SearchOptions{Limit: validatePerPage(perPage)}
+	switch orderBy {
+	case "asc", "":
+	case "desc":
+		opts.Descending = true
+	default:
+		return nil, fmt.Errorf("expected order_by to be either `asc` or `desc` or empty")
 	}
-
-	totalCount := len(results)
-	perPage = validatePerPage(perPage)
-	page = validatePage(page, perPage, totalCount)
-	skipCount := validateSkipCount(page, perPage)
-
-	apiResults := make([]*ctypes.ResultTx, cmn.MinInt(perPage, totalCount-skipCount))
-	var proof types.TxProof
-	// if there's no tx in the results array, we don't need to loop through the apiResults array
-	for i := 0; i < len(apiResults); i++ {
-		r := results[skipCount+i]
-		height := r.Height
-		index := r.Index
-
-		if prove {
-			block := blockStore.LoadBlock(height)
-			proof = block.Data.Txs.Proof(int(index)) // XXX: overflow on 32-bit machines
+	if cursor != "" {
+		after, err := txindex.ParseCursor(cursor)
+		if err != nil {
+			return nil, err
 		}
-
-		apiResults[i] = &ctypes.ResultTx{
-			Hash:     r.Tx.Hash(),
-			Height:   height,
-			Index:    index,
-			TxResult: r.Result,
-			Tx:       r.Tx,
-			Proof:    proof,
+		opts.After = &after
+	} else {
+		opts.Skip = validateSkipCount(page, opts.Limit)
+		if opts.Skip+opts.Limit > maxTxSearchDepth {
+			return nil, fmt.Errorf("page %d is too deep, use the cursor to read past %d txs", page, maxTxSearchDepth)
 		}
 	}
 
-	return &ctypes.ResultTxSearch{Txs: apiResults, TotalCount: totalCount}, nil
-}
-
-func TxSearch_BS(query string, prove bool, page, perPage int) (*ctypes.ResultTxSearch, error) {
-	// if index is disabled, return error
-	if _, ok := txIndexer.(*null.TxIndex); ok {
-		return nil, fmt.Errorf("Transaction indexing is disabled")
-	}
-
-	q, err := tmquery.New(query)
+	res, err := txIndexer.Search(q, opts)
 	if err != nil {
 		return nil, err
 	}
-
-	results, err := txIndexer.Search(q)
-	if err != nil {
-		return nil, err
+	// past the last page, return the last page
+	if cursor == "" && len(res.Txs) == 0 && res.Total > 0 {
+		page = validatePage(page, opts.Limit, res.Total)
+		opts.Skip = validateSkipCount(page, opts.Limit)
+		if res, err = txIndexer.Search(q, opts); err != nil {
+			return nil, err
+		}
 	}
 
-	totalCount := len(results)
-	perPage = validatePerPage(perPage)
-	page = validatePage(page, perPage, totalCount)
-	//skipCount := validateSkipCount(page, perPage)
-
-	//apiResults := make([]*ctypes.ResultTx, cmn.MinInt(perPage, totalCount-skipCount))
-	var apiResults []*ctypes.ResultTx
-	var proof types.TxProof
-	// if there's no tx in the results array, we don't need to loop through the apiResults array
-	for i := 0; i < totalCount; i++ {
-		r := results[i]
+	apiResults := make([]*ctypes.ResultTx, len(res.Txs))
+	var (
+		proof       types.TxProof
+		resultProof merkle.SimpleProof
+	)
+	for i, r := range res.Txs {
 		height := r.Height
 		index := r.Index
 
 		if prove {
-			block := blockStore.LoadBlock(height)
-			proof = block.Data.Txs.Proof(int(index)) // XXX: overflow on 32-bit machines
+			if proof, resultProof, err = txProofs(height, index); err != nil {
+				return nil, err
+			}
 		}
-		rt := &ctypes.ResultTx{
-			Hash:     r.Tx.Hash(),
-			Height:   height,
-			Index:    index,
-			TxResult: r.Result,
-			Tx:       r.Tx,
-			Proof:    proof,
+
+		apiResults[i] = &ctypes.ResultTx{
+			Hash:        r.Tx.Hash(),
+			Height:      height,
+			Index:       index,
+			TxResult:    r.Result,
+			Tx:          r.Tx,
+			Proof:       proof,
+			ResultProof: resultProof,
+			Group:       r.Group,
 		}
-		apiResults = append(apiResults, rt)
 	}
 
-	return &ctypes.ResultTxSearch{Txs: apiResults, TotalCount: totalCount}, nil
+	result := &ctypes.ResultTxSearch{Txs: apiResults, TotalCount: res.Total}
+	if res.Next != nil {
+		result.NextCursor = res.Next.String()
+	}
+	return result, nil
+}
+
+// TxSearch_BS is the same as TxSearch. It used to return all the matching
+// transactions at once, ignoring page and per_page; it is paged like
+// TxSearch now.
+func TxSearch_BS(query string, prove bool, page, perPage int, orderBy, cursor string) (*ctypes.ResultTxSearch, error) {
+	return TxSearch(query, prove, page, perPage, orderBy, cursor)
 }
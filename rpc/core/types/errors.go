@@ -0,0 +1,24 @@
+package core_types
+
+// JSON-RPC error codes of the errors of the mempool, in the range reserved for
+// server errors. They are stable, so clients can tell the errors apart without
+// parsing the messages.
+const (
+	// CodeTxInCache means the tx was seen earlier.
+	CodeTxInCache = -32001
+	// CodeTxTooLarge means the tx is larger than the mempool's max_tx_bytes,
+	// or too large to fit in a block.
+	CodeTxTooLarge = -32002
+	// CodeMempoolIsFull means the mempool is full and the tx doesn't have a
+	// higher priority than the txs in it.
+	CodeMempoolIsFull = -32003
+	// CodeGroupNotExist means the node doesn't run the tx's mempool group.
+	CodeGroupNotExist = -32004
+	// CodeSenderLimit means the sender of the tx reached its limits.
+	CodeSenderLimit = -32005
+	// CodePreCheck means the tx failed the mempool's pre check.
+	CodePreCheck = -32006
+	// CodeTxNotReplaced means the mempool has a tx of the same sender and
+	// nonce, with a higher or equal priority.
+	CodeTxNotReplaced = -32007
+)
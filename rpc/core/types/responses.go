@@ -6,6 +6,7 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/merkle"
 	cmn "github.com/tendermint/tendermint/libs/common"
 
 	"github.com/tendermint/tendermint/p2p"
@@ -62,7 +63,14 @@ type SyncInfo struct {
 	LatestAppHash     cmn.HexBytes `json:"latest_app_hash"`
 	LatestBlockHeight int64        `json:"latest_block_height"`
 	LatestBlockTime   time.Time    `json:"latest_block_time"`
-	CatchingUp        bool         `json:"catching_up"`
+
+	// the node has no blocks below the earliest height, they were pruned
+	EarliestBlockHash   cmn.HexBytes `json:"earliest_block_hash"`
+	EarliestAppHash     cmn.HexBytes `json:"earliest_app_hash"`
+	EarliestBlockHeight int64        `json:"earliest_block_height"`
+	EarliestBlockTime   time.Time    `json:"earliest_block_time"`
+
+	CatchingUp bool `json:"catching_up"`
 }
 
 // Info about the node's validator
@@ -152,6 +160,27 @@ type ResultBroadcastTx struct {
 	Hash cmn.HexBytes `json:"hash"`
 }
 
+// A tx and the mempool group it is broadcast to
+type TxWithGroup struct {
+	Tx    types.Tx `json:"tx"`
+	Group int32    `json:"group"`
+}
+
+// CheckTx results of a batch of txs, in the order of the txs
+type ResultBroadcastTxs struct {
+	Results []BroadcastTxsResult `json:"results"`
+}
+
+// CheckTx result of a single tx of a batch. Error is set if the tx could not
+// be checked (e.g. it is already in the cache or the group is unknown) or the
+// mempool rejected it (e.g. it is full), with the code of the error of the
+// mempool if any (see the Code* constants)
+type BroadcastTxsResult struct {
+	Result    *ResultBroadcastTx `json:"result"`
+	Error     string             `json:"error"`
+	ErrorCode int                `json:"error_code"`
+}
+
 // CheckTx and DeliverTx results
 type ResultBroadcastTxCommit struct {
 	CheckTx   abci.ResponseCheckTx   `json:"check_tx"`
@@ -162,18 +191,38 @@ type ResultBroadcastTxCommit struct {
 
 // Result of querying for a tx
 type ResultTx struct {
-	Hash     cmn.HexBytes           `json:"hash"`
-	Height   int64                  `json:"height"`
-	Index    uint32                 `json:"index"`
-	TxResult abci.ResponseDeliverTx `json:"tx_result"`
-	Tx       types.Tx               `json:"tx"`
-	Proof    types.TxProof          `json:"proof,omitempty"`
+	Hash        cmn.HexBytes           `json:"hash"`
+	Height      int64                  `json:"height"`
+	Index       uint32                 `json:"index"`
+	TxResult    abci.ResponseDeliverTx `json:"tx_result"`
+	Tx          types.Tx               `json:"tx"`
+	Proof       types.TxProof          `json:"proof,omitempty"`
+	ResultProof merkle.SimpleProof     `json:"result_proof,omitempty"` // of the ABCIResult in the next LastResultsHash
+	Group       int32                  `json:"group"`
 }
 
 // Result of searching for txs
 type ResultTxSearch struct {
 	Txs        []*ResultTx `json:"txs"`
 	TotalCount int         `json:"total_count"`
+	NextCursor string      `json:"next_cursor"`
+}
+
+// Result of searching for blocks
+type ResultBlockSearch struct {
+	Blocks     []*types.BlockMeta `json:"blocks"`
+	TotalCount int                `json:"total_count"`
+}
+
+// Hash of the broadcast evidence
+type ResultBroadcastEvidence struct {
+	Hash cmn.HexBytes `json:"hash"`
+}
+
+// List of evidence pool evidence
+type ResultPendingEvidence struct {
+	N        int              `json:"n_evidence"`
+	Evidence []types.Evidence `json:"evidence"`
 }
 
 // List of mempool txs
@@ -195,11 +244,13 @@ type ResultABCIQuery struct {
 
 // empty results
 type (
-	ResultUnsafeFlushMempool struct{}
-	ResultUnsafeProfile      struct{}
-	ResultSubscribe          struct{}
-	ResultUnsubscribe        struct{}
-	ResultHealth             struct{}
+	ResultUnsafeFlushMempool       struct{}
+	ResultUnsafeAddMempoolGroup    struct{}
+	ResultUnsafeRemoveMempoolGroup struct{}
+	ResultUnsafeProfile            struct{}
+	ResultSubscribe                struct{}
+	ResultUnsubscribe              struct{}
+	ResultHealth                   struct{}
 )
 
 // Event data from a subscription
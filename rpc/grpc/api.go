@@ -5,6 +5,7 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	core "github.com/tendermint/tendermint/rpc/core"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 )
 
 type broadcastAPI struct {
@@ -35,3 +36,27 @@ func (bapi *broadcastAPI) BroadcastTx(ctx context.Context, req *RequestBroadcast
 		},
 	}, nil
 }
+
+func (bapi *broadcastAPI) BroadcastTxs(ctx context.Context, req *RequestBroadcastTxs) (*ResponseBroadcastTxs, error) {
+	txs := make([]ctypes.TxWithGroup, len(req.Txs))
+	for i, tx := range req.Txs {
+		txs[i] = ctypes.TxWithGroup{Tx: tx.Tx, Group: tx.Group}
+	}
+	res, err := core.BroadcastTxs(txs)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*BroadcastTxsResult, len(res.Results))
+	for i, r := range res.Results {
+		results[i] = &BroadcastTxsResult{Error: r.Error}
+		if r.Result != nil {
+			results[i].CheckTx = &abci.ResponseCheckTx{
+				Code: r.Result.Code,
+				Data: r.Result.Data,
+				Log:  r.Result.Log,
+			}
+		}
+	}
+	return &ResponseBroadcastTxs{Results: results}, nil
+}
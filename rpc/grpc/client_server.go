@@ -12,12 +12,19 @@ import (
 // Config is an gRPC server configuration.
 type Config struct {
 	MaxOpenConnections int
+	// MaxBodyBytes limits the size of the received messages, 0 means the
+	// gRPC default.
+	MaxBodyBytes int64
 }
 
 // StartGRPCServer starts a new gRPC BroadcastAPIServer using the given net.Listener.
 // NOTE: This function blocks - you may want to call it in a go-routine.
-func StartGRPCServer(ln net.Listener) error {
-	grpcServer := grpc.NewServer()
+func StartGRPCServer(ln net.Listener, config Config) error {
+	var opts []grpc.ServerOption
+	if config.MaxBodyBytes > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(int(config.MaxBodyBytes)))
+	}
+	grpcServer := grpc.NewServer(opts...)
 	RegisterBroadcastAPIServer(grpcServer, &broadcastAPI{})
 	return grpcServer.Serve(ln)
 }
@@ -31,3 +31,28 @@ func TestBroadcastTx(t *testing.T) {
 	require.EqualValues(0, res.CheckTx.Code)
 	require.EqualValues(0, res.DeliverTx.Code)
 }
+
+func TestBroadcastTxs(t *testing.T) {
+	require := require.New(t)
+	req := &core_grpc.RequestBroadcastTxs{Txs: []*core_grpc.RequestBroadcastTx{
+		{Tx: []byte("batch=tx1")},
+		{Tx: []byte("batch=tx2")},
+		{Tx: []byte("batch=tx1")},
+		{Tx: []byte("batch=tx3"), Group: 7},
+	}}
+	res, err := rpctest.GetGRPCClient().BroadcastTxs(context.Background(), req)
+	require.Nil(err, "%+v", err)
+	require.Len(res.Results, 4)
+
+	// results are in the order of the txs
+	for _, r := range res.Results[:2] {
+		require.Empty(r.Error)
+		require.EqualValues(0, r.CheckTx.Code)
+	}
+	// duplicate tx
+	require.NotEmpty(res.Results[2].Error)
+	require.Nil(res.Results[2].CheckTx)
+	// unknown group
+	require.NotEmpty(res.Results[3].Error)
+	require.Nil(res.Results[3].CheckTx)
+}
@@ -119,6 +119,60 @@ func (m *RequestBroadcastTx) GetTx() []byte {
 	return nil
 }
 
+func (m *RequestBroadcastTx) GetGroup() int32 {
+	if m != nil {
+		return m.Group
+	}
+	return 0
+}
+
+type RequestBroadcastTxs struct {
+	Txs                  []*RequestBroadcastTx `protobuf:"bytes,1,rep,name=txs" json:"txs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RequestBroadcastTxs) Reset()         { *m = RequestBroadcastTxs{} }
+func (m *RequestBroadcastTxs) String() string { return proto.CompactTextString(m) }
+func (*RequestBroadcastTxs) ProtoMessage()    {}
+func (*RequestBroadcastTxs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_types_48bb8d9591d37e66, []int{2}
+}
+func (m *RequestBroadcastTxs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestBroadcastTxs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestBroadcastTxs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RequestBroadcastTxs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestBroadcastTxs.Merge(dst, src)
+}
+func (m *RequestBroadcastTxs) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestBroadcastTxs) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestBroadcastTxs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestBroadcastTxs proto.InternalMessageInfo
+
+func (m *RequestBroadcastTxs) GetTxs() []*RequestBroadcastTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
 type ResponsePing struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -129,7 +183,7 @@ func (m *ResponsePing) Reset()         { *m = ResponsePing{} }
 func (m *ResponsePing) String() string { return proto.CompactTextString(m) }
 func (*ResponsePing) ProtoMessage()    {}
 func (*ResponsePing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_types_48bb8d9591d37e66, []int{2}
+	return fileDescriptor_types_48bb8d9591d37e66, []int{3}
 }
 func (m *ResponsePing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -170,7 +224,7 @@ func (m *ResponseBroadcastTx) Reset()         { *m = ResponseBroadcastTx{} }
 func (m *ResponseBroadcastTx) String() string { return proto.CompactTextString(m) }
 func (*ResponseBroadcastTx) ProtoMessage()    {}
 func (*ResponseBroadcastTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_types_48bb8d9591d37e66, []int{3}
+	return fileDescriptor_types_48bb8d9591d37e66, []int{4}
 }
 func (m *ResponseBroadcastTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,15 +267,125 @@ func (m *ResponseBroadcastTx) GetDeliverTx() *types.ResponseDeliverTx {
 	return nil
 }
 
+type ResponseBroadcastTxs struct {
+	Results              []*BroadcastTxsResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ResponseBroadcastTxs) Reset()         { *m = ResponseBroadcastTxs{} }
+func (m *ResponseBroadcastTxs) String() string { return proto.CompactTextString(m) }
+func (*ResponseBroadcastTxs) ProtoMessage()    {}
+func (*ResponseBroadcastTxs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_types_48bb8d9591d37e66, []int{5}
+}
+func (m *ResponseBroadcastTxs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseBroadcastTxs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseBroadcastTxs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ResponseBroadcastTxs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseBroadcastTxs.Merge(dst, src)
+}
+func (m *ResponseBroadcastTxs) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseBroadcastTxs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseBroadcastTxs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseBroadcastTxs proto.InternalMessageInfo
+
+func (m *ResponseBroadcastTxs) GetResults() []*BroadcastTxsResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// error is set if CheckTx couldn't be run for the tx, eg. it is in the cache,
+// or if the mempool rejected the tx the app accepted
+type BroadcastTxsResult struct {
+	CheckTx              *types.ResponseCheckTx `protobuf:"bytes,1,opt,name=check_tx,json=checkTx" json:"check_tx,omitempty"`
+	Error                string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *BroadcastTxsResult) Reset()         { *m = BroadcastTxsResult{} }
+func (m *BroadcastTxsResult) String() string { return proto.CompactTextString(m) }
+func (*BroadcastTxsResult) ProtoMessage()    {}
+func (*BroadcastTxsResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_types_48bb8d9591d37e66, []int{6}
+}
+func (m *BroadcastTxsResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BroadcastTxsResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BroadcastTxsResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *BroadcastTxsResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BroadcastTxsResult.Merge(dst, src)
+}
+func (m *BroadcastTxsResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BroadcastTxsResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BroadcastTxsResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BroadcastTxsResult proto.InternalMessageInfo
+
+func (m *BroadcastTxsResult) GetCheckTx() *types.ResponseCheckTx {
+	if m != nil {
+		return m.CheckTx
+	}
+	return nil
+}
+
+func (m *BroadcastTxsResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*RequestPing)(nil), "core_grpc.RequestPing")
 	golang_proto.RegisterType((*RequestPing)(nil), "core_grpc.RequestPing")
 	proto.RegisterType((*RequestBroadcastTx)(nil), "core_grpc.RequestBroadcastTx")
 	golang_proto.RegisterType((*RequestBroadcastTx)(nil), "core_grpc.RequestBroadcastTx")
+	proto.RegisterType((*RequestBroadcastTxs)(nil), "core_grpc.RequestBroadcastTxs")
+	golang_proto.RegisterType((*RequestBroadcastTxs)(nil), "core_grpc.RequestBroadcastTxs")
 	proto.RegisterType((*ResponsePing)(nil), "core_grpc.ResponsePing")
 	golang_proto.RegisterType((*ResponsePing)(nil), "core_grpc.ResponsePing")
 	proto.RegisterType((*ResponseBroadcastTx)(nil), "core_grpc.ResponseBroadcastTx")
 	golang_proto.RegisterType((*ResponseBroadcastTx)(nil), "core_grpc.ResponseBroadcastTx")
+	proto.RegisterType((*ResponseBroadcastTxs)(nil), "core_grpc.ResponseBroadcastTxs")
+	golang_proto.RegisterType((*ResponseBroadcastTxs)(nil), "core_grpc.ResponseBroadcastTxs")
+	proto.RegisterType((*BroadcastTxsResult)(nil), "core_grpc.BroadcastTxsResult")
+	golang_proto.RegisterType((*BroadcastTxsResult)(nil), "core_grpc.BroadcastTxsResult")
 }
 func (this *RequestPing) Equal(that interface{}) bool {
 	if that == nil {
@@ -269,6 +433,41 @@ func (this *RequestBroadcastTx) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Tx, that1.Tx) {
 		return false
 	}
+	if this.Group != that1.Group {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *RequestBroadcastTxs) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RequestBroadcastTxs)
+	if !ok {
+		that2, ok := that.(RequestBroadcastTxs)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Txs) != len(that1.Txs) {
+		return false
+	}
+	for i := range this.Txs {
+		if !this.Txs[i].Equal(that1.Txs[i]) {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -328,6 +527,68 @@ func (this *ResponseBroadcastTx) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ResponseBroadcastTxs) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResponseBroadcastTxs)
+	if !ok {
+		that2, ok := that.(ResponseBroadcastTxs)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Results) != len(that1.Results) {
+		return false
+	}
+	for i := range this.Results {
+		if !this.Results[i].Equal(that1.Results[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *BroadcastTxsResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BroadcastTxsResult)
+	if !ok {
+		that2, ok := that.(BroadcastTxsResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.CheckTx.Equal(that1.CheckTx) {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -343,6 +604,7 @@ const _ = grpc.SupportPackageIsVersion4
 type BroadcastAPIClient interface {
 	Ping(ctx context.Context, in *RequestPing, opts ...grpc.CallOption) (*ResponsePing, error)
 	BroadcastTx(ctx context.Context, in *RequestBroadcastTx, opts ...grpc.CallOption) (*ResponseBroadcastTx, error)
+	BroadcastTxs(ctx context.Context, in *RequestBroadcastTxs, opts ...grpc.CallOption) (*ResponseBroadcastTxs, error)
 }
 
 type broadcastAPIClient struct {
@@ -371,11 +633,21 @@ func (c *broadcastAPIClient) BroadcastTx(ctx context.Context, in *RequestBroadca
 	return out, nil
 }
 
+func (c *broadcastAPIClient) BroadcastTxs(ctx context.Context, in *RequestBroadcastTxs, opts ...grpc.CallOption) (*ResponseBroadcastTxs, error) {
+	out := new(ResponseBroadcastTxs)
+	err := c.cc.Invoke(ctx, "/core_grpc.BroadcastAPI/BroadcastTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for BroadcastAPI service
 
 type BroadcastAPIServer interface {
 	Ping(context.Context, *RequestPing) (*ResponsePing, error)
 	BroadcastTx(context.Context, *RequestBroadcastTx) (*ResponseBroadcastTx, error)
+	BroadcastTxs(context.Context, *RequestBroadcastTxs) (*ResponseBroadcastTxs, error)
 }
 
 func RegisterBroadcastAPIServer(s *grpc.Server, srv BroadcastAPIServer) {
@@ -418,6 +690,24 @@ func _BroadcastAPI_BroadcastTx_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BroadcastAPI_BroadcastTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestBroadcastTxs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BroadcastAPIServer).BroadcastTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/core_grpc.BroadcastAPI/BroadcastTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BroadcastAPIServer).BroadcastTxs(ctx, req.(*RequestBroadcastTxs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _BroadcastAPI_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "core_grpc.BroadcastAPI",
 	HandlerType: (*BroadcastAPIServer)(nil),
@@ -430,6 +720,10 @@ var _BroadcastAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BroadcastTx",
 			Handler:    _BroadcastAPI_BroadcastTx_Handler,
 		},
+		{
+			MethodName: "BroadcastTxs",
+			Handler:    _BroadcastAPI_BroadcastTxs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc/grpc/types.proto",
@@ -477,6 +771,44 @@ func (m *RequestBroadcastTx) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintTypes(dAtA, i, uint64(len(m.Tx)))
 		i += copy(dAtA[i:], m.Tx)
 	}
+	if m.Group != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Group))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RequestBroadcastTxs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestBroadcastTxs) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, msg := range m.Txs {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -545,34 +877,123 @@ func (m *ResponseBroadcastTx) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func NewPopulatedRequestPing(r randyTypes, easy bool) *RequestPing {
-	this := &RequestPing{}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 1)
+func (m *ResponseBroadcastTxs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedRequestBroadcastTx(r randyTypes, easy bool) *RequestBroadcastTx {
-	this := &RequestBroadcastTx{}
-	v1 := r.Intn(100)
-	this.Tx = make([]byte, v1)
-	for i := 0; i < v1; i++ {
-		this.Tx[i] = byte(r.Intn(256))
+func (m *ResponseBroadcastTxs) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, msg := range m.Results {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTypes(r, 2)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return this
+	return i, nil
+}
+
+func (m *BroadcastTxsResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BroadcastTxsResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.CheckTx != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.CheckTx.Size()))
+		n3, err := m.CheckTx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func NewPopulatedRequestPing(r randyTypes, easy bool) *RequestPing {
+	this := &RequestPing{}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 1)
+	}
+	return this
+}
+
+func NewPopulatedRequestBroadcastTx(r randyTypes, easy bool) *RequestBroadcastTx {
+	this := &RequestBroadcastTx{}
+	v1 := r.Intn(100)
+	this.Tx = make([]byte, v1)
+	for i := 0; i < v1; i++ {
+		this.Tx[i] = byte(r.Intn(256))
+	}
+	this.Group = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Group *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedRequestBroadcastTxs(r randyTypes, easy bool) *RequestBroadcastTxs {
+	this := &RequestBroadcastTxs{}
+	if r.Intn(5) != 0 {
+		v2 := r.Intn(5)
+		this.Txs = make([]*RequestBroadcastTx, v2)
+		for i := 0; i < v2; i++ {
+			this.Txs[i] = NewPopulatedRequestBroadcastTx(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 2)
+	}
+	return this
 }
 
 func NewPopulatedResponsePing(r randyTypes, easy bool) *ResponsePing {
@@ -597,6 +1018,33 @@ func NewPopulatedResponseBroadcastTx(r randyTypes, easy bool) *ResponseBroadcast
 	return this
 }
 
+func NewPopulatedResponseBroadcastTxs(r randyTypes, easy bool) *ResponseBroadcastTxs {
+	this := &ResponseBroadcastTxs{}
+	if r.Intn(5) != 0 {
+		v3 := r.Intn(5)
+		this.Results = make([]*BroadcastTxsResult, v3)
+		for i := 0; i < v3; i++ {
+			this.Results[i] = NewPopulatedBroadcastTxsResult(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedBroadcastTxsResult(r randyTypes, easy bool) *BroadcastTxsResult {
+	this := &BroadcastTxsResult{}
+	if r.Intn(5) != 0 {
+		this.CheckTx = types.NewPopulatedResponseCheckTx(r, easy)
+	}
+	this.Error = string(randStringTypes(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTypes(r, 3)
+	}
+	return this
+}
+
 type randyTypes interface {
 	Float32() float32
 	Float64() float64
@@ -685,6 +1133,24 @@ func (m *RequestBroadcastTx) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Group != 0 {
+		n += 1 + sovTypes(uint64(m.Group))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestBroadcastTxs) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -717,6 +1183,38 @@ func (m *ResponseBroadcastTx) Size() (n int) {
 	return n
 }
 
+func (m *ResponseBroadcastTxs) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BroadcastTxsResult) Size() (n int) {
+	var l int
+	_ = l
+	if m.CheckTx != nil {
+		l = m.CheckTx.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	for {
 		n++
@@ -841,6 +1339,107 @@ func (m *RequestBroadcastTx) Unmarshal(dAtA []byte) error {
 				m.Tx = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Group |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestBroadcastTxs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestBroadcastTxs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestBroadcastTxs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &RequestBroadcastTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1031,6 +1630,201 @@ func (m *ResponseBroadcastTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ResponseBroadcastTxs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseBroadcastTxs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseBroadcastTxs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &BroadcastTxsResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BroadcastTxsResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BroadcastTxsResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BroadcastTxsResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CheckTx == nil {
+				m.CheckTx = &types.ResponseCheckTx{}
+			}
+			if err := m.CheckTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1140,26 +1934,32 @@ func init() { proto.RegisterFile("rpc/grpc/types.proto", fileDescriptor_types_48
 func init() { golang_proto.RegisterFile("rpc/grpc/types.proto", fileDescriptor_types_48bb8d9591d37e66) }
 
 var fileDescriptor_types_48bb8d9591d37e66 = []byte{
-	// 321 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x29, 0x2a, 0x48, 0xd6,
-	0x4f, 0x07, 0x11, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x9c,
-	0xc9, 0xf9, 0x45, 0xa9, 0xf1, 0x20, 0x61, 0x29, 0xdd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd,
-	0xe4, 0xfc, 0x5c, 0xfd, 0xf4, 0xfc, 0xf4, 0x7c, 0x7d, 0xb0, 0x8a, 0xa4, 0xd2, 0x34, 0x30, 0x0f,
-	0xcc, 0x01, 0xb3, 0x20, 0x3a, 0xa5, 0xcc, 0x91, 0x94, 0x97, 0xa4, 0xe6, 0xa5, 0xa4, 0x16, 0xe5,
-	0x66, 0xe6, 0x95, 0x20, 0x33, 0x13, 0x93, 0x92, 0x33, 0x21, 0x96, 0x21, 0x5b, 0xa9, 0xc4, 0xcb,
-	0xc5, 0x1d, 0x94, 0x5a, 0x58, 0x9a, 0x5a, 0x5c, 0x12, 0x90, 0x99, 0x97, 0xae, 0xa4, 0xc2, 0x25,
-	0x04, 0xe5, 0x3a, 0x15, 0xe5, 0x27, 0xa6, 0x24, 0x27, 0x16, 0x97, 0x84, 0x54, 0x08, 0xf1, 0x71,
-	0x31, 0x95, 0x54, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0xf0, 0x04, 0x31, 0x95, 0x54, 0x28, 0xf1, 0x71,
-	0xf1, 0x04, 0xa5, 0x16, 0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x82, 0x75, 0x35, 0x32, 0x72, 0x09, 0xc3,
-	0x04, 0x90, 0xf5, 0x19, 0x72, 0x71, 0x24, 0x67, 0xa4, 0x26, 0x67, 0xc7, 0x43, 0x75, 0x73, 0x1b,
-	0x89, 0xe9, 0x41, 0x2c, 0x87, 0xa9, 0x76, 0x06, 0x49, 0x87, 0x54, 0x04, 0xb1, 0x27, 0x43, 0x18,
-	0x42, 0xe6, 0x5c, 0x5c, 0x29, 0xa9, 0x39, 0x99, 0x65, 0xa9, 0x45, 0x20, 0x4d, 0x4c, 0x60, 0x4d,
-	0x12, 0x68, 0x9a, 0x5c, 0x20, 0x0a, 0x42, 0x2a, 0x82, 0x38, 0x53, 0x60, 0x4c, 0xa3, 0xa9, 0x8c,
-	0x5c, 0x3c, 0x70, 0xbb, 0x1d, 0x03, 0x3c, 0x85, 0xcc, 0xb9, 0x58, 0x40, 0x8e, 0x13, 0x12, 0xd3,
-	0x83, 0x87, 0xaa, 0x1e, 0x92, 0x57, 0xa5, 0xc4, 0x51, 0xc4, 0x11, 0xbe, 0x11, 0xf2, 0xe1, 0xe2,
-	0x46, 0xf6, 0x84, 0x2c, 0xa6, 0x7e, 0x24, 0x69, 0x29, 0x39, 0x2c, 0xc6, 0x20, 0xc9, 0x3b, 0xc9,
-	0xfc, 0x78, 0x28, 0xc7, 0xb8, 0xe2, 0x91, 0x1c, 0xe3, 0x8e, 0x47, 0x72, 0x8c, 0x27, 0x1e, 0xc9,
-	0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x81, 0xc7, 0x72, 0x8c, 0x49, 0x6c,
-	0xe0, 0x58, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xd5, 0xa8, 0xe4, 0xd9, 0x10, 0x02, 0x00,
-	0x00,
+	// 420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xb1, 0x8e, 0xd4, 0x30,
+	0x10, 0x86, 0xe5, 0x1c, 0xcb, 0xb1, 0x93, 0x70, 0x85, 0x2f, 0x3a, 0xa2, 0x08, 0xcc, 0x2a, 0xd5,
+	0x36, 0x24, 0x62, 0x29, 0x22, 0xd1, 0x71, 0x20, 0x24, 0x24, 0xa4, 0x3b, 0x59, 0xdb, 0xa2, 0xd3,
+	0xc6, 0x31, 0xb9, 0x88, 0xbb, 0x38, 0xd8, 0x0e, 0x0a, 0x25, 0x6f, 0xc3, 0x23, 0x50, 0x52, 0x52,
+	0xf2, 0x08, 0x10, 0x24, 0x9e, 0x81, 0x12, 0xc5, 0xd9, 0x2c, 0x59, 0xb2, 0xda, 0xe2, 0x9a, 0x68,
+	0x66, 0xfc, 0xfd, 0xe3, 0xf9, 0xc7, 0x01, 0x57, 0x96, 0x2c, 0xca, 0xda, 0x8f, 0xfe, 0x58, 0x72,
+	0x15, 0x96, 0x52, 0x68, 0x81, 0xa7, 0x4c, 0x48, 0x7e, 0xd1, 0x96, 0xfd, 0x47, 0x59, 0xae, 0x2f,
+	0xab, 0x24, 0x64, 0xe2, 0x3a, 0xca, 0x44, 0x26, 0x22, 0x43, 0x24, 0xd5, 0x5b, 0x93, 0x99, 0xc4,
+	0x44, 0x9d, 0xd2, 0x8f, 0x07, 0xb8, 0xe6, 0x45, 0xca, 0xe5, 0x75, 0x5e, 0xe8, 0x61, 0xb8, 0x4a,
+	0x58, 0xde, 0x5d, 0x36, 0xbc, 0x32, 0xb8, 0x0b, 0x36, 0xe5, 0xef, 0x2b, 0xae, 0xf4, 0x79, 0x5e,
+	0x64, 0xc1, 0x53, 0xc0, 0xeb, 0xf4, 0x54, 0x8a, 0x55, 0xca, 0x56, 0x4a, 0x2f, 0x6b, 0x7c, 0x04,
+	0x96, 0xae, 0x3d, 0x34, 0x43, 0x73, 0x87, 0x5a, 0xba, 0xc6, 0x2e, 0x4c, 0x32, 0x29, 0xaa, 0xd2,
+	0xb3, 0x66, 0x68, 0x3e, 0xa1, 0x5d, 0x12, 0xbc, 0x84, 0xe3, 0xb1, 0x56, 0xe1, 0x08, 0x0e, 0x74,
+	0xad, 0x3c, 0x34, 0x3b, 0x98, 0xdb, 0x8b, 0x07, 0xe1, 0xc6, 0x62, 0x38, 0x86, 0x69, 0x4b, 0x06,
+	0x47, 0xe0, 0x50, 0xae, 0x4a, 0x51, 0x28, 0x6e, 0x66, 0xfa, 0x84, 0xe0, 0xb8, 0x2f, 0x0c, 0xa7,
+	0x7a, 0x0c, 0x77, 0xd8, 0x25, 0x67, 0xef, 0x2e, 0xd6, 0xb3, 0xd9, 0x8b, 0x93, 0xb0, 0xb3, 0xd6,
+	0xd3, 0xcf, 0xdb, 0xe3, 0x65, 0x4d, 0x0f, 0x59, 0x17, 0xe0, 0x18, 0x20, 0xe5, 0x57, 0xf9, 0x07,
+	0x2e, 0x5b, 0x91, 0x65, 0x44, 0xde, 0x7f, 0xa2, 0x17, 0x1d, 0xb0, 0xac, 0xe9, 0x34, 0xed, 0xc3,
+	0xe0, 0x0c, 0xdc, 0x1d, 0x23, 0x28, 0x1c, 0xc3, 0xa1, 0xe4, 0xaa, 0xba, 0xd2, 0xbb, 0x0c, 0x0e,
+	0x49, 0x6a, 0x28, 0xda, 0xd3, 0xc1, 0x1b, 0xc0, 0xe3, 0xe3, 0x9b, 0x58, 0x72, 0x61, 0xc2, 0xa5,
+	0x14, 0xd2, 0xb8, 0x99, 0xd2, 0x2e, 0x59, 0xfc, 0x46, 0xe0, 0x6c, 0xfa, 0x3f, 0x3b, 0x7f, 0x85,
+	0x63, 0xb8, 0xd5, 0x2e, 0x13, 0x9f, 0x8c, 0x1f, 0xa0, 0xad, 0xfb, 0xf7, 0xb6, 0xea, 0xff, 0xb6,
+	0x8f, 0x5f, 0x83, 0x3d, 0x5c, 0xfa, 0xfe, 0x07, 0xf4, 0xc9, 0x8e, 0x36, 0x43, 0xf9, 0x19, 0x38,
+	0x5b, 0xfb, 0x23, 0x7b, 0xdb, 0x29, 0xff, 0xe1, 0xfe, 0x7e, 0xea, 0xf4, 0xfe, 0x9f, 0x9f, 0x04,
+	0x7d, 0x6e, 0x08, 0xfa, 0xd2, 0x10, 0xf4, 0xad, 0x21, 0xe8, 0x7b, 0x43, 0xd0, 0x8f, 0x86, 0xa0,
+	0xaf, 0xbf, 0x08, 0x4a, 0x6e, 0x9b, 0x9f, 0xfc, 0xc9, 0xdf, 0x01, 0x00, 0x07, 0xe5, 0xe2, 0x81,
+	0x6f, 0x03, 0x00, 0x00,
 }
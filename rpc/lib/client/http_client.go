@@ -190,7 +190,7 @@ func unmarshalResponseBytes(cdc *amino.Codec, responseBytes []byte, result inter
 		return nil, errors.Errorf("Error unmarshalling rpc response: %v", err)
 	}
 	if response.Error != nil {
-		return nil, errors.Errorf("Response error: %v", response.Error)
+		return nil, errors.Wrap(response.Error, "Response error")
 	}
 	// Unmarshal the RawMessage into the result.
 	err = cdc.UnmarshalJSON(response.Result, result)
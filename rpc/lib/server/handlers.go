@@ -146,7 +146,7 @@ func makeJSONRPCHandler(funcMap map[string]*RPCFunc, cdc *amino.Codec, logger lo
 		logger.Info("HTTPJSONRPC", "method", request.Method, "args", args, "returns", returns)
 		result, err := unreflectResult(returns)
 		if err != nil {
-			WriteRPCResponseHTTP(w, types.RPCInternalError(request.ID, err))
+			WriteRPCResponseHTTP(w, types.RPCFuncError(request.ID, err))
 			return
 		}
 		WriteRPCResponseHTTP(w, types.NewRPCSuccessResponse(cdc, request.ID, result))
@@ -270,7 +270,7 @@ func makeHTTPHandler(rpcFunc *RPCFunc, cdc *amino.Codec, logger log.Logger) func
 		logger.Info("HTTPRestRPC", "method", r.URL.Path, "args", args, "returns", returns)
 		result, err := unreflectResult(returns)
 		if err != nil {
-			WriteRPCResponseHTTP(w, types.RPCInternalError(types.JSONRPCStringID(""), err))
+			WriteRPCResponseHTTP(w, types.RPCFuncError(types.JSONRPCStringID(""), err))
 			return
 		}
 		WriteRPCResponseHTTP(w, types.NewRPCSuccessResponse(cdc, types.JSONRPCStringID(""), result))
@@ -434,6 +434,10 @@ type wsConnection struct {
 	// Send pings to server with this period. Must be less than readWait, but greater than zero.
 	pingPeriod time.Duration
 
+	// maximum size of a message read from the connection. 0 means
+	// DefaultMaxBodyBytes.
+	readLimit int64
+
 	// object that is used to subscribe / unsubscribe from events
 	eventSub types.EventSubscriber
 }
@@ -450,7 +454,6 @@ func NewWSConnection(
 	cdc *amino.Codec,
 	options ...func(*wsConnection),
 ) *wsConnection {
-	baseConn.SetReadLimit(maxBodyBytes)
 	wsc := &wsConnection{
 		remoteAddr:        baseConn.RemoteAddr().String(),
 		baseConn:          baseConn,
@@ -464,6 +467,7 @@ func NewWSConnection(
 	for _, option := range options {
 		option(wsc)
 	}
+	baseConn.SetReadLimit(Config{MaxBodyBytes: wsc.readLimit}.maxBodyBytes())
 	wsc.BaseService = *cmn.NewBaseService(nil, "wsConnection", wsc)
 	return wsc
 }
@@ -509,6 +513,15 @@ func PingPeriod(pingPeriod time.Duration) func(*wsConnection) {
 	}
 }
 
+// ReadLimit sets the maximum size of a message read from the websocket, 0
+// means DefaultMaxBodyBytes.
+// It should only be used in the constructor - not Goroutine-safe.
+func ReadLimit(readLimit int64) func(*wsConnection) {
+	return func(wsc *wsConnection) {
+		wsc.readLimit = readLimit
+	}
+}
+
 // OnStart implements cmn.Service by starting the read and write routines. It
 // blocks until the connection closes.
 func (wsc *wsConnection) OnStart() error {
@@ -655,7 +668,7 @@ func (wsc *wsConnection) readRoutine() {
 
 			result, err := unreflectResult(returns)
 			if err != nil {
-				wsc.WriteRPCResponse(types.RPCInternalError(request.ID, err))
+				wsc.WriteRPCResponse(types.RPCFuncError(request.ID, err))
 				continue
 			}
 
@@ -786,8 +799,8 @@ func (wm *WebsocketManager) WebsocketHandler(w http.ResponseWriter, r *http.Requ
 // NOTE: assume returns is result struct and error. If error is not nil, return it
 func unreflectResult(returns []reflect.Value) (interface{}, error) {
 	errV := returns[1]
-	if errV.Interface() != nil {
-		return nil, errors.Errorf("%v", errV.Interface())
+	if err, ok := errV.Interface().(error); ok && err != nil {
+		return nil, err
 	}
 	rv := returns[0]
 	// the result is a registered interface,
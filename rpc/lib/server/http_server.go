@@ -21,12 +21,14 @@ import (
 // Config is an RPC server configuration.
 type Config struct {
 	MaxOpenConnections int
+	// MaxBodyBytes controls the maximum number of bytes the server will read
+	// parsing the request body. 0 means DefaultMaxBodyBytes.
+	MaxBodyBytes int64
 }
 
 const (
-	// maxBodyBytes controls the maximum number of bytes the
-	// server will read parsing the request body.
-	maxBodyBytes = int64(1000000) // 1MB
+	// DefaultMaxBodyBytes is the default limit on the request body.
+	DefaultMaxBodyBytes = int64(1000000) // 1MB
 
 	// same as the net/http default
 	maxHeaderBytes = 1 << 20
@@ -40,13 +42,20 @@ const (
 	WriteTimeout = 10 * time.Second
 )
 
+func (c Config) maxBodyBytes() int64 {
+	if c.MaxBodyBytes > 0 {
+		return c.MaxBodyBytes
+	}
+	return DefaultMaxBodyBytes
+}
+
 // StartHTTPServer takes a listener and starts an HTTP server with the given handler.
 // It wraps handler with RecoverAndLogHandler.
 // NOTE: This function blocks - you may want to call it in a go-routine.
-func StartHTTPServer(listener net.Listener, handler http.Handler, logger log.Logger) error {
+func StartHTTPServer(listener net.Listener, handler http.Handler, logger log.Logger, config Config) error {
 	logger.Info(fmt.Sprintf("Starting RPC HTTP server on %s", listener.Addr()))
 	s := &http.Server{
-		Handler:        RecoverAndLogHandler(maxBytesHandler{h: handler, n: maxBodyBytes}, logger),
+		Handler:        RecoverAndLogHandler(maxBytesHandler{h: handler, n: config.maxBodyBytes()}, logger),
 		ReadTimeout:    ReadTimeout,
 		WriteTimeout:   WriteTimeout,
 		MaxHeaderBytes: maxHeaderBytes,
@@ -64,11 +73,12 @@ func StartHTTPAndTLSServer(
 	handler http.Handler,
 	certFile, keyFile string,
 	logger log.Logger,
+	config Config,
 ) error {
 	logger.Info(fmt.Sprintf("Starting RPC HTTPS server on %s (cert: %q, key: %q)",
 		listener.Addr(), certFile, keyFile))
 	s := &http.Server{
-		Handler:        RecoverAndLogHandler(maxBytesHandler{h: handler, n: maxBodyBytes}, logger),
+		Handler:        RecoverAndLogHandler(maxBytesHandler{h: handler, n: config.maxBodyBytes()}, logger),
 		ReadTimeout:    ReadTimeout,
 		WriteTimeout:   WriteTimeout,
 		MaxHeaderBytes: maxHeaderBytes,
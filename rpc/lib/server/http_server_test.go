@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -33,7 +34,7 @@ func TestMaxOpenConnections(t *testing.T) {
 	l, err := Listen("tcp://127.0.0.1:0", Config{MaxOpenConnections: max})
 	require.NoError(t, err)
 	defer l.Close()
-	go StartHTTPServer(l, mux, log.TestingLogger())
+	go StartHTTPServer(l, mux, log.TestingLogger(), Config{})
 
 	// Make N GET calls to the server.
 	attempts := max * 2
@@ -72,8 +73,34 @@ func TestStartHTTPAndTLSServer(t *testing.T) {
 	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {})
 
 	// test failure
-	err = StartHTTPAndTLSServer(listener, mux, "", "", log.TestingLogger())
+	err = StartHTTPAndTLSServer(listener, mux, "", "", log.TestingLogger(), Config{})
 	require.IsType(t, (*os.PathError)(nil), err)
 
 	// TODO: test that starting the server can actually work
 }
+
+func TestMaxBodyBytes(t *testing.T) {
+	const max = 10
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := ioutil.ReadAll(r.Body); err != nil {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+		}
+	})
+	l, err := Listen("tcp://127.0.0.1:0", Config{})
+	require.NoError(t, err)
+	defer l.Close()
+	go StartHTTPServer(l, mux, log.TestingLogger(), Config{MaxBodyBytes: max})
+
+	url := fmt.Sprintf("http://%s", l.Addr())
+	r, err := http.Post(url, "text/plain", strings.NewReader(strings.Repeat("a", max)))
+	require.NoError(t, err)
+	r.Body.Close()
+	require.Equal(t, http.StatusOK, r.StatusCode)
+
+	r, err = http.Post(url, "text/plain", strings.NewReader(strings.Repeat("a", max+1)))
+	require.NoError(t, err)
+	r.Body.Close()
+	require.Equal(t, http.StatusRequestEntityTooLarge, r.StatusCode)
+}
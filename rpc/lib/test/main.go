@@ -32,7 +32,7 @@ func main() {
 	if err != nil {
 		cmn.Exit(err.Error())
 	}
-	go rpcserver.StartHTTPServer(listener, mux, logger)
+	go rpcserver.StartHTTPServer(listener, mux, logger, rpcserver.Config{})
 	// Wait forever
 	cmn.TrapSignal(func() {
 	})
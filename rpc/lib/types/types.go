@@ -233,6 +233,18 @@ func RPCServerError(id jsonrpcid, err error) RPCResponse {
 	return NewRPCErrorResponse(id, -32000, "Server error", err.Error())
 }
 
+// RPCFuncError returns the response to an error returned by an RPC function:
+// an RPCError keeps its code, any other error is an internal error.
+func RPCFuncError(id jsonrpcid, err error) RPCResponse {
+	switch e := err.(type) {
+	case RPCError:
+		return NewRPCErrorResponse(id, e.Code, e.Message, e.Data)
+	case *RPCError:
+		return NewRPCErrorResponse(id, e.Code, e.Message, e.Data)
+	}
+	return RPCInternalError(id, err)
+}
+
 //----------------------------------------
 
 // *wsConnection implements this interface.
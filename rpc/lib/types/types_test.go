@@ -50,6 +50,16 @@ func TestResponses(t *testing.T) {
 		h, _ := json.Marshal(g)
 		i := fmt.Sprintf(`{"jsonrpc":"2.0","id":%v,"error":{"code":-32601,"message":"Method not found"}}`, tt.expected)
 		assert.Equal(string(h), string(i))
+
+		j := RPCFuncError(jsonid, &RPCError{Code: -32001, Message: "Tx already exists in cache", Data: "cached"})
+		k, _ := json.Marshal(j)
+		l := fmt.Sprintf(`{"jsonrpc":"2.0","id":%v,"error":{"code":-32001,"message":"Tx already exists in cache","data":"cached"}}`, tt.expected)
+		assert.Equal(string(l), string(k))
+
+		m := RPCFuncError(jsonid, errors.New("Hello world"))
+		n, _ := json.Marshal(m)
+		o := fmt.Sprintf(`{"jsonrpc":"2.0","id":%v,"error":{"code":-32603,"message":"Internal error","data":"Hello world"}}`, tt.expected)
+		assert.Equal(string(o), string(n))
 	}
 }
 
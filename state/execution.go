@@ -1,9 +1,14 @@
 package state
 
 import (
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"sync"
 	"time"
 
+	cmn "github.com/tendermint/tendermint/libs/common"
 	abci "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/fail"
@@ -30,12 +35,31 @@ type BlockExecutor struct {
 
 	// manage the mempool lock during commit
 	// and update both with block results after commit.
-	mempool map[int32]Mempool
-	evpool  EvidencePool
+	mempoolMtx sync.RWMutex
+	mempool    map[int32]Mempool
+	evpool     EvidencePool
+
+	// decides which mempool group fills the next proposal block
+	groupSelector GroupSelector
+
+	// notified when groups are opened or closed on chain
+	groupListener GroupListener
+
+	// needed to verify evidence. If pruning, blocks and states below the
+	// retain height are pruned from here.
+	blockStore BlockStore
+	pruning    bool
+	// number of recent blocks to keep, 0 to keep them all unless the app
+	// sets a retain height
+	retainBlocks int64
 
 	logger log.Logger
 
 	metrics *Metrics
+
+	// when the txs of each group started waiting for a block.
+	// Only used by ApplyBlock.
+	starvedSince map[int32]time.Time
 }
 
 type BlockExecutorOption func(executor *BlockExecutor)
@@ -46,17 +70,56 @@ func BlockExecutorWithMetrics(metrics *Metrics) BlockExecutorOption {
 	}
 }
 
+// BlockExecutorWithGroupSelector sets the policy used to pick the mempool
+// group of each proposal block. Defaults to round-robin.
+func BlockExecutorWithGroupSelector(selector GroupSelector) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.groupSelector = selector
+	}
+}
+
+// BlockExecutorWithGroupListener sets the listener notified when EndBlock
+// opens or closes mempool groups.
+func BlockExecutorWithGroupListener(listener GroupListener) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.groupListener = listener
+	}
+}
+
+// BlockExecutorWithPruning prunes the blocks of blockStore, and the states,
+// below the retain height after each block: the lowest of the RetainHeight
+// returned by the app's Commit and the height retainBlocks blocks back. 0
+// retainBlocks leaves it to the app. Blocks and states within the evidence
+// max age are never pruned, as they are needed to verify evidence.
+func BlockExecutorWithPruning(blockStore BlockStore, retainBlocks int64) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.blockStore = blockStore
+		blockExec.pruning = true
+		blockExec.retainBlocks = retainBlocks
+	}
+}
+
+// BlockExecutorWithBlockStore sets the block store used to verify evidence
+// against the committed blocks, without pruning it.
+func BlockExecutorWithBlockStore(blockStore BlockStore) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.blockStore = blockStore
+	}
+}
+
 // NewBlockExecutor returns a new BlockExecutor with a NopEventBus.
 // Call SetEventBus to provide one.
 func NewBlockExecutor(db dbm.DB, logger log.Logger, proxyApp proxy.AppConnConsensus, mempool map[int32]Mempool, evpool EvidencePool, options ...BlockExecutorOption) *BlockExecutor {
 	res := &BlockExecutor{
-		db:       db,
-		proxyApp: proxyApp,
-		eventBus: types.NopEventBus{},
-		mempool:  mempool,
-		evpool:   evpool,
-		logger:   logger,
-		metrics:  NopMetrics(),
+		db:            db,
+		proxyApp:      proxyApp,
+		eventBus:      types.NopEventBus{},
+		mempool:       mempool,
+		evpool:        evpool,
+		groupSelector: NewRoundRobinGroupSelector(),
+		logger:        logger,
+		metrics:       NopMetrics(),
+		starvedSince:  make(map[int32]time.Time),
 	}
 
 	for _, option := range options {
@@ -66,16 +129,64 @@ func NewBlockExecutor(db dbm.DB, logger log.Logger, proxyApp proxy.AppConnConsen
 	return res
 }
 
+// AddMempool starts including the txs of the given group's mempool in
+// proposal blocks, and updating the mempool on commit.
+func (blockExec *BlockExecutor) AddMempool(group int32, mempool Mempool) {
+	blockExec.mempoolMtx.Lock()
+	defer blockExec.mempoolMtx.Unlock()
+	mempools := make(map[int32]Mempool, len(blockExec.mempool)+1)
+	for g, mem := range blockExec.mempool {
+		mempools[g] = mem
+	}
+	mempools[group] = mempool
+	blockExec.mempool = mempools
+}
+
+// RemoveMempool stops using the mempool of the given group.
+func (blockExec *BlockExecutor) RemoveMempool(group int32) {
+	blockExec.mempoolMtx.Lock()
+	defer blockExec.mempoolMtx.Unlock()
+	mempools := make(map[int32]Mempool, len(blockExec.mempool))
+	for g, mem := range blockExec.mempool {
+		if g != group {
+			mempools[g] = mem
+		}
+	}
+	blockExec.mempool = mempools
+}
+
+// mempools returns the mempools of all groups run by this node.
+// The returned map must not be modified.
+func (blockExec *BlockExecutor) mempools() map[int32]Mempool {
+	blockExec.mempoolMtx.RLock()
+	defer blockExec.mempoolMtx.RUnlock()
+	return blockExec.mempool
+}
+
+// openMempools returns the mempools of the groups open in state.
+func (blockExec *BlockExecutor) openMempools(state State) map[int32]Mempool {
+	mempools := blockExec.mempools()
+	open := make(map[int32]Mempool, len(mempools))
+	for group, mem := range mempools {
+		if state.GroupOpen(group) {
+			open[group] = mem
+		}
+	}
+	return open
+}
+
 // SetEventBus - sets the event bus for publishing block related events.
 // If not called, it defaults to types.NopEventBus.
 func (blockExec *BlockExecutor) SetEventBus(eventBus types.BlockEventPublisher) {
 	blockExec.eventBus = eventBus
 }
 
-// CreateProposalBlock calls state.MakeBlock with evidence from the evpool
-// and txs from the mempool. The max bytes must be big enough to fit the commit.
+// CreateProposalBlock calls state.MakeMultiGroupBlock with evidence from the
+// evpool and txs from the mempool. The max bytes must be big enough to fit the commit.
 // Up to 1/10th of the block space is allcoated for maximum sized evidence.
-// The rest is given to txs, up to the max gas.
+// The rest is given to txs, up to the max gas, and split across the mempool
+// open groups (see reapGroups). The group chosen by the GroupSelector is
+// reaped first.
 func (blockExec *BlockExecutor) CreateProposalBlock(
 	height int64,
 	state State, commit *types.Commit,
@@ -91,18 +202,108 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 
 	// Fetch a limited amount of valid txs
 	maxDataBytes := types.MaxDataBytes(maxBytes, state.Validators.Size(), len(evidence))
-	// need to modify
-	// 选择mempool的策略
-	var txs types.Txs
-	var group int32 = 0
-	for key, item := range blockExec.mempool {
-		txs = item.ReapMaxBytesMaxGas(maxDataBytes, maxGas)
-		if txs != nil && len(txs) != 0 {
-			group = key
+	if state.GroupValidators() {
+		// The group is scheduled by the state and only its validators sign
+		// the block, so it can't carry txs of other groups.
+		group := state.NextBlockGroup()
+		var txs types.Txs
+		if mem, ok := blockExec.openMempools(state)[group]; ok {
+			txs = mem.ReapMaxBytesMaxGas(maxDataBytes, maxGas)
+		}
+		return state.MakeBlock(height, txs, group, commit, evidence, proposerAddr)
+	}
+	mempools := blockExec.openMempools(state)
+	group := blockExec.groupSelector.SelectGroup(state)
+	groupTxs := reapGroups(mempools, group, maxDataBytes, maxGas)
+
+	return state.MakeMultiGroupBlock(height, group, groupTxs, commit, evidence, proposerAddr)
+}
+
+// reapGroups reaps txs from all mempool groups, starting with first and
+// continuing in group order. Each group may use an equal share of the bytes
+// left by the groups before it; bytes still unused after that are handed out
+// again, in the same order, to groups that had more txs than fit their share.
+// Since the gas of reaped txs isn't known here, maxGas is split evenly.
+// If first has no mempool, the next group in order is reaped first. Empty
+// mempools are skipped, and only the first types.MaxTxSections groups with
+// txs are reaped, as a block can't have more sections.
+func reapGroups(mempools map[int32]Mempool, first int32, maxDataBytes, maxGas int64) []types.GroupTxs {
+	if mem, ok := mempools[first]; ok && len(mempools) == 1 {
+		return []types.GroupTxs{{Group: first, Txs: mem.ReapMaxBytesMaxGas(maxDataBytes, maxGas)}}
+	}
+	if len(mempools) == 0 {
+		return nil
+	}
+
+	// order groups with txs starting at first
+	sorted := sortedGroups(mempools)
+	start := sort.Search(len(sorted), func(i int) bool { return sorted[i] >= first })
+	groups := make([]int32, 0, cmn.MinInt(len(sorted), types.MaxTxSections))
+	for i := range sorted {
+		group := sorted[(start+i)%len(sorted)]
+		if mempools[group].Size() == 0 {
+			continue
+		}
+		groups = append(groups, group)
+		if len(groups) == types.MaxTxSections {
 			break
 		}
 	}
-	return state.MakeBlock(height, txs, group, commit, evidence, proposerAddr)
+	if len(groups) == 0 {
+		return nil
+	}
+
+	// leave room for the header's tx sections
+	remaining := maxDataBytes - int64(len(groups))*types.MaxTxSectionBytes
+	if remaining < 0 {
+		remaining = 0
+	}
+	gasShare := maxGas
+	if maxGas > 0 {
+		gasShare = maxGas / int64(len(groups))
+	}
+
+	groupTxs := make([]types.GroupTxs, len(groups))
+	used := make([]int64, len(groups))
+	for i, group := range groups {
+		share := remaining / int64(len(groups)-i)
+		txs := mempools[group].ReapMaxBytesMaxGas(share, gasShare)
+		groupTxs[i] = types.GroupTxs{Group: group, Txs: txs}
+		used[i] = txsBytes(txs)
+		remaining -= used[i]
+	}
+	for i, group := range groups {
+		if remaining <= 0 {
+			break
+		}
+		if len(groupTxs[i].Txs) == mempools[group].Size() {
+			continue
+		}
+		txs := mempools[group].ReapMaxBytesMaxGas(used[i]+remaining, gasShare)
+		groupTxs[i].Txs = txs
+		remaining -= txsBytes(txs) - used[i]
+		used[i] = txsBytes(txs)
+	}
+	return groupTxs
+}
+
+// sortedGroups returns the group ids of mempools in ascending order.
+func sortedGroups(mempools map[int32]Mempool) []int32 {
+	groups := make([]int32, 0, len(mempools))
+	for group := range mempools {
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i] < groups[j] })
+	return groups
+}
+
+// txsBytes returns the size of txs as counted by Mempool.ReapMaxBytesMaxGas.
+func txsBytes(txs types.Txs) int64 {
+	var size int64
+	for _, tx := range txs {
+		size += int64(len(tx)) + types.ComputeAminoOverhead(tx, 1)
+	}
+	return size
 }
 
 // ValidateBlock validates the given block against the given state.
@@ -110,8 +311,22 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 // Validation does not mutate state, but does require historical information from the stateDB,
 // ie. to verify evidence from a validator at an old height.
 func (blockExec *BlockExecutor) ValidateBlock(state State, block *types.Block) error {
-
-	return validateBlock(blockExec.evpool, blockExec.db, state, block)
+	if err := validateBlock(blockExec.evpool, blockExec.db, blockExec.blockStore, state, block); err != nil {
+		return err
+	}
+	if state.GroupValidators() {
+		// the group was checked against the schedule by validateBlock
+		return nil
+	}
+	if !state.GroupOpen(block.Group) {
+		return fmt.Errorf("Unknown Block.Header.Group %d", block.Group)
+	}
+	for _, section := range block.Sections {
+		if !state.GroupOpen(section.Group) {
+			return fmt.Errorf("Unknown group %d in Block.Header.Sections", section.Group)
+		}
+	}
+	return blockExec.groupSelector.ValidateGroup(state, block)
 }
 
 // ApplyBlock validates the block against the state, executes it against the app,
@@ -126,9 +341,10 @@ func (blockExec *BlockExecutor) ApplyBlock(state State, blockID types.BlockID, b
 	}
 
 	startTime := time.Now().UnixNano()
-	abciResponses, err := execBlockOnProxyApp(blockExec.logger, blockExec.proxyApp, block, state.LastValidators, blockExec.db)
+	abciResponses, err := execBlockOnProxyApp(blockExec.logger, blockExec.proxyApp, block, state.LastBlockValidators(), blockExec.db)
 	endTime := time.Now().UnixNano()
-	blockExec.metrics.BlockProcessingTime.Observe(float64(endTime-startTime) / 1000000)
+	blockExec.metrics.BlockProcessingTime.
+		Observe(float64(endTime-startTime) / 1000000)
 	if err != nil {
 		return state, ErrProxyAppConn(err)
 	}
@@ -136,7 +352,7 @@ func (blockExec *BlockExecutor) ApplyBlock(state State, blockID types.BlockID, b
 	fail.Fail() // XXX
 
 	// Save the results before we commit.
-	saveABCIResponses(blockExec.db, block.Height, abciResponses)
+	SaveABCIResponses(blockExec.db, block.Height, abciResponses)
 
 	fail.Fail() // XXX
 
@@ -155,13 +371,16 @@ func (blockExec *BlockExecutor) ApplyBlock(state State, blockID types.BlockID, b
 	}
 
 	// Update the state with the block and responses.
+	lastGroups := state.Groups
 	state, err = updateState(state, blockID, &block.Header, abciResponses, validatorUpdates)
 	if err != nil {
 		return state, fmt.Errorf("Commit failed for application: %v", err)
 	}
 
+	blockExec.recordStarvation(block)
+
 	// Lock mempool, commit app state, update mempoool.
-	appHash, err := blockExec.Commit(state, block)
+	appHash, appRetainHeight, err := blockExec.Commit(state, block)
 	if err != nil {
 		return state, fmt.Errorf("Commit failed for application: %v", err)
 	}
@@ -174,36 +393,83 @@ func (blockExec *BlockExecutor) ApplyBlock(state State, blockID types.BlockID, b
 	// Update the app hash and save the state.
 	state.AppHash = appHash
 	SaveState(blockExec.db, state)
+	blockExec.metrics.CommittedBlocks.With("group", groupLabel(block.Group)).Add(1)
 
 	fail.Fail() // XXX
 
+	if retainHeight := blockExec.retainHeight(state, appRetainHeight); retainHeight > 0 {
+		blockExec.prune(retainHeight)
+	}
+
+	if blockExec.groupListener != nil {
+		notifyGroupChanges(blockExec.groupListener, lastGroups, state.Groups)
+	}
+
 	// Events are fired after everything else.
 	// NOTE: if we crash between Commit and Save, events wont be fired during replay
 	fireEvents(blockExec.logger, blockExec.eventBus, block, abciResponses, validatorUpdates)
 	return state, nil
 }
 
-// Commit locks the mempool, runs the ABCI Commit message, and updates the
-// mempool.
-// It returns the result of calling abci.Commit (the AppHash), and an error.
+// recordStarvation updates, for every local mempool group, how long its txs
+// have been left out of blocks. It must be called before the block's txs are
+// removed from the mempools.
+func (blockExec *BlockExecutor) recordStarvation(block *types.Block) {
+	now := time.Now()
+	included := make(map[int32]bool)
+	for _, groupTxs := range block.GroupedTxs() {
+		if len(groupTxs.Txs) > 0 {
+			included[groupTxs.Group] = true
+		}
+	}
+	mempools := blockExec.mempools()
+	for group := range blockExec.starvedSince {
+		if _, ok := mempools[group]; !ok {
+			delete(blockExec.starvedSince, group)
+		}
+	}
+	for group, mem := range mempools {
+		since, starved := blockExec.starvedSince[group]
+		switch {
+		case included[group] || mem.Size() == 0:
+			delete(blockExec.starvedSince, group)
+			since = now
+		case !starved:
+			blockExec.starvedSince[group] = now
+			since = now
+		}
+		blockExec.metrics.ReapStarvationSeconds.With("group", groupLabel(group)).Set(now.Sub(since).Seconds())
+	}
+}
+
+// Commit locks the mempools, runs the ABCI Commit message, and updates the
+// mempools. Every group's mempool is updated, so txs of groups not included
+// in the block are rechecked against the new state too.
+// It returns the result of calling abci.Commit (the AppHash and the height
+// below which blocks may be pruned), and an error.
 // The Mempool must be locked during commit and update because state is
 // typically reset on Commit and old txs must be replayed against committed
 // state before new txs are run in the mempool, lest they be invalid.
-//need modify
 func (blockExec *BlockExecutor) Commit(
 	state State,
 	block *types.Block,
-) ([]byte, error) {
+) ([]byte, int64, error) {
 
-	blockExec.mempool[block.Header.Group].Lock()
-	defer blockExec.mempool[block.Header.Group].Unlock()
+	mempools := blockExec.mempools()
+	groups := sortedGroups(mempools)
+	for _, group := range groups {
+		mempools[group].Lock()
+		defer mempools[group].Unlock()
+	}
 
 	// while mempool is Locked, flush to ensure all async requests have completed
 	// in the ABCI app before Commit.
-	err := blockExec.mempool[block.Header.Group].FlushAppConn()
-	if err != nil {
-		blockExec.logger.Error("Client error during mempool.FlushAppConn", "err", err)
-		return nil, err
+	for _, group := range groups {
+		err := mempools[group].FlushAppConn()
+		if err != nil {
+			blockExec.logger.Error("Client error during mempool.FlushAppConn", "group", group, "err", err)
+			return nil, 0, err
+		}
 	}
 
 	// Commit block, get hash back
@@ -213,7 +479,7 @@ func (blockExec *BlockExecutor) Commit(
 			"Client error during proxyAppConn.CommitSync",
 			"err", err,
 		)
-		return nil, err
+		return nil, 0, err
 	}
 	// ResponseCommit has no error code - just data
 
@@ -224,21 +490,103 @@ func (blockExec *BlockExecutor) Commit(
 		"appHash", fmt.Sprintf("%X", res.Data),
 	)
 
-	// Update mempool.
-	err = blockExec.mempool[block.Header.Group].Update(
-		block.Height,
-		block.Txs,
-		TxPreCheck(state),
-		TxPostCheck(state),
-	)
+	// Update mempools.
+	txsByGroup := make(map[int32]types.Txs)
+	for _, gt := range block.GroupedTxs() {
+		txsByGroup[gt.Group] = gt.Txs
+	}
+	for _, group := range groups {
+		err = mempools[group].Update(
+			block.Height,
+			txsByGroup[group],
+			TxPreCheck(state),
+			TxPostCheck(state),
+		)
+		if err != nil {
+			return res.Data, res.RetainHeight, err
+		}
+	}
+
+	return res.Data, res.RetainHeight, nil
+}
+
+// retainHeight returns the height below which blocks are pruned after the
+// last block of state, or 0 if none are.
+func (blockExec *BlockExecutor) retainHeight(state State, appRetainHeight int64) int64 {
+	if !blockExec.pruning {
+		return 0
+	}
+	height := state.LastBlockHeight
+	retainHeight := appRetainHeight
+	if blockExec.retainBlocks > 0 {
+		configRetainHeight := cmn.MaxInt64(height-blockExec.retainBlocks+1, 1)
+		if retainHeight <= 0 || configRetainHeight < retainHeight {
+			retainHeight = configRetainHeight
+		}
+	}
+	if retainHeight > height {
+		retainHeight = height
+	}
+	if evidenceHeight := blockExec.evidenceRetainHeight(state); retainHeight > evidenceHeight {
+		retainHeight = evidenceHeight
+	}
+	if retainHeight <= blockExec.blockStore.Base() {
+		return 0
+	}
+	return retainHeight
+}
 
-	return res.Data, err
+// evidenceRetainHeight returns the lowest height evidence can still be
+// committed for after the last block of state: VerifyEvidence accepts
+// evidence within MaxAge blocks or MaxAgeDuration of block time, and needs
+// the blocks and validators of its height, and the ABCI responses of the
+// height before (see prune).
+func (blockExec *BlockExecutor) evidenceRetainHeight(state State) int64 {
+	params := state.ConsensusParams.Evidence
+	maxHeight := cmn.MaxInt64(state.LastBlockHeight-params.MaxAge, 1)
+
+	// search the first stored block within MaxAgeDuration; block times only
+	// increase with the height
+	minTime := state.LastBlockTime.Add(-params.MaxAgeDuration)
+	lo, hi := blockExec.blockStore.Base(), maxHeight
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		meta := blockExec.blockStore.LoadBlockMeta(mid)
+		if meta == nil || !meta.Header.Time.Before(minTime) {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return lo
+}
+
+// prune removes the blocks below retainHeight, and the states below the
+// height before: the ABCI responses of that height are needed to verify the
+// LastResultsHash of LunaticValidatorEvidence at retainHeight. Errors are
+// only logged: pruning is retried after the next block.
+func (blockExec *BlockExecutor) prune(retainHeight int64) {
+	base := blockExec.blockStore.Base()
+	pruned, err := blockExec.blockStore.PruneBlocks(retainHeight)
+	if err != nil {
+		blockExec.logger.Error("Failed to prune blocks", "retainHeight", retainHeight, "err", err)
+		return
+	}
+	if from, to := cmn.MaxInt64(base-1, 1), retainHeight-1; from < to {
+		if err := PruneStates(blockExec.db, from, to); err != nil {
+			blockExec.logger.Error("Failed to prune states", "retainHeight", retainHeight, "err", err)
+			return
+		}
+	}
+	blockExec.logger.Info("Pruned blocks", "pruned", pruned, "retainHeight", retainHeight)
 }
 
 //---------------------------------------------------------
 // Helper functions for executing blocks and updating state
 
 // Executes block's transactions on proxyAppConn.
+// Txs are delivered with the group of their section, and EndBlock is called
+// once per section; the responses are merged (see mergeEndBlock).
 // Returns a list of transaction results and updates to the validator set
 func execBlockOnProxyApp(
 	logger log.Logger,
@@ -256,12 +604,6 @@ func execBlockOnProxyApp(
 	proxyCb := func(req *abci.Request, res *abci.Response) {
 		switch r := res.Value.(type) {
 		case *abci.Response_DeliverTx:
-			fmt.Println("test deliery___")
-			fmt.Println(block.Group)
-			req := req.Value.(*abci.Request_DeliverTx)
-			if req.DeliverTx.Group != block.Group {
-				break
-			}
 			// TODO: make use of res.Log
 			// TODO: make use of this info
 			// Blocks may include invalid txs.
@@ -276,9 +618,9 @@ func execBlockOnProxyApp(
 			txIndex++
 		}
 	}
-	fmt.Println("excuse block__")
-	fmt.Println(block.Group)
-	proxyAppConn.SetResponseCallback(block.Group, proxyCb)
+	// NOTE: the consensus connection only has this callback, registered under
+	// a fixed key so that the one of the previous block is replaced.
+	proxyAppConn.SetResponseCallback(0, proxyCb)
 
 	commitInfo, byzVals := getBeginBlockValidatorInfo(block, lastValSet, stateDB)
 
@@ -295,26 +637,64 @@ func execBlockOnProxyApp(
 		return nil, err
 	}
 
+	groupTxs := block.GroupedTxs()
+
 	// Run txs of block.
-	for _, tx := range block.Txs {
-		proxyAppConn.DeliverTxAsync(tx, block.Group)
-		if err := proxyAppConn.Error(); err != nil {
-			return nil, err
+	for _, gt := range groupTxs {
+		for _, tx := range gt.Txs {
+			proxyAppConn.DeliverTxAsync(tx, gt.Group)
+			if err := proxyAppConn.Error(); err != nil {
+				return nil, err
+			}
 		}
 	}
 
 	// End block.
-	abciResponses.EndBlock, err = proxyAppConn.EndBlockSync(abci.RequestEndBlock{Height: block.Height, Group: block.Group})
-	if err != nil {
-		logger.Error("Error in proxyAppConn.EndBlock", "err", err)
-		return nil, err
+	endBlocks := make([]*abci.ResponseEndBlock, len(groupTxs))
+	for i, gt := range groupTxs {
+		endBlocks[i], err = proxyAppConn.EndBlockSync(abci.RequestEndBlock{Height: block.Height, Group: gt.Group})
+		if err != nil {
+			logger.Error("Error in proxyAppConn.EndBlock", "group", gt.Group, "err", err)
+			return nil, err
+		}
 	}
+	abciResponses.EndBlock = mergeEndBlock(endBlocks)
 
 	logger.Info("Executed block", "height", block.Height, "validTxs", validTxs, "invalidTxs", invalidTxs)
 
 	return abciResponses, nil
 }
 
+// mergeEndBlock merges the EndBlock responses of all groups of a block.
+// Validator updates and tags are concatenated in section order; a later
+// update of the same validator, or of the consensus params, overrides an
+// earlier one.
+func mergeEndBlock(endBlocks []*abci.ResponseEndBlock) *abci.ResponseEndBlock {
+	if len(endBlocks) == 1 {
+		return endBlocks[0]
+	}
+	merged := new(abci.ResponseEndBlock)
+	valIndex := make(map[string]int)
+	for _, endBlock := range endBlocks {
+		for _, valUpdate := range endBlock.ValidatorUpdates {
+			key := valUpdate.PubKey.Type + "/" + string(valUpdate.PubKey.Data)
+			if i, ok := valIndex[key]; ok {
+				merged.ValidatorUpdates[i] = valUpdate
+				continue
+			}
+			valIndex[key] = len(merged.ValidatorUpdates)
+			merged.ValidatorUpdates = append(merged.ValidatorUpdates, valUpdate)
+		}
+		merged.Tags = append(merged.Tags, endBlock.Tags...)
+		merged.OpenGroups = append(merged.OpenGroups, endBlock.OpenGroups...)
+		merged.CloseGroups = append(merged.CloseGroups, endBlock.CloseGroups...)
+		if endBlock.ConsensusParamUpdates != nil {
+			merged.ConsensusParamUpdates = endBlock.ConsensusParamUpdates
+		}
+	}
+	return merged
+}
+
 func getBeginBlockValidatorInfo(block *types.Block, lastValSet *types.ValidatorSet, stateDB dbm.DB) (abci.LastCommitInfo, []abci.Evidence) {
 
 	// Sanity check that commit length matches validator set size -
@@ -410,7 +790,13 @@ func updateState(
 	}
 
 	// Update validator proposer priority and set state variables.
-	nValSet.IncrementProposerPriority(1)
+	if state.GroupValidators() {
+		// nValSet signs the block after next, rotate the proposer of its group.
+		nextBlockGroup := nextGroup(state.NextValidators.Groups(), header.Group)
+		nValSet.IncrementGroupProposerPriority(nextGroup(nValSet.Groups(), nextBlockGroup), 1)
+	} else {
+		nValSet.IncrementProposerPriority(1)
+	}
 
 	// Update the params with the latest abciResponses.
 	nextParams := state.ConsensusParams
@@ -426,6 +812,12 @@ func updateState(
 		lastHeightParamsChanged = header.Height + 1
 	}
 
+	// Update the open groups with the latest abciResponses.
+	nextGroups, err := updateGroups(state.Groups, abciResponses.EndBlock.OpenGroups, abciResponses.EndBlock.CloseGroups)
+	if err != nil {
+		return state, fmt.Errorf("Error updating mempool groups: %v", err)
+	}
+
 	// TODO: allow app to upgrade version
 	nextVersion := state.Version
 
@@ -446,9 +838,83 @@ func updateState(
 		LastHeightConsensusParamsChanged: lastHeightParamsChanged,
 		LastResultsHash:                  abciResponses.ResultsHash(),
 		AppHash:                          nil,
+		LastBlockGroup:                   header.Group,
+		LastBlockSections:                header.TxSections(),
+		AppNextGroup:                     abciResponses.EndBlock.NextGroup,
+		Groups:                           nextGroups,
 	}, nil
 }
 
+// GroupListener is notified when EndBlock opens or closes mempool groups,
+// once the block is committed and the state saved.
+type GroupListener interface {
+	OpenGroup(group int32)
+	CloseGroup(group int32)
+}
+
+// updateGroups returns the open groups after opening and closing the given
+// groups. Group 0 can't be closed. If groups is nil, they start at group 0.
+func updateGroups(groups []int32, open, close []int32) ([]int32, error) {
+	if len(open) == 0 && len(close) == 0 {
+		return groups, nil
+	}
+	if groups == nil {
+		groups = []int32{0}
+	}
+
+	closing := make(map[int32]bool, len(close))
+	for _, group := range close {
+		if group == 0 {
+			return nil, errors.New("Group 0 can't be closed")
+		}
+		closing[group] = true
+	}
+	for _, group := range open {
+		if group < 0 {
+			return nil, fmt.Errorf("Group can't be negative %v", group)
+		}
+		if closing[group] {
+			return nil, fmt.Errorf("Group %v can't be both opened and closed", group)
+		}
+	}
+
+	seen := make(map[int32]bool, len(groups)+len(open))
+	next := make([]int32, 0, len(groups)+len(open))
+	for _, list := range [][]int32{groups, open} {
+		for _, group := range list {
+			if !closing[group] && !seen[group] {
+				seen[group] = true
+				next = append(next, group)
+			}
+		}
+	}
+	sort.Slice(next, func(i, j int) bool { return next[i] < next[j] })
+	return next, nil
+}
+
+func groupLabel(group int32) string {
+	return strconv.Itoa(int(group))
+}
+
+// notifyGroupChanges tells listener about the groups which are in next but
+// not in last, and conversely. Both are sorted.
+func notifyGroupChanges(listener GroupListener, last, next []int32) {
+	i, j := 0, 0
+	for i < len(last) || j < len(next) {
+		switch {
+		case j == len(next) || (i < len(last) && last[i] < next[j]):
+			listener.CloseGroup(last[i])
+			i++
+		case i == len(last) || next[j] < last[i]:
+			listener.OpenGroup(next[j])
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+}
+
 // Fire NewBlock, NewBlockHeader.
 // Fire TxEvent for every tx.
 // NOTE: if Tendermint crashes before commit, some or all of these events may be published again.
@@ -470,6 +936,7 @@ func fireEvents(logger log.Logger, eventBus types.BlockEventPublisher, block *ty
 			Index:  uint32(i),
 			Tx:     tx,
 			Result: *(abciResponses.DeliverTx[i]),
+			Group:  block.TxGroup(i),
 		}})
 	}
 
@@ -13,6 +13,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	cmn "github.com/tendermint/tendermint/libs/common"
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
@@ -38,10 +39,10 @@ func TestApplyBlock(t *testing.T) {
 	state, stateDB := state(1, 1)
 
 	blockExec := NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
-		MockMempool{}, MockEvidencePool{})
+		map[int32]Mempool{0: MockMempool{}}, MockEvidencePool{})
 
 	block := makeBlock(state, 1)
-	blockID := types.BlockID{block.Hash(), block.MakePartSet(testPartSize).Header()}
+	blockID := types.BlockID{Hash: block.Hash(), PartsHeader: block.MakePartSet(testPartSize).Header()}
 
 	state, err = blockExec.ApplyBlock(state, blockID, block)
 	require.Nil(t, err)
@@ -62,7 +63,7 @@ func TestBeginBlockValidators(t *testing.T) {
 
 	prevHash := state.LastBlockID.Hash
 	prevParts := types.PartSetHeader{}
-	prevBlockID := types.BlockID{prevHash, prevParts}
+	prevBlockID := types.BlockID{Hash: prevHash, PartsHeader: prevParts}
 
 	now := tmtime.Now()
 	commitSig0 := (&types.Vote{ValidatorIndex: 0, Timestamp: now, Type: types.PrecommitType}).CommitSig()
@@ -115,7 +116,7 @@ func TestBeginBlockByzantineValidators(t *testing.T) {
 
 	prevHash := state.LastBlockID.Hash
 	prevParts := types.PartSetHeader{}
-	prevBlockID := types.BlockID{prevHash, prevParts}
+	prevBlockID := types.BlockID{Hash: prevHash, PartsHeader: prevParts}
 
 	height1, idx1, val1 := int64(8), 0, state.Validators.Validators[0].Address
 	height2, idx2, val2 := int64(3), 1, state.Validators.Validators[1].Address
@@ -159,7 +160,7 @@ func TestValidateValidatorUpdates(t *testing.T) {
 
 	secpKey := secp256k1.GenPrivKey().PubKey()
 
-	defaultValidatorParams := types.ValidatorParams{[]string{types.ABCIPubKeyTypeEd25519}}
+	defaultValidatorParams := types.ValidatorParams{PubKeyTypes: []string{types.ABCIPubKeyTypeEd25519}}
 
 	testCases := []struct {
 		name string
@@ -225,9 +226,9 @@ func TestValidateValidatorUpdates(t *testing.T) {
 
 func TestUpdateValidators(t *testing.T) {
 	pubkey1 := ed25519.GenPrivKey().PubKey()
-	val1 := types.NewValidator(pubkey1, 10)
+	val1 := types.NewValidator(pubkey1, 10, 0)
 	pubkey2 := ed25519.GenPrivKey().PubKey()
-	val2 := types.NewValidator(pubkey2, 20)
+	val2 := types.NewValidator(pubkey2, 20, 0)
 
 	testCases := []struct {
 		name string
@@ -253,7 +254,7 @@ func TestUpdateValidators(t *testing.T) {
 			types.NewValidatorSet([]*types.Validator{val1}),
 			[]abci.ValidatorUpdate{{PubKey: types.TM2PB.PubKey(pubkey1), Power: 20}},
 
-			types.NewValidatorSet([]*types.Validator{types.NewValidator(pubkey1, 20)}),
+			types.NewValidatorSet([]*types.Validator{types.NewValidator(pubkey1, 20, 0)}),
 			false,
 		},
 		{
@@ -309,7 +310,7 @@ func TestEndBlockValidatorUpdates(t *testing.T) {
 
 	state, stateDB := state(1, 1)
 
-	blockExec := NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(), MockMempool{}, MockEvidencePool{})
+	blockExec := NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(), map[int32]Mempool{0: MockMempool{}}, MockEvidencePool{})
 
 	eventBus := types.NewEventBus()
 	err = eventBus.Start()
@@ -322,7 +323,7 @@ func TestEndBlockValidatorUpdates(t *testing.T) {
 	require.NoError(t, err)
 
 	block := makeBlock(state, 1)
-	blockID := types.BlockID{block.Hash(), block.MakePartSet(testPartSize).Header()}
+	blockID := types.BlockID{Hash: block.Hash(), PartsHeader: block.MakePartSet(testPartSize).Header()}
 
 	pubkey := ed25519.GenPrivKey().PubKey()
 	app.ValidatorUpdates = []abci.ValidatorUpdate{
@@ -365,10 +366,10 @@ func TestEndBlockValidatorUpdatesResultingInEmptySet(t *testing.T) {
 	defer proxyApp.Stop()
 
 	state, stateDB := state(1, 1)
-	blockExec := NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(), MockMempool{}, MockEvidencePool{})
+	blockExec := NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(), map[int32]Mempool{0: MockMempool{}}, MockEvidencePool{})
 
 	block := makeBlock(state, 1)
-	blockID := types.BlockID{block.Hash(), block.MakePartSet(testPartSize).Header()}
+	blockID := types.BlockID{Hash: block.Hash(), PartsHeader: block.MakePartSet(testPartSize).Header()}
 
 	// Remove the only validator
 	app.ValidatorUpdates = []abci.ValidatorUpdate{
@@ -384,6 +385,237 @@ func TestEndBlockValidatorUpdatesResultingInEmptySet(t *testing.T) {
 //----------------------------------------------------------------------------
 
 // make some bogus txs
+// txsMempool is a MockMempool holding txs.
+type txsMempool struct {
+	MockMempool
+	txs types.Txs
+}
+
+func (mem txsMempool) Size() int { return len(mem.txs) }
+func (mem txsMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
+	var n int
+	for n < len(mem.txs) && txsBytes(mem.txs[:n+1]) <= maxBytes {
+		n++
+	}
+	return mem.txs[:n]
+}
+
+func TestReapGroups(t *testing.T) {
+	tx := types.Tx(cmn.RandBytes(98)) // 100 bytes with amino overhead
+	repeat := func(n int) types.Txs {
+		txs := make(types.Txs, n)
+		for i := range txs {
+			txs[i] = tx
+		}
+		return txs
+	}
+	sectionBytes := 3 * types.MaxTxSectionBytes
+
+	testCases := []struct {
+		name     string
+		sizes    map[int32]int
+		first    int32
+		maxBytes int64
+		expected [][2]int // group, number of txs
+	}{
+		{"even split", map[int32]int{0: 5, 1: 5, 2: 5}, 1, sectionBytes + 600,
+			[][2]int{{1, 2}, {2, 2}, {0, 2}}},
+		{"unused bytes flow to later groups", map[int32]int{0: 5, 1: 1, 2: 5}, 1, sectionBytes + 600,
+			[][2]int{{1, 1}, {2, 2}, {0, 3}}},
+		{"unused bytes flow to earlier groups", map[int32]int{0: 1, 1: 5, 2: 1}, 1, sectionBytes + 600,
+			[][2]int{{1, 4}, {2, 1}, {0, 1}}},
+		{"missing first group starts at the next one", map[int32]int{0: 5, 2: 5}, 1, sectionBytes + 600,
+			[][2]int{{2, 3}, {0, 3}}},
+		{"empty groups are skipped", map[int32]int{0: 0, 1: 0, 2: 0}, 1, sectionBytes + 600,
+			nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mempools := make(map[int32]Mempool)
+			for group, size := range tc.sizes {
+				mempools[group] = txsMempool{txs: repeat(size)}
+			}
+			groupTxs := reapGroups(mempools, tc.first, tc.maxBytes, -1)
+			require.Len(t, groupTxs, len(tc.expected))
+			for i, gt := range groupTxs {
+				assert.EqualValues(t, tc.expected[i][0], gt.Group)
+				assert.Len(t, gt.Txs, tc.expected[i][1], "group %d", gt.Group)
+			}
+		})
+	}
+
+	// a block can't have more sections than types.MaxTxSections
+	mempools := make(map[int32]Mempool)
+	for group := int32(0); group < types.MaxTxSections+10; group++ {
+		mempools[group] = txsMempool{txs: repeat(1)}
+	}
+	groupTxs := reapGroups(mempools, 5, 1<<20, -1)
+	require.Len(t, groupTxs, types.MaxTxSections)
+	assert.EqualValues(t, 5, groupTxs[0].Group)
+}
+
+// metaBlockStore is a BlockStore holding the metas of the blocks from base
+// to height, one block a minute.
+type metaBlockStore struct {
+	BlockStore
+	base, height int64
+	start        time.Time
+}
+
+func (bs metaBlockStore) Base() int64 { return bs.base }
+
+func (bs metaBlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
+	if height < bs.base || height > bs.height {
+		return nil
+	}
+	return &types.BlockMeta{Header: types.Header{Height: height, Time: bs.blockTime(height)}}
+}
+
+func (bs metaBlockStore) blockTime(height int64) time.Time {
+	return bs.start.Add(time.Duration(height) * time.Minute)
+}
+
+func TestRetainHeight(t *testing.T) {
+	blockStore := metaBlockStore{base: 1, height: 1000, start: tmtime.Now()}
+	state := State{LastBlockHeight: 1000, LastBlockTime: blockStore.blockTime(1000)}
+
+	testCases := []struct {
+		name            string
+		maxAge          int64
+		maxAgeDuration  time.Duration
+		retainBlocks    int64
+		appRetainHeight int64
+		base            int64
+		expected        int64
+	}{
+		{"no evidence window", 0, 0, 10, 0, 1, 991},
+		{"app retain height", 0, 0, 0, 500, 1, 500},
+		{"retain blocks within max age duration", 100, 200 * time.Minute, 10, 0, 1, 800},
+		{"retain blocks within max age", 100, 50 * time.Minute, 10, 0, 1, 900},
+		{"app retain height below the window", 100, 200 * time.Minute, 0, 500, 1, 500},
+		{"window already pruned", 100, 200 * time.Minute, 10, 0, 850, 0},
+		{"nothing to prune", 0, 0, 0, 0, 1, 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			blockStore.base = tc.base
+			blockExec := &BlockExecutor{blockStore: blockStore, pruning: true, retainBlocks: tc.retainBlocks}
+			state.ConsensusParams.Evidence = types.EvidenceParams{MaxAge: tc.maxAge, MaxAgeDuration: tc.maxAgeDuration}
+			assert.Equal(t, tc.expected, blockExec.retainHeight(state, tc.appRetainHeight))
+		})
+	}
+}
+
+// pruneBlockStore is a metaBlockStore which prunes by moving its base.
+type pruneBlockStore struct {
+	metaBlockStore
+}
+
+func (bs *pruneBlockStore) PruneBlocks(height int64) (uint64, error) {
+	pruned := height - bs.base
+	bs.base = height
+	return uint64(pruned), nil
+}
+
+// Evidence at the lowest retained height must still verify after pruning,
+// including its LastResultsHash, which is checked against the ABCI responses
+// of the height before.
+func TestPruneKeepsEvidenceVerifiable(t *testing.T) {
+	var retainHeight int64 = 5
+	state, stateDB := state(1, 10)
+	state.ConsensusParams.Evidence = types.EvidenceParams{MaxAge: 100, MaxAgeDuration: time.Hour}
+	for h := int64(1); h < 10; h++ {
+		SaveABCIResponses(stateDB, h, &ABCIResponses{
+			DeliverTx: []*abci.ResponseDeliverTx{{Data: []byte{byte(h)}}},
+			EndBlock:  &abci.ResponseEndBlock{},
+		})
+	}
+	blockStore := &pruneBlockStore{metaBlockStore{base: 1, height: 9, start: tmtime.Now()}}
+	state.LastBlockTime = blockStore.blockTime(9)
+	blockExec := &BlockExecutor{db: stateDB, blockStore: blockStore, logger: log.TestingLogger()}
+	blockExec.prune(retainHeight)
+	require.Equal(t, retainHeight, blockStore.Base())
+
+	_, err := LoadABCIResponses(stateDB, retainHeight-2)
+	assert.Error(t, err, "states below the height before the retain height are pruned")
+
+	privVal := types.NewMockPVWithParams(ed25519.GenPrivKeyFromSecret([]byte("test0")), false, false)
+	header := makeBlock(state, retainHeight).Header
+	header.LastResultsHash = tmhash.Sum([]byte("invalid"))
+	vote := &types.Vote{
+		ValidatorAddress: privVal.GetPubKey().Address(),
+		Height:           retainHeight,
+		Type:             types.PrecommitType,
+		BlockID:          types.BlockID{Hash: header.Hash()},
+	}
+	require.NoError(t, privVal.SignVote(chainID, vote))
+	ev := &types.LunaticValidatorEvidence{
+		Header:             &header,
+		Vote:               vote,
+		InvalidHeaderField: types.HeaderFieldLastResultsHash,
+	}
+	assert.NoError(t, VerifyEvidence(stateDB, blockStore, state, ev))
+}
+
+func TestMergeEndBlock(t *testing.T) {
+	pubKey := ed25519.GenPrivKey().PubKey()
+	endBlocks := []*abci.ResponseEndBlock{
+		{ValidatorUpdates: []abci.ValidatorUpdate{types.TM2PB.NewValidatorUpdate(pubKey, 10)}},
+		{
+			ValidatorUpdates:      []abci.ValidatorUpdate{types.TM2PB.NewValidatorUpdate(pubKey, 20)},
+			ConsensusParamUpdates: &abci.ConsensusParams{},
+		},
+	}
+	merged := mergeEndBlock(endBlocks)
+	require.Len(t, merged.ValidatorUpdates, 1)
+	assert.EqualValues(t, 20, merged.ValidatorUpdates[0].Power)
+	assert.NotNil(t, merged.ConsensusParamUpdates)
+}
+
+func TestUpdateGroups(t *testing.T) {
+	testCases := []struct {
+		name     string
+		groups   []int32
+		open     []int32
+		close    []int32
+		expected []int32
+		wantErr  bool
+	}{
+		{"no changes keep legacy groups", nil, nil, nil, nil, false},
+		{"open on legacy groups", nil, []int32{2}, nil, []int32{0, 2}, false},
+		{"open and close", []int32{0, 1, 3}, []int32{2, 2}, []int32{3, 5}, []int32{0, 1, 2}, false},
+		{"open an open group", []int32{0, 1}, []int32{1}, nil, []int32{0, 1}, false},
+		{"close group 0", []int32{0, 1}, nil, []int32{0}, nil, true},
+		{"open a negative group", []int32{0}, []int32{-1}, nil, nil, true},
+		{"open and close the same group", []int32{0}, []int32{1}, []int32{1}, nil, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			groups, err := updateGroups(tc.groups, tc.open, tc.close)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, groups)
+		})
+	}
+}
+
+type groupListener struct {
+	opened, closed []int32
+}
+
+func (l *groupListener) OpenGroup(group int32)  { l.opened = append(l.opened, group) }
+func (l *groupListener) CloseGroup(group int32) { l.closed = append(l.closed, group) }
+
+func TestNotifyGroupChanges(t *testing.T) {
+	l := &groupListener{}
+	notifyGroupChanges(l, []int32{0, 1, 3, 4}, []int32{0, 2, 3, 5, 6})
+	assert.Equal(t, []int32{2, 5, 6}, l.opened)
+	assert.Equal(t, []int32{1, 4}, l.closed)
+}
+
 func makeTxs(height int64) (txs []types.Tx) {
 	for i := 0; i < nTxsPerBlock; i++ {
 		txs = append(txs, types.Tx([]byte{byte(height), byte(i)}))
@@ -397,10 +629,10 @@ func state(nVals, height int) (State, dbm.DB) {
 		secret := []byte(fmt.Sprintf("test%d", i))
 		pk := ed25519.GenPrivKeyFromSecret(secret)
 		vals[i] = types.GenesisValidator{
-			pk.PubKey().Address(),
-			pk.PubKey(),
-			1000,
-			fmt.Sprintf("test%d", i),
+			Address: pk.PubKey().Address(),
+			PubKey:  pk.PubKey(),
+			Power:   1000,
+			Name:    fmt.Sprintf("test%d", i),
 		}
 	}
 	s, _ := MakeGenesisState(&types.GenesisDoc{
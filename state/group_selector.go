@@ -0,0 +1,191 @@
+package state
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+// GroupSelector decides which mempool group fills the next proposal block.
+// The group chosen by the proposer is recorded in Header.Group and carried
+// over to State.LastBlockGroup. The choice is a deterministic function of the
+// committed state, so ValidateGroup can check that a proposer followed the
+// policy. All the validators of a chain must use the same policy.
+type GroupSelector interface {
+	// SelectGroup returns the group whose txs should be reaped first for the
+	// next block. The returned group is open, but the node needn't run it.
+	SelectGroup(state State) int32
+
+	// ValidateGroup returns an error if block.Group is not the group chosen
+	// by this policy.
+	ValidateGroup(state State, block *types.Block) error
+}
+
+// validateSelectedGroup checks block.Group against the group chosen by sel.
+func validateSelectedGroup(sel GroupSelector, state State, block *types.Block) error {
+	if expected := sel.SelectGroup(state); block.Group != expected {
+		return fmt.Errorf("Wrong Block.Header.Group. Expected %v, got %v", expected, block.Group)
+	}
+	return nil
+}
+
+// lastBlockGroupTxs returns the open groups which had txs in the last block,
+// in ascending order, and their number of txs.
+func lastBlockGroupTxs(state State) ([]int32, map[int32]int64) {
+	groups := make([]int32, 0, len(state.LastBlockSections))
+	numTxs := make(map[int32]int64, len(state.LastBlockSections))
+	for _, section := range state.LastBlockSections {
+		if section.NumTxs <= 0 || !state.GroupOpen(section.Group) {
+			continue
+		}
+		if _, ok := numTxs[section.Group]; !ok {
+			groups = append(groups, section.Group)
+		}
+		numTxs[section.Group] += section.NumTxs
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i] < groups[j] })
+	return groups, numTxs
+}
+
+//-----------------------------------------------------------------------------
+
+// RoundRobinGroupSelector hands out blocks to the open groups in strict
+// rotation, ordered by group id. A group whose turn it is gets the block even if it has
+// no pending txs.
+type RoundRobinGroupSelector struct{}
+
+var _ GroupSelector = RoundRobinGroupSelector{}
+
+// NewRoundRobinGroupSelector returns a new RoundRobinGroupSelector.
+func NewRoundRobinGroupSelector() RoundRobinGroupSelector {
+	return RoundRobinGroupSelector{}
+}
+
+// SelectGroup implements GroupSelector.
+func (RoundRobinGroupSelector) SelectGroup(state State) int32 {
+	return nextGroup(state.OpenGroups(), state.LastBlockGroup)
+}
+
+// ValidateGroup implements GroupSelector.
+func (sel RoundRobinGroupSelector) ValidateGroup(state State, block *types.Block) error {
+	return validateSelectedGroup(sel, state, block)
+}
+
+// nextGroup returns the first group after last, wrapping around.
+// CONTRACT: groups is sorted and not empty.
+func nextGroup(groups []int32, last int32) int32 {
+	for _, group := range groups {
+		if group > last {
+			return group
+		}
+	}
+	return groups[0]
+}
+
+//-----------------------------------------------------------------------------
+
+// OldestTxGroupSelector gives the block to the groups with pending txs in
+// turn, so the group whose txs have waited the longest for a block of their
+// own goes first. Since mempool contents are local, a group is deemed to
+// have pending txs if it had txs in the last block. If no group had, the open
+// groups take turns as with the RoundRobinGroupSelector.
+type OldestTxGroupSelector struct{}
+
+var _ GroupSelector = OldestTxGroupSelector{}
+
+// NewOldestTxGroupSelector returns a new OldestTxGroupSelector.
+func NewOldestTxGroupSelector() OldestTxGroupSelector {
+	return OldestTxGroupSelector{}
+}
+
+// SelectGroup implements GroupSelector.
+func (OldestTxGroupSelector) SelectGroup(state State) int32 {
+	groups, _ := lastBlockGroupTxs(state)
+	if len(groups) == 0 {
+		return RoundRobinGroupSelector{}.SelectGroup(state)
+	}
+	return nextGroup(groups, state.LastBlockGroup)
+}
+
+// ValidateGroup implements GroupSelector.
+func (sel OldestTxGroupSelector) ValidateGroup(state State, block *types.Block) error {
+	return validateSelectedGroup(sel, state, block)
+}
+
+//-----------------------------------------------------------------------------
+
+// BacklogGroupSelector picks a group at random, weighted by the number of
+// txs of each group in the last block, which stands for the backlog of the
+// group since mempool contents are local. The randomness is seeded by the
+// last block hash, so every validator picks the same group. If no group had
+// txs, the open groups take turns as with the RoundRobinGroupSelector.
+type BacklogGroupSelector struct{}
+
+var _ GroupSelector = BacklogGroupSelector{}
+
+// NewBacklogGroupSelector returns a new BacklogGroupSelector.
+func NewBacklogGroupSelector() BacklogGroupSelector {
+	return BacklogGroupSelector{}
+}
+
+// SelectGroup implements GroupSelector.
+func (BacklogGroupSelector) SelectGroup(state State) int32 {
+	groups, numTxs := lastBlockGroupTxs(state)
+	var total uint64
+	for _, group := range groups {
+		total += uint64(numTxs[group])
+	}
+	if total == 0 {
+		return RoundRobinGroupSelector{}.SelectGroup(state)
+	}
+
+	var seed uint64
+	if hash := state.LastBlockID.Hash; len(hash) >= 8 {
+		seed = binary.BigEndian.Uint64(hash[:8])
+	} else {
+		seed = uint64(state.LastBlockHeight)
+	}
+	pick := seed % total
+	for _, group := range groups {
+		size := uint64(numTxs[group])
+		if pick < size {
+			return group
+		}
+		pick -= size
+	}
+	return groups[len(groups)-1]
+}
+
+// ValidateGroup implements GroupSelector.
+func (sel BacklogGroupSelector) ValidateGroup(state State, block *types.Block) error {
+	return validateSelectedGroup(sel, state, block)
+}
+
+//-----------------------------------------------------------------------------
+
+// ABCIGroupSelector lets the application decide which group goes next with
+// ResponseEndBlock.NextGroup, recorded in State.AppNextGroup. If the group is
+// not open, the open groups take turns as with the RoundRobinGroupSelector.
+type ABCIGroupSelector struct{}
+
+var _ GroupSelector = ABCIGroupSelector{}
+
+// NewABCIGroupSelector returns a new ABCIGroupSelector.
+func NewABCIGroupSelector() ABCIGroupSelector {
+	return ABCIGroupSelector{}
+}
+
+// SelectGroup implements GroupSelector.
+func (ABCIGroupSelector) SelectGroup(state State) int32 {
+	if !state.GroupOpen(state.AppNextGroup) {
+		return RoundRobinGroupSelector{}.SelectGroup(state)
+	}
+	return state.AppNextGroup
+}
+
+// ValidateGroup implements GroupSelector.
+func (sel ABCIGroupSelector) ValidateGroup(state State, block *types.Block) error {
+	return validateSelectedGroup(sel, state, block)
+}
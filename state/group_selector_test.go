@@ -0,0 +1,106 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestRoundRobinGroupSelector(t *testing.T) {
+	sel := NewRoundRobinGroupSelector()
+
+	testCases := []struct {
+		last     int32
+		expected int32
+	}{
+		{0, 3},
+		{3, 5},
+		{5, 0},
+		{4, 5}, // last group was closed
+		{7, 0},
+	}
+	for _, tc := range testCases {
+		state := State{LastBlockGroup: tc.last, Groups: []int32{0, 3, 5}}
+		assert.Equal(t, tc.expected, sel.SelectGroup(state), "last group %d", tc.last)
+
+		block := &types.Block{Header: types.Header{Group: tc.expected}}
+		assert.NoError(t, sel.ValidateGroup(state, block))
+		block.Group = tc.last
+		assert.Error(t, sel.ValidateGroup(state, block))
+	}
+
+	// states saved before groups were tracked only have group 0 open
+	assert.EqualValues(t, 0, sel.SelectGroup(State{LastBlockGroup: 3}))
+}
+
+func TestOldestTxGroupSelector(t *testing.T) {
+	sel := NewOldestTxGroupSelector()
+	state := State{
+		LastBlockGroup: 1,
+		Groups:         []int32{0, 1, 2, 3},
+		LastBlockSections: []types.TxSection{
+			{Group: 1, NumTxs: 5},
+			{Group: 3, NumTxs: 1},
+			{Group: 0, NumTxs: 2},
+		},
+	}
+	// groups with txs take turns
+	assert.EqualValues(t, 3, sel.SelectGroup(state))
+	state.LastBlockGroup = 3
+	assert.EqualValues(t, 0, sel.SelectGroup(state))
+
+	block := &types.Block{Header: types.Header{Group: 0}}
+	assert.NoError(t, sel.ValidateGroup(state, block))
+	block.Group = 2
+	assert.Error(t, sel.ValidateGroup(state, block), "group 2 had no txs")
+
+	// closed groups are skipped
+	state.Groups = []int32{0, 1, 2}
+	state.LastBlockGroup = 1
+	assert.EqualValues(t, 0, sel.SelectGroup(state))
+
+	// no txs: fall back to rotation
+	state.LastBlockSections = nil
+	assert.EqualValues(t, 2, sel.SelectGroup(state))
+}
+
+func TestBacklogGroupSelector(t *testing.T) {
+	sel := NewBacklogGroupSelector()
+	state := State{
+		Groups:            []int32{0, 1, 2},
+		LastBlockSections: []types.TxSection{{Group: 1, NumTxs: 10}},
+	}
+	for height := int64(1); height < 20; height++ {
+		state.LastBlockHeight = height
+		assert.EqualValues(t, 1, sel.SelectGroup(state))
+	}
+
+	// selection only depends on the last block
+	state.LastBlockSections = append(state.LastBlockSections, types.TxSection{Group: 2, NumTxs: 10})
+	state.LastBlockID = types.BlockID{Hash: []byte("0123456789abcdef")}
+	expected := sel.SelectGroup(state)
+	assert.Contains(t, []int32{1, 2}, expected)
+	block := &types.Block{Header: types.Header{Group: expected}}
+	assert.NoError(t, sel.ValidateGroup(state, block))
+	block.Group = 0
+	assert.Error(t, sel.ValidateGroup(state, block))
+}
+
+func TestABCIGroupSelector(t *testing.T) {
+	sel := NewABCIGroupSelector()
+	state := State{
+		LastBlockGroup: 0,
+		Groups:         []int32{0, 1, 2},
+		AppNextGroup:   2,
+	}
+	assert.EqualValues(t, 2, sel.SelectGroup(state))
+	block := &types.Block{Header: types.Header{Group: 1}}
+	assert.Error(t, sel.ValidateGroup(state, block))
+
+	// a group which isn't open falls back to rotation
+	state.AppNextGroup = 5
+	assert.EqualValues(t, 1, sel.SelectGroup(state))
+	assert.NoError(t, sel.ValidateGroup(state, block))
+}
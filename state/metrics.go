@@ -17,6 +17,10 @@ const (
 type Metrics struct {
 	// Time between BeginBlock and EndBlock.
 	BlockProcessingTime metrics.Histogram
+	// Number of blocks committed, by block group.
+	CommittedBlocks metrics.Counter
+	// Time the txs of a mempool group have been waiting for a block.
+	ReapStarvationSeconds metrics.Gauge
 }
 
 // PrometheusMetrics returns Metrics build using Prometheus client library.
@@ -35,12 +39,26 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Help:      "Time between BeginBlock and EndBlock in ms.",
 			Buckets:   stdprometheus.LinearBuckets(1, 10, 10),
 		}, labels).With(labelsAndValues...),
+		CommittedBlocks: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "committed_blocks",
+			Help:      "Number of blocks committed.",
+		}, append(labels, "group")).With(labelsAndValues...),
+		ReapStarvationSeconds: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "reap_starvation_seconds",
+			Help:      "Time the txs of a mempool group have been left out of blocks in seconds.",
+		}, append(labels, "group")).With(labelsAndValues...),
 	}
 }
 
 // NopMetrics returns no-op Metrics.
 func NopMetrics() *Metrics {
 	return &Metrics{
-		BlockProcessingTime: discard.NewHistogram(),
+		BlockProcessingTime:   discard.NewHistogram(),
+		CommittedBlocks:       discard.NewCounter(),
+		ReapStarvationSeconds: discard.NewGauge(),
 	}
 }
@@ -1,6 +1,8 @@
 package state
 
 import (
+	"time"
+
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/mempool"
 	"github.com/tendermint/tendermint/types"
@@ -22,6 +24,7 @@ type Mempool interface {
 	Unlock()
 
 	Size() int
+	OldestTxTime() time.Time
 	CheckTx(types.Tx, func(*abci.Response)) error
 	ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs
 	Update(int64, types.Txs, mempool.PreCheckFunc, mempool.PostCheckFunc) error
@@ -40,6 +43,7 @@ var _ Mempool = MockMempool{}
 func (MockMempool) Lock()                                            {}
 func (MockMempool) Unlock()                                          {}
 func (MockMempool) Size() int                                        { return 0 }
+func (MockMempool) OldestTxTime() time.Time                          { return time.Time{} }
 func (MockMempool) CheckTx(_ types.Tx, _ func(*abci.Response)) error { return nil }
 func (MockMempool) ReapMaxBytesMaxGas(_, _ int64) types.Txs          { return types.Txs{} }
 func (MockMempool) Update(
@@ -60,6 +64,7 @@ func (MockMempool) EnableTxsAvailable()           {}
 
 // BlockStoreRPC is the block store interface used by the RPC.
 type BlockStoreRPC interface {
+	Base() int64
 	Height() int64
 
 	LoadBlockMeta(height int64) *types.BlockMeta
@@ -74,6 +79,7 @@ type BlockStoreRPC interface {
 type BlockStore interface {
 	BlockStoreRPC
 	SaveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit)
+	PruneBlocks(height int64) (uint64, error)
 }
 
 //-----------------------------------------------------------------------------------------------------
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io/ioutil"
+	"sort"
 	"time"
 
 	"github.com/tendermint/tendermint/types"
@@ -81,6 +82,24 @@ type State struct {
 
 	// the latest AppHash we've received from calling abci.Commit()
 	AppHash []byte
+
+	// Group of the last block, as chosen by the proposer's GroupSelector.
+	// Used to validate the group of the next block.
+	LastBlockGroup int32
+
+	// Tx sections of the last block, one per group with txs. Used by the
+	// GroupSelectors weighing the groups by their txs.
+	LastBlockSections []types.TxSection
+
+	// Group of the next block as set by the last EndBlock. Used by the
+	// ABCIGroupSelector.
+	AppNextGroup int32
+
+	// Mempool groups open on chain, in ascending order. Blocks can only carry
+	// txs of open groups. Groups are opened and closed by EndBlock, from the
+	// next height on. Nil for states saved before groups were tracked, in
+	// which case only group 0 is open.
+	Groups []int32
 }
 
 // Copy makes a copy of the State for mutating.
@@ -105,6 +124,12 @@ func (state State) Copy() State {
 		AppHash: state.AppHash,
 
 		LastResultsHash: state.LastResultsHash,
+
+		LastBlockGroup:    state.LastBlockGroup,
+		LastBlockSections: state.LastBlockSections,
+		AppNextGroup:      state.AppNextGroup,
+
+		Groups: state.Groups,
 	}
 }
 
@@ -124,6 +149,51 @@ func (state State) IsEmpty() bool {
 	return state.Validators == nil // XXX can't compare to Empty
 }
 
+// OpenGroups returns the mempool groups open on chain, in ascending order.
+func (state State) OpenGroups() []int32 {
+	if state.Groups == nil {
+		return []int32{0}
+	}
+	return state.Groups
+}
+
+// GroupOpen returns true if txs of the given group can be included in blocks.
+func (state State) GroupOpen(group int32) bool {
+	groups := state.OpenGroups()
+	i := sort.Search(len(groups), func(i int) bool { return groups[i] >= group })
+	return i < len(groups) && groups[i] == group
+}
+
+// GroupValidators returns true if the blocks of each group are proposed and
+// signed by the validators of that group only.
+func (state State) GroupValidators() bool {
+	return state.ConsensusParams.Validator.GroupValidators
+}
+
+// NextBlockGroup returns the group of the next block when GroupValidators is
+// set: groups with validators, and group 0, take turns in ascending order.
+func (state State) NextBlockGroup() int32 {
+	return state.Validators.NextGroup(state.LastBlockGroup)
+}
+
+// BlockValidators returns the validators proposing and signing the next
+// block. It is state.Validators unless GroupValidators is set.
+func (state State) BlockValidators() *types.ValidatorSet {
+	if !state.GroupValidators() {
+		return state.Validators
+	}
+	return state.Validators.GroupSet(state.NextBlockGroup())
+}
+
+// LastBlockValidators returns the validators which signed the last block.
+// It is state.LastValidators unless GroupValidators is set.
+func (state State) LastBlockValidators() *types.ValidatorSet {
+	if !state.GroupValidators() || state.LastBlockHeight == 0 {
+		return state.LastValidators
+	}
+	return state.LastValidators.GroupSet(state.LastBlockGroup)
+}
+
 //------------------------------------------------------------------------
 // Create a block from the latest state
 
@@ -142,12 +212,39 @@ func (state State) MakeBlock(
 	// Build base block with block data.
 	block := types.MakeBlock(height, txs, commit, evidence)
 	block.Header.Group = group
+	return state.populateBlock(height, block, commit, proposerAddress)
+}
+
+// MakeMultiGroupBlock is like MakeBlock, but the block carries txs from
+// several mempool groups. group is the group chosen by the GroupSelector.
+func (state State) MakeMultiGroupBlock(
+	height int64,
+	group int32,
+	groupTxs []types.GroupTxs,
+	commit *types.Commit,
+	evidence []types.Evidence,
+	proposerAddress []byte,
+) (*types.Block, *types.PartSet) {
+
+	// Build base block with block data.
+	block := types.MakeMultiGroupBlock(height, group, groupTxs, commit, evidence)
+	return state.populateBlock(height, block, commit, proposerAddress)
+}
+
+// populateBlock fills the rest of the block header with state data.
+func (state State) populateBlock(
+	height int64,
+	block *types.Block,
+	commit *types.Commit,
+	proposerAddress []byte,
+) (*types.Block, *types.PartSet) {
+
 	// Set time.
 	var timestamp time.Time
 	if height == 1 {
 		timestamp = state.LastBlockTime // genesis time
 	} else {
-		timestamp = MedianTime(commit, state.LastValidators)
+		timestamp = MedianTime(commit, state.LastBlockValidators())
 	}
 
 	// Fill rest of header with state data.
@@ -247,5 +344,7 @@ func MakeGenesisState(genDoc *types.GenesisDoc) (State, error) {
 		LastHeightConsensusParamsChanged: 1,
 
 		AppHash: genDoc.AppHash,
+
+		Groups: genDoc.MempoolGroupIDs(),
 	}, nil
 }
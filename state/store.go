@@ -9,6 +9,13 @@ import (
 	"github.com/tendermint/tendermint/types"
 )
 
+const (
+	// persist the full validator set every valSetCheckpointInterval blocks,
+	// so it can be loaded without going back to the height it last changed
+	// at, which may have been pruned.
+	valSetCheckpointInterval = 100000
+)
+
 //------------------------------------------------------------------------
 
 func calcValidatorsKey(height int64) []byte {
@@ -76,6 +83,9 @@ func loadState(db dbm.DB, key []byte) (state State) {
 	}
 	// TODO: ensure that buf is completely read.
 
+	// states saved before MaxAgeDuration was added
+	state.ConsensusParams.Complete()
+
 	return state
 }
 
@@ -85,6 +95,23 @@ func SaveState(db dbm.DB, state State) {
 	saveState(db, state, stateKey)
 }
 
+// BootstrapState saves a state which doesn't follow from the blocks stored
+// before it, like one restored by state sync. As the heights its validator
+// sets and consensus params last changed at are unknown, they are stored in
+// full for its last, current and next heights.
+func BootstrapState(db dbm.DB, state State) error {
+	height := state.LastBlockHeight
+	if height <= 0 {
+		return fmt.Errorf("Can't bootstrap a state at height %d", height)
+	}
+	state.LastHeightValidatorsChanged = height + 2
+	state.LastHeightConsensusParamsChanged = height + 1
+	saveValidatorsInfo(db, height, height, state.LastValidators)
+	saveValidatorsInfo(db, height+1, height+1, state.Validators)
+	saveState(db, state, stateKey)
+	return nil
+}
+
 func saveState(db dbm.DB, state State, key []byte) {
 	nextHeight := state.LastBlockHeight + 1
 	// If first block, save validators for block 1.
@@ -158,7 +185,7 @@ func LoadABCIResponses(db dbm.DB, height int64) (*ABCIResponses, error) {
 // SaveABCIResponses persists the ABCIResponses to the database.
 // This is useful in case we crash after app.Commit and before s.Save().
 // Responses are indexed by height so they can also be loaded later to produce Merkle proofs.
-func saveABCIResponses(db dbm.DB, height int64, abciResponses *ABCIResponses) {
+func SaveABCIResponses(db dbm.DB, height int64, abciResponses *ABCIResponses) {
 	db.SetSync(calcABCIResponsesKey(height), abciResponses.Bytes())
 }
 
@@ -184,23 +211,37 @@ func LoadValidators(db dbm.DB, height int64) (*types.ValidatorSet, error) {
 	}
 
 	if valInfo.ValidatorSet == nil {
-		valInfo2 := loadValidatorsInfo(db, valInfo.LastHeightChanged)
-		if valInfo2 == nil {
+		lastStoredHeight := lastStoredHeightFor(height, valInfo.LastHeightChanged)
+		valInfo2 := loadValidatorsInfo(db, lastStoredHeight)
+		if valInfo2 == nil || valInfo2.ValidatorSet == nil {
+			// the checkpoint may predate checkpoints being stored
+			lastStoredHeight = valInfo.LastHeightChanged
+			valInfo2 = loadValidatorsInfo(db, lastStoredHeight)
+		}
+		if valInfo2 == nil || valInfo2.ValidatorSet == nil {
 			panic(
 				fmt.Sprintf(
 					"Couldn't find validators at height %d as last changed from height %d",
-					valInfo.LastHeightChanged,
+					lastStoredHeight,
 					height,
 				),
 			)
 		}
-		valInfo2.ValidatorSet.IncrementProposerPriority(int(height - valInfo.LastHeightChanged)) // mutate
+		valInfo2.ValidatorSet.IncrementProposerPriority(int(height - lastStoredHeight)) // mutate
 		valInfo = valInfo2
 	}
 
 	return valInfo.ValidatorSet, nil
 }
 
+// lastStoredHeightFor returns the last height at or below height for which a
+// full validator set is stored: its checkpoint, or the height it last changed
+// at.
+func lastStoredHeightFor(height, lastHeightChanged int64) int64 {
+	checkpointHeight := height - height%valSetCheckpointInterval
+	return cmn.MaxInt64(checkpointHeight, lastHeightChanged)
+}
+
 // CONTRACT: Returned ValidatorsInfo can be mutated.
 func loadValidatorsInfo(db dbm.DB, height int64) *ValidatorsInfo {
 	buf := db.Get(calcValidatorsKey(height))
@@ -224,7 +265,8 @@ func loadValidatorsInfo(db dbm.DB, height int64) *ValidatorsInfo {
 // `height` is the effective height for which the validator is responsible for signing.
 // It should be called from s.Save(), right before the state itself is persisted.
 // If the validator set did not change after processing the latest block,
-// only the last height for which the validators changed is persisted.
+// only the last height for which the validators changed is persisted, but at
+// checkpoint heights.
 func saveValidatorsInfo(db dbm.DB, height, lastHeightChanged int64, valSet *types.ValidatorSet) {
 	if lastHeightChanged > height {
 		panic("LastHeightChanged cannot be greater than ValidatorsInfo height")
@@ -232,7 +274,7 @@ func saveValidatorsInfo(db dbm.DB, height, lastHeightChanged int64, valSet *type
 	valInfo := &ValidatorsInfo{
 		LastHeightChanged: lastHeightChanged,
 	}
-	if lastHeightChanged == height {
+	if lastHeightChanged == height || height%valSetCheckpointInterval == 0 {
 		valInfo.ValidatorSet = valSet
 	}
 	db.Set(calcValidatorsKey(height), valInfo.Bytes())
@@ -274,7 +316,9 @@ func LoadConsensusParams(db dbm.DB, height int64) (types.ConsensusParams, error)
 		paramsInfo = paramsInfo2
 	}
 
-	return paramsInfo.ConsensusParams, nil
+	params := paramsInfo.ConsensusParams
+	params.Complete()
+	return params, nil
 }
 
 func loadConsensusParamsInfo(db dbm.DB, height int64) *ConsensusParamsInfo {
@@ -308,3 +352,78 @@ func saveConsensusParamsInfo(db dbm.DB, nextHeight, changeHeight int64, params t
 	}
 	db.Set(calcConsensusParamsKey(nextHeight), paramsInfo.Bytes())
 }
+
+//-----------------------------------------------------------------------------
+
+// PruneStates deletes the validator sets, consensus params and ABCI responses
+// of the heights from `from` (inclusive) to `to` (exclusive). The validator
+// sets of the checkpoint heights are kept, as well as what the heights from
+// `to` on are loaded from: if their validator set or consensus params last
+// changed at a pruned height, they are stored in full at that height.
+func PruneStates(db dbm.DB, from int64, to int64) error {
+	if from <= 0 || to <= 0 {
+		return fmt.Errorf("from height %v and to height %v must be greater than 0", from, to)
+	}
+	if from >= to {
+		return fmt.Errorf("from height %v must be lower than to height %v", from, to)
+	}
+	valInfo := loadValidatorsInfo(db, to)
+	if valInfo == nil {
+		return fmt.Errorf("validators at height %v not found", to)
+	}
+	paramsInfo := loadConsensusParamsInfo(db, to)
+	if paramsInfo == nil {
+		return fmt.Errorf("consensus params at height %v not found", to)
+	}
+
+	keepVals := map[int64]bool{
+		valInfo.LastHeightChanged: true,
+		lastStoredHeightFor(to, valInfo.LastHeightChanged): true,
+	}
+	keepParams := map[int64]bool{paramsInfo.LastHeightChanged: true}
+
+	batch := db.NewBatch()
+	pruned := uint64(0)
+	// heights are deleted from the highest, as lower heights which are kept
+	// may need to be loaded through the pointers of higher heights
+	for h := to - 1; h >= from; h-- {
+		if keepVals[h] || h%valSetCheckpointInterval == 0 {
+			v := loadValidatorsInfo(db, h)
+			if v != nil && v.ValidatorSet == nil {
+				vals, err := LoadValidators(db, h)
+				if err != nil {
+					return err
+				}
+				v.ValidatorSet = vals
+				batch.Set(calcValidatorsKey(h), v.Bytes())
+			}
+		} else {
+			batch.Delete(calcValidatorsKey(h))
+		}
+
+		if keepParams[h] {
+			p := loadConsensusParamsInfo(db, h)
+			if p != nil && p.ConsensusParams.Equals(&types.ConsensusParams{}) {
+				params, err := LoadConsensusParams(db, h)
+				if err != nil {
+					return err
+				}
+				p.ConsensusParams = params
+				batch.Set(calcConsensusParamsKey(h), p.Bytes())
+			}
+		} else {
+			batch.Delete(calcConsensusParamsKey(h))
+		}
+
+		batch.Delete(calcABCIResponsesKey(h))
+		pruned++
+
+		// flush every 1000 heights, to keep batches small
+		if pruned%1000 == 0 {
+			batch.WriteSync()
+			batch = db.NewBatch()
+		}
+	}
+	batch.WriteSync()
+	return nil
+}
@@ -0,0 +1,181 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestStoreLoadValidatorsFromCheckpoint(t *testing.T) {
+	stateDB := dbm.NewMemDB()
+	vals, _ := types.RandValidatorSet(3, 10)
+
+	// the validators changed at height 1, and are stored in full at the
+	// checkpoint too
+	saveValidatorsInfo(stateDB, 1, 1, vals)
+	saveValidatorsInfo(stateDB, 2, 1, vals)
+	checkpointVals := vals.CopyIncrementProposerPriority(valSetCheckpointInterval - 1)
+	saveValidatorsInfo(stateDB, valSetCheckpointInterval, 1, checkpointVals)
+	assert.Nil(t, loadValidatorsInfo(stateDB, 2).ValidatorSet)
+	assert.NotNil(t, loadValidatorsInfo(stateDB, valSetCheckpointInterval).ValidatorSet)
+
+	loaded, err := LoadValidators(stateDB, valSetCheckpointInterval)
+	require.NoError(t, err)
+	assertValSetsEqual(t, checkpointVals, loaded)
+
+	// without the set at height 1, the following heights load from the
+	// checkpoint
+	stateDB.Delete(calcValidatorsKey(1))
+	saveValidatorsInfo(stateDB, valSetCheckpointInterval+2, 1, vals)
+	loaded, err = LoadValidators(stateDB, valSetCheckpointInterval+2)
+	require.NoError(t, err)
+	assertValSetsEqual(t, checkpointVals.CopyIncrementProposerPriority(2), loaded)
+}
+
+func TestBootstrapState(t *testing.T) {
+	stateDB := dbm.NewMemDB()
+	lastVals, _ := types.RandValidatorSet(3, 10)
+	vals, _ := types.RandValidatorSet(3, 10)
+	nextVals, _ := types.RandValidatorSet(3, 10)
+	params := types.DefaultConsensusParams()
+	params.BlockSize.MaxBytes = 10000
+	state := State{
+		ChainID:         "test",
+		LastBlockHeight: 0,
+		LastValidators:  lastVals,
+		Validators:      vals,
+		NextValidators:  nextVals,
+		ConsensusParams: *params,
+		AppHash:         []byte("app_hash"),
+	}
+	require.Error(t, BootstrapState(stateDB, state))
+
+	state.LastBlockHeight = 1000
+	require.NoError(t, BootstrapState(stateDB, state))
+
+	loaded := LoadState(stateDB)
+	assert.EqualValues(t, 1000, loaded.LastBlockHeight)
+	assert.EqualValues(t, 1002, loaded.LastHeightValidatorsChanged)
+	assert.EqualValues(t, 1001, loaded.LastHeightConsensusParamsChanged)
+	for h, expected := range map[int64]*types.ValidatorSet{1000: lastVals, 1001: vals, 1002: nextVals} {
+		loadedVals, err := LoadValidators(stateDB, h)
+		require.NoError(t, err, "height %d", h)
+		assertValSetsEqual(t, expected, loadedVals, "height %d", h)
+	}
+	loadedParams, err := LoadConsensusParams(stateDB, 1001)
+	require.NoError(t, err)
+	assert.Equal(t, *params, loadedParams)
+}
+
+func TestPruneStates(t *testing.T) {
+	testcases := map[string]struct {
+		makeHeights int64
+		pruneFrom   int64
+		pruneTo     int64
+		expectErr   bool
+		// heights below pruneTo still stored
+		expectVals   []int64
+		expectParams []int64
+		expectABCI   []int64
+	}{
+		"error on from 0":              {100, 0, 5, true, nil, nil, nil},
+		"error when from > to":         {100, 3, 2, true, nil, nil, nil},
+		"error when from == to":        {100, 3, 3, true, nil, nil, nil},
+		"error when to does not exist": {100, 1, 101, true, nil, nil, nil},
+		"prune all":                    {100, 1, 100, false, []int64{93}, []int64{95}, []int64{}},
+		"prune some":                   {10, 2, 8, false, []int64{1, 3}, []int64{1, 5}, []int64{1}},
+		"prune across checkpoint": {valSetCheckpointInterval + 10, 1, valSetCheckpointInterval + 5, false,
+			[]int64{99993, valSetCheckpointInterval}, []int64{99995}, []int64{}},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			db := dbm.NewMemDB()
+
+			// the validators change 7 heights, and the consensus params 5
+			// heights, before the highest multiple of 100 (at heights 3 and 5
+			// for shorter chains)
+			valsChangedAt := tc.makeHeights - tc.makeHeights%100 - 7
+			paramsChangedAt := tc.makeHeights - tc.makeHeights%100 - 5
+			if tc.makeHeights < 100 {
+				valsChangedAt, paramsChangedAt = 3, 5
+			}
+			vals1, _ := types.RandValidatorSet(3, 10)
+			vals2, _ := types.RandValidatorSet(3, 10)
+			params1 := types.DefaultConsensusParams()
+			params2 := types.DefaultConsensusParams()
+			params2.BlockSize.MaxBytes = 10000
+
+			valsLHC, vals := int64(1), vals1
+			for h := int64(1); h <= tc.makeHeights; h++ {
+				switch {
+				case h == valsChangedAt:
+					valsLHC, vals = valsChangedAt, vals2
+				case h > 1:
+					vals = vals.CopyIncrementProposerPriority(1)
+				}
+				paramsLHC, params := int64(1), *params1
+				if h >= paramsChangedAt {
+					paramsLHC, params = paramsChangedAt, *params2
+				}
+				saveValidatorsInfo(db, h, valsLHC, vals)
+				saveConsensusParamsInfo(db, h, paramsLHC, params)
+				SaveABCIResponses(db, h, &ABCIResponses{
+					DeliverTx: []*abci.ResponseDeliverTx{{Data: []byte{1}}},
+					EndBlock:  &abci.ResponseEndBlock{},
+				})
+			}
+
+			// the heights which are kept must load the same after pruning
+			expected := make(map[int64]*types.ValidatorSet)
+			for h := tc.pruneTo; h > 0 && h <= tc.makeHeights; h++ {
+				vals, err := LoadValidators(db, h)
+				require.NoError(t, err)
+				expected[h] = vals
+			}
+
+			err := PruneStates(db, tc.pruneFrom, tc.pruneTo)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			for h, vals := range expected {
+				loaded, err := LoadValidators(db, h)
+				require.NoError(t, err, "height %d", h)
+				assertValSetsEqual(t, vals, loaded, "height %d", h)
+				_, err = LoadConsensusParams(db, h)
+				require.NoError(t, err, "height %d", h)
+			}
+
+			assert.Equal(t, tc.expectVals, storedHeights(db, tc.pruneTo-1, calcValidatorsKey))
+			assert.Equal(t, tc.expectParams, storedHeights(db, tc.pruneTo-1, calcConsensusParamsKey))
+			assert.Equal(t, tc.expectABCI, storedHeights(db, tc.pruneTo-1, calcABCIResponsesKey))
+			_, err = LoadABCIResponses(db, tc.pruneTo)
+			require.NoError(t, err)
+		})
+	}
+}
+
+// storedHeights returns the heights up to maxHeight stored under key.
+func storedHeights(db dbm.DB, maxHeight int64, key func(int64) []byte) []int64 {
+	heights := []int64{}
+	for h := int64(1); h <= maxHeight; h++ {
+		if db.Has(key(h)) {
+			heights = append(heights, h)
+		}
+	}
+	return heights
+}
+
+func assertValSetsEqual(t *testing.T, expected, actual *types.ValidatorSet, msgAndArgs ...interface{}) {
+	t.Helper()
+	assert.Equal(t, expected.Validators, actual.Validators, msgAndArgs...)
+	assert.Equal(t, expected.Proposer, actual.Proposer, msgAndArgs...)
+}
@@ -1,6 +1,8 @@
 package txindex
 
 import (
+	"encoding/binary"
+	"encoding/hex"
 	"errors"
 
 	"github.com/tendermint/tendermint/libs/pubsub/query"
@@ -20,8 +22,114 @@ type TxIndexer interface {
 	// or stored.
 	Get(hash []byte) (*types.TxResult, error)
 
-	// Search allows you to query for transactions.
-	Search(q *query.Query) ([]*types.TxResult, error)
+	// Search allows you to query for transactions. It returns a page of the
+	// matching transactions, ordered by height and index (see SearchOptions).
+	Search(q *query.Query, opts SearchOptions) (*SearchResult, error)
+}
+
+// BlockIndexer interface defines methods to index and search blocks by the
+// tags of their BeginBlock and EndBlock responses.
+type BlockIndexer interface {
+
+	// Has returns true if the block at the given height has been indexed.
+	Has(height int64) (bool, error)
+
+	// Index analyzes, indexes and stores the tags of a block.
+	Index(block types.EventDataNewBlockHeader) error
+
+	// Search allows you to query for blocks. It returns a page of the heights
+	// of the matching blocks, ordered by height (see SearchOptions; the Index
+	// of the cursor is not used).
+	Search(q *query.Query, opts SearchOptions) (*BlockSearchResult, error)
+}
+
+//----------------------------------------------------
+// Search pages
+
+// Cursor points at a transaction by the height of its block and its index in
+// the block. Searches use it to continue from the last returned transaction.
+type Cursor struct {
+	Height int64
+	Index  uint32
+}
+
+// Less reports whether the transaction c points at comes before the one other
+// points at, in ascending order.
+func (c Cursor) Less(other Cursor) bool {
+	if c.Height == other.Height {
+		return c.Index < other.Index
+	}
+	return c.Height < other.Height
+}
+
+// String returns the cursor as an opaque continuation token, which can be
+// turned back into a cursor with ParseCursor.
+func (c Cursor) String() string {
+	bz := make([]byte, 12)
+	binary.BigEndian.PutUint64(bz[:8], uint64(c.Height))
+	binary.BigEndian.PutUint32(bz[8:], c.Index)
+	return hex.EncodeToString(bz)
+}
+
+// ParseCursor parses a continuation token returned by Cursor.String.
+func ParseCursor(token string) (Cursor, error) {
+	bz, err := hex.DecodeString(token)
+	if err != nil || len(bz) != 12 {
+		return Cursor{}, ErrorInvalidCursor
+	}
+	c := Cursor{
+		Height: int64(binary.BigEndian.Uint64(bz[:8])),
+		Index:  binary.BigEndian.Uint32(bz[8:]),
+	}
+	if c.Height < 1 {
+		return Cursor{}, ErrorInvalidCursor
+	}
+	return c, nil
+}
+
+// SearchOptions selects the page of transactions returned by a search.
+type SearchOptions struct {
+	// Descending orders the transactions from the highest height and index
+	// to the lowest, instead of the other way around.
+	Descending bool
+	// After, when set, skips the transactions up to and including the one it
+	// points at.
+	After *Cursor
+	// Skip is the number of transactions to skip after the cursor.
+	Skip int
+	// Limit is the maximum number of transactions to return. It must be
+	// positive.
+	Limit int
+}
+
+// Before reports whether a comes before b in the order of the search.
+func (opts SearchOptions) Before(a, b Cursor) bool {
+	if opts.Descending {
+		return b.Less(a)
+	}
+	return a.Less(b)
+}
+
+// SearchResult is a page of transactions matching a query.
+type SearchResult struct {
+	Txs []*types.TxResult
+	// Total is the number of transactions matching the query, regardless of
+	// the page.
+	Total int
+	// Next points at the last returned transaction when more transactions
+	// follow it, and is nil otherwise.
+	Next *Cursor
+}
+
+// BlockSearchResult is a page of the heights of the blocks matching a query.
+type BlockSearchResult struct {
+	Heights []int64
+	// Total is the number of blocks matching the query, regardless of the
+	// page.
+	Total int
+	// Next points at the last returned height when more blocks follow it,
+	// and is nil otherwise.
+	Next *Cursor
 }
 
 //----------------------------------------------------
@@ -56,3 +164,9 @@ func (b *Batch) Size() int {
 
 // ErrorEmptyHash indicates empty hash
 var ErrorEmptyHash = errors.New("Transaction hash cannot be empty")
+
+// ErrorInvalidCursor indicates a malformed continuation token
+var ErrorInvalidCursor = errors.New("Invalid search cursor")
+
+// ErrorInvalidLimit indicates a search without a positive limit
+var ErrorInvalidLimit = errors.New("Search limit must be positive")
@@ -12,24 +12,25 @@ const (
 	subscriber = "IndexerService"
 )
 
-// IndexerService connects event bus and transaction indexer together in order
-// to index transactions coming from event bus.
+// IndexerService connects event bus and transaction and block indexers
+// together in order to index transactions and blocks coming from event bus.
 type IndexerService struct {
 	cmn.BaseService
 
-	idr      TxIndexer
-	eventBus *types.EventBus
+	idr       TxIndexer
+	blockIdxr BlockIndexer
+	eventBus  *types.EventBus
 }
 
 // NewIndexerService returns a new service instance.
-func NewIndexerService(idr TxIndexer, eventBus *types.EventBus) *IndexerService {
-	is := &IndexerService{idr: idr, eventBus: eventBus}
+func NewIndexerService(idr TxIndexer, blockIdxr BlockIndexer, eventBus *types.EventBus) *IndexerService {
+	is := &IndexerService{idr: idr, blockIdxr: blockIdxr, eventBus: eventBus}
 	is.BaseService = *cmn.NewBaseService(nil, "IndexerService", is)
 	return is
 }
 
-// OnStart implements cmn.Service by subscribing for all transactions
-// and indexing them by tags.
+// OnStart implements cmn.Service by subscribing for all block headers and
+// transactions and indexing them by tags.
 func (is *IndexerService) OnStart() error {
 	blockHeadersCh := make(chan interface{})
 	if err := is.eventBus.Subscribe(context.Background(), subscriber, types.EventQueryNewBlockHeader, blockHeadersCh); err != nil {
@@ -47,7 +48,11 @@ func (is *IndexerService) OnStart() error {
 			if !ok {
 				return
 			}
-			header := e.(types.EventDataNewBlockHeader).Header
+			blockHeader := e.(types.EventDataNewBlockHeader)
+			header := blockHeader.Header
+			if err := is.blockIdxr.Index(blockHeader); err != nil {
+				is.Logger.Error("Failed to index block", "height", header.Height, "err", err)
+			}
 			batch := NewBatch(header.NumTxs)
 			for i := int64(0); i < header.NumTxs; i++ {
 				e, ok := <-txsCh
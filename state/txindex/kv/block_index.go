@@ -0,0 +1,193 @@
+package kv
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/types"
+)
+
+var _ txindex.BlockIndexer = (*BlockIndex)(nil)
+
+// BlockIndex indexes blocks by the tags of their BeginBlock and EndBlock
+// responses, backed by key-value storage. Like for txs, each tag is stored as
+// a "tag/value/height" key. "block.height" and "block.group" are always
+// indexed, the latter once per group of the block (see Header.Groups), and
+// the "block.height" key of a block holds its indexed tags.
+type BlockIndex struct {
+	store        dbm.DB
+	tagsToIndex  []string
+	indexAllTags bool
+}
+
+// NewBlockIndex creates new KV block indexer.
+func NewBlockIndex(store dbm.DB, options ...func(*BlockIndex)) *BlockIndex {
+	bi := &BlockIndex{store: store, tagsToIndex: make([]string, 0), indexAllTags: false}
+	for _, o := range options {
+		o(bi)
+	}
+	return bi
+}
+
+// BlockIndexTags is an option for setting which tags to index.
+func BlockIndexTags(tags []string) func(*BlockIndex) {
+	return func(bi *BlockIndex) {
+		bi.tagsToIndex = tags
+	}
+}
+
+// BlockIndexAllTags is an option for indexing all tags.
+func BlockIndexAllTags() func(*BlockIndex) {
+	return func(bi *BlockIndex) {
+		bi.indexAllTags = true
+	}
+}
+
+// Has returns true if the block at the given height has been indexed.
+func (bi *BlockIndex) Has(height int64) (bool, error) {
+	return bi.store.Has(keyForBlock(types.BlockHeightKey, height, height)), nil
+}
+
+// Index indexes the tags of a block.
+func (bi *BlockIndex) Index(block types.EventDataNewBlockHeader) error {
+	height := block.Header.Height
+	b := bi.store.NewBatch()
+
+	// index block by each of its groups
+	var tags []cmn.KVPair
+	for _, group := range block.Header.Groups() {
+		tags = append(tags, cmn.KVPair{Key: []byte(types.BlockGroupKey), Value: []byte(fmt.Sprintf("%d", group))})
+		b.Set(keyForBlock(types.BlockGroupKey, group, height), []byte{})
+	}
+
+	// index block by tags
+	resultTags := append(append([]cmn.KVPair{}, block.ResultBeginBlock.Tags...), block.ResultEndBlock.Tags...)
+	for _, tag := range resultTags {
+		key := string(tag.Key)
+		if len(key) == 0 || key == types.BlockHeightKey || key == types.BlockGroupKey {
+			continue
+		}
+		if bi.indexAllTags || cmn.StringInSlice(key, bi.tagsToIndex) {
+			b.Set(keyForBlock(key, string(tag.Value), height), []byte{})
+			tags = append(tags, tag)
+		}
+	}
+
+	// index block by height
+	rawBytes, err := cdc.MarshalBinaryBare(tags)
+	if err != nil {
+		return err
+	}
+	b.Set(keyForBlock(types.BlockHeightKey, height, height), rawBytes)
+
+	b.Write()
+	return nil
+}
+
+// Search performs a search using the given query, the same way
+// TxIndex.Search does, and returns the heights of the matching blocks.
+func (bi *BlockIndex) Search(q *query.Query, opts txindex.SearchOptions) (*txindex.BlockSearchResult, error) {
+	if opts.Limit < 1 {
+		return nil, txindex.ErrorInvalidLimit
+	}
+	page := newSearchPage(opts)
+
+	filters := lookForFilters(q.Conditions())
+	if len(filters) > 0 {
+		scanned, others := filters[0], filters[1:]
+		err := scan(bi.store, scanned, scanned.startKey(0), parseBlockKey, page, func(ptr pointer) (bool, error) {
+			return bi.check(ptr.Height, others)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ptrs, next := page.pointers()
+	res := &txindex.BlockSearchResult{
+		Heights: make([]int64, len(ptrs)),
+		Total:   page.total,
+		Next:    next,
+	}
+	for i, ptr := range ptrs {
+		res.Heights[i] = ptr.Height
+	}
+	return res, nil
+}
+
+// check reports whether the block at height matches all the given filters.
+// Exact matches are checked against the index; other filters need the tags
+// of the block, which are loaded once.
+func (bi *BlockIndex) check(height int64, filters []filter) (bool, error) {
+	var tags []cmn.KVPair
+	for _, f := range filters {
+		if f.r == nil && f.c.Op == query.OpEqual {
+			if !bi.store.Has(keyForBlock(f.c.Tag, f.c.Operand, height)) {
+				return false, nil
+			}
+			continue
+		}
+
+		tag := f.tag()
+		if tag == types.BlockHeightKey {
+			if !f.matches(strconv.FormatInt(height, 10)) {
+				return false, nil
+			}
+			continue
+		}
+		if tag != types.BlockGroupKey && !bi.indexAllTags && !cmn.StringInSlice(tag, bi.tagsToIndex) {
+			return false, nil
+		}
+		if tags == nil {
+			rawBytes := bi.store.Get(keyForBlock(types.BlockHeightKey, height, height))
+			if rawBytes == nil {
+				return false, nil
+			}
+			if err := cdc.UnmarshalBinaryBare(rawBytes, &tags); err != nil {
+				return false, errors.Wrapf(err, "failed to read the tags of block %d", height)
+			}
+		}
+		var values []string
+		for _, t := range tags {
+			if string(t.Key) == tag {
+				values = append(values, string(t.Value))
+			}
+		}
+		if !f.matchesAny(values) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// Keys
+
+// parseBlockKey returns the tag value and the height of a block index key.
+func parseBlockKey(key []byte) (value string, cursor txindex.Cursor, ok bool) {
+	parts := strings.Split(string(key), tagKeySeparator)
+	if len(parts) < 3 {
+		return "", cursor, false
+	}
+	height, err := strconv.ParseInt(parts[len(parts)-1], 10, 64)
+	if err != nil {
+		return "", cursor, false
+	}
+	value = strings.Join(parts[1:len(parts)-1], tagKeySeparator)
+	return value, txindex.Cursor{Height: height}, true
+}
+
+func keyForBlock(tag string, value interface{}, height int64) []byte {
+	return []byte(fmt.Sprintf("%s/%v/%d",
+		tag,
+		value,
+		height,
+	))
+}
@@ -0,0 +1,84 @@
+package kv
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	db "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestBlockIndex(t *testing.T) {
+	indexer := NewBlockIndex(db.NewMemDB(), BlockIndexTags([]string{"slashing.validator", "group.switch", "proposer.power"}))
+
+	for height := int64(1); height <= 10; height++ {
+		block := types.EventDataNewBlockHeader{
+			Header: types.Header{Height: height, Group: int32(height % 2)},
+			ResultBeginBlock: abci.ResponseBeginBlock{Tags: []cmn.KVPair{
+				{Key: []byte("proposer.power"), Value: []byte(fmt.Sprintf("%d", height*10))},
+				{Key: []byte("not_allowed"), Value: []byte("Vlad")},
+			}},
+		}
+		if height%3 == 0 {
+			block.ResultEndBlock.Tags = []cmn.KVPair{
+				{Key: []byte("slashing.validator"), Value: []byte(fmt.Sprintf("val%d", height))},
+			}
+		}
+		if height == 7 {
+			block.ResultEndBlock.Tags = []cmn.KVPair{{Key: []byte("group.switch"), Value: []byte("1")}}
+		}
+		if height == 4 {
+			// a block with the txs of another group
+			block.Header.Sections = []types.TxSection{{Group: 0, NumTxs: 1}, {Group: 2, NumTxs: 1}}
+		}
+		require.NoError(t, indexer.Index(block))
+	}
+
+	ok, err := indexer.Has(5)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = indexer.Has(11)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	testCases := []struct {
+		q       string
+		heights []int64
+	}{
+		{"block.height = 5", []int64{5}},
+		{"block.height > 8", []int64{9, 10}},
+		{"block.group = 1 AND block.height <= 5", []int64{1, 3, 5}},
+		{"block.group = 0 AND block.height <= 5", []int64{2, 4}},
+		{"block.group = 2", []int64{4}},
+		{"block.group >= 0 AND block.height <= 4", []int64{1, 2, 3, 4}},
+		{"slashing.validator = 'val6'", []int64{6}},
+		{"slashing.validator CONTAINS 'val'", []int64{3, 6, 9}},
+		{"slashing.validator CONTAINS 'val' AND block.group = 0", []int64{6}},
+		{"group.switch = 1", []int64{7}},
+		{"proposer.power >= 50 AND proposer.power < 80 AND block.group = 1", []int64{5, 7}},
+		{"not_allowed = 'Vlad'", []int64{}},
+		{"slashing.validator = 'val7'", []int64{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.q, func(t *testing.T) {
+			res, err := indexer.Search(query.MustParse(tc.q), txindex.SearchOptions{Limit: 100})
+			require.NoError(t, err)
+			assert.Equal(t, tc.heights, res.Heights)
+			assert.Equal(t, len(tc.heights), res.Total)
+		})
+	}
+
+	res, err := indexer.Search(query.MustParse("block.height >= 1"), txindex.SearchOptions{Descending: true, Skip: 1, Limit: 3})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{9, 8, 7}, res.Heights)
+	assert.Equal(t, 10, res.Total)
+	require.NotNil(t, res.Next)
+	assert.EqualValues(t, 7, res.Next.Height)
+}
@@ -2,6 +2,7 @@ package kv
 
 import (
 	"bytes"
+	"container/heap"
 	"encoding/hex"
 	"fmt"
 	"sort"
@@ -94,6 +95,11 @@ func (txi *TxIndex) AddBatch(b *txindex.Batch) error {
 			storeBatch.Set(keyForHeight(result), hash)
 		}
 
+		// index tx by group
+		if txi.indexAllTags || cmn.StringInSlice(types.TxGroupKey, txi.tagsToIndex) {
+			storeBatch.Set(keyForGroup(result), hash)
+		}
+
 		// index tx by hash
 		rawBytes, err := cdc.MarshalBinaryBare(result)
 		if err != nil {
@@ -124,6 +130,11 @@ func (txi *TxIndex) Index(result *types.TxResult) error {
 		b.Set(keyForHeight(result), hash)
 	}
 
+	// index tx by group
+	if txi.indexAllTags || cmn.StringInSlice(types.TxGroupKey, txi.tagsToIndex) {
+		b.Set(keyForGroup(result), hash)
+	}
+
 	// index tx by hash
 	rawBytes, err := cdc.MarshalBinaryBare(result)
 	if err != nil {
@@ -136,14 +147,21 @@ func (txi *TxIndex) Index(result *types.TxResult) error {
 }
 
 // Search performs a search using the given query. It breaks the query into
-// conditions (like "tx.height > 5"). For each condition, it queries the DB
-// index. One special use cases here: (1) if "tx.hash" is found, it returns tx
-// result for it (2) for range queries it is better for the client to provide
-// both lower and upper bounds, so we are not performing a full scan. Results
-// from querying indexes are then intersected and returned to the caller.
-func (txi *TxIndex) Search(q *query.Query) ([]*types.TxResult, error) {
-	var hashes [][]byte
-	var hashesInitialized bool
+// conditions (like "tx.height > 5"). One special use case here: if "tx.hash"
+// is found, it returns tx result for it. Otherwise, it scans the DB index of a
+// single condition, preferring an exact match, and checks the other
+// conditions for each tx found. For range queries it is better for the client
+// to provide both lower and upper bounds, or another condition, so we are not
+// performing a full scan of the tag.
+//
+// Only the positions of the txs within the requested page are kept while
+// scanning, and tx results are loaded for the returned page only, so the
+// memory used by a search depends on the page, not on the number of matches.
+func (txi *TxIndex) Search(q *query.Query, opts txindex.SearchOptions) (*txindex.SearchResult, error) {
+	if opts.Limit < 1 {
+		return nil, txindex.ErrorInvalidLimit
+	}
+	page := newSearchPage(opts)
 
 	// get a list of conditions (like "tx.height > 5")
 	conditions := q.Conditions()
@@ -154,68 +172,97 @@ func (txi *TxIndex) Search(q *query.Query) ([]*types.TxResult, error) {
 		return nil, errors.Wrap(err, "error during searching for a hash in the query")
 	} else if ok {
 		res, err := txi.Get(hash)
-		if res == nil {
-			return []*types.TxResult{}, nil
+		if err != nil {
+			return nil, errors.Wrap(err, "error while retrieving the result")
 		}
-		return []*types.TxResult{res}, errors.Wrap(err, "error while retrieving the result")
+		if res != nil {
+			page.add(pointer{txindex.Cursor{Height: res.Height, Index: res.Index}, hash})
+		}
+		return page.result(txi)
 	}
 
-	// conditions to skip because they're handled before "everything else"
-	skipIndexes := make([]int, 0)
-
-	// extract ranges
-	// if both upper and lower bounds exist, it's better to get them in order not
-	// no iterate over kvs that are not within range.
-	ranges, rangeIndexes := lookForRanges(conditions)
-	if len(ranges) > 0 {
-		skipIndexes = append(skipIndexes, rangeIndexes...)
-
-		for _, r := range ranges {
-			if !hashesInitialized {
-				hashes = txi.matchRange(r, startKey(r.key))
-				hashesInitialized = true
-			} else {
-				hashes = intersect(hashes, txi.matchRange(r, startKey(r.key)))
-			}
-		}
+	filters := lookForFilters(conditions)
+	if len(filters) == 0 {
+		return page.result(txi)
 	}
 
 	// if there is a height condition ("tx.height=3"), extract it
 	height := lookForHeight(conditions)
 
-	// for all other conditions
-	for i, c := range conditions {
-		if cmn.IntInSlice(i, skipIndexes) {
-			continue
-		}
+	scanned, others := filters[0], filters[1:]
+	err = scan(txi.store, scanned, scanned.startKey(height), parseKey, page, func(ptr pointer) (bool, error) {
+		return txi.check(ptr, others)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return page.result(txi)
+}
 
-		if !hashesInitialized {
-			hashes = txi.match(c, startKeyForCondition(c, height))
-			hashesInitialized = true
-		} else {
-			hashes = intersect(hashes, txi.match(c, startKeyForCondition(c, height)))
-		}
+// scan iterates the index keys of the scanned filter, starting with prefix,
+// and adds the positions matching the filter, which check accepts, to the
+// page.
+func scan(store dbm.DB, scanned filter, prefix []byte,
+	parse func(key []byte) (string, txindex.Cursor, bool),
+	page *searchPage, check func(pointer) (bool, error)) error {
+
+	// an exact match is scanned with the value in the prefix, so it finds a
+	// position once; other filters may find it once per value of the tag
+	if scanned.r != nil || scanned.c.Op != query.OpEqual {
+		page.seen = make(map[txindex.Cursor]struct{})
 	}
 
-	results := make([]*types.TxResult, len(hashes))
-	i := 0
-	for _, h := range hashes {
-		results[i], err = txi.Get(h)
+	it := dbm.IteratePrefix(store, prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		value, cursor, ok := parse(it.Key())
+		if !ok || !scanned.matches(value) {
+			continue
+		}
+		ptr := pointer{cursor, append([]byte(nil), it.Value()...)}
+		ok, err := check(ptr)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to get Tx{%X}", h)
+			return err
+		}
+		if ok {
+			page.add(ptr)
 		}
-		i++
 	}
+	return nil
+}
 
-	// sort by height & index by default
-	sort.Slice(results, func(i, j int) bool {
-		if results[i].Height == results[j].Height {
-			return results[i].Index < results[j].Index
+// check reports whether the tx ptr points at matches all the given filters.
+// Exact matches are checked against the index; other filters need the tx
+// result, which is loaded once.
+func (txi *TxIndex) check(ptr pointer, filters []filter) (bool, error) {
+	var res *types.TxResult
+	for _, f := range filters {
+		if f.r == nil && f.c.Op == query.OpEqual {
+			if !txi.store.Has(keyForCondition(f.c, ptr.Cursor)) {
+				return false, nil
+			}
+			continue
 		}
-		return results[i].Height < results[j].Height
-	})
 
-	return results, nil
+		tag := f.tag()
+		if !txi.indexAllTags && !cmn.StringInSlice(tag, txi.tagsToIndex) {
+			return false, nil
+		}
+		if res == nil {
+			var err error
+			res, err = txi.Get(ptr.hash)
+			if err != nil {
+				return false, errors.Wrapf(err, "failed to get Tx{%X}", ptr.hash)
+			}
+			if res == nil {
+				return false, nil
+			}
+		}
+		if !f.matchesAny(tagValues(res, tag)) {
+			return false, nil
+		}
+	}
+	return true, nil
 }
 
 func lookForHash(conditions []query.Condition) (hash []byte, err error, ok bool) {
@@ -332,90 +379,238 @@ func isRangeOperation(op query.Operator) bool {
 	}
 }
 
-func (txi *TxIndex) match(c query.Condition, startKeyBz []byte) (hashes [][]byte) {
-	if c.Op == query.OpEqual {
-		it := dbm.IteratePrefix(txi.store, startKeyBz)
-		defer it.Close()
-		for ; it.Valid(); it.Next() {
-			hashes = append(hashes, it.Value())
+// matches reports whether the tag value v is within the range. Only integer
+// bounds are supported.
+// XXX: passing time in a ABCI Tags is not yet implemented
+func (r queryRange) matches(v string) bool {
+	if _, ok := r.AnyBound().(int64); !ok {
+		return false
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return false
+	}
+	if lowerBound := r.lowerBoundValue(); lowerBound != nil {
+		if lb, ok := lowerBound.(int64); !ok || n < lb {
+			return false
 		}
-	} else if c.Op == query.OpContains {
-		// XXX: startKey does not apply here.
-		// For example, if startKey = "account.owner/an/" and search query = "accoutn.owner CONTAINS an"
-		// we can't iterate with prefix "account.owner/an/" because we might miss keys like "account.owner/Ulan/"
-		it := dbm.IteratePrefix(txi.store, startKey(c.Tag))
-		defer it.Close()
-		for ; it.Valid(); it.Next() {
-			if !isTagKey(it.Key()) {
-				continue
-			}
-			if strings.Contains(extractValueFromKey(it.Key()), c.Operand.(string)) {
-				hashes = append(hashes, it.Value())
+	}
+	if upperBound := r.upperBoundValue(); upperBound != nil {
+		if ub, ok := upperBound.(int64); !ok || n > ub {
+			return false
+		}
+	}
+	return true
+}
+
+// filter is either a condition of the query or all the range conditions on
+// a tag, merged into a range.
+type filter struct {
+	c query.Condition
+	r *queryRange
+}
+
+// lookForFilters turns the conditions into filters, exact matches first, then
+// ranges, then the others.
+func lookForFilters(conditions []query.Condition) []filter {
+	ranges, rangeIndexes := lookForRanges(conditions)
+
+	var equal, rng, other []filter
+	for i, c := range conditions {
+		if cmn.IntInSlice(i, rangeIndexes) {
+			if r, ok := ranges[c.Tag]; ok {
+				rng = append(rng, filter{r: &r})
+				delete(ranges, c.Tag)
 			}
+			continue
 		}
-	} else {
+		if c.Op == query.OpEqual {
+			equal = append(equal, filter{c: c})
+		} else {
+			other = append(other, filter{c: c})
+		}
+	}
+	return append(append(equal, rng...), other...)
+}
+
+func (f filter) tag() string {
+	if f.r != nil {
+		return f.r.key
+	}
+	return f.c.Tag
+}
+
+// startKey returns the prefix of the index keys to scan for the filter.
+func (f filter) startKey(height int64) []byte {
+	if f.r == nil && f.c.Op == query.OpEqual {
+		return startKeyForCondition(f.c, height)
+	}
+	// XXX: startKey does not apply to CONTAINS.
+	// For example, if startKey = "account.owner/an/" and search query = "accoutn.owner CONTAINS an"
+	// we can't iterate with prefix "account.owner/an/" because we might miss keys like "account.owner/Ulan/"
+	return startKey(f.tag())
+}
+
+// matches reports whether the tag value v satisfies the filter.
+func (f filter) matches(v string) bool {
+	if f.r != nil {
+		return f.r.matches(v)
+	}
+	switch f.c.Op {
+	case query.OpEqual:
+		return v == fmt.Sprintf("%v", f.c.Operand)
+	case query.OpContains:
+		return strings.Contains(v, f.c.Operand.(string))
+	default:
 		panic("other operators should be handled already")
 	}
-	return
 }
 
-func (txi *TxIndex) matchRange(r queryRange, startKey []byte) (hashes [][]byte) {
-	// create a map to prevent duplicates
-	hashesMap := make(map[string][]byte)
+// matchesAny reports whether one of the tag values satisfies the filter.
+func (f filter) matchesAny(values []string) bool {
+	for _, v := range values {
+		if f.matches(v) {
+			return true
+		}
+	}
+	return false
+}
 
-	lowerBound := r.lowerBoundValue()
-	upperBound := r.upperBoundValue()
+// pointer is the position of a tx or a block found by a search, and the value
+// of its index key (the hash of a tx).
+type pointer struct {
+	txindex.Cursor
+	hash []byte
+}
 
-	it := dbm.IteratePrefix(txi.store, startKey)
-	defer it.Close()
-LOOP:
-	for ; it.Valid(); it.Next() {
-		if !isTagKey(it.Key()) {
-			continue
+// searchPage collects the positions of a search page. It keeps the first
+// Skip+Limit positions after the cursor, in the order of the search, in a heap
+// with the last one on top.
+type searchPage struct {
+	opts  txindex.SearchOptions
+	ptrs  []pointer
+	total int // positions matching the query
+	after int // positions matching the query after the cursor
+
+	// positions added so far, when the scanned index may find a position more
+	// than once
+	seen map[txindex.Cursor]struct{}
+}
+
+var _ heap.Interface = (*searchPage)(nil)
+
+func newSearchPage(opts txindex.SearchOptions) *searchPage {
+	return &searchPage{opts: opts}
+}
+
+func (p *searchPage) Len() int { return len(p.ptrs) }
+
+func (p *searchPage) Less(i, j int) bool {
+	return p.opts.Before(p.ptrs[j].Cursor, p.ptrs[i].Cursor)
+}
+
+func (p *searchPage) Swap(i, j int) { p.ptrs[i], p.ptrs[j] = p.ptrs[j], p.ptrs[i] }
+
+func (p *searchPage) Push(x interface{}) { p.ptrs = append(p.ptrs, x.(pointer)) }
+
+func (p *searchPage) Pop() interface{} {
+	last := p.ptrs[len(p.ptrs)-1]
+	p.ptrs = p.ptrs[:len(p.ptrs)-1]
+	return last
+}
+
+func (p *searchPage) add(ptr pointer) {
+	if p.seen != nil {
+		if _, ok := p.seen[ptr.Cursor]; ok {
+			return
 		}
-		switch r.AnyBound().(type) {
-		case int64:
-			v, err := strconv.ParseInt(extractValueFromKey(it.Key()), 10, 64)
-			if err != nil {
-				continue LOOP
-			}
-			include := true
-			if lowerBound != nil && v < lowerBound.(int64) {
-				include = false
-			}
-			if upperBound != nil && v > upperBound.(int64) {
-				include = false
-			}
-			if include {
-				hashesMap[fmt.Sprintf("%X", it.Value())] = it.Value()
-			}
-			// XXX: passing time in a ABCI Tags is not yet implemented
-			// case time.Time:
-			// 	v := strconv.ParseInt(extractValueFromKey(it.Key()), 10, 64)
-			// 	if v == r.upperBound {
-			// 		break
-			// 	}
+		p.seen[ptr.Cursor] = struct{}{}
+	}
+
+	p.total++
+	if p.opts.After != nil && !p.opts.Before(*p.opts.After, ptr.Cursor) {
+		return
+	}
+	p.after++
+
+	if len(p.ptrs) == p.opts.Skip+p.opts.Limit {
+		if !p.opts.Before(ptr.Cursor, p.ptrs[0].Cursor) {
+			return
 		}
+		heap.Pop(p)
 	}
-	hashes = make([][]byte, len(hashesMap))
-	i := 0
-	for _, h := range hashesMap {
-		hashes[i] = h
-		i++
+	heap.Push(p, ptr)
+}
+
+// pointers returns the positions of the page, in order, and the cursor of
+// the next page, if any.
+func (p *searchPage) pointers() (ptrs []pointer, next *txindex.Cursor) {
+	sort.Slice(p.ptrs, func(i, j int) bool {
+		return p.opts.Before(p.ptrs[i].Cursor, p.ptrs[j].Cursor)
+	})
+	if p.opts.Skip < len(p.ptrs) {
+		ptrs = p.ptrs[p.opts.Skip:]
 	}
-	return
+	if p.after > p.opts.Skip+p.opts.Limit {
+		next = &ptrs[len(ptrs)-1].Cursor
+	}
+	return ptrs, next
 }
 
-///////////////////////////////////////////////////////////////////////////////
-// Keys
+// result loads the txs of the page.
+func (p *searchPage) result(txi *TxIndex) (*txindex.SearchResult, error) {
+	ptrs, next := p.pointers()
+	res := &txindex.SearchResult{
+		Txs:   make([]*types.TxResult, len(ptrs)),
+		Total: p.total,
+		Next:  next,
+	}
+	for i, ptr := range ptrs {
+		tx, err := txi.Get(ptr.hash)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get Tx{%X}", ptr.hash)
+		}
+		res.Txs[i] = tx
+	}
+	return res, nil
+}
 
-func isTagKey(key []byte) bool {
-	return strings.Count(string(key), tagKeySeparator) == 3
+// tagValues returns the values of the tag for the given tx.
+func tagValues(res *types.TxResult, tag string) []string {
+	switch tag {
+	case types.TxHeightKey:
+		return []string{strconv.FormatInt(res.Height, 10)}
+	case types.TxGroupKey:
+		return []string{strconv.FormatInt(int64(res.Group), 10)}
+	}
+	var values []string
+	for _, t := range res.Result.Tags {
+		if string(t.Key) == tag {
+			values = append(values, string(t.Value))
+		}
+	}
+	return values
 }
 
-func extractValueFromKey(key []byte) string {
-	parts := strings.SplitN(string(key), tagKeySeparator, 3)
-	return parts[1]
+///////////////////////////////////////////////////////////////////////////////
+// Keys
+
+// parseKey returns the tag value and the position of the tx of an index key.
+func parseKey(key []byte) (value string, cursor txindex.Cursor, ok bool) {
+	parts := strings.Split(string(key), tagKeySeparator)
+	if len(parts) < 4 {
+		return "", cursor, false
+	}
+	height, err := strconv.ParseInt(parts[len(parts)-2], 10, 64)
+	if err != nil {
+		return "", cursor, false
+	}
+	index, err := strconv.ParseUint(parts[len(parts)-1], 10, 32)
+	if err != nil {
+		return "", cursor, false
+	}
+	value = strings.Join(parts[1:len(parts)-2], tagKeySeparator)
+	return value, txindex.Cursor{Height: height, Index: uint32(index)}, true
 }
 
 func keyForTag(tag cmn.KVPair, result *types.TxResult) []byte {
@@ -436,6 +631,24 @@ func keyForHeight(result *types.TxResult) []byte {
 	))
 }
 
+func keyForGroup(result *types.TxResult) []byte {
+	return []byte(fmt.Sprintf("%s/%d/%d/%d",
+		types.TxGroupKey,
+		result.Group,
+		result.Height,
+		result.Index,
+	))
+}
+
+func keyForCondition(c query.Condition, cursor txindex.Cursor) []byte {
+	return []byte(fmt.Sprintf("%s/%v/%d/%d",
+		c.Tag,
+		c.Operand,
+		cursor.Height,
+		cursor.Index,
+	))
+}
+
 func startKeyForCondition(c query.Condition, height int64) []byte {
 	if height > 0 {
 		return startKey(c.Tag, c.Operand, height)
@@ -450,18 +663,3 @@ func startKey(fields ...interface{}) []byte {
 	}
 	return b.Bytes()
 }
-
-///////////////////////////////////////////////////////////////////////////////
-// Utils
-
-func intersect(as, bs [][]byte) [][]byte {
-	i := make([][]byte, 0, cmn.MinInt(len(as), len(bs)))
-	for _, a := range as {
-		for _, b := range bs {
-			if bytes.Equal(a, b) {
-				i = append(i, a)
-			}
-		}
-	}
-	return i
-}
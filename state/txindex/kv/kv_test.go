@@ -21,7 +21,7 @@ func TestTxIndex(t *testing.T) {
 	indexer := NewTxIndex(db.NewMemDB())
 
 	tx := types.Tx("HELLO WORLD")
-	txResult := &types.TxResult{1, 0, tx, abci.ResponseDeliverTx{Data: []byte{0}, Code: abci.CodeTypeOK, Log: "", Tags: nil}}
+	txResult := &types.TxResult{1, 0, tx, abci.ResponseDeliverTx{Data: []byte{0}, Code: abci.CodeTypeOK, Log: "", Tags: nil}, 0}
 	hash := tx.Hash()
 
 	batch := txindex.NewBatch(1)
@@ -36,7 +36,7 @@ func TestTxIndex(t *testing.T) {
 	assert.Equal(t, txResult, loadedTxResult)
 
 	tx2 := types.Tx("BYE BYE WORLD")
-	txResult2 := &types.TxResult{1, 0, tx2, abci.ResponseDeliverTx{Data: []byte{0}, Code: abci.CodeTypeOK, Log: "", Tags: nil}}
+	txResult2 := &types.TxResult{1, 0, tx2, abci.ResponseDeliverTx{Data: []byte{0}, Code: abci.CodeTypeOK, Log: "", Tags: nil}, 0}
 	hash2 := tx2.Hash()
 
 	err = indexer.Index(txResult2)
@@ -97,7 +97,7 @@ func TestTxSearch(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.q, func(t *testing.T) {
-			results, err := indexer.Search(query.MustParse(tc.q))
+			results, err := search(indexer, query.MustParse(tc.q))
 			assert.NoError(t, err)
 
 			assert.Len(t, results, tc.resultsLength)
@@ -120,7 +120,7 @@ func TestTxSearchOneTxWithMultipleSameTagsButDifferentValues(t *testing.T) {
 	err := indexer.Index(txResult)
 	require.NoError(t, err)
 
-	results, err := indexer.Search(query.MustParse("account.number >= 1"))
+	results, err := search(indexer, query.MustParse("account.number >= 1"))
 	assert.NoError(t, err)
 
 	assert.Len(t, results, 1)
@@ -173,7 +173,7 @@ func TestTxSearchMultipleTxs(t *testing.T) {
 	err = indexer.Index(txResult4)
 	require.NoError(t, err)
 
-	results, err := indexer.Search(query.MustParse("account.number >= 1"))
+	results, err := search(indexer, query.MustParse("account.number >= 1"))
 	assert.NoError(t, err)
 
 	require.Len(t, results, 3)
@@ -191,17 +191,125 @@ func TestIndexAllTags(t *testing.T) {
 	err := indexer.Index(txResult)
 	require.NoError(t, err)
 
-	results, err := indexer.Search(query.MustParse("account.number >= 1"))
+	results, err := search(indexer, query.MustParse("account.number >= 1"))
 	assert.NoError(t, err)
 	assert.Len(t, results, 1)
 	assert.Equal(t, []*types.TxResult{txResult}, results)
 
-	results, err = indexer.Search(query.MustParse("account.owner = 'Ivan'"))
+	results, err = search(indexer, query.MustParse("account.owner = 'Ivan'"))
 	assert.NoError(t, err)
 	assert.Len(t, results, 1)
 	assert.Equal(t, []*types.TxResult{txResult}, results)
 }
 
+func TestTxSearchByGroup(t *testing.T) {
+	indexer := NewTxIndex(db.NewMemDB(), IndexTags([]string{"account.number", types.TxGroupKey}))
+
+	tags := []cmn.KVPair{{Key: []byte("account.number"), Value: []byte("1")}}
+	txResult := txResultWithTags(tags)
+	txResult2 := txResultWithTags(tags)
+	txResult2.Tx = types.Tx("BYE BYE WORLD")
+	txResult2.Index = 1
+	txResult2.Group = 3
+
+	for _, res := range []*types.TxResult{txResult, txResult2} {
+		err := indexer.Index(res)
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		q       string
+		results []*types.TxResult
+	}{
+		{"tx.group = 3 AND account.number = 1", []*types.TxResult{txResult2}},
+		{"tx.group = 0", []*types.TxResult{txResult}},
+		{"tx.group > 0", []*types.TxResult{txResult2}},
+		{"tx.group = 2", nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.q, func(t *testing.T) {
+			results, err := search(indexer, query.MustParse(tc.q))
+			assert.NoError(t, err)
+			assert.Len(t, results, len(tc.results))
+			if len(tc.results) > 0 {
+				assert.Equal(t, tc.results, results)
+			}
+		})
+	}
+}
+
+func TestTxSearchPages(t *testing.T) {
+	indexer := NewTxIndex(db.NewMemDB(), IndexTags([]string{"account.number", "account.owner"}))
+
+	// indexed out of order, the second tx has two account numbers
+	var txResults []*types.TxResult
+	for i, pos := range [][2]int{{2, 1}, {1, 0}, {3, 0}, {1, 1}, {2, 0}} {
+		txResult := txResultWithTags([]cmn.KVPair{
+			{Key: []byte("account.owner"), Value: []byte("Ivan")},
+			{Key: []byte("account.number"), Value: []byte(fmt.Sprintf("%d", i))},
+		})
+		if i == 1 {
+			txResult.Result.Tags = append(txResult.Result.Tags,
+				cmn.KVPair{Key: []byte("account.number"), Value: []byte("5")})
+		}
+		txResult.Tx = types.Tx(fmt.Sprintf("tx %d", i))
+		txResult.Height, txResult.Index = int64(pos[0]), uint32(pos[1])
+		require.NoError(t, indexer.Index(txResult))
+		txResults = append(txResults, txResult)
+	}
+	ascending := []*types.TxResult{txResults[1], txResults[3], txResults[4], txResults[0], txResults[2]}
+
+	for _, q := range []string{"account.owner = 'Ivan'", "account.number >= 0", "account.owner = 'Ivan' AND account.number < 10"} {
+		for _, desc := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s/desc=%v", q, desc), func(t *testing.T) {
+				expected := make([]*types.TxResult, len(ascending))
+				copy(expected, ascending)
+				if desc {
+					for i, j := 0, len(expected)-1; i < j; i, j = i+1, j-1 {
+						expected[i], expected[j] = expected[j], expected[i]
+					}
+				}
+
+				// read 2 txs at a time, passing the cursor around as a token
+				var txs []*types.TxResult
+				opts := txindex.SearchOptions{Descending: desc, Limit: 2}
+				for {
+					res, err := indexer.Search(query.MustParse(q), opts)
+					require.NoError(t, err)
+					assert.Equal(t, len(expected), res.Total)
+					txs = append(txs, res.Txs...)
+					if res.Next == nil {
+						break
+					}
+					require.Len(t, res.Txs, 2)
+					after, err := txindex.ParseCursor(res.Next.String())
+					require.NoError(t, err)
+					opts.After = &after
+				}
+				assert.Equal(t, expected, txs)
+
+				res, err := indexer.Search(query.MustParse(q), txindex.SearchOptions{Descending: desc, Skip: 3, Limit: 5})
+				require.NoError(t, err)
+				assert.Equal(t, expected[3:], res.Txs)
+				assert.Nil(t, res.Next)
+			})
+		}
+	}
+
+	_, err := indexer.Search(query.MustParse("account.owner = 'Ivan'"), txindex.SearchOptions{})
+	assert.Equal(t, txindex.ErrorInvalidLimit, err)
+	_, err = txindex.ParseCursor("boom")
+	assert.Equal(t, txindex.ErrorInvalidCursor, err)
+}
+
+func search(indexer *TxIndex, q *query.Query) ([]*types.TxResult, error) {
+	res, err := indexer.Search(q, txindex.SearchOptions{Limit: 100})
+	if err != nil {
+		return nil, err
+	}
+	return res.Txs, nil
+}
+
 func txResultWithTags(tags []cmn.KVPair) *types.TxResult {
 	tx := types.Tx("HELLO WORLD")
 	return &types.TxResult{
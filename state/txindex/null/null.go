@@ -9,6 +9,7 @@ import (
 )
 
 var _ txindex.TxIndexer = (*TxIndex)(nil)
+var _ txindex.BlockIndexer = (*BlockIndex)(nil)
 
 // TxIndex acts as a /dev/null.
 type TxIndex struct{}
@@ -28,6 +29,25 @@ func (txi *TxIndex) Index(result *types.TxResult) error {
 	return nil
 }
 
-func (txi *TxIndex) Search(q *query.Query) ([]*types.TxResult, error) {
-	return []*types.TxResult{}, nil
+// Search is a noop and always returns an empty page.
+func (txi *TxIndex) Search(q *query.Query, opts txindex.SearchOptions) (*txindex.SearchResult, error) {
+	return &txindex.SearchResult{Txs: []*types.TxResult{}}, nil
+}
+
+// BlockIndex acts as a /dev/null.
+type BlockIndex struct{}
+
+// Has is a noop and always returns false.
+func (bi *BlockIndex) Has(height int64) (bool, error) {
+	return false, nil
+}
+
+// Index is a noop and always returns nil.
+func (bi *BlockIndex) Index(block types.EventDataNewBlockHeader) error {
+	return nil
+}
+
+// Search is a noop and always returns an empty page.
+func (bi *BlockIndex) Search(q *query.Query, opts txindex.SearchOptions) (*txindex.BlockSearchResult, error) {
+	return &txindex.BlockSearchResult{Heights: []int64{}}, nil
 }
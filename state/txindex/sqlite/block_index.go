@@ -0,0 +1,135 @@
+// +build sqlite
+
+package sqlite
+
+import (
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/types"
+)
+
+var _ txindex.BlockIndexer = (*BlockIndex)(nil)
+
+// BlockIndex indexes blocks by the tags of their BeginBlock and EndBlock
+// responses, in the database of a TxIndex and with the same tags to index.
+// The tags of a block are stored as events without a tx. The predefined tags
+// are always indexed: "block.height" is a column of blocks, and the groups of
+// a block (see Header.Groups), any of which matches "block.group", are rows
+// of block_groups.
+type BlockIndex struct {
+	txi *TxIndex
+}
+
+// BlockIndex returns a block indexer writing to the database of the indexer.
+func (txi *TxIndex) BlockIndex() *BlockIndex {
+	return &BlockIndex{txi: txi}
+}
+
+// Has returns true if the block at the given height has been indexed.
+func (bi *BlockIndex) Has(height int64) (bool, error) {
+	var ok bool
+	err := bi.txi.db.QueryRow(`SELECT EXISTS (SELECT 1 FROM blocks WHERE height = ? AND grp IS NOT NULL)`,
+		height).Scan(&ok)
+	return ok, err
+}
+
+// Index indexes the tags of a block, replacing the ones indexed before.
+func (bi *BlockIndex) Index(block types.EventDataNewBlockHeader) (err error) {
+	dbtx, err := bi.txi.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			dbtx.Rollback() // nolint: errcheck
+		}
+	}()
+
+	height := block.Header.Height
+	_, err = dbtx.Exec(`INSERT INTO blocks (height, grp) VALUES (?, ?)
+		ON CONFLICT (height) DO UPDATE SET grp = excluded.grp`, height, block.Header.Group)
+	if err != nil {
+		return err
+	}
+	if _, err = dbtx.Exec(`DELETE FROM block_groups WHERE height = ?`, height); err != nil {
+		return err
+	}
+	for _, group := range block.Header.Groups() {
+		_, err = dbtx.Exec(`INSERT INTO block_groups (height, grp) VALUES (?, ?)`, height, group)
+		if err != nil {
+			return err
+		}
+	}
+	if _, err = dbtx.Exec(`DELETE FROM events WHERE height = ? AND tx_id IS NULL`, height); err != nil {
+		return err
+	}
+
+	var tags []cmn.KVPair
+	resultTags := append(append([]cmn.KVPair{}, block.ResultBeginBlock.Tags...), block.ResultEndBlock.Tags...)
+	for _, tag := range resultTags {
+		key := string(tag.Key)
+		if len(key) == 0 || key == types.BlockHeightKey || key == types.BlockGroupKey {
+			continue
+		}
+		tags = append(tags, tag)
+	}
+	if err = bi.txi.writeTags(dbtx, height, nil, tags); err != nil {
+		return err
+	}
+	return dbtx.Commit()
+}
+
+// Search performs a search using the given query, translated into SQL like
+// for TxIndex.Search, and returns the heights of the matching blocks.
+func (bi *BlockIndex) Search(q *query.Query, opts txindex.SearchOptions) (*txindex.BlockSearchResult, error) {
+	if opts.Limit < 1 {
+		return nil, txindex.ErrorInvalidLimit
+	}
+
+	where, args, err := queryToSQL(blocksTarget, q.Conditions())
+	if err != nil {
+		return nil, err
+	}
+	where = "blocks.grp IS NOT NULL AND " + where
+
+	res := &txindex.BlockSearchResult{Heights: []int64{}}
+	err = bi.txi.db.QueryRow(`SELECT COUNT(*) FROM blocks WHERE `+where, args...).Scan(&res.Total)
+	if err != nil {
+		return nil, err
+	}
+
+	order, after := "ASC", ">"
+	if opts.Descending {
+		order, after = "DESC", "<"
+	}
+	if opts.After != nil {
+		where += fmt.Sprintf(" AND blocks.height %s ?", after)
+		args = append(args, opts.After.Height)
+	}
+	// one more block than asked tells if there is a next page
+	stmt := fmt.Sprintf(`SELECT blocks.height FROM blocks WHERE %s
+		ORDER BY blocks.height %s LIMIT ? OFFSET ?`, where, order)
+	args = append(args, opts.Limit+1, opts.Skip)
+
+	rows, err := bi.txi.db.Query(stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var height int64
+		if err := rows.Scan(&height); err != nil {
+			return nil, err
+		}
+		if len(res.Heights) == opts.Limit {
+			res.Next = &txindex.Cursor{Height: res.Heights[len(res.Heights)-1]}
+			break
+		}
+		res.Heights = append(res.Heights, height)
+	}
+	return res, rows.Err()
+}
@@ -0,0 +1,239 @@
+// +build sqlite
+
+package sqlite
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/types"
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS blocks (
+	height  INTEGER PRIMARY KEY,
+	grp     INTEGER,
+	num_txs INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS block_groups (
+	height INTEGER NOT NULL REFERENCES blocks (height),
+	grp    INTEGER NOT NULL,
+	PRIMARY KEY (height, grp)
+);
+CREATE INDEX IF NOT EXISTS block_groups_grp ON block_groups (grp, height);
+
+CREATE TABLE IF NOT EXISTS txs (
+	rowid  INTEGER PRIMARY KEY,
+	height INTEGER NOT NULL REFERENCES blocks (height),
+	idx    INTEGER NOT NULL,
+	grp    INTEGER NOT NULL,
+	hash   TEXT NOT NULL UNIQUE,
+	result BLOB NOT NULL,
+	UNIQUE (height, idx)
+);
+CREATE INDEX IF NOT EXISTS txs_grp ON txs (grp, height, idx);
+
+CREATE TABLE IF NOT EXISTS events (
+	rowid  INTEGER PRIMARY KEY,
+	height INTEGER NOT NULL REFERENCES blocks (height),
+	tx_id  INTEGER REFERENCES txs (rowid) ON DELETE CASCADE,
+	type   TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS events_tx_id ON events (tx_id);
+CREATE INDEX IF NOT EXISTS events_height ON events (height, tx_id);
+
+CREATE TABLE IF NOT EXISTS attributes (
+	event_id      INTEGER NOT NULL REFERENCES events (rowid) ON DELETE CASCADE,
+	key           TEXT NOT NULL,
+	composite_key TEXT NOT NULL,
+	value         TEXT NOT NULL,
+	num           REAL
+);
+CREATE INDEX IF NOT EXISTS attributes_event_id ON attributes (event_id);
+CREATE INDEX IF NOT EXISTS attributes_value ON attributes (composite_key, value);
+CREATE INDEX IF NOT EXISTS attributes_num ON attributes (composite_key, num);
+`
+
+// target is what a query selects, txs or blocks.
+type target struct {
+	table   string
+	columns map[string]string // predefined tags => columns
+	lists   map[string]list   // predefined tags with several values => lists
+	events  string            // predicate selecting the events of a row
+}
+
+// list is a table holding the values of a predefined tag, in a column, for
+// each height.
+type list struct {
+	table  string
+	column string
+}
+
+var (
+	txsTarget = target{
+		table: "txs",
+		columns: map[string]string{
+			types.TxHeightKey: "txs.height",
+			types.TxGroupKey:  "txs.grp",
+		},
+		events: "e.tx_id = txs.rowid",
+	}
+	blocksTarget = target{
+		table: "blocks",
+		columns: map[string]string{
+			types.BlockHeightKey: "blocks.height",
+		},
+		lists: map[string]list{
+			types.BlockGroupKey: {table: "block_groups", column: "grp"},
+		},
+		events: "e.height = blocks.height AND e.tx_id IS NULL",
+	}
+)
+
+// queryToSQL translates the conditions of a query into the WHERE clause of a
+// statement selecting from the table of the target, and its arguments.
+//
+// Conditions on the predefined tags compare the columns of the table, or
+// require one of the values listed for the row to match.
+// Conditions on other tags require an attribute of the row to match; like the kv indexer,
+// all the range conditions on a tag (e.g. "account.number > 1 AND
+// account.number < 5") must be matched by the same attribute. Numbers are
+// compared with the numeric value of the attributes, and times with the time
+// they hold, if any. Comparisons the query language doesn't define match
+// nothing.
+func queryToSQL(t target, conditions []query.Condition) (where string, args []interface{}, err error) {
+	if len(conditions) == 0 {
+		return "0", nil, nil
+	}
+
+	var clauses []clause
+	ranges := make(map[string]int) // tag => index of its clause
+	for _, c := range conditions {
+		if c.Tag == types.TxHashKey && t.table == txsTarget.table {
+			hash, err := hex.DecodeString(fmt.Sprintf("%v", c.Operand))
+			if err != nil {
+				return "", nil, errors.Wrap(err, "error during searching for a hash in the query")
+			}
+			clauses = append(clauses, clause{
+				preds: []string{"txs.hash = ?"},
+				args:  []interface{}{fmt.Sprintf("%X", hash)},
+			})
+			continue
+		}
+		if column, ok := t.columns[c.Tag]; ok {
+			pred, arg := compare(column, column, c)
+			clauses = append(clauses, clause{preds: []string{pred}, args: appendArg(nil, arg)})
+			continue
+		}
+		if l, ok := t.lists[c.Tag]; ok {
+			pred, arg := compare("l."+l.column, "l."+l.column, c)
+			pred = fmt.Sprintf("EXISTS (SELECT 1 FROM %s l WHERE l.height = %s.height AND %s)", l.table, t.table, pred)
+			clauses = append(clauses, clause{preds: []string{pred}, args: appendArg(nil, arg)})
+			continue
+		}
+
+		pred, arg := compare("a.value", "a.num", c)
+		if i, ok := ranges[c.Tag]; ok && isRange(c.Op) {
+			// add the condition to the clause of the tag
+			clauses[i].preds = append(clauses[i].preds, pred)
+			clauses[i].args = appendArg(clauses[i].args, arg)
+			continue
+		}
+		if isRange(c.Op) {
+			ranges[c.Tag] = len(clauses)
+		}
+		clauses = append(clauses, clause{
+			tag:   c.Tag,
+			preds: []string{pred},
+			args:  appendArg([]interface{}{c.Tag}, arg),
+		})
+	}
+
+	sqls := make([]string, len(clauses))
+	for i, cl := range clauses {
+		sqls[i] = cl.sql(t)
+		args = append(args, cl.args...)
+	}
+	return strings.Join(sqls, " AND "), args, nil
+}
+
+// clause is a predicate of the WHERE clause: either a comparison of a column,
+// or the predicates an attribute of the row must match.
+type clause struct {
+	tag   string // empty for a column
+	preds []string
+	args  []interface{}
+}
+
+func (cl clause) sql(t target) string {
+	if cl.tag == "" {
+		return cl.preds[0]
+	}
+	return `EXISTS (SELECT 1 FROM events e JOIN attributes a ON a.event_id = e.rowid
+		WHERE ` + t.events + ` AND a.composite_key = ? AND ` + strings.Join(cl.preds, " AND ") + ")"
+}
+
+// compare returns the predicate comparing the value to the operand of the
+// condition, and its argument: text is compared with the value, numbers with
+// the numeric value, and times with julianday. A nil argument means the
+// predicate has no parameter.
+func compare(value, num string, c query.Condition) (pred string, arg interface{}) {
+	switch operand := c.Operand.(type) {
+	case string:
+		switch c.Op {
+		case query.OpEqual:
+			return value + " = ?", operand
+		case query.OpContains:
+			return "instr(" + value + ", ?) > 0", operand
+		}
+	case int64, float64:
+		if c.Op != query.OpContains {
+			return num + " " + sqlOperator(c.Op) + " ?", operand
+		}
+	case time.Time:
+		if c.Op != query.OpContains {
+			return "julianday(" + value + ") " + sqlOperator(c.Op) + " julianday(?)",
+				operand.UTC().Format(time.RFC3339Nano)
+		}
+	}
+	return "0", nil
+}
+
+func appendArg(args []interface{}, arg interface{}) []interface{} {
+	if arg == nil {
+		return args
+	}
+	return append(args, arg)
+}
+
+func sqlOperator(op query.Operator) string {
+	switch op {
+	case query.OpLessEqual:
+		return "<="
+	case query.OpGreaterEqual:
+		return ">="
+	case query.OpLess:
+		return "<"
+	case query.OpGreater:
+		return ">"
+	case query.OpEqual:
+		return "="
+	default:
+		panic(fmt.Sprintf("unexpected operator %v", op))
+	}
+}
+
+func isRange(op query.Operator) bool {
+	switch op {
+	case query.OpGreater, query.OpGreaterEqual, query.OpLess, query.OpLessEqual:
+		return true
+	default:
+		return false
+	}
+}
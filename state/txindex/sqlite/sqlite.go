@@ -0,0 +1,297 @@
+// +build sqlite
+
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	// pure Go SQLite driver, registered as "sqlite"
+	_ "modernc.org/sqlite"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/types"
+)
+
+var _ txindex.TxIndexer = (*TxIndex)(nil)
+
+// TxIndex is an indexer backed by an embedded SQLite database, kept apart
+// from the node's databases. Transactions are stored with their blocks, and
+// their tags as events and attributes, which can be queried with SQL:
+//
+//  - blocks(height, grp, num_txs), num_txs counting the indexed txs, and grp
+//    being set once the block itself is indexed (see BlockIndex)
+//  - block_groups(height, grp), the groups of the indexed blocks
+//  - txs(rowid, height, idx, grp, hash, result)
+//  - events(rowid, height, tx_id, type), tx_id being NULL for the events of
+//    a block
+//  - attributes(event_id, key, composite_key, value, num)
+//
+// A tag "account.owner" is stored as the attribute "owner" of the event
+// "account", with the composite key "account.owner". The predefined tags
+// ("tx.hash", "tx.height" and "tx.group") are columns of txs and are always
+// indexed.
+type TxIndex struct {
+	db           *sql.DB
+	tagsToIndex  []string
+	indexAllTags bool
+}
+
+// NewTxIndex opens, and creates if needed, the SQLite database at the given
+// path and returns an indexer writing to it.
+func NewTxIndex(path string, options ...func(*TxIndex)) (*TxIndex, error) {
+	// foreign keys cascade deletes of reindexed txs to their tags; WAL lets
+	// searches run while blocks are indexed
+	dsn := fmt.Sprintf("file:%s?_pragma=foreign_keys(1)&_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)", path)
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, errors.Wrap(err, "failed to create the tx index schema")
+	}
+
+	txi := &TxIndex{db: db, tagsToIndex: make([]string, 0), indexAllTags: false}
+	for _, o := range options {
+		o(txi)
+	}
+	return txi, nil
+}
+
+// IndexTags is an option for setting which tags to index.
+func IndexTags(tags []string) func(*TxIndex) {
+	return func(txi *TxIndex) {
+		txi.tagsToIndex = tags
+	}
+}
+
+// IndexAllTags is an option for indexing all tags.
+func IndexAllTags() func(*TxIndex) {
+	return func(txi *TxIndex) {
+		txi.indexAllTags = true
+	}
+}
+
+// DB returns the database of the indexer, for queries the TxIndexer
+// interface doesn't cover.
+func (txi *TxIndex) DB() *sql.DB {
+	return txi.db
+}
+
+// Close closes the database.
+func (txi *TxIndex) Close() error {
+	return txi.db.Close()
+}
+
+// Get gets transaction from the TxIndex storage and returns it or nil if the
+// transaction is not found.
+func (txi *TxIndex) Get(hash []byte) (*types.TxResult, error) {
+	if len(hash) == 0 {
+		return nil, txindex.ErrorEmptyHash
+	}
+
+	var rawBytes []byte
+	err := txi.db.QueryRow(`SELECT result FROM txs WHERE hash = ?`, fmt.Sprintf("%X", hash)).Scan(&rawBytes)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return decodeTxResult(rawBytes)
+}
+
+// AddBatch indexes a batch of transactions using the given list of tags.
+func (txi *TxIndex) AddBatch(b *txindex.Batch) error {
+	return txi.write(b.Ops)
+}
+
+// Index indexes a single transaction using the given list of tags.
+func (txi *TxIndex) Index(result *types.TxResult) error {
+	return txi.write([]*types.TxResult{result})
+}
+
+// write stores the txs in a single SQL transaction, replacing txs which were
+// already indexed.
+func (txi *TxIndex) write(results []*types.TxResult) (err error) {
+	dbtx, err := txi.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			dbtx.Rollback() // nolint: errcheck
+		}
+	}()
+
+	heights := make(map[int64]struct{})
+	for _, result := range results {
+		if _, ok := heights[result.Height]; !ok {
+			_, err = dbtx.Exec(`INSERT OR IGNORE INTO blocks (height) VALUES (?)`, result.Height)
+			if err != nil {
+				return err
+			}
+			heights[result.Height] = struct{}{}
+		}
+		if err = txi.writeTx(dbtx, result); err != nil {
+			return err
+		}
+	}
+	for height := range heights {
+		_, err = dbtx.Exec(`UPDATE blocks SET num_txs = (SELECT COUNT(*) FROM txs WHERE height = ?) WHERE height = ?`,
+			height, height)
+		if err != nil {
+			return err
+		}
+	}
+	return dbtx.Commit()
+}
+
+func (txi *TxIndex) writeTx(dbtx *sql.Tx, result *types.TxResult) error {
+	hash := fmt.Sprintf("%X", result.Tx.Hash())
+	rawBytes, err := cdc.MarshalBinaryBare(result)
+	if err != nil {
+		return err
+	}
+
+	_, err = dbtx.Exec(`DELETE FROM txs WHERE hash = ? OR (height = ? AND idx = ?)`,
+		hash, result.Height, result.Index)
+	if err != nil {
+		return err
+	}
+	res, err := dbtx.Exec(`INSERT INTO txs (height, idx, grp, hash, result) VALUES (?, ?, ?, ?, ?)`,
+		result.Height, result.Index, result.Group, hash, rawBytes)
+	if err != nil {
+		return err
+	}
+	txID, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	return txi.writeTags(dbtx, result.Height, txID, result.Result.Tags)
+}
+
+// writeTags stores the indexed tags of a tx, or of a block if txID is nil, as
+// one event per tag prefix, in the order of the tags.
+func (txi *TxIndex) writeTags(dbtx *sql.Tx, height int64, txID interface{}, tags []cmn.KVPair) error {
+	eventIDs := make(map[string]int64)
+	for _, tag := range tags {
+		compositeKey := string(tag.Key)
+		if !txi.indexAllTags && !cmn.StringInSlice(compositeKey, txi.tagsToIndex) {
+			continue
+		}
+		eventType, key := splitTagKey(compositeKey)
+		eventID, ok := eventIDs[eventType]
+		if !ok {
+			res, err := dbtx.Exec(`INSERT INTO events (height, tx_id, type) VALUES (?, ?, ?)`, height, txID, eventType)
+			if err != nil {
+				return err
+			}
+			if eventID, err = res.LastInsertId(); err != nil {
+				return err
+			}
+			eventIDs[eventType] = eventID
+		}
+
+		value := string(tag.Value)
+		var num interface{}
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			num = f
+		}
+		_, err := dbtx.Exec(`INSERT INTO attributes (event_id, key, composite_key, value, num) VALUES (?, ?, ?, ?, ?)`,
+			eventID, key, compositeKey, value, num)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Search performs a search using the given query. The query is translated
+// into a single SQL statement (see queryToSQL), so the conditions, the
+// ordering and the counting are all done by the database.
+func (txi *TxIndex) Search(q *query.Query, opts txindex.SearchOptions) (*txindex.SearchResult, error) {
+	if opts.Limit < 1 {
+		return nil, txindex.ErrorInvalidLimit
+	}
+
+	where, args, err := queryToSQL(txsTarget, q.Conditions())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &txindex.SearchResult{Txs: []*types.TxResult{}}
+	err = txi.db.QueryRow(`SELECT COUNT(*) FROM txs WHERE `+where, args...).Scan(&res.Total)
+	if err != nil {
+		return nil, err
+	}
+
+	order, after := "ASC", ">"
+	if opts.Descending {
+		order, after = "DESC", "<"
+	}
+	if opts.After != nil {
+		where += fmt.Sprintf(" AND (txs.height, txs.idx) %s (?, ?)", after)
+		args = append(args, opts.After.Height, opts.After.Index)
+	}
+	// one more tx than asked tells if there is a next page
+	stmt := fmt.Sprintf(`SELECT txs.height, txs.idx, txs.result FROM txs WHERE %s
+		ORDER BY txs.height %s, txs.idx %s LIMIT ? OFFSET ?`, where, order, order)
+	args = append(args, opts.Limit+1, opts.Skip)
+
+	rows, err := txi.db.Query(stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var last txindex.Cursor
+	for rows.Next() {
+		var (
+			cursor   txindex.Cursor
+			rawBytes []byte
+		)
+		if err := rows.Scan(&cursor.Height, &cursor.Index, &rawBytes); err != nil {
+			return nil, err
+		}
+		if len(res.Txs) == opts.Limit {
+			res.Next = &last
+			break
+		}
+		txResult, err := decodeTxResult(rawBytes)
+		if err != nil {
+			return nil, err
+		}
+		res.Txs = append(res.Txs, txResult)
+		last = cursor
+	}
+	return res, rows.Err()
+}
+
+func decodeTxResult(rawBytes []byte) (*types.TxResult, error) {
+	txResult := new(types.TxResult)
+	err := cdc.UnmarshalBinaryBare(rawBytes, &txResult)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading TxResult: %v", err)
+	}
+	return txResult, nil
+}
+
+// splitTagKey splits a tag key into an event type and an attribute key at the
+// first dot, e.g. "account.owner" into "account" and "owner". A key without a
+// dot is an attribute of an event of the same name.
+func splitTagKey(compositeKey string) (eventType, key string) {
+	i := strings.Index(compositeKey, ".")
+	if i < 0 {
+		return compositeKey, compositeKey
+	}
+	return compositeKey[:i], compositeKey[i+1:]
+}
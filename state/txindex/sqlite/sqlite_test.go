@@ -0,0 +1,245 @@
+// +build sqlite
+
+package sqlite
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/types"
+)
+
+func newTestTxIndex(t *testing.T, options ...func(*TxIndex)) (*TxIndex, func()) {
+	dir, err := ioutil.TempDir("", "tx_index_sqlite")
+	require.NoError(t, err)
+	indexer, err := NewTxIndex(filepath.Join(dir, "tx_index.sqlite"), options...)
+	require.NoError(t, err)
+	return indexer, func() {
+		indexer.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestTxIndex(t *testing.T) {
+	indexer, cleanup := newTestTxIndex(t)
+	defer cleanup()
+
+	tx := types.Tx("HELLO WORLD")
+	txResult := &types.TxResult{Height: 1, Index: 0, Tx: tx, Result: abci.ResponseDeliverTx{Data: []byte{0}, Code: abci.CodeTypeOK}}
+	hash := tx.Hash()
+
+	batch := txindex.NewBatch(1)
+	require.NoError(t, batch.Add(txResult))
+	require.NoError(t, indexer.AddBatch(batch))
+
+	loadedTxResult, err := indexer.Get(hash)
+	require.NoError(t, err)
+	assert.Equal(t, txResult, loadedTxResult)
+
+	tx2 := types.Tx("BYE BYE WORLD")
+	txResult2 := &types.TxResult{Height: 1, Index: 1, Tx: tx2, Result: abci.ResponseDeliverTx{Data: []byte{0}, Code: abci.CodeTypeOK}, Group: 2}
+	require.NoError(t, indexer.Index(txResult2))
+
+	loadedTxResult2, err := indexer.Get(tx2.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, txResult2, loadedTxResult2)
+
+	loadedTxResult3, err := indexer.Get(types.Tx("nope").Hash())
+	require.NoError(t, err)
+	assert.Nil(t, loadedTxResult3)
+
+	var numTxs int
+	err = indexer.DB().QueryRow(`SELECT num_txs FROM blocks WHERE height = 1`).Scan(&numTxs)
+	require.NoError(t, err)
+	assert.Equal(t, 2, numTxs)
+}
+
+func TestTxSearch(t *testing.T) {
+	allowedTags := []string{"account.number", "account.owner", "account.date"}
+	indexer, cleanup := newTestTxIndex(t, IndexTags(allowedTags))
+	defer cleanup()
+
+	txResult := txResultWithTags([]cmn.KVPair{
+		{Key: []byte("account.number"), Value: []byte("1")},
+		{Key: []byte("account.owner"), Value: []byte("Ivan")},
+		{Key: []byte("account.date"), Value: []byte("2013-05-03T14:45:00Z")},
+		{Key: []byte("not_allowed"), Value: []byte("Vlad")},
+	})
+	txResult.Group = 3
+	hash := txResult.Tx.Hash()
+
+	err := indexer.Index(txResult)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		q             string
+		resultsLength int
+	}{
+		// search by hash
+		{fmt.Sprintf("tx.hash = '%X'", hash), 1},
+		// search by height and group
+		{"tx.height = 1", 1},
+		{"tx.height > 1", 0},
+		{"tx.group = 3 AND account.number = 1", 1},
+		// search by exact match (one tag)
+		{"account.number = 1", 1},
+		// search by exact match (two tags)
+		{"account.number = 1 AND account.owner = 'Ivan'", 1},
+		// search by exact match (two tags)
+		{"account.number = 1 AND account.owner = 'Vlad'", 0},
+		// search using a prefix of the stored value
+		{"account.owner = 'Iv'", 0},
+		// search by range
+		{"account.number >= 1 AND account.number <= 5", 1},
+		// search by range (lower bound)
+		{"account.number >= 1", 1},
+		// search by range (upper bound)
+		{"account.number <= 5", 1},
+		// search by range across tags
+		{"account.number < 5 AND account.date > TIME 2013-05-03T14:44:00Z", 1},
+		{"account.date < DATE 2013-05-03", 0},
+		// search using not allowed tag
+		{"not_allowed = 'boom'", 0},
+		{"not_allowed = 'Vlad'", 0},
+		// search for not existing tx result
+		{"account.number >= 2 AND account.number <= 5", 0},
+		// search using CONTAINS
+		{"account.owner CONTAINS 'an'", 1},
+		// search for non existing value using CONTAINS
+		{"account.owner CONTAINS 'Vlad'", 0},
+		// search using the wrong tag (of numeric type) using CONTAINS
+		{"account.number CONTAINS 'Iv'", 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.q, func(t *testing.T) {
+			res, err := indexer.Search(query.MustParse(tc.q), txindex.SearchOptions{Limit: 100})
+			require.NoError(t, err)
+
+			assert.Len(t, res.Txs, tc.resultsLength)
+			assert.Equal(t, tc.resultsLength, res.Total)
+			if tc.resultsLength > 0 {
+				assert.Equal(t, []*types.TxResult{txResult}, res.Txs)
+			}
+		})
+	}
+}
+
+func TestTxSearchRangeOnSameAttribute(t *testing.T) {
+	indexer, cleanup := newTestTxIndex(t, IndexAllTags())
+	defer cleanup()
+
+	txResult := txResultWithTags([]cmn.KVPair{
+		{Key: []byte("account.number"), Value: []byte("1")},
+		{Key: []byte("account.owner"), Value: []byte("Ivan")},
+		{Key: []byte("account.number"), Value: []byte("10")},
+	})
+	require.NoError(t, indexer.Index(txResult))
+
+	for q, n := range map[string]int{
+		"account.number > 5 AND account.number < 20":                           1,
+		"account.number > 5 AND account.owner = 'Ivan' AND account.number < 2": 0,
+	} {
+		res, err := indexer.Search(query.MustParse(q), txindex.SearchOptions{Limit: 100})
+		require.NoError(t, err)
+		assert.Len(t, res.Txs, n, q)
+	}
+}
+
+func TestTxSearchPages(t *testing.T) {
+	indexer, cleanup := newTestTxIndex(t, IndexAllTags())
+	defer cleanup()
+
+	// indexed out of order
+	var txResults []*types.TxResult
+	for i, pos := range [][2]int{{2, 1}, {1, 0}, {3, 0}, {1, 1}, {2, 0}} {
+		txResult := txResultWithTags([]cmn.KVPair{
+			{Key: []byte("account.owner"), Value: []byte("Ivan")},
+		})
+		txResult.Tx = types.Tx(fmt.Sprintf("tx %d", i))
+		txResult.Height, txResult.Index = int64(pos[0]), uint32(pos[1])
+		require.NoError(t, indexer.Index(txResult))
+		txResults = append(txResults, txResult)
+	}
+	ascending := []*types.TxResult{txResults[1], txResults[3], txResults[4], txResults[0], txResults[2]}
+
+	for _, desc := range []bool{false, true} {
+		expected := make([]*types.TxResult, len(ascending))
+		copy(expected, ascending)
+		if desc {
+			for i, j := 0, len(expected)-1; i < j; i, j = i+1, j-1 {
+				expected[i], expected[j] = expected[j], expected[i]
+			}
+		}
+
+		var txs []*types.TxResult
+		opts := txindex.SearchOptions{Descending: desc, Limit: 2}
+		for {
+			res, err := indexer.Search(query.MustParse("account.owner = 'Ivan'"), opts)
+			require.NoError(t, err)
+			assert.Equal(t, len(expected), res.Total)
+			txs = append(txs, res.Txs...)
+			if res.Next == nil {
+				break
+			}
+			require.Len(t, res.Txs, 2)
+			opts.After = res.Next
+		}
+		assert.Equal(t, expected, txs, "desc=%v", desc)
+
+		res, err := indexer.Search(query.MustParse("account.owner = 'Ivan'"), txindex.SearchOptions{Descending: desc, Skip: 3, Limit: 5})
+		require.NoError(t, err)
+		assert.Equal(t, expected[3:], res.Txs)
+		assert.Nil(t, res.Next)
+	}
+}
+
+func TestReindexTx(t *testing.T) {
+	indexer, cleanup := newTestTxIndex(t, IndexAllTags())
+	defer cleanup()
+
+	txResult := txResultWithTags([]cmn.KVPair{
+		{Key: []byte("account.owner"), Value: []byte("Ivan")},
+	})
+	require.NoError(t, indexer.Index(txResult))
+
+	txResult.Result.Tags = []cmn.KVPair{{Key: []byte("account.owner"), Value: []byte("Vlad")}}
+	require.NoError(t, indexer.Index(txResult))
+
+	res, err := indexer.Search(query.MustParse("account.owner = 'Ivan'"), txindex.SearchOptions{Limit: 100})
+	require.NoError(t, err)
+	assert.Empty(t, res.Txs)
+	res, err = indexer.Search(query.MustParse("account.owner = 'Vlad'"), txindex.SearchOptions{Limit: 100})
+	require.NoError(t, err)
+	assert.Equal(t, []*types.TxResult{txResult}, res.Txs)
+
+	var numAttributes int
+	err = indexer.DB().QueryRow(`SELECT COUNT(*) FROM attributes`).Scan(&numAttributes)
+	require.NoError(t, err)
+	assert.Equal(t, 1, numAttributes)
+}
+
+func txResultWithTags(tags []cmn.KVPair) *types.TxResult {
+	tx := types.Tx("HELLO WORLD")
+	return &types.TxResult{
+		Height: 1,
+		Index:  0,
+		Tx:     tx,
+		Result: abci.ResponseDeliverTx{
+			Data: []byte{0},
+			Code: abci.CodeTypeOK,
+			Log:  "",
+			Tags: tags,
+		},
+	}
+}
@@ -0,0 +1,9 @@
+// +build sqlite
+
+package sqlite
+
+import (
+	amino "github.com/tendermint/go-amino"
+)
+
+var cdc = amino.NewCodec()
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/tendermint/tendermint/crypto"
 	dbm "github.com/tendermint/tendermint/libs/db"
@@ -13,7 +14,9 @@ import (
 //-----------------------------------------------------
 // Validate block
 
-func validateBlock(evidencePool EvidencePool, stateDB dbm.DB, state State, block *types.Block) error {
+func validateBlock(evidencePool EvidencePool, stateDB dbm.DB, blockStore BlockStoreRPC,
+	state State, block *types.Block) error {
+
 	// Validate internal consistency.
 	if err := block.ValidateBasic(); err != nil {
 		return err
@@ -87,19 +90,34 @@ func validateBlock(evidencePool EvidencePool, stateDB dbm.DB, state State, block
 		)
 	}
 
+	// With group validators, groups take turns and blocks carry txs of their
+	// own group only.
+	if state.GroupValidators() {
+		if expected := state.NextBlockGroup(); block.Group != expected {
+			return fmt.Errorf("Wrong Block.Header.Group. Expected %v, got %v",
+				expected,
+				block.Group,
+			)
+		}
+		if len(block.Sections) != 0 {
+			return errors.New("Block.Header.Sections must be empty with group validators")
+		}
+	}
+
 	// Validate block LastCommit.
+	lastValidators := state.LastBlockValidators()
 	if block.Height == 1 {
 		if len(block.LastCommit.Precommits) != 0 {
 			return errors.New("Block at height 1 can't have LastCommit precommits")
 		}
 	} else {
-		if len(block.LastCommit.Precommits) != state.LastValidators.Size() {
+		if len(block.LastCommit.Precommits) != lastValidators.Size() {
 			return fmt.Errorf("Invalid block commit size. Expected %v, got %v",
-				state.LastValidators.Size(),
+				lastValidators.Size(),
 				len(block.LastCommit.Precommits),
 			)
 		}
-		err := state.LastValidators.VerifyCommit(
+		err := lastValidators.VerifyCommit(
 			state.ChainID, state.LastBlockID, block.Height-1, block.LastCommit)
 		if err != nil {
 			return err
@@ -115,7 +133,7 @@ func validateBlock(evidencePool EvidencePool, stateDB dbm.DB, state State, block
 			)
 		}
 
-		medianTime := MedianTime(block.LastCommit, state.LastValidators)
+		medianTime := MedianTime(block.LastCommit, lastValidators)
 		if !block.Time.Equal(medianTime) {
 			return fmt.Errorf("Invalid block time. Expected %v, got %v",
 				medianTime,
@@ -142,7 +160,7 @@ func validateBlock(evidencePool EvidencePool, stateDB dbm.DB, state State, block
 
 	// Validate all evidence.
 	for _, ev := range block.Evidence.Evidence {
-		if err := VerifyEvidence(stateDB, state, ev); err != nil {
+		if err := VerifyEvidence(stateDB, blockStore, state, ev); err != nil {
 			return types.NewErrEvidenceInvalid(ev, err)
 		}
 		if evidencePool != nil && evidencePool.IsCommitted(ev) {
@@ -154,7 +172,7 @@ func validateBlock(evidencePool EvidencePool, stateDB dbm.DB, state State, block
 	// know what round the block was first proposed. So just check that it's
 	// a legit address and a known validator.
 	if len(block.ProposerAddress) != crypto.AddressSize ||
-		!state.Validators.HasAddress(block.ProposerAddress) {
+		!state.BlockValidators().HasAddress(block.ProposerAddress) {
 		return fmt.Errorf("Block.Header.ProposerAddress, %X, is not a validator",
 			block.ProposerAddress,
 		)
@@ -164,18 +182,33 @@ func validateBlock(evidencePool EvidencePool, stateDB dbm.DB, state State, block
 }
 
 // VerifyEvidence verifies the evidence fully by checking:
-// - it is sufficiently recent (MaxAge)
+// - it is sufficiently recent (MaxAge blocks or MaxAgeDuration, measured from
+//   the time of the block at its height)
 // - it is from a key who was a validator at the given height
 // - it is internally consistent
 // - it was properly signed by the alleged equivocator
-func VerifyEvidence(stateDB dbm.DB, state State, evidence types.Evidence) error {
+// - for LunaticValidatorEvidence, the header field is indeed invalid
+// - for AmnesiaEvidence, the precommit is for the committed block, in a round
+//   no earlier than its commit in blockStore
+//
+// ConflictingHeadersEvidence is never valid: it must be split first (see
+// SplitConflictingHeaders).
+func VerifyEvidence(stateDB dbm.DB, blockStore BlockStoreRPC, state State, evidence types.Evidence) error {
+	if _, ok := evidence.(*types.ConflictingHeadersEvidence); ok {
+		return errors.New("ConflictingHeadersEvidence must be split into evidence against each validator")
+	}
+
 	height := state.LastBlockHeight
 
-	evidenceAge := height - evidence.Height()
-	maxAge := state.ConsensusParams.Evidence.MaxAge
-	if evidenceAge > maxAge {
-		return fmt.Errorf("Evidence from height %d is too old. Min height is %d",
-			evidence.Height(), height-maxAge)
+	var (
+		params      = state.ConsensusParams.Evidence
+		evidenceAge = height - evidence.Height()
+		blockTime   = EvidenceBlockTime(blockStore, state, evidence.Height())
+		ageDuration = state.LastBlockTime.Sub(blockTime)
+	)
+	if evidenceAge > params.MaxAge && ageDuration > params.MaxAgeDuration {
+		return fmt.Errorf("Evidence from height %d (block time %v) is too old. Min height is %d, min time is %v",
+			evidence.Height(), blockTime, height-params.MaxAge, state.LastBlockTime.Add(-params.MaxAgeDuration))
 	}
 
 	valset, err := LoadValidators(stateDB, evidence.Height())
@@ -201,5 +234,126 @@ func VerifyEvidence(stateDB dbm.DB, state State, evidence types.Evidence) error
 		return err
 	}
 
+	if ev, ok := evidence.(*types.LunaticValidatorEvidence); ok {
+		validHash, err := validHeaderField(stateDB, valset, ev)
+		if err != nil {
+			return err
+		}
+		if err := ev.VerifyHeaderField(validHash); err != nil {
+			return err
+		}
+	}
+
+	if ev, ok := evidence.(*types.AmnesiaEvidence); ok {
+		if err := verifyAmnesiaCommit(blockStore, ev); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+// EvidenceBlockTime returns the time of the block at the given evidence
+// height, from which the age of the evidence is measured, as the time of the
+// evidence itself is chosen by the equivocator. It returns the zero time if
+// the block is unknown, so that the evidence is then only as recent as its
+// height.
+func EvidenceBlockTime(blockStore BlockStoreRPC, state State, height int64) time.Time {
+	if height == state.LastBlockHeight {
+		return state.LastBlockTime
+	}
+	if blockStore != nil {
+		if meta := blockStore.LoadBlockMeta(height); meta != nil {
+			return meta.Header.Time
+		}
+	}
+	return time.Time{}
+}
+
+// verifyAmnesiaCommit returns an error if the precommit of ev isn't for the
+// block committed at its height, in the round of the canonical commit or
+// later. Only the commit included in the next block is used, as the seen
+// commit of each node can be from a different round.
+func verifyAmnesiaCommit(blockStore BlockStoreRPC, ev *types.AmnesiaEvidence) error {
+	if blockStore == nil {
+		return errors.New("AmnesiaEvidence can't be verified without the block store")
+	}
+	commit := blockStore.LoadBlockCommit(ev.Height())
+	if commit == nil {
+		return fmt.Errorf("No canonical commit for height %d to verify AmnesiaEvidence", ev.Height())
+	}
+	if !ev.Precommit.BlockID.Equals(commit.BlockID) {
+		return fmt.Errorf("AmnesiaEvidence precommit is for %v, not the committed block %v",
+			ev.Precommit.BlockID, commit.BlockID)
+	}
+	if ev.Precommit.Round < commit.Round() {
+		return fmt.Errorf("AmnesiaEvidence precommit round %d is before the commit round %d",
+			ev.Precommit.Round, commit.Round())
+	}
+	return nil
+}
+
+// SplitConflictingHeaders returns the evidence against each validator who
+// signed the header of ev which isn't committed, once ev is verified against
+// the committed header and validators at its height.
+func SplitConflictingHeaders(stateDB dbm.DB, blockStore BlockStoreRPC, state State,
+	ev *types.ConflictingHeadersEvidence) ([]types.Evidence, error) {
+
+	if err := ev.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if err := ev.Verify(state.ChainID, nil); err != nil {
+		return nil, err
+	}
+	height := ev.Height()
+	if blockStore == nil {
+		return nil, errors.New("ConflictingHeadersEvidence can't be split without the block store")
+	}
+	meta := blockStore.LoadBlockMeta(height)
+	commit := blockStore.LoadBlockCommit(height)
+	if meta == nil || commit == nil {
+		return nil, fmt.Errorf("No committed header at height %d", height)
+	}
+	valset, err := LoadValidators(stateDB, height)
+	if err != nil {
+		return nil, err
+	}
+	committed := types.SignedHeader{Header: &meta.Header, Commit: commit}
+	return ev.Split(committed, valset), nil
+}
+
+// validHeaderField returns the value the header field of the evidence should
+// have, derived from the state at its height. valset is the validator set at
+// that height.
+func validHeaderField(stateDB dbm.DB, valset *types.ValidatorSet,
+	ev *types.LunaticValidatorEvidence) ([]byte, error) {
+
+	height := ev.Height()
+	switch ev.InvalidHeaderField {
+	case types.HeaderFieldValidatorsHash:
+		return valset.Hash(), nil
+	case types.HeaderFieldNextValidatorsHash:
+		nextValset, err := LoadValidators(stateDB, height+1)
+		if err != nil {
+			return nil, err
+		}
+		return nextValset.Hash(), nil
+	case types.HeaderFieldConsensusHash:
+		params, err := LoadConsensusParams(stateDB, height)
+		if err != nil {
+			return nil, err
+		}
+		return params.Hash(), nil
+	case types.HeaderFieldLastResultsHash:
+		if height == 1 {
+			return nil, nil
+		}
+		abciResponses, err := LoadABCIResponses(stateDB, height-1)
+		if err != nil {
+			return nil, err
+		}
+		return abciResponses.ResultsHash(), nil
+	default:
+		return nil, fmt.Errorf("Unknown header field %q", ev.InvalidHeaderField)
+	}
+}
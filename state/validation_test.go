@@ -146,6 +146,131 @@ func TestValidateFailBlockOnCommittedEvidence(t *testing.T) {
 	require.IsType(t, err, &types.ErrEvidenceInvalid{})
 }
 
+func TestVerifyLunaticValidatorEvidence(t *testing.T) {
+	var height int64 = 1
+	state, stateDB := state(1, 2)
+
+	ev := makeLunaticEvidence(t, state, height, tmhash.Sum([]byte("lunatic")))
+	require.NoError(t, VerifyEvidence(stateDB, nil, state, ev))
+
+	// the header is valid
+	ev = makeLunaticEvidence(t, state, height, state.NextValidators.Hash())
+	require.Error(t, VerifyEvidence(stateDB, nil, state, ev))
+}
+
+func TestVerifyEvidenceMaxAge(t *testing.T) {
+	var height int64 = 1
+	state, stateDB := state(1, 2)
+	ev := makeLunaticEvidence(t, state, height, tmhash.Sum([]byte("lunatic")))
+
+	state.LastBlockHeight = height + 2
+	state.ConsensusParams.Evidence.MaxAge = 1
+	state.ConsensusParams.Evidence.MaxAgeDuration = 2 * time.Hour
+
+	// the age is measured from the block time, not the evidence time
+	blockTime := ev.Time().Add(-24 * time.Hour)
+	blockStore := commitBlockStore{metas: map[int64]*types.BlockMeta{
+		height: {Header: types.Header{Height: height, Time: blockTime}},
+	}}
+
+	// too old in blocks only
+	state.LastBlockTime = blockTime.Add(time.Hour)
+	require.NoError(t, VerifyEvidence(stateDB, blockStore, state, ev))
+
+	// too old in both
+	state.LastBlockTime = blockTime.Add(3 * time.Hour)
+	require.Error(t, VerifyEvidence(stateDB, blockStore, state, ev))
+
+	// too old in blocks, and the block time is unknown
+	state.LastBlockTime = blockTime.Add(time.Hour)
+	require.Error(t, VerifyEvidence(stateDB, commitBlockStore{}, state, ev))
+	require.Error(t, VerifyEvidence(stateDB, nil, state, ev))
+
+	// too old in time only
+	state.LastBlockHeight = height + 1
+	state.LastBlockTime = blockTime.Add(3 * time.Hour)
+	require.NoError(t, VerifyEvidence(stateDB, blockStore, state, ev))
+}
+
+func makeLunaticEvidence(t *testing.T, state State, height int64,
+	nextValsHash []byte) *types.LunaticValidatorEvidence {
+
+	privVal := types.NewMockPVWithParams(ed25519.GenPrivKeyFromSecret([]byte("test0")), false, false)
+	header := makeBlock(state, height).Header
+	header.NextValidatorsHash = nextValsHash
+	vote := &types.Vote{
+		ValidatorAddress: privVal.GetPubKey().Address(),
+		Height:           height,
+		Type:             types.PrecommitType,
+		BlockID:          types.BlockID{Hash: header.Hash()},
+	}
+	require.NoError(t, privVal.SignVote(chainID, vote))
+	return &types.LunaticValidatorEvidence{
+		Header:             &header,
+		Vote:               vote,
+		InvalidHeaderField: types.HeaderFieldNextValidatorsHash,
+	}
+}
+
+// commitBlockStore is a BlockStoreRPC holding the canonical commits and block
+// metas only.
+type commitBlockStore struct {
+	BlockStoreRPC
+	commits map[int64]*types.Commit
+	metas   map[int64]*types.BlockMeta
+}
+
+func (bs commitBlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
+	return bs.metas[height]
+}
+
+func (bs commitBlockStore) LoadBlockCommit(height int64) *types.Commit {
+	return bs.commits[height]
+}
+
+func TestVerifyAmnesiaEvidence(t *testing.T) {
+	var height int64 = 1
+	state, stateDB := state(1, 2)
+
+	privVal := types.NewMockPVWithParams(ed25519.GenPrivKeyFromSecret([]byte("test0")), false, false)
+	blockID := types.BlockID{Hash: tmhash.Sum([]byte("committed"))}
+	otherID := types.BlockID{Hash: tmhash.Sum([]byte("other"))}
+	vote := func(typ types.SignedMsgType, round int, blockID types.BlockID) *types.Vote {
+		v := &types.Vote{
+			ValidatorAddress: privVal.GetPubKey().Address(),
+			Height:           height,
+			Round:            round,
+			Type:             typ,
+			BlockID:          blockID,
+		}
+		require.NoError(t, privVal.SignVote(chainID, v))
+		return v
+	}
+	ev := &types.AmnesiaEvidence{
+		PubKey:    privVal.GetPubKey(),
+		Precommit: vote(types.PrecommitType, 1, blockID),
+		Vote:      vote(types.PrevoteType, 2, otherID),
+	}
+	commitAt := func(round int, blockID types.BlockID) BlockStoreRPC {
+		precommit := vote(types.PrecommitType, round, blockID)
+		commit := types.NewCommit(blockID, []*types.CommitSig{precommit.CommitSig()})
+		return commitBlockStore{commits: map[int64]*types.Commit{height: commit}}
+	}
+
+	require.NoError(t, VerifyEvidence(stateDB, commitAt(1, blockID), state, ev))
+	require.NoError(t, VerifyEvidence(stateDB, commitAt(0, blockID), state, ev))
+
+	// the block isn't committed yet
+	require.Error(t, VerifyEvidence(stateDB, commitBlockStore{}, state, ev))
+	require.Error(t, VerifyEvidence(stateDB, nil, state, ev))
+
+	// the validator could have unlocked before the commit round
+	require.Error(t, VerifyEvidence(stateDB, commitAt(2, blockID), state, ev))
+
+	// the precommit isn't for the committed block
+	require.Error(t, VerifyEvidence(stateDB, commitAt(1, otherID), state, ev))
+}
+
 /*
 	TODO(#2589):
 	- test unmarshalling BlockParts that are too big into a Block that
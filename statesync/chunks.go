@@ -0,0 +1,327 @@
+package statesync
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/p2p"
+)
+
+// errDone is returned by chunkQueue.Next() and chunkQueue.Allocate() once
+// all chunks have been returned or allocated.
+var errDone = errors.New("chunk queue has completed")
+
+// chunk contains data for a chunk.
+type chunk struct {
+	Height uint64
+	Format uint32
+	Index  uint32
+	Chunk  []byte
+	Sender p2p.ID
+}
+
+// chunkQueue manages the chunks of the snapshot being restored. Chunks are
+// stored in a temporary directory until applied, and are returned in order
+// by Next(). Fetchers take chunks to request with Allocate(), and callers
+// can have chunks returned again with Retry(), optionally after refetching
+// them with Discard().
+type chunkQueue struct {
+	mtx            sync.Mutex
+	snapshot       *snapshot                  // nil once the queue is closed
+	dir            string                     // temp dir for the chunk files
+	chunkFiles     map[uint32]string          // paths of the fetched chunks
+	chunkSenders   map[uint32]p2p.ID          // peers which sent the chunks
+	chunkAllocated map[uint32]bool            // chunks allocated with Allocate()
+	chunkReturned  map[uint32]bool            // chunks returned by Next()
+	waiters        map[uint32][]chan<- uint32 // WaitFor() channels per chunk
+}
+
+// newChunkQueue creates a new chunk queue for a snapshot, using a temp dir
+// created in tempDir (the OS temp dir if empty) for storage.
+func newChunkQueue(snapshot *snapshot, tempDir string) (*chunkQueue, error) {
+	if snapshot.Chunks == 0 {
+		return nil, errors.New("snapshot has no chunks")
+	}
+	dir, err := ioutil.TempDir(tempDir, "tm-statesync")
+	if err != nil {
+		return nil, fmt.Errorf("unable to create temp dir for state sync chunks: %v", err)
+	}
+	return &chunkQueue{
+		snapshot:       snapshot,
+		dir:            dir,
+		chunkFiles:     make(map[uint32]string, snapshot.Chunks),
+		chunkSenders:   make(map[uint32]p2p.ID, snapshot.Chunks),
+		chunkAllocated: make(map[uint32]bool, snapshot.Chunks),
+		chunkReturned:  make(map[uint32]bool, snapshot.Chunks),
+		waiters:        make(map[uint32][]chan<- uint32),
+	}, nil
+}
+
+// Add adds a chunk to the queue. It returns false if the chunk is already
+// there, or doesn't belong to the snapshot.
+func (q *chunkQueue) Add(chunk *chunk) (bool, error) {
+	if chunk == nil || chunk.Chunk == nil {
+		return false, errors.New("cannot add nil chunk")
+	}
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	if q.snapshot == nil {
+		return false, nil // queue is closed
+	}
+	if chunk.Height != q.snapshot.Height {
+		return false, fmt.Errorf("invalid chunk height %v, expected %v", chunk.Height, q.snapshot.Height)
+	}
+	if chunk.Format != q.snapshot.Format {
+		return false, fmt.Errorf("invalid chunk format %v, expected %v", chunk.Format, q.snapshot.Format)
+	}
+	if chunk.Index >= q.snapshot.Chunks {
+		return false, fmt.Errorf("received unexpected chunk %v", chunk.Index)
+	}
+	if q.chunkFiles[chunk.Index] != "" {
+		return false, nil
+	}
+
+	path := filepath.Join(q.dir, strconv.FormatUint(uint64(chunk.Index), 10))
+	err := ioutil.WriteFile(path, chunk.Chunk, 0600)
+	if err != nil {
+		return false, fmt.Errorf("failed to save chunk %v to file %v: %v", chunk.Index, path, err)
+	}
+	q.chunkFiles[chunk.Index] = path
+	q.chunkSenders[chunk.Index] = chunk.Sender
+
+	// signal any waiters that the chunk has arrived
+	for _, waiter := range q.waiters[chunk.Index] {
+		waiter <- chunk.Index
+		close(waiter)
+	}
+	delete(q.waiters, chunk.Index)
+
+	return true, nil
+}
+
+// Allocate allocates a chunk to the caller, making it responsible for
+// fetching it. It returns errDone once all chunks are allocated.
+func (q *chunkQueue) Allocate() (uint32, error) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	if q.snapshot == nil {
+		return 0, errDone
+	}
+	if uint32(len(q.chunkAllocated)) >= q.snapshot.Chunks {
+		return 0, errDone
+	}
+	for i := uint32(0); i < q.snapshot.Chunks; i++ {
+		if !q.chunkAllocated[i] {
+			q.chunkAllocated[i] = true
+			return i, nil
+		}
+	}
+	return 0, errDone
+}
+
+// Close closes the chunk queue, removing all its files.
+func (q *chunkQueue) Close() error {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	if q.snapshot == nil {
+		return nil
+	}
+	for _, waiters := range q.waiters {
+		for _, waiter := range waiters {
+			close(waiter)
+		}
+	}
+	q.waiters = nil
+	q.snapshot = nil
+	err := os.RemoveAll(q.dir)
+	if err != nil {
+		return fmt.Errorf("failed to clean up state sync tempdir %v: %v", q.dir, err)
+	}
+	return nil
+}
+
+// Discard discards a chunk, fetched or not. It will be removed from the queue,
+// available for allocation, and returned by Next() again once refetched.
+func (q *chunkQueue) Discard(index uint32) error {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	return q.discard(index)
+}
+
+// discard discards a chunk. The caller must hold the mutex lock.
+func (q *chunkQueue) discard(index uint32) error {
+	if q.snapshot == nil {
+		return nil
+	}
+	if path := q.chunkFiles[index]; path != "" {
+		err := os.Remove(path)
+		if err != nil {
+			return fmt.Errorf("failed to remove chunk %v: %v", index, err)
+		}
+	}
+	delete(q.chunkFiles, index)
+	delete(q.chunkReturned, index)
+	delete(q.chunkAllocated, index)
+	return nil
+}
+
+// DiscardSender discards all chunks from a sender which haven't been returned
+// by Next() yet, to have them refetched.
+func (q *chunkQueue) DiscardSender(peerID p2p.ID) error {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	for index, sender := range q.chunkSenders {
+		if sender == peerID && !q.chunkReturned[index] {
+			err := q.discard(index)
+			if err != nil {
+				return err
+			}
+			delete(q.chunkSenders, index)
+		}
+	}
+	return nil
+}
+
+// GetSender returns the sender of a chunk, or an empty ID if it isn't known.
+func (q *chunkQueue) GetSender(index uint32) p2p.ID {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	return q.chunkSenders[index]
+}
+
+// Has checks whether a chunk is in the queue.
+func (q *chunkQueue) Has(index uint32) bool {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	return q.chunkFiles[index] != ""
+}
+
+// load loads a chunk from disk, or nil if it isn't in the queue. The caller
+// must hold the mutex lock.
+func (q *chunkQueue) load(index uint32) (*chunk, error) {
+	if q.snapshot == nil {
+		return nil, errDone
+	}
+	path, ok := q.chunkFiles[index]
+	if !ok {
+		return nil, nil
+	}
+	body, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load chunk %v: %v", index, err)
+	}
+	return &chunk{
+		Height: q.snapshot.Height,
+		Format: q.snapshot.Format,
+		Index:  index,
+		Chunk:  body,
+		Sender: q.chunkSenders[index],
+	}, nil
+}
+
+// Next returns the next chunk in order, blocking until it arrives. It
+// returns errDone once all chunks have been returned.
+func (q *chunkQueue) Next() (*chunk, error) {
+	q.mtx.Lock()
+	var chunk *chunk
+	index, err := q.nextUp()
+	if err == nil {
+		chunk, err = q.load(index)
+		if err == nil && chunk != nil {
+			q.chunkReturned[index] = true
+		}
+	}
+	q.mtx.Unlock()
+	if chunk != nil || err != nil {
+		return chunk, err
+	}
+
+	select {
+	case _, ok := <-q.WaitFor(index):
+		if !ok {
+			return nil, errDone // queue closed
+		}
+	case <-time.After(chunkTimeout):
+		return nil, errTimeout
+	}
+
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	chunk, err = q.load(index)
+	if err != nil {
+		return nil, err
+	}
+	if chunk == nil {
+		// discarded while we were waiting for it
+		return nil, errTimeout
+	}
+	q.chunkReturned[index] = true
+	return chunk, nil
+}
+
+// nextUp returns the index of the next chunk to return, or errDone if all
+// have been returned. The caller must hold the mutex lock.
+func (q *chunkQueue) nextUp() (uint32, error) {
+	if q.snapshot == nil {
+		return 0, errDone
+	}
+	for i := uint32(0); i < q.snapshot.Chunks; i++ {
+		if !q.chunkReturned[i] {
+			return i, nil
+		}
+	}
+	return 0, errDone
+}
+
+// Retry has a chunk returned by Next() again.
+func (q *chunkQueue) Retry(index uint32) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	delete(q.chunkReturned, index)
+}
+
+// RetryAll has all chunks returned by Next() again.
+func (q *chunkQueue) RetryAll() {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	q.chunkReturned = make(map[uint32]bool)
+}
+
+// Size returns the number of chunks of the snapshot, or 0 once the queue is
+// closed.
+func (q *chunkQueue) Size() uint32 {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	if q.snapshot == nil {
+		return 0
+	}
+	return q.snapshot.Chunks
+}
+
+// WaitFor returns a channel receiving the chunk index once the chunk is in
+// the queue (immediately if it already is). The channel is closed afterwards,
+// or when the queue is closed.
+func (q *chunkQueue) WaitFor(index uint32) <-chan uint32 {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	ch := make(chan uint32, 1)
+	switch {
+	case q.snapshot == nil:
+		close(ch)
+	case index >= q.snapshot.Chunks:
+		close(ch)
+	case q.chunkFiles[index] != "":
+		ch <- index
+		close(ch)
+	default:
+		q.waiters[index] = append(q.waiters[index], ch)
+	}
+	return ch
+}
@@ -0,0 +1,172 @@
+package statesync
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/p2p"
+)
+
+func setupChunkQueue(t *testing.T) (*chunkQueue, func()) {
+	s := &snapshot{Height: 3, Format: 1, Chunks: 5, Hash: []byte{7}}
+	queue, err := newChunkQueue(s, "")
+	require.NoError(t, err)
+	return queue, func() { queue.Close() } // nolint: errcheck
+}
+
+func TestNewChunkQueue(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "chunks")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	_, err = newChunkQueue(&snapshot{Height: 1, Format: 1, Chunks: 0}, tempDir)
+	require.Error(t, err)
+
+	queue, err := newChunkQueue(&snapshot{Height: 1, Format: 1, Chunks: 2}, tempDir)
+	require.NoError(t, err)
+	files, err := ioutil.ReadDir(tempDir)
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+
+	// closing removes the queue's temp dir
+	require.NoError(t, queue.Close())
+	files, err = ioutil.ReadDir(tempDir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestChunkQueueAdd(t *testing.T) {
+	queue, teardown := setupChunkQueue(t)
+	defer teardown()
+
+	added, err := queue.Add(&chunk{Height: 3, Format: 1, Index: 2, Chunk: []byte{2}})
+	require.NoError(t, err)
+	assert.True(t, added)
+	assert.True(t, queue.Has(2))
+
+	added, err = queue.Add(&chunk{Height: 3, Format: 1, Index: 2, Chunk: []byte{9}})
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	invalid := []*chunk{
+		nil,
+		{Height: 3, Format: 1, Index: 0},
+		{Height: 4, Format: 1, Index: 0, Chunk: []byte{0}},
+		{Height: 3, Format: 2, Index: 0, Chunk: []byte{0}},
+		{Height: 3, Format: 1, Index: 5, Chunk: []byte{0}},
+	}
+	for i, c := range invalid {
+		_, err = queue.Add(c)
+		assert.Error(t, err, i)
+	}
+
+	// a closed queue ignores chunks
+	require.NoError(t, queue.Close())
+	added, err = queue.Add(&chunk{Height: 3, Format: 1, Index: 0, Chunk: []byte{0}})
+	require.NoError(t, err)
+	assert.False(t, added)
+}
+
+func TestChunkQueueNext(t *testing.T) {
+	queue, teardown := setupChunkQueue(t)
+	defer teardown()
+
+	// chunks are returned in order, as they arrive
+	chunks := make(chan *chunk, 5)
+	go func() {
+		for {
+			c, err := queue.Next()
+			if err == errDone {
+				close(chunks)
+				return
+			}
+			require.NoError(t, err)
+			chunks <- c
+		}
+	}()
+
+	for _, i := range []uint32{3, 1, 0, 4, 2} {
+		_, err := queue.Add(&chunk{Height: 3, Format: 1, Index: i, Chunk: []byte{byte(i)}, Sender: p2p.ID("a")})
+		require.NoError(t, err)
+	}
+	for i := uint32(0); i < 5; i++ {
+		c := <-chunks
+		require.NotNil(t, c)
+		assert.Equal(t, &chunk{Height: 3, Format: 1, Index: i, Chunk: []byte{byte(i)}, Sender: p2p.ID("a")}, c)
+	}
+	_, ok := <-chunks
+	assert.False(t, ok)
+
+	// retried chunks are returned again
+	queue.Retry(3)
+	c, err := queue.Next()
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, c.Index)
+	queue.RetryAll()
+	c, err = queue.Next()
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, c.Index)
+}
+
+func TestChunkQueueNextClosed(t *testing.T) {
+	queue, teardown := setupChunkQueue(t)
+	defer teardown()
+
+	errs := make(chan error)
+	go func() {
+		_, err := queue.Next()
+		errs <- err
+	}()
+	require.NoError(t, queue.Close())
+	assert.Equal(t, errDone, <-errs)
+}
+
+func TestChunkQueueAllocateDiscard(t *testing.T) {
+	queue, teardown := setupChunkQueue(t)
+	defer teardown()
+
+	for i := uint32(0); i < 5; i++ {
+		index, err := queue.Allocate()
+		require.NoError(t, err)
+		assert.Equal(t, i, index)
+	}
+	_, err := queue.Allocate()
+	assert.Equal(t, errDone, err)
+
+	for i := uint32(0); i < 5; i++ {
+		sender := p2p.ID("a")
+		if i%2 == 1 {
+			sender = p2p.ID("b")
+		}
+		_, err = queue.Add(&chunk{Height: 3, Format: 1, Index: i, Chunk: []byte{byte(i)}, Sender: sender})
+		require.NoError(t, err)
+	}
+	c, err := queue.Next()
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, c.Index)
+	assert.Equal(t, p2p.ID("b"), queue.GetSender(1))
+
+	// discarded chunks are refetched and returned again
+	require.NoError(t, queue.Discard(0))
+	assert.False(t, queue.Has(0))
+	index, err := queue.Allocate()
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, index)
+
+	// chunks from a sender are discarded, unless already returned
+	require.NoError(t, queue.DiscardSender(p2p.ID("b")))
+	assert.False(t, queue.Has(1))
+	assert.True(t, queue.Has(2))
+	assert.False(t, queue.Has(3))
+	for _, i := range []uint32{1, 3} {
+		index, err = queue.Allocate()
+		require.NoError(t, err)
+		assert.Equal(t, i, index)
+	}
+	_, err = queue.Allocate()
+	assert.Equal(t, errDone, err)
+}
@@ -0,0 +1,137 @@
+package statesync
+
+import (
+	"net"
+	"sync"
+
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/p2p"
+	tmconn "github.com/tendermint/tendermint/p2p/conn"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+)
+
+// mockPeer records the messages sent to it.
+type mockPeer struct {
+	cmn.BaseService
+	id   p2p.ID
+	sent chan StateSyncMessage
+}
+
+func newMockPeer() *mockPeer {
+	nodeKey := p2p.NodeKey{PrivKey: ed25519.GenPrivKey()}
+	return &mockPeer{
+		id:   nodeKey.ID(),
+		sent: make(chan StateSyncMessage, 100),
+	}
+}
+
+func (mp *mockPeer) Send(chID byte, msgBytes []byte) bool {
+	msg, err := decodeMsg(msgBytes)
+	if err != nil {
+		panic(err)
+	}
+	mp.sent <- msg
+	return true
+}
+
+func (mp *mockPeer) TrySend(chID byte, msgBytes []byte) bool { return mp.Send(chID, msgBytes) }
+func (mp *mockPeer) FlushStop()                              {}
+func (mp *mockPeer) NodeInfo() p2p.NodeInfo                  { return p2p.DefaultNodeInfo{} }
+func (mp *mockPeer) Status() tmconn.ConnectionStatus         { return tmconn.ConnectionStatus{} }
+func (mp *mockPeer) ID() p2p.ID                              { return mp.id }
+func (mp *mockPeer) IsOutbound() bool                        { return false }
+func (mp *mockPeer) IsPersistent() bool                      { return false }
+func (mp *mockPeer) Get(s string) interface{}                { return nil }
+func (mp *mockPeer) Set(string, interface{})                 {}
+func (mp *mockPeer) RemoteIP() net.IP                        { return net.IP{127, 0, 0, 1} }
+func (mp *mockPeer) OriginalAddr() *p2p.NetAddress           { return nil }
+func (mp *mockPeer) RemoteAddr() net.Addr                    { return &net.TCPAddr{IP: mp.RemoteIP(), Port: 26656} }
+func (mp *mockPeer) CloseConn() error                        { return nil }
+
+// mockStateProvider trusts the app hash of any height, or fails if err is set.
+type mockStateProvider struct {
+	appHash []byte
+	err     error
+}
+
+func (p *mockStateProvider) AppHash(height uint64) ([]byte, error) {
+	return p.appHash, p.err
+}
+
+func (p *mockStateProvider) Commit(height uint64) (*types.Commit, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &types.Commit{BlockID: types.BlockID{Hash: []byte("block")}}, nil
+}
+
+func (p *mockStateProvider) State(height uint64) (sm.State, error) {
+	if p.err != nil {
+		return sm.State{}, p.err
+	}
+	return sm.State{LastBlockHeight: int64(height), AppHash: p.appHash}, nil
+}
+
+// snapshotApp is an app restoring snapshots, with hooks deciding the results.
+type snapshotApp struct {
+	abci.BaseApplication
+
+	mtx            sync.Mutex
+	snapshots      []*abci.Snapshot
+	chunks         map[uint32][]byte
+	offer          func(abci.RequestOfferSnapshot) abci.ResponseOfferSnapshot
+	apply          func(abci.RequestApplySnapshotChunk) abci.ResponseApplySnapshotChunk
+	applied        []uint32
+	lastHeight     int64
+	lastAppHash    []byte
+	openGroups     []int32
+	offeredHeights []uint64
+}
+
+func (app *snapshotApp) Info(req abci.RequestInfo) abci.ResponseInfo {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	return abci.ResponseInfo{
+		LastBlockHeight:  app.lastHeight,
+		LastBlockAppHash: app.lastAppHash,
+		OpenGroups:       app.openGroups,
+	}
+}
+
+func (app *snapshotApp) ListSnapshots(req abci.RequestListSnapshots) abci.ResponseListSnapshots {
+	return abci.ResponseListSnapshots{Snapshots: app.snapshots}
+}
+
+func (app *snapshotApp) LoadSnapshotChunk(req abci.RequestLoadSnapshotChunk) abci.ResponseLoadSnapshotChunk {
+	return abci.ResponseLoadSnapshotChunk{Chunk: app.chunks[req.Chunk]}
+}
+
+func (app *snapshotApp) OfferSnapshot(req abci.RequestOfferSnapshot) abci.ResponseOfferSnapshot {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	app.offeredHeights = append(app.offeredHeights, req.Snapshot.Height)
+	if app.offer != nil {
+		return app.offer(req)
+	}
+	return abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_ACCEPT}
+}
+
+func (app *snapshotApp) ApplySnapshotChunk(req abci.RequestApplySnapshotChunk) abci.ResponseApplySnapshotChunk {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	app.applied = append(app.applied, req.Index)
+	if app.apply != nil {
+		return app.apply(req)
+	}
+	return abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ACCEPT}
+}
+
+func newAppConns(app abci.Application) (proxy.AppConnSnapshot, proxy.AppConnQuery) {
+	client := abcicli.NewLocalClient(nil, app)
+	return proxy.NewAppConnSnapshot(client), proxy.NewAppConnQuery(client)
+}
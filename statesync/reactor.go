@@ -0,0 +1,389 @@
+package statesync
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	amino "github.com/tendermint/go-amino"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	// SnapshotChannel exchanges snapshot metadata
+	SnapshotChannel = byte(0x60)
+	// ChunkChannel exchanges chunk contents
+	ChunkChannel = byte(0x61)
+
+	// recentSnapshots is the number of recent snapshots to send to a peer
+	recentSnapshots = 10
+
+	// snapshotMsgSize is the maximum size of a snapshotsResponseMessage
+	snapshotMsgSize = int(4e6)
+	// chunkMsgSize is the maximum size of a chunkResponseMessage
+	chunkMsgSize = int(16e6)
+	maxMsgSize   = chunkMsgSize
+)
+
+// StateSyncReactor handles state sync, both restoring snapshots for the local
+// node and serving snapshots of the local app to other nodes.
+type StateSyncReactor struct {
+	p2p.BaseReactor
+
+	config    *cfg.StateSyncConfig
+	conn      proxy.AppConnSnapshot
+	connQuery proxy.AppConnQuery
+
+	// set while a state sync is in progress, to feed it the snapshots and
+	// chunks received
+	mtx    sync.RWMutex
+	syncer *syncer
+}
+
+// NewStateSyncReactor returns a new StateSyncReactor, serving the snapshots
+// of the app on the given connections.
+func NewStateSyncReactor(config *cfg.StateSyncConfig, conn proxy.AppConnSnapshot,
+	connQuery proxy.AppConnQuery) *StateSyncReactor {
+	ssR := &StateSyncReactor{
+		config:    config,
+		conn:      conn,
+		connQuery: connQuery,
+	}
+	ssR.BaseReactor = *p2p.NewBaseReactor("StateSyncReactor", ssR)
+	return ssR
+}
+
+// GetChannels implements Reactor.
+func (ssR *StateSyncReactor) GetChannels() []*p2p.ChannelDescriptor {
+	return []*p2p.ChannelDescriptor{
+		{
+			ID:                  SnapshotChannel,
+			Priority:            3,
+			SendQueueCapacity:   10,
+			RecvMessageCapacity: snapshotMsgSize,
+		},
+		{
+			ID:                  ChunkChannel,
+			Priority:            1,
+			SendQueueCapacity:   4,
+			RecvMessageCapacity: chunkMsgSize,
+		},
+	}
+}
+
+// AddPeer implements Reactor. Peers are asked for their snapshots while a
+// state sync is in progress.
+func (ssR *StateSyncReactor) AddPeer(peer p2p.Peer) {
+	ssR.mtx.RLock()
+	defer ssR.mtx.RUnlock()
+	if ssR.syncer != nil {
+		ssR.syncer.AddPeer(peer)
+	}
+}
+
+// RemovePeer implements Reactor.
+func (ssR *StateSyncReactor) RemovePeer(peer p2p.Peer, reason interface{}) {
+	ssR.mtx.RLock()
+	defer ssR.mtx.RUnlock()
+	if ssR.syncer != nil {
+		ssR.syncer.RemovePeer(peer)
+	}
+}
+
+// Receive implements Reactor.
+func (ssR *StateSyncReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
+	if !ssR.IsRunning() {
+		return
+	}
+
+	msg, err := decodeMsg(msgBytes)
+	if err != nil {
+		ssR.Logger.Error("Error decoding message", "src", src, "chId", chID, "msg", msg, "err", err, "bytes", msgBytes)
+		ssR.Switch.StopPeerForError(src, err)
+		return
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		ssR.Logger.Error("Peer sent us invalid msg", "peer", src, "msg", msg, "err", err)
+		ssR.Switch.StopPeerForError(src, err)
+		return
+	}
+
+	ssR.Logger.Debug("Receive", "src", src, "chId", chID, "msg", msg)
+
+	switch msg := msg.(type) {
+	case *snapshotsRequestMessage:
+		snapshots, err := ssR.recentSnapshots(recentSnapshots)
+		if err != nil {
+			ssR.Logger.Error("Failed to fetch snapshots", "err", err)
+			return
+		}
+		for _, snapshot := range snapshots {
+			ssR.Logger.Debug("Advertising snapshot", "height", snapshot.Height,
+				"format", snapshot.Format, "peer", src.ID())
+			src.Send(SnapshotChannel, cdc.MustMarshalBinaryBare(&snapshotsResponseMessage{
+				Height:   snapshot.Height,
+				Format:   snapshot.Format,
+				Chunks:   snapshot.Chunks,
+				Hash:     snapshot.Hash,
+				Metadata: snapshot.Metadata,
+			}))
+		}
+
+	case *snapshotsResponseMessage:
+		ssR.mtx.RLock()
+		defer ssR.mtx.RUnlock()
+		if ssR.syncer == nil {
+			ssR.Logger.Debug("Received unexpected snapshot, no state sync in progress")
+			return
+		}
+		ssR.Logger.Debug("Received snapshot", "height", msg.Height, "format", msg.Format, "peer", src.ID())
+		_, err := ssR.syncer.AddSnapshot(src, &snapshot{
+			Height:   msg.Height,
+			Format:   msg.Format,
+			Chunks:   msg.Chunks,
+			Hash:     msg.Hash,
+			Metadata: msg.Metadata,
+		})
+		if err != nil {
+			ssR.Logger.Error("Failed to add snapshot", "height", msg.Height, "format", msg.Format,
+				"peer", src.ID(), "err", err)
+		}
+
+	case *chunkRequestMessage:
+		ssR.Logger.Debug("Received chunk request", "height", msg.Height, "format", msg.Format,
+			"chunk", msg.Index, "peer", src.ID())
+		res, err := ssR.conn.LoadSnapshotChunkSync(abci.RequestLoadSnapshotChunk{
+			Height: msg.Height,
+			Format: msg.Format,
+			Chunk:  msg.Index,
+		})
+		if err != nil {
+			ssR.Logger.Error("Failed to load chunk", "height", msg.Height, "format", msg.Format,
+				"chunk", msg.Index, "err", err)
+			return
+		}
+		src.Send(ChunkChannel, cdc.MustMarshalBinaryBare(&chunkResponseMessage{
+			Height:  msg.Height,
+			Format:  msg.Format,
+			Index:   msg.Index,
+			Chunk:   res.Chunk,
+			Missing: res.Chunk == nil,
+		}))
+
+	case *chunkResponseMessage:
+		ssR.mtx.RLock()
+		defer ssR.mtx.RUnlock()
+		if ssR.syncer == nil {
+			ssR.Logger.Debug("Received unexpected chunk, no state sync in progress", "peer", src.ID())
+			return
+		}
+		ssR.Logger.Debug("Received chunk", "height", msg.Height, "format", msg.Format,
+			"chunk", msg.Index, "peer", src.ID())
+		if msg.Missing {
+			// the peer no longer has the chunk, it's requested again from
+			// another peer once the request times out
+			return
+		}
+		// amino decodes empty chunks as nil
+		body := msg.Chunk
+		if body == nil {
+			body = []byte{}
+		}
+		_, err := ssR.syncer.AddChunk(&chunk{
+			Height: msg.Height,
+			Format: msg.Format,
+			Index:  msg.Index,
+			Chunk:  body,
+			Sender: src.ID(),
+		})
+		if err != nil {
+			ssR.Logger.Error("Failed to add chunk", "height", msg.Height, "format", msg.Format,
+				"chunk", msg.Index, "err", err)
+		}
+
+	default:
+		ssR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
+	}
+}
+
+// recentSnapshots fetches the n most recent snapshots from the app.
+func (ssR *StateSyncReactor) recentSnapshots(n uint32) ([]*snapshot, error) {
+	res, err := ssR.conn.ListSnapshotsSync(abci.RequestListSnapshots{})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(res.Snapshots, func(i, j int) bool {
+		a, b := res.Snapshots[i], res.Snapshots[j]
+		if a.Height != b.Height {
+			return a.Height > b.Height
+		}
+		return a.Format > b.Format
+	})
+
+	snapshots := make([]*snapshot, 0, n)
+	for _, s := range res.Snapshots {
+		if uint32(len(snapshots)) >= n {
+			break
+		}
+		snapshots = append(snapshots, &snapshot{
+			Height:   s.Height,
+			Format:   s.Format,
+			Chunks:   s.Chunks,
+			Hash:     s.Hash,
+			Metadata: s.Metadata,
+		})
+	}
+	return snapshots, nil
+}
+
+// Sync restores the app from a snapshot discovered among the peers, verified
+// with the state provider. It returns the state and commit of the snapshot
+// height, to bootstrap the node with.
+func (ssR *StateSyncReactor) Sync(stateProvider StateProvider, discoveryTime time.Duration) (
+	sm.State, *types.Commit, error) {
+	ssR.mtx.Lock()
+	if ssR.syncer != nil {
+		ssR.mtx.Unlock()
+		return sm.State{}, nil, errors.New("a state sync is already in progress")
+	}
+	ssR.syncer = newSyncer(ssR.config, ssR.Logger, ssR.conn, ssR.connQuery, stateProvider)
+	ssR.mtx.Unlock()
+
+	// ask the peers already connected for their snapshots
+	ssR.Logger.Debug("Requesting snapshots from known peers")
+	ssR.Switch.Broadcast(SnapshotChannel, cdc.MustMarshalBinaryBare(&snapshotsRequestMessage{}))
+
+	state, commit, err := ssR.syncer.SyncAny(discoveryTime)
+	ssR.mtx.Lock()
+	ssR.syncer = nil
+	ssR.mtx.Unlock()
+	return state, commit, err
+}
+
+//-----------------------------------------------------------------------------
+// Messages
+
+// StateSyncMessage is a message sent or received by the StateSyncReactor.
+type StateSyncMessage interface {
+	ValidateBasic() error
+}
+
+func RegisterStateSyncMessages(cdc *amino.Codec) {
+	cdc.RegisterInterface((*StateSyncMessage)(nil), nil)
+	cdc.RegisterConcrete(&snapshotsRequestMessage{}, "tendermint/statesync/SnapshotsRequest", nil)
+	cdc.RegisterConcrete(&snapshotsResponseMessage{}, "tendermint/statesync/SnapshotsResponse", nil)
+	cdc.RegisterConcrete(&chunkRequestMessage{}, "tendermint/statesync/ChunkRequest", nil)
+	cdc.RegisterConcrete(&chunkResponseMessage{}, "tendermint/statesync/ChunkResponse", nil)
+}
+
+func decodeMsg(bz []byte) (msg StateSyncMessage, err error) {
+	if len(bz) > maxMsgSize {
+		return msg, fmt.Errorf("Msg exceeds max size (%d > %d)", len(bz), maxMsgSize)
+	}
+	err = cdc.UnmarshalBinaryBare(bz, &msg)
+	return
+}
+
+//-------------------------------------
+
+// snapshotsRequestMessage asks a peer for its recent snapshots.
+type snapshotsRequestMessage struct{}
+
+// ValidateBasic performs basic validation.
+func (m *snapshotsRequestMessage) ValidateBasic() error {
+	return nil
+}
+
+// String returns a string representation.
+func (m *snapshotsRequestMessage) String() string {
+	return "[snapshotsRequestMessage]"
+}
+
+//-------------------------------------
+
+// snapshotsResponseMessage advertises a snapshot.
+type snapshotsResponseMessage struct {
+	Height   uint64
+	Format   uint32
+	Chunks   uint32
+	Hash     []byte
+	Metadata []byte
+}
+
+// ValidateBasic performs basic validation.
+func (m *snapshotsResponseMessage) ValidateBasic() error {
+	if m.Height == 0 {
+		return errors.New("Snapshot height cannot be 0")
+	}
+	if m.Chunks == 0 {
+		return errors.New("Snapshot has no chunks")
+	}
+	if len(m.Hash) == 0 {
+		return errors.New("Snapshot has no hash")
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *snapshotsResponseMessage) String() string {
+	return fmt.Sprintf("[snapshotsResponseMessage %v/%v %X]", m.Height, m.Format, m.Hash)
+}
+
+//-------------------------------------
+
+// chunkRequestMessage requests a snapshot chunk.
+type chunkRequestMessage struct {
+	Height uint64
+	Format uint32
+	Index  uint32
+}
+
+// ValidateBasic performs basic validation.
+func (m *chunkRequestMessage) ValidateBasic() error {
+	if m.Height == 0 {
+		return errors.New("Snapshot height cannot be 0")
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *chunkRequestMessage) String() string {
+	return fmt.Sprintf("[chunkRequestMessage %v/%v #%v]", m.Height, m.Format, m.Index)
+}
+
+//-------------------------------------
+
+// chunkResponseMessage returns a snapshot chunk, or tells it is missing.
+type chunkResponseMessage struct {
+	Height  uint64
+	Format  uint32
+	Index   uint32
+	Chunk   []byte
+	Missing bool
+}
+
+// ValidateBasic performs basic validation.
+func (m *chunkResponseMessage) ValidateBasic() error {
+	if m.Height == 0 {
+		return errors.New("Snapshot height cannot be 0")
+	}
+	if m.Missing && len(m.Chunk) > 0 {
+		return errors.New("Missing chunk cannot have contents")
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *chunkResponseMessage) String() string {
+	return fmt.Sprintf("[chunkResponseMessage %v/%v #%v (%v bytes)]", m.Height, m.Format, m.Index, len(m.Chunk))
+}
@@ -0,0 +1,86 @@
+package statesync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func newTestReactor(t *testing.T, app abci.Application) *StateSyncReactor {
+	conn, connQuery := newAppConns(app)
+	ssR := NewStateSyncReactor(cfg.TestStateSyncConfig(), conn, connQuery)
+	ssR.SetLogger(log.TestingLogger())
+	require.NoError(t, ssR.Start())
+	return ssR
+}
+
+func receive(t *testing.T, peer *mockPeer) StateSyncMessage {
+	select {
+	case msg := <-peer.sent:
+		return msg
+	case <-time.After(time.Second):
+		t.Fatal("no message sent to peer")
+	}
+	return nil
+}
+
+func TestReactorReceiveSnapshotsRequest(t *testing.T) {
+	app := &snapshotApp{}
+	for i := uint64(1); i <= recentSnapshots+2; i++ {
+		app.snapshots = append(app.snapshots, &abci.Snapshot{Height: i, Format: 1, Chunks: 1, Hash: []byte{byte(i)}})
+	}
+	ssR := newTestReactor(t, app)
+	defer ssR.Stop() // nolint: errcheck
+
+	peer := newMockPeer()
+	ssR.Receive(SnapshotChannel, peer, cdc.MustMarshalBinaryBare(&snapshotsRequestMessage{}))
+
+	// only the most recent snapshots are advertised, latest first
+	for i := uint64(recentSnapshots + 2); i > 2; i-- {
+		msg := receive(t, peer)
+		assert.Equal(t, &snapshotsResponseMessage{Height: i, Format: 1, Chunks: 1, Hash: []byte{byte(i)}}, msg)
+	}
+	assert.Empty(t, peer.sent)
+}
+
+func TestReactorReceiveChunkRequest(t *testing.T) {
+	app := &snapshotApp{chunks: map[uint32][]byte{1: {1, 2, 3}}}
+	ssR := newTestReactor(t, app)
+	defer ssR.Stop() // nolint: errcheck
+
+	peer := newMockPeer()
+	ssR.Receive(ChunkChannel, peer, cdc.MustMarshalBinaryBare(&chunkRequestMessage{Height: 1, Format: 1, Index: 1}))
+	assert.Equal(t, &chunkResponseMessage{Height: 1, Format: 1, Index: 1, Chunk: []byte{1, 2, 3}}, receive(t, peer))
+
+	ssR.Receive(ChunkChannel, peer, cdc.MustMarshalBinaryBare(&chunkRequestMessage{Height: 1, Format: 1, Index: 2}))
+	assert.Equal(t, &chunkResponseMessage{Height: 1, Format: 1, Index: 2, Missing: true}, receive(t, peer))
+}
+
+func TestStateSyncMessageValidateBasic(t *testing.T) {
+	testCases := []struct {
+		msg     StateSyncMessage
+		wantErr bool
+	}{
+		{&snapshotsRequestMessage{}, false},
+		{&snapshotsResponseMessage{Height: 1, Format: 1, Chunks: 2, Hash: []byte{1}}, false},
+		{&snapshotsResponseMessage{Height: 0, Format: 1, Chunks: 2, Hash: []byte{1}}, true},
+		{&snapshotsResponseMessage{Height: 1, Format: 1, Chunks: 0, Hash: []byte{1}}, true},
+		{&snapshotsResponseMessage{Height: 1, Format: 1, Chunks: 2}, true},
+		{&chunkRequestMessage{Height: 1, Format: 1, Index: 0}, false},
+		{&chunkRequestMessage{Height: 0, Format: 1, Index: 0}, true},
+		{&chunkResponseMessage{Height: 1, Format: 1, Index: 0, Chunk: []byte{}}, false},
+		{&chunkResponseMessage{Height: 1, Format: 1, Index: 0, Missing: true}, false},
+		{&chunkResponseMessage{Height: 0, Format: 1, Index: 0, Chunk: []byte{1}}, true},
+		{&chunkResponseMessage{Height: 1, Format: 1, Index: 0, Chunk: []byte{1}, Missing: true}, true},
+	}
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		assert.Equal(t, tc.wantErr, err != nil, "#%d %v", i, tc.msg)
+	}
+}
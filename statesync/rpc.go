@@ -0,0 +1,121 @@
+package statesync
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/lite"
+	lerr "github.com/tendermint/tendermint/lite/errors"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/lib/client"
+	"github.com/tendermint/tendermint/types"
+)
+
+// rpcClient is the part of the RPC API used by the light client state
+// provider.
+//
+// NOTE: rpc/client can't be used here, it imports the node package, which
+// imports this one.
+type rpcClient interface {
+	Commit(height *int64) (*ctypes.ResultCommit, error)
+	Validators(height *int64) (*ctypes.ResultValidators, error)
+	ConsensusParams(height *int64) (*ctypes.ResultConsensusParams, error)
+}
+
+// httpClient is an rpcClient calling the JSON-RPC endpoint of a node.
+type httpClient struct {
+	rpc *rpcclient.JSONRPCClient
+}
+
+var _ rpcClient = (*httpClient)(nil)
+
+func newHTTPClient(remote string) *httpClient {
+	rc := rpcclient.NewJSONRPCClient(remote)
+	cdc := rc.Codec()
+	ctypes.RegisterAmino(cdc)
+	rc.SetCodec(cdc)
+	return &httpClient{rpc: rc}
+}
+
+func (c *httpClient) Commit(height *int64) (*ctypes.ResultCommit, error) {
+	result := new(ctypes.ResultCommit)
+	_, err := c.rpc.Call("commit", map[string]interface{}{"height": height}, result)
+	if err != nil {
+		return nil, fmt.Errorf("Commit: %v", err)
+	}
+	return result, nil
+}
+
+func (c *httpClient) Validators(height *int64) (*ctypes.ResultValidators, error) {
+	result := new(ctypes.ResultValidators)
+	_, err := c.rpc.Call("validators", map[string]interface{}{"height": height}, result)
+	if err != nil {
+		return nil, fmt.Errorf("Validators: %v", err)
+	}
+	return result, nil
+}
+
+func (c *httpClient) ConsensusParams(height *int64) (*ctypes.ResultConsensusParams, error) {
+	result := new(ctypes.ResultConsensusParams)
+	_, err := c.rpc.Call("consensus_params", map[string]interface{}{"height": height}, result)
+	if err != nil {
+		return nil, fmt.Errorf("ConsensusParams: %v", err)
+	}
+	return result, nil
+}
+
+// rpcProvider is a lite.Provider fetching full commits from an rpcClient,
+// like lite/client does.
+type rpcProvider struct {
+	chainID string
+	client  rpcClient
+}
+
+var _ lite.Provider = (*rpcProvider)(nil)
+
+func newRPCProvider(chainID string, client rpcClient) *rpcProvider {
+	return &rpcProvider{chainID: chainID, client: client}
+}
+
+// SetLogger implements lite.Provider.
+func (p *rpcProvider) SetLogger(logger log.Logger) {}
+
+// LatestFullCommit implements lite.Provider. Only the commit at maxHeight is
+// looked up, or the latest one if maxHeight is 0.
+func (p *rpcProvider) LatestFullCommit(chainID string, minHeight, maxHeight int64) (lite.FullCommit, error) {
+	if chainID != p.chainID {
+		return lite.FullCommit{}, fmt.Errorf("expected chainID %s, got %s", p.chainID, chainID)
+	}
+	var height *int64
+	if maxHeight > 0 {
+		height = &maxHeight
+	}
+	res, err := p.client.Commit(height)
+	if err != nil {
+		return lite.FullCommit{}, err
+	}
+	if res.Header == nil || res.Header.Height < minHeight {
+		return lite.FullCommit{}, lerr.ErrCommitNotFound()
+	}
+	vals, err := p.ValidatorSet(chainID, res.Header.Height)
+	if err != nil {
+		return lite.FullCommit{}, err
+	}
+	nextVals, err := p.ValidatorSet(chainID, res.Header.Height+1)
+	if err != nil {
+		return lite.FullCommit{}, err
+	}
+	return lite.NewFullCommit(res.SignedHeader, vals, nextVals), nil
+}
+
+// ValidatorSet implements lite.Provider.
+func (p *rpcProvider) ValidatorSet(chainID string, height int64) (*types.ValidatorSet, error) {
+	if chainID != p.chainID {
+		return nil, fmt.Errorf("expected chainID %s, got %s", p.chainID, chainID)
+	}
+	res, err := p.client.Validators(&height)
+	if err != nil {
+		return nil, lerr.ErrUnknownValidators(chainID, height)
+	}
+	return types.NewValidatorSet(res.Validators), nil
+}
@@ -0,0 +1,228 @@
+package statesync
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"sync"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/p2p"
+)
+
+// snapshotKey is a snapshot key used for lookups.
+type snapshotKey [sha256.Size]byte
+
+// snapshot contains data about a snapshot.
+type snapshot struct {
+	Height   uint64
+	Format   uint32
+	Chunks   uint32
+	Hash     []byte
+	Metadata []byte
+
+	trustedAppHash []byte // populated by the state provider
+}
+
+// Key generates a snapshot key, used for lookups. It takes into account not
+// only the height and format, but also the chunks, hash and metadata, in case
+// peers have generated snapshots in a non-deterministic manner. All fields
+// must be equal for snapshots to be considered the same.
+func (s *snapshot) Key() snapshotKey {
+	// hash.Hash.Write never returns an error
+	hasher := sha256.New()
+	hasher.Write([]byte(fmt.Sprintf("%v:%v:%v", s.Height, s.Format, s.Chunks)))
+	hasher.Write(s.Hash)
+	hasher.Write(s.Metadata)
+	var key snapshotKey
+	copy(key[:], hasher.Sum(nil))
+	return key
+}
+
+// snapshotPool discovers and aggregates snapshots across peers.
+type snapshotPool struct {
+	stateProvider StateProvider
+
+	mtx           sync.Mutex
+	snapshots     map[snapshotKey]*snapshot
+	snapshotPeers map[snapshotKey]map[p2p.ID]p2p.Peer
+
+	// rejected snapshots, formats and peers are never added again
+	snapshotBlacklist map[snapshotKey]bool
+	formatBlacklist   map[uint32]bool
+	peerBlacklist     map[p2p.ID]bool
+}
+
+// newSnapshotPool creates a new snapshot pool. The state provider is used to
+// fetch the trusted app hashes of the snapshot heights.
+func newSnapshotPool(stateProvider StateProvider) *snapshotPool {
+	return &snapshotPool{
+		stateProvider:     stateProvider,
+		snapshots:         make(map[snapshotKey]*snapshot),
+		snapshotPeers:     make(map[snapshotKey]map[p2p.ID]p2p.Peer),
+		snapshotBlacklist: make(map[snapshotKey]bool),
+		formatBlacklist:   make(map[uint32]bool),
+		peerBlacklist:     make(map[p2p.ID]bool),
+	}
+}
+
+// Add adds a snapshot offered by a peer to the pool. It returns true if the
+// snapshot is new and not blacklisted. The trusted app hash of new snapshots
+// is fetched from the state provider, which verifies their height.
+func (p *snapshotPool) Add(peer p2p.Peer, s *snapshot) (bool, error) {
+	key := s.Key()
+
+	p.mtx.Lock()
+	if p.isBlacklisted(peer.ID(), s.Format, key) {
+		p.mtx.Unlock()
+		return false, nil
+	}
+	if _, ok := p.snapshots[key]; ok {
+		p.snapshotPeers[key][peer.ID()] = peer
+		p.mtx.Unlock()
+		return false, nil
+	}
+	p.mtx.Unlock()
+
+	// this can take a while, so don't hold the lock
+	appHash, err := p.stateProvider.AppHash(s.Height)
+	if err != nil {
+		return false, err
+	}
+	s.trustedAppHash = appHash
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if p.isBlacklisted(peer.ID(), s.Format, key) {
+		return false, nil
+	}
+	if p.snapshotPeers[key] == nil {
+		p.snapshotPeers[key] = make(map[p2p.ID]p2p.Peer)
+	}
+	p.snapshotPeers[key][peer.ID()] = peer
+	if _, ok := p.snapshots[key]; ok {
+		return false, nil
+	}
+	p.snapshots[key] = s
+	return true, nil
+}
+
+func (p *snapshotPool) isBlacklisted(peerID p2p.ID, format uint32, key snapshotKey) bool {
+	return p.peerBlacklist[peerID] || p.formatBlacklist[format] || p.snapshotBlacklist[key]
+}
+
+// Best returns the best snapshot (see Ranked), or nil if there are none.
+func (p *snapshotPool) Best() *snapshot {
+	ranked := p.Ranked()
+	if len(ranked) == 0 {
+		return nil
+	}
+	return ranked[0]
+}
+
+// Ranked returns the snapshots by preference: highest height first, then
+// highest format, then those held by the most peers.
+func (p *snapshotPool) Ranked() []*snapshot {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	candidates := make([]*snapshot, 0, len(p.snapshots))
+	for _, s := range p.snapshots {
+		candidates = append(candidates, s)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		switch {
+		case a.Height != b.Height:
+			return a.Height > b.Height
+		case a.Format != b.Format:
+			return a.Format > b.Format
+		default:
+			return len(p.snapshotPeers[a.Key()]) > len(p.snapshotPeers[b.Key()])
+		}
+	})
+	return candidates
+}
+
+// GetPeer returns a random peer holding the snapshot, or nil if there are
+// none.
+func (p *snapshotPool) GetPeer(s *snapshot) p2p.Peer {
+	peers := p.GetPeers(s)
+	if len(peers) == 0 {
+		return nil
+	}
+	return peers[cmn.RandIntn(len(peers))]
+}
+
+// GetPeers returns the peers holding the snapshot, ordered by ID.
+func (p *snapshotPool) GetPeers(s *snapshot) []p2p.Peer {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	peers := make([]p2p.Peer, 0, len(p.snapshotPeers[s.Key()]))
+	for _, peer := range p.snapshotPeers[s.Key()] {
+		peers = append(peers, peer)
+	}
+	sort.Slice(peers, func(i, j int) bool { return peers[i].ID() < peers[j].ID() })
+	return peers
+}
+
+// Reject rejects a snapshot, it won't be added again.
+func (p *snapshotPool) Reject(s *snapshot) {
+	key := s.Key()
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.snapshotBlacklist[key] = true
+	p.removeSnapshot(key)
+}
+
+// RejectFormat rejects a snapshot format, snapshots of it won't be added
+// again.
+func (p *snapshotPool) RejectFormat(format uint32) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.formatBlacklist[format] = true
+	for key, s := range p.snapshots {
+		if s.Format == format {
+			p.removeSnapshot(key)
+		}
+	}
+}
+
+// RejectPeer rejects a peer, its snapshots won't be added again.
+func (p *snapshotPool) RejectPeer(peerID p2p.ID) {
+	if peerID == "" {
+		return
+	}
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.peerBlacklist[peerID] = true
+	p.removePeer(peerID)
+}
+
+// RemovePeer removes a peer from the pool, e.g. once it disconnects. Its
+// snapshots are removed unless other peers hold them too.
+func (p *snapshotPool) RemovePeer(peerID p2p.ID) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.removePeer(peerID)
+}
+
+// removePeer removes a peer. The caller must hold the mutex lock.
+func (p *snapshotPool) removePeer(peerID p2p.ID) {
+	for key, peers := range p.snapshotPeers {
+		delete(peers, peerID)
+		if len(peers) == 0 {
+			p.removeSnapshot(key)
+		}
+	}
+}
+
+// removeSnapshot removes a snapshot. The caller must hold the mutex lock.
+func (p *snapshotPool) removeSnapshot(key snapshotKey) {
+	delete(p.snapshots, key)
+	delete(p.snapshotPeers, key)
+}
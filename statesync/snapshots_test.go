@@ -0,0 +1,142 @@
+package statesync
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotKey(t *testing.T) {
+	base := snapshot{Height: 1, Format: 1, Chunks: 1, Hash: []byte{1}, Metadata: []byte{1}}
+	baseKey := base.Key()
+
+	testCases := map[string]func(s *snapshot){
+		"height":   func(s *snapshot) { s.Height = 2 },
+		"format":   func(s *snapshot) { s.Format = 2 },
+		"chunks":   func(s *snapshot) { s.Chunks = 2 },
+		"hash":     func(s *snapshot) { s.Hash = []byte{2} },
+		"metadata": func(s *snapshot) { s.Metadata = nil },
+	}
+	for name, mutate := range testCases {
+		s := base
+		mutate(&s)
+		assert.NotEqual(t, baseKey, s.Key(), name)
+	}
+
+	// the trusted app hash isn't part of the key
+	s := base
+	s.trustedAppHash = []byte{9}
+	assert.Equal(t, baseKey, s.Key())
+}
+
+func TestSnapshotPoolAdd(t *testing.T) {
+	pool := newSnapshotPool(&mockStateProvider{appHash: []byte("app_hash")})
+	peerA, peerB := newMockPeer(), newMockPeer()
+
+	added, err := pool.Add(peerA, &snapshot{Height: 1, Format: 1, Chunks: 1, Hash: []byte{1}})
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	// the same snapshot from another peer isn't new, but the peer is recorded
+	s := &snapshot{Height: 1, Format: 1, Chunks: 1, Hash: []byte{1}}
+	added, err = pool.Add(peerB, s)
+	require.NoError(t, err)
+	assert.False(t, added)
+	assert.Len(t, pool.GetPeers(s), 2)
+
+	best := pool.Best()
+	require.NotNil(t, best)
+	assert.Equal(t, []byte("app_hash"), best.trustedAppHash)
+
+	// snapshots which can't be verified aren't added
+	pool = newSnapshotPool(&mockStateProvider{err: errors.New("no header")})
+	added, err = pool.Add(peerA, &snapshot{Height: 1, Format: 1, Chunks: 1, Hash: []byte{1}})
+	require.Error(t, err)
+	assert.False(t, added)
+	assert.Nil(t, pool.Best())
+}
+
+func TestSnapshotPoolRanked(t *testing.T) {
+	pool := newSnapshotPool(&mockStateProvider{})
+	peerA, peerB := newMockPeer(), newMockPeer()
+
+	snapshots := []*snapshot{
+		{Height: 2, Format: 1, Chunks: 1, Hash: []byte{1}},
+		{Height: 1, Format: 2, Chunks: 1, Hash: []byte{2}},
+		{Height: 2, Format: 2, Chunks: 1, Hash: []byte{3}},
+		{Height: 2, Format: 1, Chunks: 1, Hash: []byte{4}},
+	}
+	for _, s := range snapshots {
+		_, err := pool.Add(peerA, s)
+		require.NoError(t, err)
+	}
+	_, err := pool.Add(peerB, &snapshot{Height: 2, Format: 1, Chunks: 1, Hash: []byte{4}})
+	require.NoError(t, err)
+
+	ranked := pool.Ranked()
+	require.Len(t, ranked, 4)
+	assert.Equal(t, snapshots[2], ranked[0])
+	assert.Equal(t, snapshots[3], ranked[1]) // held by two peers
+	assert.Equal(t, snapshots[0], ranked[2])
+	assert.Equal(t, snapshots[1], ranked[3])
+}
+
+func TestSnapshotPoolReject(t *testing.T) {
+	pool := newSnapshotPool(&mockStateProvider{})
+	peerA, peerB := newMockPeer(), newMockPeer()
+
+	s1 := &snapshot{Height: 2, Format: 1, Chunks: 1, Hash: []byte{1}}
+	s2 := &snapshot{Height: 2, Format: 2, Chunks: 1, Hash: []byte{2}}
+	s3 := &snapshot{Height: 1, Format: 1, Chunks: 1, Hash: []byte{3}}
+	for _, s := range []*snapshot{s1, s2, s3} {
+		_, err := pool.Add(peerA, s)
+		require.NoError(t, err)
+	}
+
+	pool.Reject(s2)
+	assert.Equal(t, []*snapshot{s1, s3}, pool.Ranked())
+	added, err := pool.Add(peerB, s2)
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	pool.RejectFormat(1)
+	assert.Empty(t, pool.Ranked())
+	added, err = pool.Add(peerB, &snapshot{Height: 3, Format: 1, Chunks: 1, Hash: []byte{4}})
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	added, err = pool.Add(peerB, &snapshot{Height: 3, Format: 3, Chunks: 1, Hash: []byte{5}})
+	require.NoError(t, err)
+	assert.True(t, added)
+	pool.RejectPeer(peerB.ID())
+	assert.Empty(t, pool.Ranked())
+	added, err = pool.Add(peerB, &snapshot{Height: 4, Format: 3, Chunks: 1, Hash: []byte{6}})
+	require.NoError(t, err)
+	assert.False(t, added)
+}
+
+func TestSnapshotPoolRemovePeer(t *testing.T) {
+	pool := newSnapshotPool(&mockStateProvider{})
+	peerA, peerB := newMockPeer(), newMockPeer()
+
+	s1 := &snapshot{Height: 1, Format: 1, Chunks: 1, Hash: []byte{1}}
+	s2 := &snapshot{Height: 2, Format: 1, Chunks: 1, Hash: []byte{2}}
+	for _, peer := range []*mockPeer{peerA, peerB} {
+		_, err := pool.Add(peer, s1)
+		require.NoError(t, err)
+	}
+	_, err := pool.Add(peerA, s2)
+	require.NoError(t, err)
+
+	// snapshots are only removed once no peer holds them
+	pool.RemovePeer(peerA.ID())
+	assert.Equal(t, []*snapshot{s1}, pool.Ranked())
+	assert.Equal(t, peerB, pool.GetPeer(s1))
+
+	// removed peers can add their snapshots again
+	added, err := pool.Add(peerA, s2)
+	require.NoError(t, err)
+	assert.True(t, added)
+}
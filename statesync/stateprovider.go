@@ -0,0 +1,282 @@
+package statesync
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/lite"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+	"github.com/tendermint/tendermint/version"
+)
+
+// StateProvider is a provider of trusted state data for bootstrapping a node.
+// This refers to the state.State object, not the state machine.
+type StateProvider interface {
+	// AppHash returns the app hash after the given height has been committed.
+	AppHash(height uint64) ([]byte, error)
+	// Commit returns the commit at the given height.
+	Commit(height uint64) (*types.Commit, error)
+	// State returns a state object at the given height.
+	State(height uint64) (sm.State, error)
+}
+
+// lightClientStateProvider is a state provider using the lite verifier. The
+// headers are fetched from a primary RPC server, checked against those of
+// the witnesses, and verified starting from a trusted header.
+type lightClientStateProvider struct {
+	mtx             sync.Mutex // the verifier is not used concurrently
+	genDoc          *types.GenesisDoc
+	groupValidators bool
+	verifier        *lite.DynamicVerifier
+	primary         rpcClient
+	witnesses       []rpcClient
+}
+
+// NewLightClientStateProvider creates a new StateProvider using the lite
+// verifier, with the first RPC server as its primary and the others as
+// witnesses. The header at trustHeight must have the hash trustHash, and only
+// the heights above it can be verified.
+func NewLightClientStateProvider(
+	genDoc *types.GenesisDoc,
+	servers []string,
+	trustHeight int64,
+	trustHash []byte,
+	logger log.Logger,
+) (StateProvider, error) {
+	if len(servers) < 2 {
+		return nil, fmt.Errorf("at least 2 RPC servers are required, got %v", len(servers))
+	}
+	clients := make([]rpcClient, 0, len(servers))
+	for _, server := range servers {
+		clients = append(clients, newHTTPClient(server))
+	}
+	return newLightClientStateProvider(genDoc, clients[0], clients[1:], trustHeight, trustHash, logger)
+}
+
+func newLightClientStateProvider(
+	genDoc *types.GenesisDoc,
+	primary rpcClient,
+	witnesses []rpcClient,
+	trustHeight int64,
+	trustHash []byte,
+	logger log.Logger,
+) (*lightClientStateProvider, error) {
+	chainID := genDoc.ChainID
+	groupValidators := genDoc.ConsensusParams != nil && genDoc.ConsensusParams.Validator.GroupValidators
+	trusted := lite.NewDBProvider("trusted.mem", dbm.NewMemDB())
+	verifier := lite.NewDynamicVerifier(chainID, trusted, newRPCProvider(chainID, primary))
+	verifier.SetLogger(logger)
+	verifier.SetGroupValidators(groupValidators)
+
+	// trust the header of the trust height, if it has the trusted hash
+	res, err := primary.Commit(&trustHeight)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the header at trust height %v: %v", trustHeight, err)
+	}
+	if hash := res.Header.Hash(); !bytes.Equal(hash, trustHash) {
+		return nil, fmt.Errorf("header at trust height %v has hash %X, expected %X", trustHeight, hash, trustHash)
+	}
+	vals, err := fetchValidators(primary, trustHeight)
+	if err != nil {
+		return nil, err
+	}
+	nextVals, err := fetchValidators(primary, trustHeight+1)
+	if err != nil {
+		return nil, err
+	}
+	fc := lite.NewFullCommit(res.SignedHeader, vals, nextVals)
+	if groupValidators {
+		err = fc.ValidateFullGroup(chainID)
+	} else {
+		err = fc.ValidateFull(chainID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid commit at trust height %v: %v", trustHeight, err)
+	}
+	if err := trusted.SaveFullCommit(fc); err != nil {
+		return nil, err
+	}
+
+	return &lightClientStateProvider{
+		genDoc:          genDoc,
+		groupValidators: groupValidators,
+		verifier:        verifier,
+		primary:         primary,
+		witnesses:       witnesses,
+	}, nil
+}
+
+// AppHash implements StateProvider.
+func (s *lightClientStateProvider) AppHash(height uint64) ([]byte, error) {
+	header, err := s.signedHeader(int64(height) + 1)
+	if err != nil {
+		return nil, err
+	}
+	// the state needs the header after next too, check we can get it before
+	// the snapshot is restored
+	_, err = s.signedHeader(int64(height) + 2)
+	if err != nil {
+		return nil, err
+	}
+	return header.AppHash, nil
+}
+
+// Commit implements StateProvider.
+func (s *lightClientStateProvider) Commit(height uint64) (*types.Commit, error) {
+	header, err := s.signedHeader(int64(height))
+	if err != nil {
+		return nil, err
+	}
+	return header.Commit, nil
+}
+
+// State implements StateProvider.
+func (s *lightClientStateProvider) State(height uint64) (sm.State, error) {
+	// The state of height h is made from the header of h, the header of h+1
+	// (which has the app hash and results of h) and the validators of h, h+1
+	// and h+2, which sign those blocks.
+	h := int64(height)
+	lastHeader, err := s.signedHeader(h)
+	if err != nil {
+		return sm.State{}, err
+	}
+	curHeader, err := s.signedHeader(h + 1)
+	if err != nil {
+		return sm.State{}, err
+	}
+	nextHeader, err := s.signedHeader(h + 2)
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	state := sm.State{
+		Version: sm.Version{
+			Consensus: curHeader.Version,
+			Software:  version.TMCoreSemVer,
+		},
+		ChainID: s.genDoc.ChainID,
+
+		LastBlockHeight:   h,
+		LastBlockTotalTx:  lastHeader.TotalTxs,
+		LastBlockID:       lastHeader.Commit.BlockID,
+		LastBlockTime:     lastHeader.Time,
+		LastBlockGroup:    lastHeader.Group,
+		LastBlockSections: lastHeader.TxSections(),
+
+		LastHeightValidatorsChanged:      h + 2,
+		LastHeightConsensusParamsChanged: h + 1,
+
+		AppHash:         curHeader.AppHash,
+		LastResultsHash: curHeader.LastResultsHash,
+	}
+	if state.LastValidators, err = s.validators(lastHeader); err != nil {
+		return sm.State{}, err
+	}
+	if state.Validators, err = s.validators(curHeader); err != nil {
+		return sm.State{}, err
+	}
+	if state.NextValidators, err = s.validators(nextHeader); err != nil {
+		return sm.State{}, err
+	}
+	if state.ConsensusParams, err = s.consensusParams(curHeader); err != nil {
+		return sm.State{}, err
+	}
+	return state, nil
+}
+
+// signedHeader fetches the signed header of a height from the primary, checks
+// the witnesses have the same header, and verifies it.
+func (s *lightClientStateProvider) signedHeader(height int64) (types.SignedHeader, error) {
+	res, err := s.primary.Commit(&height)
+	if err != nil {
+		return types.SignedHeader{}, fmt.Errorf("failed to fetch header at height %v: %v", height, err)
+	}
+	if res.Header == nil || res.Commit == nil {
+		return types.SignedHeader{}, fmt.Errorf("no signed header at height %v", height)
+	}
+	hash := res.Header.Hash()
+	for i, witness := range s.witnesses {
+		wres, err := witness.Commit(&height)
+		if err != nil {
+			return types.SignedHeader{}, fmt.Errorf("failed to fetch header at height %v from witness %v: %v",
+				height, i, err)
+		}
+		if wres.Header == nil || !bytes.Equal(wres.Header.Hash(), hash) {
+			return types.SignedHeader{}, fmt.Errorf("witness %v has a different header at height %v than the primary",
+				i, height)
+		}
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if err := s.verifier.Verify(res.SignedHeader); err != nil {
+		return types.SignedHeader{}, fmt.Errorf("failed to verify header at height %v: %v", height, err)
+	}
+	return res.SignedHeader, nil
+}
+
+// validators fetches the validators which signed a verified header, with the
+// proposer priorities of the stored state.
+func (s *lightClientStateProvider) validators(header types.SignedHeader) (*types.ValidatorSet, error) {
+	vals, err := fetchValidators(s.primary, header.Height)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(vals.Hash(), header.ValidatorsHash) {
+		return nil, fmt.Errorf("validators at height %v have hash %X, expected %X",
+			header.Height, vals.Hash(), header.ValidatorsHash)
+	}
+	// The proposer isn't given by the priorities, it's the one of round 0.
+	// With group validators it's reset by the group rotation.
+	if !s.groupValidators && header.Commit.Round() == 0 {
+		_, vals.Proposer = vals.GetByAddress(header.ProposerAddress)
+	}
+	return vals, nil
+}
+
+// consensusParams fetches the consensus params of a verified header. Only
+// some of them are covered by its ConsensusHash, the others must match
+// between the primary and the witnesses.
+func (s *lightClientStateProvider) consensusParams(header types.SignedHeader) (types.ConsensusParams, error) {
+	height := header.Height
+	res, err := s.primary.ConsensusParams(&height)
+	if err != nil {
+		return types.ConsensusParams{}, fmt.Errorf("failed to fetch consensus params at height %v: %v", height, err)
+	}
+	params := res.ConsensusParams
+	if !bytes.Equal(params.Hash(), header.ConsensusHash) {
+		return types.ConsensusParams{}, fmt.Errorf("consensus params at height %v have hash %X, expected %X",
+			height, params.Hash(), header.ConsensusHash)
+	}
+	for i, witness := range s.witnesses {
+		wres, err := witness.ConsensusParams(&height)
+		if err != nil {
+			return types.ConsensusParams{}, fmt.Errorf("failed to fetch consensus params at height %v from witness %v: %v",
+				height, i, err)
+		}
+		if !wres.ConsensusParams.Equals(&params) {
+			return types.ConsensusParams{}, fmt.Errorf("witness %v has different consensus params at height %v than the primary",
+				i, height)
+		}
+	}
+	// GroupValidators can only be set in the genesis
+	if params.Validator.GroupValidators != s.groupValidators {
+		return types.ConsensusParams{}, fmt.Errorf("consensus params at height %v have GroupValidators %v, genesis has %v",
+			height, params.Validator.GroupValidators, s.groupValidators)
+	}
+	return params, nil
+}
+
+// fetchValidators fetches the validators of a height.
+func fetchValidators(client rpcClient, height int64) (*types.ValidatorSet, error) {
+	res, err := client.Validators(&height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch validators at height %v: %v", height, err)
+	}
+	// NOTE: don't use NewValidatorSet, it would increment priorities.
+	return &types.ValidatorSet{Validators: res.Validators}, nil
+}
@@ -0,0 +1,104 @@
+package statesync
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/lite"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+// mockRPCClient serves a chain of signed headers.
+type mockRPCClient struct {
+	headers map[int64]types.SignedHeader
+	vals    *types.ValidatorSet
+	params  types.ConsensusParams
+}
+
+func (c *mockRPCClient) Commit(height *int64) (*ctypes.ResultCommit, error) {
+	sh, ok := c.headers[*height]
+	if !ok {
+		return nil, fmt.Errorf("no header at height %v", *height)
+	}
+	return &ctypes.ResultCommit{SignedHeader: sh, CanonicalCommit: true}, nil
+}
+
+func (c *mockRPCClient) Validators(height *int64) (*ctypes.ResultValidators, error) {
+	return &ctypes.ResultValidators{BlockHeight: *height, Validators: c.vals.Copy().Validators}, nil
+}
+
+func (c *mockRPCClient) ConsensusParams(height *int64) (*ctypes.ResultConsensusParams, error) {
+	return &ctypes.ResultConsensusParams{BlockHeight: *height, ConsensusParams: c.params}, nil
+}
+
+func newMockRPCClient(chainID string, height int64) *mockRPCClient {
+	keys := lite.GenSecpPrivKeys(4)
+	vals := keys.ToValidators(10, 0)
+	params := *types.DefaultConsensusParams()
+	c := &mockRPCClient{
+		headers: make(map[int64]types.SignedHeader),
+		vals:    vals,
+		params:  params,
+	}
+	for h := int64(1); h <= height; h++ {
+		appHash := []byte(fmt.Sprintf("app_hash_%v", h))
+		c.headers[h] = keys.GenSignedHeader(chainID, h, nil, vals, vals, appHash, params.Hash(), nil, 0, len(keys))
+	}
+	return c
+}
+
+func TestLightClientStateProvider(t *testing.T) {
+	chainID := "test-chain"
+	genDoc := &types.GenesisDoc{ChainID: chainID, ConsensusParams: types.DefaultConsensusParams()}
+	primary := newMockRPCClient(chainID, 6)
+	witness := &mockRPCClient{headers: primary.headers, vals: primary.vals, params: primary.params}
+	trustHash := primary.headers[1].Hash()
+
+	// the trusted header must have the trust hash
+	_, err := newLightClientStateProvider(genDoc, primary, []rpcClient{witness}, 1, []byte("bad"), log.TestingLogger())
+	require.Error(t, err)
+
+	provider, err := newLightClientStateProvider(genDoc, primary, []rpcClient{witness}, 1, trustHash, log.TestingLogger())
+	require.NoError(t, err)
+
+	appHash, err := provider.AppHash(3)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("app_hash_4"), appHash)
+
+	commit, err := provider.Commit(3)
+	require.NoError(t, err)
+	assert.Equal(t, primary.headers[3].Commit, commit)
+
+	state, err := provider.State(3)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, state.LastBlockHeight)
+	assert.Equal(t, chainID, state.ChainID)
+	assert.Equal(t, []byte("app_hash_4"), state.AppHash)
+	assert.Equal(t, primary.vals.Hash(), state.Validators.Hash())
+	assert.Equal(t, primary.params, state.ConsensusParams)
+	assert.EqualValues(t, 5, state.LastHeightValidatorsChanged)
+
+	// the header after next must exist to build the state
+	_, err = provider.AppHash(5)
+	require.Error(t, err)
+}
+
+func TestLightClientStateProviderWitnessMismatch(t *testing.T) {
+	chainID := "test-chain"
+	genDoc := &types.GenesisDoc{ChainID: chainID, ConsensusParams: types.DefaultConsensusParams()}
+	primary := newMockRPCClient(chainID, 6)
+	witness := newMockRPCClient(chainID, 6)
+	witness.headers[1] = primary.headers[1]
+
+	provider, err := newLightClientStateProvider(genDoc, primary, []rpcClient{witness}, 1,
+		primary.headers[1].Hash(), log.TestingLogger())
+	require.NoError(t, err)
+
+	_, err = provider.AppHash(3)
+	require.Error(t, err)
+}
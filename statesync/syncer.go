@@ -0,0 +1,450 @@
+package statesync
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	// chunkTimeout is the time to wait for the next chunk to arrive before
+	// giving up on the snapshot
+	chunkTimeout = 2 * time.Minute
+)
+
+var (
+	// errAbort is returned by Sync() when the app aborts the restore.
+	errAbort = errors.New("state sync aborted")
+	// errRetrySnapshot is returned by Sync() when the app asks to restore
+	// the snapshot again.
+	errRetrySnapshot = errors.New("retry snapshot")
+	// errRejectSnapshot is returned by Sync() when the app rejects the
+	// snapshot.
+	errRejectSnapshot = errors.New("snapshot was rejected")
+	// errRejectFormat is returned by Sync() when the app rejects the snapshot
+	// format.
+	errRejectFormat = errors.New("snapshot format was rejected")
+	// errRejectSender is returned by Sync() when the app rejects the peers
+	// which sent the snapshot.
+	errRejectSender = errors.New("snapshot sender was rejected")
+	// errVerifyFailed is returned by Sync() when the restored app doesn't
+	// have the trusted height or app hash.
+	errVerifyFailed = errors.New("verification failed")
+	// errTimeout is returned by Sync() when a chunk didn't arrive in time.
+	errTimeout = errors.New("timed out waiting for chunk")
+	// errNoSnapshots is returned by SyncAny() when no snapshot is found and
+	// discovery is disabled.
+	errNoSnapshots = errors.New("no suitable snapshots found")
+)
+
+// syncer restores the app from a snapshot. SyncAny() picks the best snapshot
+// discovered, Sync() restores a given one. Snapshots and chunks received from
+// peers are fed with AddSnapshot() and AddChunk().
+type syncer struct {
+	logger        log.Logger
+	stateProvider StateProvider
+	conn          proxy.AppConnSnapshot
+	connQuery     proxy.AppConnQuery
+	snapshots     *snapshotPool
+
+	tempDir             string
+	chunkFetchers       int32
+	chunkRequestTimeout time.Duration
+
+	mtx    sync.RWMutex
+	chunks *chunkQueue
+}
+
+// newSyncer creates a new syncer.
+func newSyncer(config *cfg.StateSyncConfig, logger log.Logger, conn proxy.AppConnSnapshot,
+	connQuery proxy.AppConnQuery, stateProvider StateProvider) *syncer {
+	return &syncer{
+		logger:              logger,
+		stateProvider:       stateProvider,
+		conn:                conn,
+		connQuery:           connQuery,
+		snapshots:           newSnapshotPool(stateProvider),
+		tempDir:             config.TempDir,
+		chunkFetchers:       config.ChunkFetchers,
+		chunkRequestTimeout: config.ChunkRequestTimeout,
+	}
+}
+
+// AddChunk adds a chunk to the chunk queue of the sync in progress. It
+// returns false if the chunk was already there.
+func (s *syncer) AddChunk(chunk *chunk) (bool, error) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	if s.chunks == nil {
+		return false, errors.New("no state sync in progress")
+	}
+	added, err := s.chunks.Add(chunk)
+	if err != nil {
+		return false, err
+	}
+	if added {
+		s.logger.Debug("Added chunk to queue", "height", chunk.Height, "format", chunk.Format,
+			"chunk", chunk.Index)
+	} else {
+		s.logger.Debug("Ignoring duplicate chunk in queue", "height", chunk.Height, "format", chunk.Format,
+			"chunk", chunk.Index)
+	}
+	return added, nil
+}
+
+// AddSnapshot adds a snapshot to the snapshot pool. It returns true if the
+// snapshot is new and was accepted.
+func (s *syncer) AddSnapshot(peer p2p.Peer, snapshot *snapshot) (bool, error) {
+	added, err := s.snapshots.Add(peer, snapshot)
+	if err != nil {
+		return false, err
+	}
+	if added {
+		s.logger.Info("Discovered new snapshot", "height", snapshot.Height, "format", snapshot.Format,
+			"hash", fmt.Sprintf("%X", snapshot.Hash))
+	}
+	return added, nil
+}
+
+// AddPeer asks a new peer for its snapshots.
+func (s *syncer) AddPeer(peer p2p.Peer) {
+	s.logger.Debug("Requesting snapshots from peer", "peer", peer.ID())
+	peer.Send(SnapshotChannel, cdc.MustMarshalBinaryBare(&snapshotsRequestMessage{}))
+}
+
+// RemovePeer removes a peer from the snapshot pool.
+func (s *syncer) RemovePeer(peer p2p.Peer) {
+	s.logger.Debug("Removing peer from sync", "peer", peer.ID())
+	s.snapshots.RemovePeer(peer.ID())
+}
+
+// SyncAny restores the best snapshot discovered, trying the next best ones
+// when the app rejects it. It waits discoveryTime for snapshots before each
+// attempt, or gives up if discoveryTime is 0 and none are left. It returns the
+// state and commit of the snapshot height, to bootstrap the node with.
+func (s *syncer) SyncAny(discoveryTime time.Duration) (sm.State, *types.Commit, error) {
+	if discoveryTime > 0 {
+		s.logger.Info(fmt.Sprintf("Discovering snapshots for %v", discoveryTime))
+		time.Sleep(discoveryTime)
+	}
+
+	// the snapshot and chunks are kept when the app asks to retry the snapshot
+	var (
+		snapshot *snapshot
+		chunks   *chunkQueue
+		err      error
+	)
+	defer func() {
+		if chunks != nil {
+			chunks.Close() // nolint: errcheck
+		}
+	}()
+	for {
+		if snapshot == nil {
+			snapshot = s.snapshots.Best()
+		}
+		if snapshot == nil {
+			if discoveryTime == 0 {
+				return sm.State{}, nil, errNoSnapshots
+			}
+			s.logger.Info(fmt.Sprintf("Discovering snapshots for %v", discoveryTime))
+			time.Sleep(discoveryTime)
+			continue
+		}
+		if chunks == nil {
+			chunks, err = newChunkQueue(snapshot, s.tempDir)
+			if err != nil {
+				return sm.State{}, nil, fmt.Errorf("failed to create chunk queue: %v", err)
+			}
+		}
+
+		newState, commit, err := s.Sync(snapshot, chunks)
+		switch err {
+		case nil:
+			return newState, commit, nil
+
+		case errAbort:
+			return sm.State{}, nil, err
+
+		case errRetrySnapshot:
+			chunks.RetryAll()
+			s.logger.Info("Retrying snapshot", "height", snapshot.Height, "format", snapshot.Format,
+				"hash", fmt.Sprintf("%X", snapshot.Hash))
+			continue
+
+		case errTimeout:
+			s.snapshots.Reject(snapshot)
+			s.logger.Error("Timed out waiting for snapshot chunks, rejected snapshot",
+				"height", snapshot.Height, "format", snapshot.Format, "hash", fmt.Sprintf("%X", snapshot.Hash))
+
+		case errRejectSnapshot:
+			s.snapshots.Reject(snapshot)
+			s.logger.Info("Snapshot rejected", "height", snapshot.Height, "format", snapshot.Format,
+				"hash", fmt.Sprintf("%X", snapshot.Hash))
+
+		case errRejectFormat:
+			s.snapshots.RejectFormat(snapshot.Format)
+			s.logger.Info("Snapshot format rejected", "format", snapshot.Format)
+
+		case errRejectSender:
+			for _, peer := range s.snapshots.GetPeers(snapshot) {
+				s.snapshots.RejectPeer(peer.ID())
+				s.logger.Info("Snapshot sender rejected", "peer", peer.ID())
+			}
+
+		default:
+			return sm.State{}, nil, fmt.Errorf("snapshot restoration failed: %v", err)
+		}
+
+		// try the next best snapshot
+		if err := chunks.Close(); err != nil {
+			s.logger.Error("Failed to clean up chunk queue", "err", err)
+		}
+		snapshot = nil
+		chunks = nil
+	}
+}
+
+// Sync restores a snapshot, fetching its chunks into the given queue. It
+// returns the state and commit of the snapshot height.
+func (s *syncer) Sync(snapshot *snapshot, chunks *chunkQueue) (sm.State, *types.Commit, error) {
+	s.mtx.Lock()
+	if s.chunks != nil {
+		s.mtx.Unlock()
+		return sm.State{}, nil, errors.New("a state sync is already in progress")
+	}
+	s.chunks = chunks
+	s.mtx.Unlock()
+	defer func() {
+		s.mtx.Lock()
+		s.chunks = nil
+		s.mtx.Unlock()
+	}()
+
+	if err := s.offerSnapshot(snapshot); err != nil {
+		return sm.State{}, nil, err
+	}
+
+	// fetch the chunks until the restore is done
+	quit := make(chan struct{})
+	defer close(quit)
+	for i := int32(0); i < s.chunkFetchers; i++ {
+		go s.fetchChunks(snapshot, chunks, quit)
+	}
+
+	// build the state first, so we don't only find out about failures to
+	// verify it once the app is restored
+	state, err := s.stateProvider.State(snapshot.Height)
+	if err != nil {
+		return sm.State{}, nil, fmt.Errorf("failed to build new state: %v", err)
+	}
+	commit, err := s.stateProvider.Commit(snapshot.Height)
+	if err != nil {
+		return sm.State{}, nil, fmt.Errorf("failed to fetch commit: %v", err)
+	}
+
+	if err := s.applyChunks(chunks); err != nil {
+		return sm.State{}, nil, err
+	}
+
+	groups, nextGroup, err := s.verifyApp(snapshot)
+	if err != nil {
+		return sm.State{}, nil, err
+	}
+	state.Groups = groups
+	state.AppNextGroup = nextGroup
+
+	s.logger.Info("Snapshot restored", "height", snapshot.Height, "format", snapshot.Format,
+		"hash", fmt.Sprintf("%X", snapshot.Hash))
+	return state, commit, nil
+}
+
+// offerSnapshot offers a snapshot to the app.
+func (s *syncer) offerSnapshot(snapshot *snapshot) error {
+	s.logger.Info("Offering snapshot to ABCI app", "height", snapshot.Height,
+		"format", snapshot.Format, "hash", fmt.Sprintf("%X", snapshot.Hash))
+	res, err := s.conn.OfferSnapshotSync(abci.RequestOfferSnapshot{
+		Snapshot: &abci.Snapshot{
+			Height:   snapshot.Height,
+			Format:   snapshot.Format,
+			Chunks:   snapshot.Chunks,
+			Hash:     snapshot.Hash,
+			Metadata: snapshot.Metadata,
+		},
+		AppHash: snapshot.trustedAppHash,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to offer snapshot: %v", err)
+	}
+	switch res.Result {
+	case abci.ResponseOfferSnapshot_ACCEPT:
+		s.logger.Info("Snapshot accepted, restoring", "height", snapshot.Height,
+			"format", snapshot.Format, "hash", fmt.Sprintf("%X", snapshot.Hash))
+		return nil
+	case abci.ResponseOfferSnapshot_ABORT:
+		return errAbort
+	case abci.ResponseOfferSnapshot_REJECT:
+		return errRejectSnapshot
+	case abci.ResponseOfferSnapshot_REJECT_FORMAT:
+		return errRejectFormat
+	case abci.ResponseOfferSnapshot_REJECT_SENDER:
+		return errRejectSender
+	default:
+		return fmt.Errorf("unknown ResponseOfferSnapshot result %v", res.Result)
+	}
+}
+
+// applyChunks applies the chunks to the app in order, as they arrive, until
+// all are applied or the app asks to stop.
+func (s *syncer) applyChunks(chunks *chunkQueue) error {
+	for {
+		chunk, err := chunks.Next()
+		if err == errDone {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to fetch chunk: %v", err)
+		}
+
+		res, err := s.conn.ApplySnapshotChunkSync(abci.RequestApplySnapshotChunk{
+			Index:  chunk.Index,
+			Chunk:  chunk.Chunk,
+			Sender: string(chunk.Sender),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to apply chunk %v: %v", chunk.Index, err)
+		}
+		s.logger.Info("Applied snapshot chunk to ABCI app", "height", chunk.Height,
+			"format", chunk.Format, "chunk", chunk.Index, "total", chunks.Size())
+
+		// discard and refetch any chunks as requested by the app
+		for _, index := range res.RefetchChunks {
+			if err := chunks.Discard(index); err != nil {
+				return fmt.Errorf("failed to discard chunk %v: %v", index, err)
+			}
+		}
+
+		// reject any senders as requested by the app, and refetch their
+		// chunks not applied yet
+		for _, sender := range res.RejectSenders {
+			if sender != "" {
+				s.snapshots.RejectPeer(p2p.ID(sender))
+				if err := chunks.DiscardSender(p2p.ID(sender)); err != nil {
+					return fmt.Errorf("failed to reject sender: %v", err)
+				}
+			}
+		}
+
+		switch res.Result {
+		case abci.ResponseApplySnapshotChunk_ACCEPT:
+		case abci.ResponseApplySnapshotChunk_ABORT:
+			return errAbort
+		case abci.ResponseApplySnapshotChunk_RETRY:
+			chunks.Retry(chunk.Index)
+		case abci.ResponseApplySnapshotChunk_RETRY_SNAPSHOT:
+			return errRetrySnapshot
+		case abci.ResponseApplySnapshotChunk_REJECT_SNAPSHOT:
+			return errRejectSnapshot
+		default:
+			return fmt.Errorf("unknown ResponseApplySnapshotChunk result %v", res.Result)
+		}
+	}
+}
+
+// fetchChunks requests the chunks of the queue from the peers holding the
+// snapshot, requesting them again from another peer if they don't arrive in
+// time, until the queue is done or quit is closed.
+func (s *syncer) fetchChunks(snapshot *snapshot, chunks *chunkQueue, quit <-chan struct{}) {
+	for {
+		index, err := chunks.Allocate()
+		if err == errDone {
+			// all chunks are allocated, but some may be discarded and need
+			// refetching later
+			select {
+			case <-quit:
+				return
+			case <-time.After(2 * time.Second):
+				continue
+			}
+		}
+		if err != nil {
+			s.logger.Error("Failed to allocate chunk from queue", "err", err)
+			return
+		}
+		s.logger.Info("Fetching snapshot chunk", "height", snapshot.Height,
+			"format", snapshot.Format, "chunk", index, "total", chunks.Size())
+
+		timer := time.NewTimer(s.chunkRequestTimeout)
+		s.requestChunk(snapshot, index)
+		select {
+		case <-chunks.WaitFor(index):
+		case <-timer.C:
+			// have it allocated again, possibly to a different peer
+			s.logger.Debug("Timed out fetching chunk", "chunk", index)
+			if err := chunks.Discard(index); err != nil {
+				s.logger.Error("Failed to discard chunk", "chunk", index, "err", err)
+			}
+		case <-quit:
+			timer.Stop()
+			return
+		}
+		timer.Stop()
+	}
+}
+
+// requestChunk requests a chunk from a random peer holding the snapshot.
+func (s *syncer) requestChunk(snapshot *snapshot, chunk uint32) {
+	peer := s.snapshots.GetPeer(snapshot)
+	if peer == nil {
+		s.logger.Error("No valid peers found for snapshot", "height", snapshot.Height,
+			"format", snapshot.Format, "hash", fmt.Sprintf("%X", snapshot.Hash))
+		return
+	}
+	s.logger.Debug("Requesting snapshot chunk", "height", snapshot.Height,
+		"format", snapshot.Format, "chunk", chunk, "peer", peer.ID())
+	peer.Send(ChunkChannel, cdc.MustMarshalBinaryBare(&chunkRequestMessage{
+		Height: snapshot.Height,
+		Format: snapshot.Format,
+		Index:  chunk,
+	}))
+}
+
+// verifyApp checks the restored app has the height and app hash of the
+// snapshot, and returns the mempool groups open in it and the group it chose
+// for the next block.
+func (s *syncer) verifyApp(snapshot *snapshot) ([]int32, int32, error) {
+	res, err := s.connQuery.InfoSync(proxy.RequestInfo)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query ABCI app for app hash: %v", err)
+	}
+	if !bytes.Equal(snapshot.trustedAppHash, res.LastBlockAppHash) {
+		s.logger.Error("AppHash verification failed", "expected", fmt.Sprintf("%X", snapshot.trustedAppHash),
+			"actual", fmt.Sprintf("%X", res.LastBlockAppHash))
+		return nil, 0, errVerifyFailed
+	}
+	if uint64(res.LastBlockHeight) != snapshot.Height {
+		s.logger.Error("ABCI app reported unexpected last block height", "expected", snapshot.Height,
+			"actual", res.LastBlockHeight)
+		return nil, 0, errVerifyFailed
+	}
+	s.logger.Info("Verified ABCI app", "height", snapshot.Height,
+		"appHash", fmt.Sprintf("%X", snapshot.trustedAppHash))
+
+	// nil means only group 0 is open, see sm.State.Groups
+	if len(res.OpenGroups) == 0 {
+		return nil, res.NextGroup, nil
+	}
+	groups := append([]int32{}, res.OpenGroups...)
+	sort.Slice(groups, func(i, j int) bool { return groups[i] < groups[j] })
+	return groups, res.NextGroup, nil
+}
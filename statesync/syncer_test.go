@@ -0,0 +1,161 @@
+package statesync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// serveChunks answers the chunk requests sent to a peer with the given
+// chunks, until the peer is stopped.
+func serveChunks(t *testing.T, s *syncer, peer *mockPeer, chunks map[uint32][]byte, quit <-chan struct{}) {
+	for {
+		select {
+		case msg := <-peer.sent:
+			req, ok := msg.(*chunkRequestMessage)
+			if !ok {
+				continue
+			}
+			_, err := s.AddChunk(&chunk{
+				Height: req.Height,
+				Format: req.Format,
+				Index:  req.Index,
+				Chunk:  chunks[req.Index],
+				Sender: peer.ID(),
+			})
+			if err != nil {
+				t.Log(err)
+			}
+		case <-quit:
+			return
+		}
+	}
+}
+
+func newTestSyncer(app abci.Application, stateProvider StateProvider) *syncer {
+	conn, connQuery := newAppConns(app)
+	config := cfg.TestStateSyncConfig()
+	config.ChunkRequestTimeout = 100 * time.Millisecond
+	return newSyncer(config, log.TestingLogger(), conn, connQuery, stateProvider)
+}
+
+func TestSyncerSyncAny(t *testing.T) {
+	appHash := []byte("app_hash")
+	app := &snapshotApp{lastHeight: 2, lastAppHash: appHash, openGroups: []int32{3, 1}}
+	s := newTestSyncer(app, &mockStateProvider{appHash: appHash})
+
+	_, _, err := s.SyncAny(0)
+	assert.Equal(t, errNoSnapshots, err)
+
+	peer := newMockPeer()
+	added, err := s.AddSnapshot(peer, &snapshot{Height: 2, Format: 1, Chunks: 3, Hash: []byte{1}})
+	require.NoError(t, err)
+	require.True(t, added)
+
+	quit := make(chan struct{})
+	defer close(quit)
+	go serveChunks(t, s, peer, map[uint32][]byte{0: {0}, 1: {1}, 2: {2}}, quit)
+
+	state, commit, err := s.SyncAny(0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, state.LastBlockHeight)
+	assert.Equal(t, appHash, state.AppHash)
+	assert.Equal(t, []int32{1, 3}, state.Groups)
+	assert.NotNil(t, commit)
+	assert.Equal(t, []uint32{0, 1, 2}, app.applied)
+}
+
+func TestSyncerSyncAnyRejected(t *testing.T) {
+	appHash := []byte("app_hash")
+	app := &snapshotApp{lastHeight: 1, lastAppHash: appHash}
+	app.offer = func(req abci.RequestOfferSnapshot) abci.ResponseOfferSnapshot {
+		switch {
+		case req.Snapshot.Format == 2:
+			return abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_REJECT_FORMAT}
+		case req.Snapshot.Height == 2:
+			return abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_REJECT}
+		}
+		return abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_ACCEPT}
+	}
+	s := newTestSyncer(app, &mockStateProvider{appHash: appHash})
+
+	peer := newMockPeer()
+	for _, snapshot := range []*snapshot{
+		{Height: 3, Format: 2, Chunks: 1, Hash: []byte{1}},
+		{Height: 2, Format: 2, Chunks: 1, Hash: []byte{2}},
+		{Height: 2, Format: 1, Chunks: 1, Hash: []byte{3}},
+		{Height: 1, Format: 1, Chunks: 1, Hash: []byte{4}},
+	} {
+		_, err := s.AddSnapshot(peer, snapshot)
+		require.NoError(t, err)
+	}
+
+	quit := make(chan struct{})
+	defer close(quit)
+	go serveChunks(t, s, peer, map[uint32][]byte{0: {0}}, quit)
+
+	// the rejected format isn't offered again for the other snapshot
+	state, _, err := s.SyncAny(0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, state.LastBlockHeight)
+	assert.Nil(t, state.Groups)
+	assert.Equal(t, []uint64{3, 2, 1}, app.offeredHeights)
+}
+
+func TestSyncerApplyChunksRefetch(t *testing.T) {
+	appHash := []byte("app_hash")
+	app := &snapshotApp{lastHeight: 1, lastAppHash: appHash}
+	refetched := false
+	app.apply = func(req abci.RequestApplySnapshotChunk) abci.ResponseApplySnapshotChunk {
+		if req.Index == 1 && !refetched {
+			refetched = true
+			return abci.ResponseApplySnapshotChunk{
+				Result:        abci.ResponseApplySnapshotChunk_RETRY,
+				RefetchChunks: []uint32{1},
+			}
+		}
+		return abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ACCEPT}
+	}
+	s := newTestSyncer(app, &mockStateProvider{appHash: appHash})
+
+	peer := newMockPeer()
+	_, err := s.AddSnapshot(peer, &snapshot{Height: 1, Format: 1, Chunks: 2, Hash: []byte{1}})
+	require.NoError(t, err)
+
+	quit := make(chan struct{})
+	defer close(quit)
+	go serveChunks(t, s, peer, map[uint32][]byte{0: {0}, 1: {1}}, quit)
+
+	_, _, err = s.SyncAny(0)
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{0, 1, 1}, app.applied)
+}
+
+func TestSyncerVerifyFailed(t *testing.T) {
+	app := &snapshotApp{lastHeight: 1, lastAppHash: []byte("other_hash")}
+	app.offer = func(req abci.RequestOfferSnapshot) abci.ResponseOfferSnapshot {
+		return abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_ACCEPT}
+	}
+	s := newTestSyncer(app, &mockStateProvider{appHash: []byte("app_hash")})
+
+	peer := newMockPeer()
+	snapshot := &snapshot{Height: 1, Format: 1, Chunks: 1, Hash: []byte{1}}
+	_, err := s.AddSnapshot(peer, snapshot)
+	require.NoError(t, err)
+	chunks, err := newChunkQueue(snapshot, "")
+	require.NoError(t, err)
+	defer chunks.Close() // nolint: errcheck
+
+	quit := make(chan struct{})
+	defer close(quit)
+	go serveChunks(t, s, peer, map[uint32][]byte{0: {0}}, quit)
+
+	_, _, err = s.Sync(snapshot, chunks)
+	assert.Equal(t, errVerifyFailed, err)
+}
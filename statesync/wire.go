@@ -0,0 +1,11 @@
+package statesync
+
+import (
+	amino "github.com/tendermint/go-amino"
+)
+
+var cdc = amino.NewCodec()
+
+func init() {
+	RegisterStateSyncMessages(cdc)
+}
@@ -46,7 +46,8 @@ const (
 		"max_gas": "-1"
 		},
 		"evidence": {
-		"max_age": "100000"
+		"max_age": "100000",
+		"max_age_duration": "172800000000000"
 		},
 		"validator": {
 		"pub_key_types": [
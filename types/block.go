@@ -30,6 +30,18 @@ const (
 	// Uvarint length of Data.Txs:          4 bytes
 	// Data.Txs field:                      1 byte
 	MaxAminoOverheadForBlock int64 = 11
+
+	// MaxTxSectionBytes is the maximum size of one TxSection in the header
+	// (including amino overhead).
+	//
+	// Field number & length prefix:  2 bytes
+	// Group:                         11 bytes
+	// NumTxs:                        11 bytes
+	// DataHash:                      34 bytes
+	MaxTxSectionBytes int64 = 58
+
+	// MaxTxSections is the maximum number of tx sections in a block.
+	MaxTxSections = 64
 )
 
 // Block defines the atomic unit of a Tendermint blockchain.
@@ -60,6 +72,32 @@ func MakeBlock(height int64, txs []Tx, lastCommit *Commit, evidence []Evidence)
 	return block
 }
 
+// MakeMultiGroupBlock returns a new block carrying txs from several mempool
+// groups, one section per group with at least one tx. group is recorded in
+// Header.Group. If all txs belong to group, the block is identical to the one
+// returned by MakeBlock.
+func MakeMultiGroupBlock(height int64, group int32, groupTxs []GroupTxs, lastCommit *Commit, evidence []Evidence) *Block {
+	var txs Txs
+	var sections []TxSection
+	for _, gt := range groupTxs {
+		if len(gt.Txs) == 0 {
+			continue
+		}
+		txs = append(txs, gt.Txs...)
+		sections = append(sections, TxSection{
+			Group:    gt.Group,
+			NumTxs:   int64(len(gt.Txs)),
+			DataHash: gt.Txs.Hash(),
+		})
+	}
+	block := MakeBlock(height, txs, lastCommit, evidence)
+	block.Header.Group = group
+	if len(sections) > 1 || (len(sections) == 1 && sections[0].Group != group) {
+		block.Header.Sections = sections
+	}
+	return block
+}
+
 // ValidateBasic performs basic validation that doesn't involve state data.
 // It checks the internal consistency of the block.
 // Further validation is done using state#ValidateBlock.
@@ -134,6 +172,9 @@ func (b *Block) ValidateBasic() error {
 			b.DataHash,
 		)
 	}
+	if err := b.validateTxSections(); err != nil {
+		return err
+	}
 
 	// Basic validation of hashes related to application data.
 	// Will validate fully against state in state#ValidateBlock.
@@ -176,6 +217,80 @@ func (b *Block) ValidateBasic() error {
 	return nil
 }
 
+// validateTxSections checks Header.Sections against Data.Txs.
+// A block without sections carries txs of Header.Group only, so a block with
+// a single section of Header.Group must be encoded without sections.
+func (b *Block) validateTxSections() error {
+	if len(b.Sections) == 0 {
+		return nil
+	}
+	if len(b.Sections) > MaxTxSections {
+		return fmt.Errorf("Too many Header.Sections. Max is %d, got %d", MaxTxSections, len(b.Sections))
+	}
+	if len(b.Sections) == 1 && b.Sections[0].Group == b.Group {
+		return errors.New("Header.Sections must be empty if all txs belong to Header.Group")
+	}
+	seen := make(map[int32]struct{}, len(b.Sections))
+	var start int64
+	for i, section := range b.Sections {
+		if _, ok := seen[section.Group]; ok {
+			return fmt.Errorf("Duplicate group %d in Header.Sections", section.Group)
+		}
+		seen[section.Group] = struct{}{}
+		if section.NumTxs <= 0 {
+			return fmt.Errorf("Header.Sections[%d] has no txs", i)
+		}
+		if start+section.NumTxs > int64(len(b.Data.Txs)) {
+			return fmt.Errorf("Header.Sections[%d] exceeds Data.Txs", i)
+		}
+		txs := b.Data.Txs[start : start+section.NumTxs]
+		if !bytes.Equal(section.DataHash, txs.Hash()) {
+			return fmt.Errorf("Wrong Header.Sections[%d].DataHash. Expected %v, got %v",
+				i,
+				txs.Hash(),
+				section.DataHash,
+			)
+		}
+		start += section.NumTxs
+	}
+	if start != int64(len(b.Data.Txs)) {
+		return fmt.Errorf("Wrong Header.Sections. Expected %v txs, got %v", len(b.Data.Txs), start)
+	}
+	return nil
+}
+
+// GroupedTxs returns the block's txs split by mempool group, in block order.
+// A block without sections yields a single, possibly empty, entry for
+// Header.Group.
+func (b *Block) GroupedTxs() []GroupTxs {
+	if len(b.Sections) == 0 {
+		return []GroupTxs{{Group: b.Group, Txs: b.Data.Txs}}
+	}
+	groupTxs := make([]GroupTxs, len(b.Sections))
+	var start int64
+	for i, section := range b.Sections {
+		groupTxs[i] = GroupTxs{
+			Group: section.Group,
+			Txs:   b.Data.Txs[start : start+section.NumTxs],
+		}
+		start += section.NumTxs
+	}
+	return groupTxs
+}
+
+// TxGroup returns the mempool group of the i-th tx of the block.
+// CONTRACT: the block passed ValidateBasic.
+func (b *Block) TxGroup(i int) int32 {
+	var end int64
+	for _, section := range b.Sections {
+		end += section.NumTxs
+		if int64(i) < end {
+			return section.Group
+		}
+	}
+	return b.Group
+}
+
 // fillHeader fills in any remaining header fields that are a function of the block data
 func (b *Block) fillHeader() {
 	if b.LastCommitHash == nil {
@@ -380,6 +495,49 @@ type Header struct {
 	EvidenceHash    cmn.HexBytes `json:"evidence_hash"`    // evidence included in the block
 	ProposerAddress Address      `json:"proposer_address"` // original proposer of the block
 	Group           int32        `json:"group"`
+
+	// per-group tx sections, empty if all txs belong to Group
+	Sections []TxSection `json:"sections,omitempty"`
+}
+
+// TxSection describes a run of consecutive Data.Txs reaped from one mempool
+// group. DataHash is the Merkle root of the section's txs.
+type TxSection struct {
+	Group    int32        `json:"group"`
+	NumTxs   int64        `json:"num_txs"`
+	DataHash cmn.HexBytes `json:"data_hash"`
+}
+
+// String returns a string representation of the TxSection.
+func (section TxSection) String() string {
+	return fmt.Sprintf("TxSection{%d:%d:%v}", section.Group, section.NumTxs, section.DataHash)
+}
+
+// TxSections returns the tx sections of the block. A header without sections
+// yields a single section for Group, unless the block has no txs.
+func (h *Header) TxSections() []TxSection {
+	if len(h.Sections) > 0 || h.NumTxs == 0 {
+		return h.Sections
+	}
+	return []TxSection{{Group: h.Group, NumTxs: h.NumTxs, DataHash: h.DataHash}}
+}
+
+// Groups returns the groups of the block: Group first, then the groups of
+// the other tx sections, in order.
+func (h *Header) Groups() []int32 {
+	groups := []int32{h.Group}
+	for _, section := range h.Sections {
+		if section.Group != h.Group {
+			groups = append(groups, section.Group)
+		}
+	}
+	return groups
+}
+
+// GroupTxs are txs belonging to one mempool group.
+type GroupTxs struct {
+	Group int32
+	Txs   Txs
 }
 
 // Populate the Header with state-derived data.
@@ -404,6 +562,80 @@ func (h *Header) Populate(
 	h.ProposerAddress = proposerAddress
 }
 
+// ValidateBasic performs basic validation of the header alone, without the
+// block data it commits to. Unlike Block.ValidateBasic, it requires a
+// ValidatorsHash, so that the header has a hash.
+func (h *Header) ValidateBasic() error {
+	if h == nil {
+		return errors.New("nil header")
+	}
+	if len(h.ChainID) > MaxChainIDLen {
+		return fmt.Errorf("ChainID is too long. Max is %d, got %d", MaxChainIDLen, len(h.ChainID))
+	}
+	if h.Height <= 0 {
+		return errors.New("Non-positive Header.Height")
+	}
+	if h.NumTxs < 0 {
+		return errors.New("Negative Header.NumTxs")
+	}
+	if h.TotalTxs < 0 {
+		return errors.New("Negative Header.TotalTxs")
+	}
+	if err := h.LastBlockID.ValidateBasic(); err != nil {
+		return fmt.Errorf("Wrong Header.LastBlockID: %v", err)
+	}
+	if len(h.ValidatorsHash) == 0 {
+		return errors.New("Empty Header.ValidatorsHash")
+	}
+	hashes := []struct {
+		name string
+		hash []byte
+	}{
+		{"LastCommitHash", h.LastCommitHash},
+		{"DataHash", h.DataHash},
+		{"ValidatorsHash", h.ValidatorsHash},
+		{"NextValidatorsHash", h.NextValidatorsHash},
+		{"ConsensusHash", h.ConsensusHash},
+		{"LastResultsHash", h.LastResultsHash},
+		{"EvidenceHash", h.EvidenceHash},
+	}
+	for _, field := range hashes {
+		if err := ValidateHash(field.hash); err != nil {
+			return fmt.Errorf("Wrong Header.%s: %v", field.name, err)
+		}
+	}
+	if len(h.ProposerAddress) != crypto.AddressSize {
+		return fmt.Errorf("Expected len(Header.ProposerAddress) to be %d, got %d",
+			crypto.AddressSize, len(h.ProposerAddress))
+	}
+
+	if len(h.Sections) == 0 {
+		return nil
+	}
+	if len(h.Sections) > MaxTxSections {
+		return fmt.Errorf("Too many Header.Sections. Max is %d, got %d", MaxTxSections, len(h.Sections))
+	}
+	seen := make(map[int32]struct{}, len(h.Sections))
+	var numTxs int64
+	for i, section := range h.Sections {
+		if _, ok := seen[section.Group]; ok {
+			return fmt.Errorf("Duplicate group %d in Header.Sections", section.Group)
+		}
+		seen[section.Group] = struct{}{}
+		if section.NumTxs <= 0 {
+			return fmt.Errorf("Header.Sections[%d] has no txs", i)
+		}
+		if err := ValidateHash(section.DataHash); err != nil {
+			return fmt.Errorf("Wrong Header.Sections[%d].DataHash: %v", i, err)
+		}
+		numTxs += section.NumTxs
+	}
+	if numTxs != h.NumTxs {
+		return fmt.Errorf("Wrong Header.Sections. Expected %v txs, got %v", h.NumTxs, numTxs)
+	}
+	return nil
+}
+
 // Hash returns the hash of the header.
 // It computes a Merkle tree from the header fields
 // ordered as they appear in the Header.
@@ -414,7 +646,7 @@ func (h *Header) Hash() cmn.HexBytes {
 	if h == nil || len(h.ValidatorsHash) == 0 {
 		return nil
 	}
-	return merkle.SimpleHashFromByteSlices([][]byte{
+	fields := [][]byte{
 		cdcEncode(h.Version),
 		cdcEncode(h.ChainID),
 		cdcEncode(h.Height),
@@ -431,7 +663,17 @@ func (h *Header) Hash() cmn.HexBytes {
 		cdcEncode(h.LastResultsHash),
 		cdcEncode(h.EvidenceHash),
 		cdcEncode(h.ProposerAddress),
-	})
+	}
+	// Group and Sections are only hashed if not empty, so headers of group 0
+	// without sections keep their hash. Group must be hashed, since it tells
+	// which validators sign the block with ValidatorParams.GroupValidators.
+	if h.Group != 0 || len(h.Sections) > 0 {
+		fields = append(fields, cdcEncode(h.Group))
+	}
+	if len(h.Sections) > 0 {
+		fields = append(fields, cdcEncode(h.Sections))
+	}
+	return merkle.SimpleHashFromByteSlices(fields)
 }
 
 // StringIndented returns a string representation of the header
@@ -456,6 +698,7 @@ func (h *Header) StringIndented(indent string) string {
 %s  Results:        %v
 %s  Evidence:       %v
 %s  Proposer:       %v
+%s  Sections:       %v
 %s}#%v`,
 		indent, h.Version,
 		indent, h.ChainID,
@@ -473,6 +716,7 @@ func (h *Header) StringIndented(indent string) string {
 		indent, h.LastResultsHash,
 		indent, h.EvidenceHash,
 		indent, h.ProposerAddress,
+		indent, h.Sections,
 		indent, h.Hash())
 }
 
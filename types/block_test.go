@@ -93,6 +93,72 @@ func TestBlockValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMultiGroupBlock(t *testing.T) {
+	lastID := makeBlockIDRandom()
+	h := int64(3)
+	voteSet, valSet, vals := randVoteSet(h-1, 1, PrecommitType, 10, 1)
+	commit, err := MakeCommit(lastID, h-1, 1, voteSet, vals)
+	require.NoError(t, err)
+
+	groupTxs := []GroupTxs{
+		{Group: 2, Txs: Txs{Tx("foo"), Tx("bar")}},
+		{Group: 0},
+		{Group: 5, Txs: Txs{Tx("baz")}},
+	}
+	block := MakeMultiGroupBlock(h, 2, groupTxs, commit, nil)
+	block.ProposerAddress = valSet.GetProposer().Address
+	require.NoError(t, block.ValidateBasic())
+	require.Len(t, block.Sections, 2)
+	assert.EqualValues(t, 3, block.NumTxs)
+	assert.Equal(t, []GroupTxs{groupTxs[0], groupTxs[2]}, block.GroupedTxs())
+	assert.EqualValues(t, 2, block.TxGroup(1))
+	assert.EqualValues(t, 5, block.TxGroup(2))
+
+	proof := block.Data.Txs.Proof(2)
+	assert.NoError(t, proof.Validate(block.DataHash))
+
+	// sections are part of the header hash
+	block.ValidatorsHash = valSet.Hash()
+	hash := block.Hash()
+	block.Sections[0].Group = 3
+	assert.NotEqual(t, hash, block.Hash())
+
+	// single group blocks don't carry sections
+	single := MakeMultiGroupBlock(h, 2, groupTxs[:2], commit, nil)
+	assert.Empty(t, single.Sections)
+	assert.Equal(t, MakeBlock(h, groupTxs[0].Txs, commit, nil).DataHash, single.DataHash)
+
+	testCases := []struct {
+		testName      string
+		malleateBlock func(*Block)
+	}{
+		{"Tampered section DataHash", func(blk *Block) { blk.Sections[1].DataHash = cmn.RandBytes(tmhash.Size) }},
+		{"Wrong section NumTxs", func(blk *Block) { blk.Sections[0].NumTxs-- }},
+		{"Duplicate section group", func(blk *Block) { blk.Sections[1].Group = blk.Sections[0].Group }},
+		{"Single section of Header.Group", func(blk *Block) { blk.Sections = blk.Sections[:1] }},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			block := MakeMultiGroupBlock(h, 2, groupTxs, commit, nil)
+			block.ProposerAddress = valSet.GetProposer().Address
+			tc.malleateBlock(block)
+			assert.Error(t, block.ValidateBasic())
+		})
+	}
+}
+
+func TestHeaderHashCoversGroup(t *testing.T) {
+	h := Header{Height: 3, ValidatorsHash: tmhash.Sum([]byte("validators_hash"))}
+	hash := h.Hash()
+
+	h.Group = 1
+	assert.NotEqual(t, hash, h.Hash())
+
+	// group 0 is only hashed with sections
+	h.Group = 0
+	assert.Equal(t, hash, h.Hash())
+}
+
 func TestBlockHash(t *testing.T) {
 	assert.Nil(t, (*Block)(nil).Hash())
 	assert.Nil(t, MakeBlock(int64(3), []Tx{Tx("Hello World")}, nil, nil).Hash())
@@ -236,6 +302,43 @@ func TestCommitValidateBasic(t *testing.T) {
 	}
 }
 
+func TestHeaderValidateBasic(t *testing.T) {
+	require.Error(t, (*Header)(nil).ValidateBasic())
+
+	testCases := []struct {
+		testName       string
+		malleateHeader func(*Header)
+		expErr         bool
+	}{
+		{"Make Header", func(h *Header) {}, false},
+		{"Zero Height", func(h *Header) { h.Height = 0 }, true},
+		{"No ValidatorsHash", func(h *Header) { h.ValidatorsHash = nil }, true},
+		{"Short DataHash", func(h *Header) { h.DataHash = []byte("short") }, true},
+		{"No ProposerAddress", func(h *Header) { h.ProposerAddress = nil }, true},
+		{"Sections", func(h *Header) {
+			h.Sections = []TxSection{{Group: 0, NumTxs: 1}, {Group: 1, NumTxs: 1}}
+		}, false},
+		{"Sections Missing Txs", func(h *Header) { h.Sections = []TxSection{{Group: 1, NumTxs: 1}} }, true},
+		{"Duplicate Section", func(h *Header) {
+			h.Sections = []TxSection{{Group: 1, NumTxs: 1}, {Group: 1, NumTxs: 1}}
+		}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			h := &Header{
+				ChainID:         "header_test",
+				Height:          3,
+				NumTxs:          2,
+				TotalTxs:        2,
+				ValidatorsHash:  tmhash.Sum([]byte("validators_hash")),
+				ProposerAddress: crypto.AddressHash([]byte("proposer_address")),
+			}
+			tc.malleateHeader(h)
+			assert.Equal(t, tc.expErr, h.ValidateBasic() != nil, "ValidateBasic had an unexpected result")
+		})
+	}
+}
+
 func TestMaxHeaderBytes(t *testing.T) {
 	// Construct a UTF-8 string of MaxChainIDLen length using the supplementary
 	// characters.
@@ -95,7 +95,16 @@ func (b *EventBus) PublishEventNewBlock(data EventDataNewBlock) error {
 	logIfTagExists(EventTypeKey, tags, b.Logger)
 	tags[EventTypeKey] = EventNewBlock
 
-	b.pubsub.PublishWithTags(ctx, data, tmpubsub.NewTagMap(tags))
+	if data.Block == nil {
+		b.pubsub.PublishWithTags(ctx, data, tmpubsub.NewTagMap(tags))
+		return nil
+	}
+
+	logIfTagExists(BlockGroupKey, tags, b.Logger)
+	logIfTagExists(BlockHeightKey, tags, b.Logger)
+	tags[BlockHeightKey] = fmt.Sprintf("%d", data.Block.Height)
+
+	b.pubsub.PublishWithTags(ctx, data, blockTagMap(tags, &data.Block.Header))
 	return nil
 }
 
@@ -111,10 +120,28 @@ func (b *EventBus) PublishEventNewBlockHeader(data EventDataNewBlockHeader) erro
 	logIfTagExists(EventTypeKey, tags, b.Logger)
 	tags[EventTypeKey] = EventNewBlockHeader
 
-	b.pubsub.PublishWithTags(ctx, data, tmpubsub.NewTagMap(tags))
+	logIfTagExists(BlockGroupKey, tags, b.Logger)
+	logIfTagExists(BlockHeightKey, tags, b.Logger)
+	tags[BlockHeightKey] = fmt.Sprintf("%d", data.Header.Height)
+
+	b.pubsub.PublishWithTags(ctx, data, blockTagMap(tags, &data.Header))
 	return nil
 }
 
+// blockTagMap returns the tags of a block event, with every group of the
+// block as a value of BlockGroupKey: Header.Group first, then the groups of
+// the other sections of the block.
+func blockTagMap(tags map[string]string, header *Header) tmpubsub.TagMap {
+	data := make(map[string][]string, len(tags)+1)
+	for key, value := range tags {
+		data[key] = []string{value}
+	}
+	for _, group := range header.Groups() {
+		data[BlockGroupKey] = append(data[BlockGroupKey], fmt.Sprintf("%d", group))
+	}
+	return tmpubsub.NewMultiTagMap(data)
+}
+
 func (b *EventBus) PublishEventVote(data EventDataVote) error {
 	return b.Publish(EventVote, data)
 }
@@ -124,7 +151,7 @@ func (b *EventBus) PublishEventValidBlock(data EventDataRoundState) error {
 }
 
 // PublishEventTx publishes tx event with tags from Result. Note it will add
-// predefined tags (EventTypeKey, TxHashKey). Existing tags with the same names
+// predefined tags (EventTypeKey, TxHashKey, TxHeightKey, TxGroupKey). Existing tags with the same names
 // will be overwritten.
 func (b *EventBus) PublishEventTx(data EventDataTx) error {
 	// no explicit deadline for publishing events
@@ -142,6 +169,24 @@ func (b *EventBus) PublishEventTx(data EventDataTx) error {
 	logIfTagExists(TxHeightKey, tags, b.Logger)
 	tags[TxHeightKey] = fmt.Sprintf("%d", data.Height)
 
+	logIfTagExists(TxGroupKey, tags, b.Logger)
+	tags[TxGroupKey] = fmt.Sprintf("%d", data.Group)
+
+	b.pubsub.PublishWithTags(ctx, data, tmpubsub.NewTagMap(tags))
+	return nil
+}
+
+// PublishEventEvictedTx publishes an evicted tx event, with the
+// EventTypeKey, TxHashKey and TxGroupKey tags.
+func (b *EventBus) PublishEventEvictedTx(data EventDataEvictedTx) error {
+	// no explicit deadline for publishing events
+	ctx := context.Background()
+
+	tags := map[string]string{
+		EventTypeKey: EventEvictedTx,
+		TxHashKey:    fmt.Sprintf("%X", data.Tx.Hash()),
+		TxGroupKey:   fmt.Sprintf("%d", data.Group),
+	}
 	b.pubsub.PublishWithTags(ctx, data, tmpubsub.NewTagMap(tags))
 	return nil
 }
@@ -223,6 +268,10 @@ func (NopEventBus) PublishEventTx(data EventDataTx) error {
 	return nil
 }
 
+func (NopEventBus) PublishEventEvictedTx(data EventDataEvictedTx) error {
+	return nil
+}
+
 func (NopEventBus) PublishEventNewRoundStep(data EventDataRoundState) error {
 	return nil
 }
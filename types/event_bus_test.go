@@ -26,8 +26,8 @@ func TestEventBusPublishEventTx(t *testing.T) {
 
 	txEventsCh := make(chan interface{})
 
-	// PublishEventTx adds all these 3 tags, so the query below should work
-	query := fmt.Sprintf("tm.event='Tx' AND tx.height=1 AND tx.hash='%X' AND baz=1", tx.Hash())
+	// PublishEventTx adds all these 4 tags, so the query below should work
+	query := fmt.Sprintf("tm.event='Tx' AND tx.height=1 AND tx.hash='%X' AND tx.group=3 AND baz=1", tx.Hash())
 	err = eventBus.Subscribe(context.Background(), "test", tmquery.MustParse(query), txEventsCh)
 	require.NoError(t, err)
 
@@ -48,6 +48,7 @@ func TestEventBusPublishEventTx(t *testing.T) {
 		Index:  0,
 		Tx:     tx,
 		Result: result,
+		Group:  3,
 	}})
 	assert.NoError(t, err)
 
@@ -58,6 +59,40 @@ func TestEventBusPublishEventTx(t *testing.T) {
 	}
 }
 
+func TestEventBusPublishEventEvictedTx(t *testing.T) {
+	eventBus := NewEventBus()
+	err := eventBus.Start()
+	require.NoError(t, err)
+	defer eventBus.Stop()
+
+	tx := Tx("foo")
+
+	txEventsCh := make(chan interface{})
+
+	query := fmt.Sprintf("tm.event='EvictedTx' AND tx.hash='%X' AND tx.group=3", tx.Hash())
+	err = eventBus.Subscribe(context.Background(), "test", tmquery.MustParse(query), txEventsCh)
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		for e := range txEventsCh {
+			edt := e.(EventDataEvictedTx)
+			assert.Equal(t, tx, edt.Tx)
+			assert.Equal(t, "expired", edt.Reason)
+			close(done)
+		}
+	}()
+
+	err = eventBus.PublishEventEvictedTx(EventDataEvictedTx{Tx: tx, Group: 3, Reason: "expired"})
+	assert.NoError(t, err)
+
+	select {
+	case <-done:
+	case <-time.After(1 * time.Second):
+		t.Fatal("did not receive an evicted transaction after 1 sec.")
+	}
+}
+
 func TestEventBusPublishEventNewBlock(t *testing.T) {
 	eventBus := NewEventBus()
 	err := eventBus.Start()
@@ -65,13 +100,16 @@ func TestEventBusPublishEventNewBlock(t *testing.T) {
 	defer eventBus.Stop()
 
 	block := MakeBlock(0, []Tx{}, nil, []Evidence{})
+	block.Group = 2
+	block.Sections = []TxSection{{Group: 2, NumTxs: 1}, {Group: 5, NumTxs: 1}}
 	resultBeginBlock := abci.ResponseBeginBlock{Tags: []cmn.KVPair{{Key: []byte("baz"), Value: []byte("1")}}}
 	resultEndBlock := abci.ResponseEndBlock{Tags: []cmn.KVPair{{Key: []byte("foz"), Value: []byte("2")}}}
 
 	txEventsCh := make(chan interface{})
 
-	// PublishEventNewBlock adds the tm.event tag, so the query below should work
-	query := "tm.event='NewBlock' AND baz=1 AND foz=2"
+	// PublishEventNewBlock adds the tm.event, block.group and block.height tags, so the query below should work;
+	// block.group matches any group of the block
+	query := "tm.event='NewBlock' AND block.group=5 AND block.height=0 AND baz=1 AND foz=2"
 	err = eventBus.Subscribe(context.Background(), "test", tmquery.MustParse(query), txEventsCh)
 	require.NoError(t, err)
 
@@ -107,13 +145,14 @@ func TestEventBusPublishEventNewBlockHeader(t *testing.T) {
 	defer eventBus.Stop()
 
 	block := MakeBlock(0, []Tx{}, nil, []Evidence{})
+	block.Group = 2
 	resultBeginBlock := abci.ResponseBeginBlock{Tags: []cmn.KVPair{{Key: []byte("baz"), Value: []byte("1")}}}
 	resultEndBlock := abci.ResponseEndBlock{Tags: []cmn.KVPair{{Key: []byte("foz"), Value: []byte("2")}}}
 
 	txEventsCh := make(chan interface{})
 
-	// PublishEventNewBlockHeader adds the tm.event tag, so the query below should work
-	query := "tm.event='NewBlockHeader' AND baz=1 AND foz=2"
+	// PublishEventNewBlockHeader adds the tm.event, block.group and block.height tags, so the query below should work
+	query := "tm.event='NewBlockHeader' AND block.group=2 AND block.height=0 AND baz=1 AND foz=2"
 	err = eventBus.Subscribe(context.Background(), "test", tmquery.MustParse(query), txEventsCh)
 	require.NoError(t, err)
 
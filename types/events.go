@@ -21,6 +21,10 @@ const (
 	EventTx                  = "Tx"
 	EventValidatorSetUpdates = "ValidatorSetUpdates"
 
+	// Mempool events, triggered when a tx leaves the mempool without being
+	// committed.
+	EventEvictedTx = "EvictedTx"
+
 	// Internal consensus events.
 	// These are used for testing the consensus state machine.
 	// They can also be used to build real-time consensus visualizers.
@@ -57,6 +61,7 @@ func RegisterEventDatas(cdc *amino.Codec) {
 	cdc.RegisterConcrete(EventDataVote{}, "tendermint/event/Vote", nil)
 	cdc.RegisterConcrete(EventDataValidatorSetUpdates{}, "tendermint/event/ValidatorSetUpdates", nil)
 	cdc.RegisterConcrete(EventDataString(""), "tendermint/event/ProposalString", nil)
+	cdc.RegisterConcrete(EventDataEvictedTx{}, "tendermint/event/EvictedTx", nil)
 }
 
 // Most event messages are basic types (a block, a transaction)
@@ -123,6 +128,14 @@ type EventDataValidatorSetUpdates struct {
 	ValidatorUpdates []*Validator `json:"validator_updates"`
 }
 
+// EventDataEvictedTx is fired when a tx is evicted from the mempool of its
+// group. Reason tells why, see the mempool package.
+type EventDataEvictedTx struct {
+	Tx     Tx     `json:"tx"`
+	Group  int32  `json:"group"`
+	Reason string `json:"reason"`
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 // PUBSUB
 ///////////////////////////////////////////////////////////////////////////////
@@ -136,10 +149,21 @@ const (
 	// TxHeightKey is a reserved key, used to specify transaction block's height.
 	// see EventBus#PublishEventTx
 	TxHeightKey = "tx.height"
+	// TxGroupKey is a reserved key, used to specify transaction's mempool group.
+	// see EventBus#PublishEventTx
+	TxGroupKey = "tx.group"
+	// BlockGroupKey is a reserved key, used to specify block's mempool groups
+	// (Header.Group and the groups of Header.Sections), any of which matches.
+	// see EventBus#PublishEventNewBlock
+	BlockGroupKey = "block.group"
+	// BlockHeightKey is a reserved key, used to specify block's height.
+	// see EventBus#PublishEventNewBlock
+	BlockHeightKey = "block.height"
 )
 
 var (
 	EventQueryCompleteProposal    = QueryForEvent(EventCompleteProposal)
+	EventQueryEvictedTx           = QueryForEvent(EventEvictedTx)
 	EventQueryLock                = QueryForEvent(EventLock)
 	EventQueryNewBlock            = QueryForEvent(EventNewBlock)
 	EventQueryNewBlockHeader      = QueryForEvent(EventNewBlockHeader)
@@ -164,6 +188,22 @@ func QueryForEvent(eventType string) tmpubsub.Query {
 	return tmquery.MustParse(fmt.Sprintf("%s='%s'", EventTypeKey, eventType))
 }
 
+// QueryForGroupEvent returns a query for the events of the given type and
+// mempool group. Only Tx, EvictedTx, NewBlock and NewBlockHeader events have
+// a group.
+func QueryForGroupEvent(eventType string, group int32) (tmpubsub.Query, error) {
+	var groupKey string
+	switch eventType {
+	case EventTx, EventEvictedTx:
+		groupKey = TxGroupKey
+	case EventNewBlock, EventNewBlockHeader:
+		groupKey = BlockGroupKey
+	default:
+		return nil, fmt.Errorf("%s events have no group", eventType)
+	}
+	return tmquery.MustParse(fmt.Sprintf("%s='%s' AND %s=%d", EventTypeKey, eventType, groupKey, group)), nil
+}
+
 // BlockEventPublisher publishes all block related events
 type BlockEventPublisher interface {
 	PublishEventNewBlock(block EventDataNewBlock) error
@@ -175,3 +215,8 @@ type BlockEventPublisher interface {
 type TxEventPublisher interface {
 	PublishEventTx(EventDataTx) error
 }
+
+// MempoolEventPublisher publishes the events of the mempool.
+type MempoolEventPublisher interface {
+	PublishEventEvictedTx(EventDataEvictedTx) error
+}
@@ -21,3 +21,20 @@ func TestQueryForEvent(t *testing.T) {
 		QueryForEvent(EventNewBlock).String(),
 	)
 }
+
+func TestQueryForGroupEvent(t *testing.T) {
+	q, err := QueryForGroupEvent(EventTx, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, "tm.event='Tx' AND tx.group=3", q.String())
+
+	q, err = QueryForGroupEvent(EventEvictedTx, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, "tm.event='EvictedTx' AND tx.group=3", q.String())
+
+	q, err = QueryForGroupEvent(EventNewBlock, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, "tm.event='NewBlock' AND block.group=3", q.String())
+
+	_, err = QueryForGroupEvent(EventVote, 3)
+	assert.Error(t, err)
+}
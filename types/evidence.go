@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/tendermint/tendermint/crypto/tmhash"
@@ -15,7 +16,9 @@ import (
 
 const (
 	// MaxEvidenceBytes is a maximum size of any evidence (including amino overhead).
-	MaxEvidenceBytes int64 = 484
+	// The largest is LunaticValidatorEvidence, which includes a header with up
+	// to MaxTxSections sections. ConflictingHeadersEvidence is never committed.
+	MaxEvidenceBytes int64 = 4618
 )
 
 // ErrEvidenceInvalid wraps a piece of evidence and the error denoting how or why it is invalid.
@@ -55,6 +58,7 @@ func (err *ErrEvidenceOverflow) Error() string {
 // Evidence represents any provable malicious activity by a validator
 type Evidence interface {
 	Height() int64                                     // height of the equivocation
+	Time() time.Time                                   // time of the equivocation
 	Address() []byte                                   // address of the equivocating validator
 	Bytes() []byte                                     // bytes which compromise the evidence
 	Hash() []byte                                      // hash of the evidence
@@ -68,6 +72,9 @@ type Evidence interface {
 func RegisterEvidences(cdc *amino.Codec) {
 	cdc.RegisterInterface((*Evidence)(nil), nil)
 	cdc.RegisterConcrete(&DuplicateVoteEvidence{}, "tendermint/DuplicateVoteEvidence", nil)
+	cdc.RegisterConcrete(&LunaticValidatorEvidence{}, "tendermint/LunaticValidatorEvidence", nil)
+	cdc.RegisterConcrete(&AmnesiaEvidence{}, "tendermint/AmnesiaEvidence", nil)
+	cdc.RegisterConcrete(&ConflictingHeadersEvidence{}, "tendermint/ConflictingHeadersEvidence", nil)
 }
 
 func RegisterMockEvidences(cdc *amino.Codec) {
@@ -114,6 +121,11 @@ func (dve *DuplicateVoteEvidence) Height() int64 {
 	return dve.VoteA.Height
 }
 
+// Time returns the time the first vote was signed.
+func (dve *DuplicateVoteEvidence) Time() time.Time {
+	return dve.VoteA.Timestamp
+}
+
 // Address returns the address of the validator.
 func (dve *DuplicateVoteEvidence) Address() []byte {
 	return dve.PubKey.Address()
@@ -201,6 +213,464 @@ func (dve *DuplicateVoteEvidence) ValidateBasic() error {
 	return nil
 }
 
+//-------------------------------------------
+
+// Header fields which a LunaticValidatorEvidence can show to be invalid:
+// they are derived from the state at the height of the header.
+const (
+	HeaderFieldValidatorsHash     = "ValidatorsHash"
+	HeaderFieldNextValidatorsHash = "NextValidatorsHash"
+	HeaderFieldConsensusHash      = "ConsensusHash"
+	HeaderFieldLastResultsHash    = "LastResultsHash"
+)
+
+// LunaticValidatorEvidence contains evidence a validator signed a header with
+// an invalid field, i.e. one which doesn't match the state of the chain at
+// its height. Such headers are used to attack light clients, which can't
+// check them against the state. Correct validators never sign them, whether
+// the header is committed or not.
+type LunaticValidatorEvidence struct {
+	Header             *Header
+	Vote               *Vote
+	InvalidHeaderField string
+}
+
+var _ Evidence = &LunaticValidatorEvidence{}
+
+// String returns a string representation of the evidence.
+func (e *LunaticValidatorEvidence) String() string {
+	return fmt.Sprintf("LunaticValidatorEvidence{Header: %v, Vote: %v, InvalidHeaderField: %s}",
+		e.Header.Hash(), e.Vote, e.InvalidHeaderField)
+}
+
+// Height returns the height of the header.
+func (e *LunaticValidatorEvidence) Height() int64 {
+	return e.Header.Height
+}
+
+// Time returns the time of the header.
+func (e *LunaticValidatorEvidence) Time() time.Time {
+	return e.Header.Time
+}
+
+// Address returns the address of the validator.
+func (e *LunaticValidatorEvidence) Address() []byte {
+	return e.Vote.ValidatorAddress
+}
+
+// Bytes returns the amino encoding of the evidence.
+func (e *LunaticValidatorEvidence) Bytes() []byte {
+	return cdcEncode(e)
+}
+
+// Hash returns the hash of the evidence.
+func (e *LunaticValidatorEvidence) Hash() []byte {
+	return tmhash.Sum(cdcEncode(e))
+}
+
+// Verify returns an error if the vote isn't a properly signed precommit for
+// the header. It doesn't check the header field is invalid: see
+// VerifyHeaderField.
+func (e *LunaticValidatorEvidence) Verify(chainID string, pubKey crypto.PubKey) error {
+	if e.Header.ChainID != chainID {
+		return fmt.Errorf("LunaticValidatorEvidence Error: header is for chain %s, not %s", e.Header.ChainID, chainID)
+	}
+	if e.Vote.Type != PrecommitType {
+		return fmt.Errorf("LunaticValidatorEvidence Error: vote is not a precommit: %v", e.Vote)
+	}
+	if e.Vote.Height != e.Header.Height {
+		return fmt.Errorf("LunaticValidatorEvidence Error: vote height %d doesn't match header height %d",
+			e.Vote.Height, e.Header.Height)
+	}
+	// A nil precommit doesn't sign any header, and a header without
+	// ValidatorsHash has no hash.
+	if e.Vote.BlockID.IsZero() || len(e.Header.Hash()) == 0 ||
+		!bytes.Equal(e.Vote.BlockID.Hash, e.Header.Hash()) {
+		return fmt.Errorf("LunaticValidatorEvidence Error: vote is for block %X, not the header %X",
+			e.Vote.BlockID.Hash, e.Header.Hash())
+	}
+
+	// pubkey must match address (this should already be true, sanity check)
+	if !bytes.Equal(pubKey.Address(), e.Vote.ValidatorAddress) {
+		return fmt.Errorf("LunaticValidatorEvidence FAILED SANITY CHECK - address (%X) doesn't match pubkey (%v - %X)",
+			e.Vote.ValidatorAddress, pubKey, pubKey.Address())
+	}
+	if !pubKey.VerifyBytes(e.Vote.SignBytes(chainID), e.Vote.Signature) {
+		return fmt.Errorf("LunaticValidatorEvidence Error verifying Vote: %v", ErrVoteInvalidSignature)
+	}
+	return nil
+}
+
+// VerifyHeaderField returns an error if the invalid field of the header
+// matches validHash, the value derived from the state at its height.
+func (e *LunaticValidatorEvidence) VerifyHeaderField(validHash []byte) error {
+	var value []byte
+	switch e.InvalidHeaderField {
+	case HeaderFieldValidatorsHash:
+		value = e.Header.ValidatorsHash
+	case HeaderFieldNextValidatorsHash:
+		value = e.Header.NextValidatorsHash
+	case HeaderFieldConsensusHash:
+		value = e.Header.ConsensusHash
+	case HeaderFieldLastResultsHash:
+		value = e.Header.LastResultsHash
+	default:
+		return fmt.Errorf("LunaticValidatorEvidence Error: unknown header field %q", e.InvalidHeaderField)
+	}
+	if bytes.Equal(value, validHash) {
+		return fmt.Errorf("LunaticValidatorEvidence Error: header %s %X is valid", e.InvalidHeaderField, value)
+	}
+	return nil
+}
+
+// Equal checks if two pieces of evidence are equal.
+func (e *LunaticValidatorEvidence) Equal(ev Evidence) bool {
+	if _, ok := ev.(*LunaticValidatorEvidence); !ok {
+		return false
+	}
+	return bytes.Equal(e.Hash(), ev.Hash())
+}
+
+// ValidateBasic performs basic validation.
+func (e *LunaticValidatorEvidence) ValidateBasic() error {
+	if e.Header == nil {
+		return errors.New("Empty Header")
+	}
+	if e.Vote == nil {
+		return errors.New("Empty Vote")
+	}
+	if err := e.Header.ValidateBasic(); err != nil {
+		return fmt.Errorf("Invalid Header: %v", err)
+	}
+	if err := e.Vote.ValidateBasic(); err != nil {
+		return fmt.Errorf("Invalid Vote: %v", err)
+	}
+	if e.Vote.BlockID.IsZero() {
+		return errors.New("Vote is for nil block")
+	}
+	if !bytes.Equal(e.Vote.BlockID.Hash, e.Header.Hash()) {
+		return fmt.Errorf("Vote is for block %X, not the header %X", e.Vote.BlockID.Hash, e.Header.Hash())
+	}
+	if size := int64(len(e.Bytes())); size > MaxEvidenceBytes {
+		return fmt.Errorf("Evidence is too big. Max is %d bytes, got %d", MaxEvidenceBytes, size)
+	}
+	switch e.InvalidHeaderField {
+	case HeaderFieldValidatorsHash, HeaderFieldNextValidatorsHash,
+		HeaderFieldConsensusHash, HeaderFieldLastResultsHash:
+	default:
+		return fmt.Errorf("Unknown InvalidHeaderField %q", e.InvalidHeaderField)
+	}
+	return nil
+}
+
+//-------------------------------------------
+
+// AmnesiaEvidence contains evidence a validator precommitted the block
+// committed at its height, and voted for a different block in a later round.
+// Once it precommitted a block, a correct validator is locked on it: it only
+// votes for another block after a later round had +2/3 prevotes (a POL) for
+// it. The committed block had +2/3 precommits in a round no later than the
+// precommit, so correct validators stayed locked on it and no other block can
+// have had a POL since, unless a third of the voting power is Byzantine.
+// Forgetting about the lock lets a Byzantine minority fork the chain, or fool
+// light clients.
+//
+// Verify only checks the votes. That Precommit is for the committed block, in
+// a round no earlier than the commit, is checked against the block store (see
+// state.VerifyEvidence).
+type AmnesiaEvidence struct {
+	PubKey    crypto.PubKey
+	Precommit *Vote
+	Vote      *Vote // prevote or precommit in a later round
+}
+
+var _ Evidence = &AmnesiaEvidence{}
+
+// String returns a string representation of the evidence.
+func (e *AmnesiaEvidence) String() string {
+	return fmt.Sprintf("Precommit: %v; Vote: %v", e.Precommit, e.Vote)
+}
+
+// Height returns the height this evidence refers to.
+func (e *AmnesiaEvidence) Height() int64 {
+	return e.Precommit.Height
+}
+
+// Time returns the time the precommit was signed.
+func (e *AmnesiaEvidence) Time() time.Time {
+	return e.Precommit.Timestamp
+}
+
+// Address returns the address of the validator.
+func (e *AmnesiaEvidence) Address() []byte {
+	return e.PubKey.Address()
+}
+
+// Bytes returns the amino encoding of the evidence.
+func (e *AmnesiaEvidence) Bytes() []byte {
+	return cdcEncode(e)
+}
+
+// Hash returns the hash of the evidence.
+func (e *AmnesiaEvidence) Hash() []byte {
+	return tmhash.Sum(cdcEncode(e))
+}
+
+// Verify returns an error if the vote isn't for a different block than the
+// precommit, in a later round of the same height, by the same validator.
+func (e *AmnesiaEvidence) Verify(chainID string, pubKey crypto.PubKey) error {
+	if e.Precommit.Type != PrecommitType {
+		return fmt.Errorf("AmnesiaEvidence Error: want a precommit. Got %v", e.Precommit)
+	}
+	if e.Vote.Type != PrevoteType && e.Vote.Type != PrecommitType {
+		return fmt.Errorf("AmnesiaEvidence Error: want a prevote or a precommit. Got %v", e.Vote)
+	}
+	// H must be the same, and the vote in a later round
+	if e.Precommit.Height != e.Vote.Height {
+		return fmt.Errorf("AmnesiaEvidence Error: heights do not match. Got %v and %v", e.Precommit, e.Vote)
+	}
+	if e.Vote.Round <= e.Precommit.Round {
+		return fmt.Errorf("AmnesiaEvidence Error: vote round %d isn't after precommit round %d",
+			e.Vote.Round, e.Precommit.Round)
+	}
+
+	// Address and index must be the same
+	if !bytes.Equal(e.Precommit.ValidatorAddress, e.Vote.ValidatorAddress) {
+		return fmt.Errorf("AmnesiaEvidence Error: Validator addresses do not match. Got %X and %X",
+			e.Precommit.ValidatorAddress, e.Vote.ValidatorAddress)
+	}
+	if e.Precommit.ValidatorIndex != e.Vote.ValidatorIndex {
+		return fmt.Errorf("AmnesiaEvidence Error: Validator indices do not match. Got %d and %d",
+			e.Precommit.ValidatorIndex, e.Vote.ValidatorIndex)
+	}
+
+	// Both votes must be for blocks, and different ones. Voting nil doesn't
+	// break the lock.
+	if e.Precommit.BlockID.IsZero() || e.Vote.BlockID.IsZero() {
+		return fmt.Errorf("AmnesiaEvidence Error: votes must be for blocks. Got %v and %v",
+			e.Precommit.BlockID, e.Vote.BlockID)
+	}
+	if e.Precommit.BlockID.Equals(e.Vote.BlockID) {
+		return fmt.Errorf("AmnesiaEvidence Error: BlockIDs are the same (%v)", e.Precommit.BlockID)
+	}
+
+	// pubkey must match address (this should already be true, sanity check)
+	addr := e.Precommit.ValidatorAddress
+	if !bytes.Equal(pubKey.Address(), addr) {
+		return fmt.Errorf("AmnesiaEvidence FAILED SANITY CHECK - address (%X) doesn't match pubkey (%v - %X)",
+			addr, pubKey, pubKey.Address())
+	}
+
+	// Signatures must be valid
+	if !pubKey.VerifyBytes(e.Precommit.SignBytes(chainID), e.Precommit.Signature) {
+		return fmt.Errorf("AmnesiaEvidence Error verifying Precommit: %v", ErrVoteInvalidSignature)
+	}
+	if !pubKey.VerifyBytes(e.Vote.SignBytes(chainID), e.Vote.Signature) {
+		return fmt.Errorf("AmnesiaEvidence Error verifying Vote: %v", ErrVoteInvalidSignature)
+	}
+	return nil
+}
+
+// Equal checks if two pieces of evidence are equal.
+func (e *AmnesiaEvidence) Equal(ev Evidence) bool {
+	if _, ok := ev.(*AmnesiaEvidence); !ok {
+		return false
+	}
+	return bytes.Equal(e.Hash(), ev.Hash())
+}
+
+// ValidateBasic performs basic validation.
+func (e *AmnesiaEvidence) ValidateBasic() error {
+	if e.PubKey == nil || len(e.PubKey.Bytes()) == 0 {
+		return errors.New("Empty PubKey")
+	}
+	if e.Precommit == nil || e.Vote == nil {
+		return fmt.Errorf("One or both of the votes are empty %v, %v", e.Precommit, e.Vote)
+	}
+	if err := e.Precommit.ValidateBasic(); err != nil {
+		return fmt.Errorf("Invalid Precommit: %v", err)
+	}
+	if err := e.Vote.ValidateBasic(); err != nil {
+		return fmt.Errorf("Invalid Vote: %v", err)
+	}
+	return nil
+}
+
+//-------------------------------------------
+
+// ConflictingHeadersEvidence contains two signed headers at the same height
+// with different hashes, as found by light clients (see lite.ConflictReport).
+// At most one of them is committed: the validators who signed the other one
+// either signed an invalid header, precommitted two blocks in the same round,
+// or forgot about their lock.
+//
+// Unlike other evidence, it isn't committed itself: Split turns it into
+// evidence against each of those validators, given the committed header at
+// its height.
+type ConflictingHeadersEvidence struct {
+	H1 *SignedHeader
+	H2 *SignedHeader
+}
+
+var _ Evidence = &ConflictingHeadersEvidence{}
+
+// String returns a string representation of the evidence.
+func (e *ConflictingHeadersEvidence) String() string {
+	return fmt.Sprintf("ConflictingHeadersEvidence{H1: %d#%v, H2: %d#%v}",
+		e.H1.Height, e.H1.Hash(), e.H2.Height, e.H2.Hash())
+}
+
+// Height returns the height of the headers.
+func (e *ConflictingHeadersEvidence) Height() int64 {
+	return e.H1.Height
+}
+
+// Time returns the time of the first header.
+func (e *ConflictingHeadersEvidence) Time() time.Time {
+	return e.H1.Time
+}
+
+// Address returns nil: the evidence is against all the validators who signed
+// the header which isn't committed.
+func (e *ConflictingHeadersEvidence) Address() []byte {
+	return nil
+}
+
+// Bytes returns the amino encoding of the evidence.
+func (e *ConflictingHeadersEvidence) Bytes() []byte {
+	return cdcEncode(e)
+}
+
+// Hash returns the hash of the evidence.
+func (e *ConflictingHeadersEvidence) Hash() []byte {
+	return tmhash.Sum(cdcEncode(e))
+}
+
+// Verify returns an error if the headers aren't for chainID. The signatures
+// are verified by the evidence Split returns.
+func (e *ConflictingHeadersEvidence) Verify(chainID string, _ crypto.PubKey) error {
+	if err := e.H1.ValidateBasic(chainID); err != nil {
+		return fmt.Errorf("ConflictingHeadersEvidence Error: invalid H1: %v", err)
+	}
+	if err := e.H2.ValidateBasic(chainID); err != nil {
+		return fmt.Errorf("ConflictingHeadersEvidence Error: invalid H2: %v", err)
+	}
+	return nil
+}
+
+// Split returns the evidence against the validators of valSet, the validator
+// set at the height of the headers, who signed the header which isn't
+// committed. committed is the committed header and its canonical commit.
+// If the header has a ValidatorsHash, NextValidatorsHash, ConsensusHash or
+// LastResultsHash other than the committed one's, its signers are lunatic
+// validators. Otherwise, its signers who signed the commit in the same round
+// precommitted two blocks, and those who signed the commit in an earlier
+// round forgot about their lock.
+func (e *ConflictingHeadersEvidence) Split(committed SignedHeader, valSet *ValidatorSet) []Evidence {
+	var evidence []Evidence
+	for _, sh := range []*SignedHeader{e.H1, e.H2} {
+		if bytes.Equal(sh.Hash(), committed.Hash()) {
+			continue
+		}
+		field := invalidHeaderField(sh.Header, committed.Header)
+		for _, cs := range sh.Commit.Precommits {
+			if cs == nil || !cs.BlockID.Equals(sh.Commit.BlockID) {
+				continue
+			}
+			vote := sh.Commit.ToVote(cs)
+			_, val := valSet.GetByAddress(vote.ValidatorAddress)
+			if val == nil {
+				continue
+			}
+			if field != "" {
+				evidence = append(evidence, &LunaticValidatorEvidence{
+					Header:             sh.Header,
+					Vote:               vote,
+					InvalidHeaderField: field,
+				})
+				continue
+			}
+			precommit := commitVoteByAddress(committed.Commit, vote.ValidatorAddress)
+			switch {
+			case precommit == nil:
+			case precommit.Round == vote.Round:
+				evidence = append(evidence, &DuplicateVoteEvidence{
+					PubKey: val.PubKey,
+					VoteA:  precommit,
+					VoteB:  vote,
+				})
+			case precommit.Round < vote.Round:
+				evidence = append(evidence, &AmnesiaEvidence{
+					PubKey:    val.PubKey,
+					Precommit: precommit,
+					Vote:      vote,
+				})
+			}
+		}
+	}
+	return evidence
+}
+
+// invalidHeaderField returns the first field LunaticValidatorEvidence can
+// show to be invalid whose value in header isn't the one in the committed
+// header, or "" if there's none.
+func invalidHeaderField(header, committed *Header) string {
+	switch {
+	case !bytes.Equal(header.ValidatorsHash, committed.ValidatorsHash):
+		return HeaderFieldValidatorsHash
+	case !bytes.Equal(header.NextValidatorsHash, committed.NextValidatorsHash):
+		return HeaderFieldNextValidatorsHash
+	case !bytes.Equal(header.ConsensusHash, committed.ConsensusHash):
+		return HeaderFieldConsensusHash
+	case !bytes.Equal(header.LastResultsHash, committed.LastResultsHash):
+		return HeaderFieldLastResultsHash
+	default:
+		return ""
+	}
+}
+
+// commitVoteByAddress returns the precommit of the validator with the given
+// address for the block of commit, or nil if there's none.
+func commitVoteByAddress(commit *Commit, address []byte) *Vote {
+	for _, cs := range commit.Precommits {
+		if cs != nil && cs.BlockID.Equals(commit.BlockID) && bytes.Equal(cs.ValidatorAddress, address) {
+			return commit.ToVote(cs)
+		}
+	}
+	return nil
+}
+
+// Equal checks if two pieces of evidence are equal.
+func (e *ConflictingHeadersEvidence) Equal(ev Evidence) bool {
+	if _, ok := ev.(*ConflictingHeadersEvidence); !ok {
+		return false
+	}
+	return bytes.Equal(e.Hash(), ev.Hash())
+}
+
+// ValidateBasic performs basic validation.
+func (e *ConflictingHeadersEvidence) ValidateBasic() error {
+	if e.H1 == nil || e.H2 == nil {
+		return fmt.Errorf("One or both of the headers are empty %v, %v", e.H1, e.H2)
+	}
+	if e.H1.Header == nil || e.H2.Header == nil || e.H1.Commit == nil || e.H2.Commit == nil {
+		return errors.New("Signed headers must have a header and a commit")
+	}
+	if err := e.H1.Header.ValidateBasic(); err != nil {
+		return fmt.Errorf("Invalid H1: %v", err)
+	}
+	if err := e.H2.Header.ValidateBasic(); err != nil {
+		return fmt.Errorf("Invalid H2: %v", err)
+	}
+	if e.H1.Height != e.H2.Height {
+		return fmt.Errorf("Headers are at different heights %d and %d", e.H1.Height, e.H2.Height)
+	}
+	if bytes.Equal(e.H1.Hash(), e.H2.Hash()) {
+		return fmt.Errorf("Headers are the same (%X)", e.H1.Hash())
+	}
+	return nil
+}
+
 //-----------------------------------------------------------------
 
 // UNSTABLE
@@ -237,6 +707,9 @@ func NewMockGoodEvidence(height int64, idx int, address []byte) MockGoodEvidence
 
 func (e MockGoodEvidence) Height() int64   { return e.Height_ }
 func (e MockGoodEvidence) Address() []byte { return e.Address_ }
+
+// Time returns the zero time, so mock evidence only expires by height.
+func (e MockGoodEvidence) Time() time.Time { return time.Time{} }
 func (e MockGoodEvidence) Hash() []byte {
 	return []byte(fmt.Sprintf("%d-%x", e.Height_, e.Address_))
 }
@@ -3,11 +3,14 @@ package types
 import (
 	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/version"
 )
 
 type voteData struct {
@@ -100,15 +103,67 @@ func TestMaxEvidenceBytes(t *testing.T) {
 	blockID := makeBlockID(tmhash.Sum([]byte("blockhash")), math.MaxInt64, tmhash.Sum([]byte("partshash")))
 	blockID2 := makeBlockID(tmhash.Sum([]byte("blockhash2")), math.MaxInt64, tmhash.Sum([]byte("partshash")))
 	const chainID = "mychain"
+	voteA := makeVote(val, chainID, math.MaxInt64, math.MaxInt64, math.MaxInt64, math.MaxInt64, blockID)
+	voteB := makeVote(val, chainID, math.MaxInt64, math.MaxInt64, math.MaxInt64, math.MaxInt64, blockID2)
+
 	ev := &DuplicateVoteEvidence{
 		PubKey: secp256k1.GenPrivKey().PubKey(), // use secp because it's pubkey is longer
-		VoteA:  makeVote(val, chainID, math.MaxInt64, math.MaxInt64, math.MaxInt64, math.MaxInt64, blockID),
-		VoteB:  makeVote(val, chainID, math.MaxInt64, math.MaxInt64, math.MaxInt64, math.MaxInt64, blockID2),
+		VoteA:  voteA,
+		VoteB:  voteB,
 	}
-
 	bz, err := cdc.MarshalBinaryLengthPrefixed(ev)
 	require.NoError(t, err)
+	assert.EqualValues(t, 484, len(bz))
+
+	amnesia := &AmnesiaEvidence{
+		PubKey:    secp256k1.GenPrivKey().PubKey(),
+		Precommit: voteA,
+		Vote:      voteB,
+	}
+	bz, err = cdc.MarshalBinaryLengthPrefixed(amnesia)
+	require.NoError(t, err)
+	assert.EqualValues(t, 484, len(bz))
 
+	// the largest evidence has a header of MaxHeaderBytes, with MaxTxSections
+	// sections
+	maxChainID := ""
+	for i := 0; i < MaxChainIDLen; i++ {
+		maxChainID += "𠜎"
+	}
+	maxSections := make([]TxSection, MaxTxSections)
+	for i := range maxSections {
+		maxSections[i] = TxSection{
+			Group:    math.MinInt32,
+			NumTxs:   math.MaxInt64,
+			DataHash: tmhash.Sum([]byte("data_hash")),
+		}
+	}
+	lunatic := &LunaticValidatorEvidence{
+		Header: &Header{
+			Version:            version.Consensus{Block: math.MaxInt64, App: math.MaxInt64},
+			ChainID:            maxChainID,
+			Height:             math.MaxInt64,
+			Time:               time.Date(math.MaxInt64, 0, 0, 0, 0, 0, math.MaxInt64, time.UTC),
+			NumTxs:             math.MaxInt64,
+			TotalTxs:           math.MaxInt64,
+			LastBlockID:        makeBlockID(make([]byte, tmhash.Size), math.MaxInt64, make([]byte, tmhash.Size)),
+			LastCommitHash:     tmhash.Sum([]byte("last_commit_hash")),
+			DataHash:           tmhash.Sum([]byte("data_hash")),
+			ValidatorsHash:     tmhash.Sum([]byte("validators_hash")),
+			NextValidatorsHash: tmhash.Sum([]byte("next_validators_hash")),
+			ConsensusHash:      tmhash.Sum([]byte("consensus_hash")),
+			AppHash:            tmhash.Sum([]byte("app_hash")),
+			LastResultsHash:    tmhash.Sum([]byte("last_results_hash")),
+			EvidenceHash:       tmhash.Sum([]byte("evidence_hash")),
+			ProposerAddress:    crypto.AddressHash([]byte("proposer_address")),
+			Group:              math.MaxInt32,
+			Sections:           maxSections,
+		},
+		Vote:               voteA,
+		InvalidHeaderField: HeaderFieldNextValidatorsHash,
+	}
+	bz, err = cdc.MarshalBinaryLengthPrefixed(lunatic)
+	require.NoError(t, err)
 	assert.EqualValues(t, MaxEvidenceBytes, len(bz))
 }
 
@@ -157,3 +212,191 @@ func TestDuplicateVoteEvidenceValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestLunaticValidatorEvidence(t *testing.T) {
+	val := NewMockPV()
+	val2 := NewMockPV()
+	const chainID = "mychain"
+	header := &Header{
+		ChainID:            chainID,
+		Height:             10,
+		ValidatorsHash:     tmhash.Sum([]byte("validators_hash")),
+		NextValidatorsHash: tmhash.Sum([]byte("next_validators_hash")),
+		ProposerAddress:    crypto.AddressHash([]byte("proposer_address")),
+	}
+	blockID := makeBlockID(header.Hash(), 1000, tmhash.Sum([]byte("partshash")))
+	otherBlockID := makeBlockID(tmhash.Sum([]byte("blockhash")), 1000, tmhash.Sum([]byte("partshash")))
+
+	// a nil precommit and a header without hash can't frame the validator
+	noHashHeader := &Header{ChainID: chainID, Height: 10, ProposerAddress: header.ProposerAddress}
+	// the header must be bounded in size
+	bigHeader := *header
+	bigHeader.AppHash = make([]byte, MaxEvidenceBytes)
+	bigBlockID := makeBlockID(bigHeader.Hash(), 1000, tmhash.Sum([]byte("partshash")))
+
+	cases := []struct {
+		header *Header
+		vote   *Vote
+		valid  bool
+	}{
+		{header, makeVote(val, chainID, 0, 10, 2, 2, blockID), true},
+		{header, makeVote(val, chainID, 0, 10, 2, 1, blockID), false},      // not a precommit
+		{header, makeVote(val, chainID, 0, 11, 2, 2, blockID), false},      // wrong height
+		{header, makeVote(val, chainID, 0, 10, 2, 2, otherBlockID), false}, // vote for another block
+		{header, makeVote(val, chainID, 0, 10, 2, 2, BlockID{}), false},    // vote for nil
+		{header, makeVote(val, "mychain2", 0, 10, 2, 2, blockID), false},   // wrong chain id
+		{header, makeVote(val2, chainID, 0, 10, 2, 2, blockID), false},     // wrong validator
+		{noHashHeader, makeVote(val, chainID, 0, 10, 2, 2, BlockID{}), false},
+		{&bigHeader, makeVote(val, chainID, 0, 10, 2, 2, bigBlockID), false},
+	}
+
+	pubKey := val.GetPubKey()
+	for i, c := range cases {
+		ev := &LunaticValidatorEvidence{
+			Header:             c.header,
+			Vote:               c.vote,
+			InvalidHeaderField: HeaderFieldValidatorsHash,
+		}
+		err := ev.ValidateBasic()
+		if err == nil {
+			err = ev.Verify(chainID, pubKey)
+		}
+		if c.valid {
+			assert.Nil(t, err, "#%d: evidence should be valid", i)
+		} else {
+			assert.NotNil(t, err, "#%d: evidence should be invalid", i)
+		}
+	}
+
+	ev := &LunaticValidatorEvidence{
+		Header:             header,
+		Vote:               makeVote(val, chainID, 0, 10, 2, 2, blockID),
+		InvalidHeaderField: HeaderFieldNextValidatorsHash,
+	}
+	assert.NoError(t, ev.VerifyHeaderField(tmhash.Sum([]byte("other"))))
+	assert.Error(t, ev.VerifyHeaderField(header.NextValidatorsHash))
+	assert.EqualValues(t, 10, ev.Height())
+	assert.EqualValues(t, pubKey.Address(), ev.Address())
+	assert.True(t, ev.Equal(ev))
+	assert.False(t, ev.Equal(randomDuplicatedVoteEvidence()))
+
+	ev.InvalidHeaderField = "AppHash"
+	assert.Error(t, ev.ValidateBasic())
+	assert.Error(t, ev.VerifyHeaderField(nil))
+}
+
+func TestAmnesiaEvidence(t *testing.T) {
+	val := NewMockPV()
+	val2 := NewMockPV()
+	blockID := makeBlockID(tmhash.Sum([]byte("blockhash")), 1000, tmhash.Sum([]byte("partshash")))
+	blockID2 := makeBlockID(tmhash.Sum([]byte("blockhash2")), 1000, tmhash.Sum([]byte("partshash")))
+	const chainID = "mychain"
+
+	precommit := makeVote(val, chainID, 0, 10, 2, 2, blockID)
+	cases := []voteData{
+		{precommit, makeVote(val, chainID, 0, 10, 3, 1, blockID2), true},
+		{precommit, makeVote(val, chainID, 0, 10, 4, 2, blockID2), true},     // precommit in a later round
+		{precommit, makeVote(val, chainID, 0, 10, 3, 1, BlockID{}), false},   // prevote for nil
+		{precommit, makeVote(val, chainID, 0, 10, 3, 1, blockID), false},     // same block
+		{precommit, makeVote(val, chainID, 0, 10, 2, 1, blockID2), false},    // same round
+		{precommit, makeVote(val, chainID, 0, 10, 1, 1, blockID2), false},    // earlier round
+		{precommit, makeVote(val, chainID, 0, 11, 3, 1, blockID2), false},    // another height
+		{precommit, makeVote(val, chainID, 1, 10, 3, 1, blockID2), false},    // another index
+		{precommit, makeVote(val2, chainID, 0, 10, 3, 1, blockID2), false},   // another validator
+		{precommit, makeVote(val, "mychain2", 0, 10, 3, 1, blockID2), false}, // wrong chain id
+		{makeVote(val, chainID, 0, 10, 2, 1, blockID), precommit, false},     // not a precommit
+		{makeVote(val, chainID, 0, 10, 2, 2, BlockID{}), precommit, false},   // precommit for nil
+	}
+
+	pubKey := val.GetPubKey()
+	for i, c := range cases {
+		ev := &AmnesiaEvidence{
+			PubKey:    pubKey,
+			Precommit: c.vote1,
+			Vote:      c.vote2,
+		}
+		if c.valid {
+			assert.Nil(t, ev.Verify(chainID, pubKey), "#%d: evidence should be valid", i)
+		} else {
+			assert.NotNil(t, ev.Verify(chainID, pubKey), "#%d: evidence should be invalid", i)
+		}
+	}
+
+	ev := &AmnesiaEvidence{PubKey: pubKey, Precommit: precommit, Vote: precommit}
+	assert.NoError(t, ev.ValidateBasic())
+	assert.True(t, ev.Equal(ev))
+	assert.False(t, ev.Equal(&AmnesiaEvidence{}))
+	ev.Vote = nil
+	assert.Error(t, ev.ValidateBasic())
+}
+
+func TestConflictingHeadersEvidence(t *testing.T) {
+	const chainID = "mychain"
+	vals := []PrivValidator{NewMockPV(), NewMockPV(), NewMockPV(), NewMockPV()}
+	validators := make([]*Validator, len(vals))
+	for i, val := range vals {
+		validators[i] = NewValidator(val.GetPubKey(), 10, 0)
+	}
+	valSet := NewValidatorSet(validators)
+	index := func(val PrivValidator) int {
+		idx, _ := valSet.GetByAddress(val.GetPubKey().Address())
+		return idx
+	}
+	signedHeader := func(header *Header, round int, signers ...PrivValidator) *SignedHeader {
+		blockID := makeBlockID(header.Hash(), 1, tmhash.Sum([]byte("partshash")))
+		precommits := make([]*CommitSig, len(vals))
+		for _, val := range signers {
+			precommits[index(val)] = makeVote(val, chainID, index(val), header.Height, round, 2, blockID).CommitSig()
+		}
+		return &SignedHeader{Header: header, Commit: NewCommit(blockID, precommits)}
+	}
+	header := &Header{
+		ChainID:            chainID,
+		Height:             10,
+		ValidatorsHash:     valSet.Hash(),
+		NextValidatorsHash: valSet.Hash(),
+		ProposerAddress:    vals[0].GetPubKey().Address(),
+	}
+	committed := signedHeader(header, 1, vals[0], vals[1], vals[2])
+
+	// another app hash, signed in the same round and in a later one
+	forked := *header
+	forked.AppHash = []byte("forked")
+	ev := &ConflictingHeadersEvidence{H1: committed, H2: signedHeader(&forked, 1, vals[0], vals[3])}
+	require.NoError(t, ev.ValidateBasic())
+	require.NoError(t, ev.Verify(chainID, nil))
+	split := ev.Split(*committed, valSet)
+	require.Len(t, split, 1, "the validator who didn't sign the commit can't be blamed")
+	assert.IsType(t, &DuplicateVoteEvidence{}, split[0])
+	assert.NoError(t, split[0].Verify(chainID, vals[0].GetPubKey()))
+
+	ev.H2 = signedHeader(&forked, 2, vals[0], vals[1])
+	split = ev.Split(*committed, valSet)
+	require.Len(t, split, 2)
+	for _, e := range split {
+		assert.IsType(t, &AmnesiaEvidence{}, e)
+		_, val := valSet.GetByAddress(e.Address())
+		require.NotNil(t, val)
+		assert.NoError(t, e.Verify(chainID, val.PubKey))
+	}
+
+	// the validators are lunatic whatever the round
+	lunatic := forked
+	lunatic.NextValidatorsHash = tmhash.Sum([]byte("lunatic"))
+	ev.H2 = signedHeader(&lunatic, 0, vals[2], vals[3])
+	split = ev.Split(*committed, valSet)
+	require.Len(t, split, 2)
+	for _, e := range split {
+		require.IsType(t, &LunaticValidatorEvidence{}, e)
+		assert.Equal(t, HeaderFieldNextValidatorsHash, e.(*LunaticValidatorEvidence).InvalidHeaderField)
+		assert.NoError(t, e.ValidateBasic())
+	}
+
+	// the headers must conflict
+	ev.H2 = committed
+	assert.Error(t, ev.ValidateBasic())
+	ev.H2 = signedHeader(&forked, 1, vals[0])
+	assert.Error(t, ev.Verify("mychain2", nil))
+	assert.True(t, ev.Equal(ev))
+	assert.Nil(t, ev.Address())
+}
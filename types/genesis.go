@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"sort"
 	"time"
 
 	"github.com/tendermint/tendermint/crypto"
@@ -29,7 +30,7 @@ type GenesisValidator struct {
 	PubKey  crypto.PubKey `json:"pub_key"`
 	Power   int64         `json:"power"`
 	Name    string        `json:"name"`
-	Group   int32         `json:"group`
+	Group   int32         `json:"group"`
 }
 
 // GenesisDoc defines the initial conditions for a tendermint blockchain, in particular its validator set.
@@ -38,6 +39,7 @@ type GenesisDoc struct {
 	ChainID         string             `json:"chain_id"`
 	ConsensusParams *ConsensusParams   `json:"consensus_params,omitempty"`
 	Validators      []GenesisValidator `json:"validators,omitempty"`
+	MempoolGroups   []int32            `json:"mempool_groups,omitempty"`
 	AppHash         cmn.HexBytes       `json:"app_hash"`
 	AppState        json.RawMessage    `json:"app_state,omitempty"`
 }
@@ -61,6 +63,19 @@ func (genDoc *GenesisDoc) ValidatorHash() []byte {
 	return vset.Hash()
 }
 
+// MempoolGroupIDs returns the mempool groups open at genesis in ascending
+// order: group 0, which is always open, and the listed MempoolGroups.
+func (genDoc *GenesisDoc) MempoolGroupIDs() []int32 {
+	groups := []int32{0}
+	for _, group := range genDoc.MempoolGroups {
+		if group != 0 {
+			groups = append(groups, group)
+		}
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i] < groups[j] })
+	return groups
+}
+
 // ValidateAndComplete checks that all necessary fields are present
 // and fills in defaults for optional fields left empty
 func (genDoc *GenesisDoc) ValidateAndComplete() error {
@@ -74,6 +89,7 @@ func (genDoc *GenesisDoc) ValidateAndComplete() error {
 	if genDoc.ConsensusParams == nil {
 		genDoc.ConsensusParams = DefaultConsensusParams()
 	} else {
+		genDoc.ConsensusParams.Complete()
 		if err := genDoc.ConsensusParams.Validate(); err != nil {
 			return err
 		}
@@ -91,6 +107,17 @@ func (genDoc *GenesisDoc) ValidateAndComplete() error {
 		}
 	}
 
+	seen := make(map[int32]bool, len(genDoc.MempoolGroups))
+	for _, group := range genDoc.MempoolGroups {
+		if group < 0 {
+			return cmn.NewError("The genesis file cannot contain negative mempool groups: %v", group)
+		}
+		if seen[group] {
+			return cmn.NewError("Duplicate mempool group %v in the genesis file", group)
+		}
+		seen[group] = true
+	}
+
 	if genDoc.GenesisTime.IsZero() {
 		genDoc.GenesisTime = tmtime.Now()
 	}
@@ -27,6 +27,10 @@ func TestGenesisBad(t *testing.T) {
 		[]byte(`{"chain_id": "Lorem ipsum dolor sit amet, consectetuer adipiscing", "validators": [{"pub_key":{"type":"tendermint/PubKeyEd25519","value":"AT/+aaL1eB0477Mud9JMm8Sh8BIvOYlPGC9KkIUmFaE="},"power":"10","name":""}]}`),
 		// wrong address
 		[]byte(`{"chain_id":"mychain", "validators":[{"address": "A", "pub_key":{"type":"tendermint/PubKeyEd25519","value":"AT/+aaL1eB0477Mud9JMm8Sh8BIvOYlPGC9KkIUmFaE="},"power":"10","name":""}]}`),
+		// negative mempool group
+		[]byte(`{"chain_id":"mychain","mempool_groups":[1,-1]}`),
+		// duplicate mempool group
+		[]byte(`{"chain_id":"mychain","mempool_groups":[1,1]}`),
 	}
 
 	for _, testCase := range testCases {
@@ -45,7 +49,7 @@ func TestGenesisGood(t *testing.T) {
 	// create a base gendoc from struct
 	baseGenDoc := &GenesisDoc{
 		ChainID:    "abc",
-		Validators: []GenesisValidator{{pubkey.Address(), pubkey, 10, "myval"}},
+		Validators: []GenesisValidator{{pubkey.Address(), pubkey, 10, "myval", 0}},
 	}
 	genDocBytes, err = cdc.MarshalJSON(baseGenDoc)
 	assert.NoError(t, err, "error marshalling genDoc")
@@ -71,6 +75,12 @@ func TestGenesisGood(t *testing.T) {
 	genDoc, err = GenesisDocFromJSON(genDocBytes)
 	assert.Error(t, err, "expected error for genDoc json with block size of 0")
 
+	// consensus params predating max_age_duration are completed
+	genDocBytes = []byte(`{"genesis_time":"0001-01-01T00:00:00Z","chain_id":"test-chain-QDKdJr","consensus_params":{"block_size":{"max_bytes":"22020096","max_gas":"-1"},"evidence":{"max_age":"100000"},"validator":{"pub_key_types":["ed25519"]}},"validators":[{"pub_key":{"type":"tendermint/PubKeyEd25519","value":"AT/+aaL1eB0477Mud9JMm8Sh8BIvOYlPGC9KkIUmFaE="},"power":"10","name":""}],"app_hash":""}`)
+	genDoc, err = GenesisDocFromJSON(genDocBytes)
+	assert.NoError(t, err, "expected no error for genDoc json without max_age_duration")
+	assert.Equal(t, DefaultEvidenceParams().MaxAgeDuration, genDoc.ConsensusParams.Evidence.MaxAgeDuration)
+
 	// Genesis doc from raw json
 	missingValidatorsTestCases := [][]byte{
 		[]byte(`{"chain_id":"mychain"}`),                   // missing validators
@@ -83,6 +93,11 @@ func TestGenesisGood(t *testing.T) {
 		_, err := GenesisDocFromJSON(tc)
 		assert.NoError(t, err)
 	}
+
+	// group 0 is always open
+	genDoc, err = GenesisDocFromJSON([]byte(`{"chain_id":"mychain","mempool_groups":[3,1]}`))
+	require.NoError(t, err)
+	assert.Equal(t, []int32{0, 1, 3}, genDoc.MempoolGroupIDs())
 }
 
 func TestGenesisSaveAs(t *testing.T) {
@@ -122,7 +137,7 @@ func randomGenesisDoc() *GenesisDoc {
 	return &GenesisDoc{
 		GenesisTime:     tmtime.Now(),
 		ChainID:         "abc",
-		Validators:      []GenesisValidator{{pubkey.Address(), pubkey, 10, "myval"}},
+		Validators:      []GenesisValidator{{pubkey.Address(), pubkey, 10, "myval", 0}},
 		ConsensusParams: DefaultConsensusParams(),
 	}
 }
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	cmn "github.com/tendermint/tendermint/libs/common"
@@ -36,15 +38,25 @@ type BlockSizeParams struct {
 	MaxGas   int64 `json:"max_gas"`
 }
 
-// EvidenceParams determine how we handle evidence of malfeasance
+// EvidenceParams determine how we handle evidence of malfeasance.
+//
+// Evidence is only expired once it is older than both MaxAge blocks and
+// MaxAgeDuration, so that it can't expire early on chains where the block
+// times vary greatly.
 type EvidenceParams struct {
-	MaxAge int64 `json:"max_age"` // only accept new evidence more recent than this
+	MaxAge         int64         `json:"max_age"`          // only accept new evidence more recent than this, in blocks
+	MaxAgeDuration time.Duration `json:"max_age_duration"` // only accept new evidence more recent than this, in block time
 }
 
 // ValidatorParams restrict the public key types validators can use.
 // NOTE: uses ABCI pubkey naming, not Amino names.
+//
+// If GroupValidators is set, the blocks of each group are proposed and signed
+// only by the validators of that group (see ValidatorSet.GroupSet), and groups
+// take turns producing blocks. It can only be set in the genesis.
 type ValidatorParams struct {
-	PubKeyTypes []string `json:"pub_key_types"`
+	PubKeyTypes     []string `json:"pub_key_types"`
+	GroupValidators bool     `json:"group_validators"`
 }
 
 // DefaultConsensusParams returns a default ConsensusParams.
@@ -67,14 +79,15 @@ func DefaultBlockSizeParams() BlockSizeParams {
 // DefaultEvidenceParams Params returns a default EvidenceParams.
 func DefaultEvidenceParams() EvidenceParams {
 	return EvidenceParams{
-		MaxAge: 100000, // 27.8 hrs at 1block/s
+		MaxAge:         100000, // 27.8 hrs at 1block/s
+		MaxAgeDuration: 48 * time.Hour,
 	}
 }
 
 // DefaultValidatorParams returns a default ValidatorParams, which allows
 // only ed25519 pubkeys.
 func DefaultValidatorParams() ValidatorParams {
-	return ValidatorParams{[]string{ABCIPubKeyTypeEd25519}}
+	return ValidatorParams{PubKeyTypes: []string{ABCIPubKeyTypeEd25519}}
 }
 
 func (params *ValidatorParams) IsValidPubkeyType(pubkeyType string) bool {
@@ -86,6 +99,14 @@ func (params *ValidatorParams) IsValidPubkeyType(pubkeyType string) bool {
 	return false
 }
 
+// Complete fills in the defaults of the parameters which genesis files,
+// applications and chains predating them leave unset.
+func (params *ConsensusParams) Complete() {
+	if params.Evidence.MaxAgeDuration == 0 {
+		params.Evidence.MaxAgeDuration = DefaultEvidenceParams().MaxAgeDuration
+	}
+}
+
 // Validate validates the ConsensusParams to ensure all values are within their
 // allowed limits, and returns an error if they are not.
 func (params *ConsensusParams) Validate() error {
@@ -108,6 +129,11 @@ func (params *ConsensusParams) Validate() error {
 			params.Evidence.MaxAge)
 	}
 
+	if params.Evidence.MaxAgeDuration <= 0 {
+		return cmn.NewError("EvidenceParams.MaxAgeDuration must be greater than 0. Got %v",
+			params.Evidence.MaxAgeDuration)
+	}
+
 	if len(params.Validator.PubKeyTypes) == 0 {
 		return cmn.NewError("len(Validator.PubKeyTypes) must be greater than 0")
 	}
@@ -144,7 +170,8 @@ func (params *ConsensusParams) Hash() []byte {
 func (params *ConsensusParams) Equals(params2 *ConsensusParams) bool {
 	return params.BlockSize == params2.BlockSize &&
 		params.Evidence == params2.Evidence &&
-		cmn.StringSliceEqual(params.Validator.PubKeyTypes, params2.Validator.PubKeyTypes)
+		cmn.StringSliceEqual(params.Validator.PubKeyTypes, params2.Validator.PubKeyTypes) &&
+		params.Validator.GroupValidators == params2.Validator.GroupValidators
 }
 
 // Update returns a copy of the params with updates from the non-zero fields of p2.
@@ -163,6 +190,10 @@ func (params ConsensusParams) Update(params2 *abci.ConsensusParams) ConsensusPar
 	}
 	if params2.Evidence != nil {
 		res.Evidence.MaxAge = params2.Evidence.MaxAge
+		// apps unaware of the duration leave it unset
+		if params2.Evidence.MaxAgeDuration > 0 {
+			res.Evidence.MaxAgeDuration = params2.Evidence.MaxAgeDuration
+		}
 	}
 	if params2.Validator != nil {
 		// Copy params2.Validator.PubkeyTypes, and set result's value to the copy.
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -29,12 +30,14 @@ func TestConsensusParamsValidation(t *testing.T) {
 		6: {makeParams(1024*1024*1024, 0, 1, valEd25519), false},
 		7: {makeParams(1024*1024*1024, 0, -1, valEd25519), false},
 		// test evidence age
-		8: {makeParams(1, 0, 0, valEd25519), false},
-		9: {makeParams(1, 0, -1, valEd25519), false},
+		8:  {makeParams(1, 0, 0, valEd25519), false},
+		9:  {makeParams(1, 0, -1, valEd25519), false},
+		10: {makeParamsWithEvidenceDuration(1, 0, 1, 0, valEd25519), false},
+		11: {makeParamsWithEvidenceDuration(1, 0, 1, -time.Second, valEd25519), false},
 		// test no pubkey type provided
-		10: {makeParams(1, 0, 1, []string{}), false},
+		12: {makeParams(1, 0, 1, []string{}), false},
 		// test invalid pubkey type provided
-		11: {makeParams(1, 0, 1, []string{"potatoes make good pubkeys"}), false},
+		13: {makeParams(1, 0, 1, []string{"potatoes make good pubkeys"}), false},
 	}
 	for i, tc := range testCases {
 		if tc.valid {
@@ -46,13 +49,19 @@ func TestConsensusParamsValidation(t *testing.T) {
 }
 
 func makeParams(blockBytes, blockGas, evidenceAge int64, pubkeyTypes []string) ConsensusParams {
+	return makeParamsWithEvidenceDuration(blockBytes, blockGas, evidenceAge, 48*time.Hour, pubkeyTypes)
+}
+
+func makeParamsWithEvidenceDuration(blockBytes, blockGas, evidenceAge int64,
+	evidenceAgeDuration time.Duration, pubkeyTypes []string) ConsensusParams {
 	return ConsensusParams{
 		BlockSize: BlockSizeParams{
 			MaxBytes: blockBytes,
 			MaxGas:   blockGas,
 		},
 		Evidence: EvidenceParams{
-			MaxAge: evidenceAge,
+			MaxAge:         evidenceAge,
+			MaxAgeDuration: evidenceAgeDuration,
 		},
 		Validator: ValidatorParams{
 			PubKeyTypes: pubkeyTypes,
@@ -116,8 +125,30 @@ func TestConsensusParamsUpdate(t *testing.T) {
 			},
 			makeParams(100, 200, 300, valSecp256k1),
 		},
+		// evidence duration update
+		{
+			makeParams(1, 2, 3, valEd25519),
+			&abci.ConsensusParams{
+				Evidence: &abci.EvidenceParams{
+					MaxAge:         300,
+					MaxAgeDuration: time.Hour,
+				},
+			},
+			makeParamsWithEvidenceDuration(1, 2, 300, time.Hour, valEd25519),
+		},
 	}
 	for _, tc := range testCases {
 		assert.Equal(t, tc.updatedParams, tc.params.Update(tc.updates))
 	}
 }
+
+func TestConsensusParamsComplete(t *testing.T) {
+	params := makeParamsWithEvidenceDuration(1, 2, 3, 0, valEd25519)
+	params.Complete()
+	assert.Equal(t, DefaultEvidenceParams().MaxAgeDuration, params.Evidence.MaxAgeDuration)
+	assert.NoError(t, params.Validate())
+
+	params = makeParamsWithEvidenceDuration(1, 2, 3, time.Hour, valEd25519)
+	params.Complete()
+	assert.Equal(t, time.Hour, params.Evidence.MaxAgeDuration)
+}
@@ -17,6 +17,8 @@ import (
 
 const (
 	ABCIEvidenceTypeDuplicateVote = "duplicate/vote"
+	ABCIEvidenceTypeLunatic       = "lunatic/header"
+	ABCIEvidenceTypeAmnesia       = "amnesia/vote"
 	ABCIEvidenceTypeMockGood      = "mock/good"
 )
 
@@ -139,7 +141,8 @@ func (tm2pb) ConsensusParams(params *ConsensusParams) *abci.ConsensusParams {
 			MaxGas:   params.BlockSize.MaxGas,
 		},
 		Evidence: &abci.EvidenceParams{
-			MaxAge: params.Evidence.MaxAge,
+			MaxAge:         params.Evidence.MaxAge,
+			MaxAgeDuration: params.Evidence.MaxAgeDuration,
 		},
 		Validator: &abci.ValidatorParams{
 			PubKeyTypes: params.Validator.PubKeyTypes,
@@ -162,6 +165,10 @@ func (tm2pb) Evidence(ev Evidence, valSet *ValidatorSet, evTime time.Time) abci.
 	switch ev.(type) {
 	case *DuplicateVoteEvidence:
 		evType = ABCIEvidenceTypeDuplicateVote
+	case *LunaticValidatorEvidence:
+		evType = ABCIEvidenceTypeLunatic
+	case *AmnesiaEvidence:
+		evType = ABCIEvidenceTypeAmnesia
 	case MockGoodEvidence:
 		// XXX: not great to have test types in production paths ...
 		evType = ABCIEvidenceTypeMockGood
@@ -239,16 +246,19 @@ func (pb2tm) ValidatorUpdates(vals []abci.ValidatorUpdate) ([]*Validator, error)
 }
 
 func (pb2tm) ConsensusParams(csp *abci.ConsensusParams) ConsensusParams {
-	return ConsensusParams{
+	params := ConsensusParams{
 		BlockSize: BlockSizeParams{
 			MaxBytes: csp.BlockSize.MaxBytes,
 			MaxGas:   csp.BlockSize.MaxGas,
 		},
 		Evidence: EvidenceParams{
-			MaxAge: csp.Evidence.MaxAge,
+			MaxAge:         csp.Evidence.MaxAge,
+			MaxAgeDuration: csp.Evidence.MaxAgeDuration,
 		},
 		Validator: ValidatorParams{
 			PubKeyTypes: csp.Validator.PubKeyTypes,
 		},
 	}
+	params.Complete()
+	return params
 }
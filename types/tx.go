@@ -120,6 +120,7 @@ type TxResult struct {
 	Index  uint32                 `json:"index"`
 	Tx     Tx                     `json:"tx"`
 	Result abci.ResponseDeliverTx `json:"result"`
+	Group  int32                  `json:"group"`
 }
 
 // ComputeAminoOverhead calculates the overhead for amino encoding a transaction.
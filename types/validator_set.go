@@ -338,6 +338,88 @@ func (vals *ValidatorSet) Iterate(fn func(index int, val *Validator) bool) {
 	}
 }
 
+//-----------------------------------------------------------------------------
+// Groups
+//
+// With ValidatorParams.GroupValidators, the blocks of group N are proposed and
+// signed only by the validators whose Group is N. Group 0 blocks, and blocks of
+// groups without validators, fall back to the whole set.
+
+// Groups returns the ids of the groups having validators, plus group 0, in
+// ascending order.
+func (vals *ValidatorSet) Groups() []int32 {
+	seen := map[int32]bool{0: true}
+	groups := []int32{0}
+	for _, val := range vals.Validators {
+		if !seen[val.Group] {
+			seen[val.Group] = true
+			groups = append(groups, val.Group)
+		}
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i] < groups[j] })
+	return groups
+}
+
+// groupMembers returns the validators of the given group, or nil if the
+// group falls back to the whole set. The returned validators are not copied.
+func (vals *ValidatorSet) groupMembers(group int32) []*Validator {
+	if group == 0 {
+		return nil
+	}
+	var members []*Validator
+	for _, val := range vals.Validators {
+		if val.Group == group {
+			members = append(members, val)
+		}
+	}
+	return members
+}
+
+// GroupSet returns a copy of the validators proposing and signing blocks of
+// the given group, keeping their ProposerPriority. Indices in the returned set
+// are the ones used by commits of the group's blocks.
+func (vals *ValidatorSet) GroupSet(group int32) *ValidatorSet {
+	members := vals.groupMembers(group)
+	if members == nil {
+		return vals.Copy()
+	}
+	// NOTE: don't use NewValidatorSet, it would increment priorities.
+	return &ValidatorSet{Validators: validatorListCopy(members)}
+}
+
+// IncrementGroupProposerPriority is like IncrementProposerPriority, but only
+// rotates the proposer among the validators of the given group, so every
+// group keeps its own rotation. The proposer of the whole set is reset.
+func (vals *ValidatorSet) IncrementGroupProposerPriority(group int32, times int) {
+	members := vals.groupMembers(group)
+	if members == nil {
+		vals.IncrementProposerPriority(times)
+		return
+	}
+	// members point into vals, so priorities are updated in place.
+	groupVals := &ValidatorSet{Validators: members}
+	groupVals.IncrementProposerPriority(times)
+	vals.Proposer = nil
+}
+
+// NextGroup returns the group of the block following a block of group last:
+// the groups returned by Groups take turns in ascending order.
+func (vals *ValidatorSet) NextGroup(last int32) int32 {
+	groups := vals.Groups()
+	for _, group := range groups {
+		if group > last {
+			return group
+		}
+	}
+	return groups[0]
+}
+
+// VerifyGroupCommit verifies that +2/3 of the validators of the given group
+// signed the commit. See GroupSet and VerifyCommit.
+func (vals *ValidatorSet) VerifyGroupCommit(chainID string, blockID BlockID, height int64, group int32, commit *Commit) error {
+	return vals.GroupSet(group).VerifyCommit(chainID, blockID, height, commit)
+}
+
 // Checks changes against duplicates, splits the changes in updates and removals, sorts them by address
 //
 // Returns:
@@ -660,6 +742,20 @@ func (vals *ValidatorSet) VerifyCommit(chainID string, blockID BlockID, height i
 // commit height is greater than the height for this validator set.
 func (vals *ValidatorSet) VerifyFutureCommit(newSet *ValidatorSet, chainID string,
 	blockID BlockID, height int64, commit *Commit) error {
+	return vals.VerifyCommitTrusting(newSet, chainID, blockID, height, commit, 2, 3)
+}
+
+// VerifyCommitTrusting is like VerifyFutureCommit, but only requires more than
+// trustNumerator/trustDenominator of the power in the old vals to have signed
+// the commit.
+//
+// With a trust level of 1/3, at least one correct validator of the old set
+// vouches for the new one, as long as less than 1/3 of the old vals are
+// Byzantine. Light clients use it to skip over validator set changes without
+// downloading every header in between, falling back to bisection when too
+// much has changed.
+func (vals *ValidatorSet) VerifyCommitTrusting(newSet *ValidatorSet, chainID string,
+	blockID BlockID, height int64, commit *Commit, trustNumerator, trustDenominator int64) error {
 	oldVals := vals
 
 	// Commit must be a valid commit for newSet.
@@ -707,8 +803,11 @@ func (vals *ValidatorSet) VerifyFutureCommit(newSet *ValidatorSet, chainID strin
 		}
 	}
 
-	if oldVotingPower <= oldVals.TotalVotingPower()*2/3 {
-		return errTooMuchChange{oldVotingPower, oldVals.TotalVotingPower()*2/3 + 1}
+	// The product may not fit in an int64 for large trust levels.
+	threshold := new(big.Int).Mul(big.NewInt(oldVals.TotalVotingPower()), big.NewInt(trustNumerator))
+	threshold.Quo(threshold, big.NewInt(trustDenominator))
+	if oldVotingPower <= threshold.Int64() {
+		return errTooMuchChange{oldVotingPower, threshold.Int64() + 1}
 	}
 	return nil
 }
@@ -115,7 +115,7 @@ func BenchmarkValidatorSetCopy(b *testing.B) {
 	for i := 0; i < 1000; i++ {
 		privKey := ed25519.GenPrivKey()
 		pubKey := privKey.PubKey()
-		val := NewValidator(pubKey, 10)
+		val := NewValidator(pubKey, 10, 0)
 		err := vset.UpdateWithChangeSet([]*Validator{val})
 		if err != nil {
 			panic("Failed to add validator")
@@ -284,7 +284,7 @@ func randPubKey() crypto.PubKey {
 func randValidator_(totalVotingPower int64) *Validator {
 	// this modulo limits the ProposerPriority/VotingPower to stay in the
 	// bounds of MaxTotalVotingPower minus the already existing voting power:
-	val := NewValidator(randPubKey(), int64(cmn.RandUint64()%uint64((MaxTotalVotingPower-totalVotingPower))))
+	val := NewValidator(randPubKey(), int64(cmn.RandUint64()%uint64((MaxTotalVotingPower-totalVotingPower))), 0)
 	val.ProposerPriority = cmn.RandInt64() % (MaxTotalVotingPower - totalVotingPower)
 	return val
 }
@@ -545,7 +545,7 @@ func TestSafeSubClip(t *testing.T) {
 func TestValidatorSetVerifyCommit(t *testing.T) {
 	privKey := ed25519.GenPrivKey()
 	pubKey := privKey.PubKey()
-	v1 := NewValidator(pubKey, 1000)
+	v1 := NewValidator(pubKey, 1000, 0)
 	vset := NewValidatorSet([]*Validator{v1})
 
 	chainID := "mychainID"
@@ -594,6 +594,73 @@ func TestValidatorSetVerifyCommit(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestValidatorSetGroups(t *testing.T) {
+	privKeys := make([]crypto.PrivKey, 4)
+	vals := make([]*Validator, 4)
+	for i := range vals {
+		privKeys[i] = ed25519.GenPrivKey()
+		vals[i] = NewValidator(privKeys[i].PubKey(), 10, int32(1+i/2)) // groups 1, 1, 2, 2
+	}
+	vset := NewValidatorSet(vals)
+	assert.Equal(t, []int32{0, 1, 2}, vset.Groups())
+	assert.EqualValues(t, 1, vset.NextGroup(0))
+	assert.EqualValues(t, 2, vset.NextGroup(1))
+	assert.EqualValues(t, 0, vset.NextGroup(2))
+	assert.EqualValues(t, 0, vset.NextGroup(3))
+
+	// group 0 and groups without validators fall back to the whole set
+	assert.Equal(t, vset.Hash(), vset.GroupSet(0).Hash())
+	assert.Equal(t, vset.Hash(), vset.GroupSet(3).Hash())
+	g1 := vset.GroupSet(1)
+	assert.Equal(t, 2, g1.Size())
+	for _, val := range g1.Validators {
+		assert.EqualValues(t, 1, val.Group)
+	}
+
+	// rotating group 1 leaves the priorities of group 2 untouched
+	before := vset.GroupSet(2)
+	proposers := map[string]bool{}
+	for i := 0; i < 4; i++ {
+		vset.IncrementGroupProposerPriority(1, 1)
+		proposer := vset.GroupSet(1).GetProposer()
+		assert.EqualValues(t, 1, proposer.Group)
+		proposers[string(proposer.Address)] = true
+	}
+	assert.Len(t, proposers, 2, "both validators of group 1 should propose")
+	after := vset.GroupSet(2)
+	for i := range before.Validators {
+		assert.Equal(t, before.Validators[i].ProposerPriority, after.Validators[i].ProposerPriority)
+	}
+
+	// a commit signed by group 1 is enough for group 1 but not for the whole set
+	chainID := "mychainID"
+	blockID := BlockID{Hash: []byte("hello")}
+	height := int64(5)
+	commitSigs := make([]*CommitSig, g1.Size())
+	for _, privKey := range privKeys {
+		idx, val := g1.GetByAddress(privKey.PubKey().Address())
+		if val == nil {
+			continue
+		}
+		vote := &Vote{
+			ValidatorAddress: val.Address,
+			ValidatorIndex:   idx,
+			Height:           height,
+			Timestamp:        tmtime.Now(),
+			Type:             PrecommitType,
+			BlockID:          blockID,
+		}
+		sig, err := privKey.Sign(vote.SignBytes(chainID))
+		assert.NoError(t, err)
+		vote.Signature = sig
+		commitSigs[idx] = vote.CommitSig()
+	}
+	commit := NewCommit(blockID, commitSigs)
+	assert.NoError(t, vset.VerifyGroupCommit(chainID, blockID, height, 1, commit))
+	assert.Error(t, vset.VerifyGroupCommit(chainID, blockID, height, 2, commit))
+	assert.Error(t, vset.VerifyCommit(chainID, blockID, height, commit))
+}
+
 func TestEmptySet(t *testing.T) {
 
 	var valList []*Validator